@@ -57,6 +57,10 @@ type Context struct {
 	// the server is running ("node"), or the user passed in client calls.
 	User string
 
+	// Password of User, used for password authentication by clients. If set,
+	// clients do not present a certificate.
+	Password string
+
 	// Protects both clientTLSConfig and serverTLSConfig.
 	tlsConfigMu sync.Mutex
 	// clientTLSConfig is the loaded client tlsConfig. It is initialized lazily.
@@ -97,7 +101,9 @@ func (ctx *Context) HTTPRequestScheme() string {
 
 // GetClientTLSConfig returns the context client TLS config, initializing it if needed.
 // If Insecure is true, return a nil config, otherwise load a config based
-// on the Certs directory. If Certs is empty, use a very permissive config.
+// on the Certs directory. If Certs is empty, use a very permissive config. If
+// a Password is set, the config only verifies the server and does not present
+// a client certificate.
 // TODO(marc): empty Certs dir should fail when client certificates are required.
 func (ctx *Context) GetClientTLSConfig() (*tls.Config, error) {
 	// Early out.
@@ -112,7 +118,13 @@ func (ctx *Context) GetClientTLSConfig() (*tls.Config, error) {
 		return ctx.clientTLSConfig, nil
 	}
 
-	if ctx.Certs != "" {
+	if ctx.Certs != "" && ctx.Password != "" {
+		cfg, err := security.LoadClientCATLSConfig(ctx.Certs)
+		if err != nil {
+			return nil, util.Errorf("error setting up client TLS config: %s", err)
+		}
+		ctx.clientTLSConfig = cfg
+	} else if ctx.Certs != "" {
 		cfg, err := security.LoadClientTLSConfig(ctx.Certs, ctx.User)
 		if err != nil {
 			return nil, util.Errorf("error setting up client TLS config: %s", err)
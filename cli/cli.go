@@ -74,6 +74,7 @@ func init() {
 		userCmd,
 		rangeCmd,
 		zoneCmd,
+		nodeCmd,
 
 		// Miscellaneous commands.
 		// TODO(pmattis): stats
@@ -49,6 +49,11 @@ func (v pflagValue) IsBoolFlag() bool {
 var flagUsage = map[string]string{
 	"addr": `
         The host:port to bind for HTTP/RPC traffic.
+`,
+	"auth-method": `
+        The authentication method of the user: "password" to authenticate
+        with the user's password over TLS, or "cert" to authenticate with a
+        client certificate.
 `,
 	"attrs": `
         An ordered, colon-separated list of node attributes. Attributes are
@@ -62,6 +67,15 @@ var flagUsage = map[string]string{
         nodes. For example:
 
           --attrs=us-west-1b,gpu.
+`,
+	"locality": `
+        An ordered, comma-separated list of key=value tiers describing the
+        location of the node, from the most general tier to the most
+        specific one. Replicas of each range are spread across as many
+        distinct localities as possible. The same tier keys should be
+        specified in the same order for all nodes. For example:
+
+          --locality=region=us-east,zone=us-east-1a,rack=12
 `,
 	"cache-size": `
         Total size in bytes for caches, shared evenly if there are multiple
@@ -112,6 +126,11 @@ var flagUsage = map[string]string{
 `,
 	"metrics-frequency": `
         Adjust the frequency at which the server records its own internal metrics.
+`,
+	"closed-timestamp-interval": `
+        Adjusts how often leader lease holders publish the timestamp below
+        which follower replicas may serve reads. Publishing proposes a command
+        on every idle range, so follower reads are disabled (0) by default.
 `,
 	"scan-interval": `
         Adjusts the target for the duration of a single scan through a store's
@@ -178,6 +197,7 @@ func initFlags(ctx *server.Context) {
 		// Server flags.
 		f.StringVar(&ctx.Addr, "addr", ctx.Addr, flagUsage["addr"])
 		f.StringVar(&ctx.Attrs, "attrs", ctx.Attrs, flagUsage["attrs"])
+		f.Var(&ctx.Locality, "locality", flagUsage["locality"])
 		f.StringVar(&ctx.Stores, "stores", ctx.Stores, flagUsage["stores"])
 		f.DurationVar(&ctx.MaxOffset, "max-offset", ctx.MaxOffset, flagUsage["max-offset"])
 		f.DurationVar(&ctx.MetricsFrequency, "metrics-frequency", ctx.MetricsFrequency, flagUsage["metrics-frequency"])
@@ -196,6 +216,7 @@ func initFlags(ctx *server.Context) {
 
 		// Engine flags.
 		f.Int64Var(&ctx.CacheSize, "cache-size", ctx.CacheSize, flagUsage["cache-size"])
+		f.DurationVar(&ctx.ClosedTimestampInterval, "closed-timestamp-interval", ctx.ClosedTimestampInterval, flagUsage["closed-timestamp-interval"])
 		f.DurationVar(&ctx.ScanInterval, "scan-interval", ctx.ScanInterval, flagUsage["scan-interval"])
 		f.DurationVar(&ctx.ScanMaxIdleTime, "scan-max-idle-time", ctx.ScanMaxIdleTime, flagUsage["scan-max-idle-time"])
 		f.DurationVar(&ctx.TimeUntilStoreDead, "time-until-store-dead", ctx.TimeUntilStoreDead, flagUsage["time-until-store-dead"])
@@ -230,7 +251,7 @@ func initFlags(ctx *server.Context) {
 
 	clientCmds := []*cobra.Command{
 		sqlShellCmd, kvCmd, rangeCmd,
-		userCmd, zoneCmd,
+		userCmd, zoneCmd, nodeCmd,
 		exterminateCmd, quitCmd, /* startCmd is covered above */
 	}
 	for _, cmd := range clientCmds {
@@ -242,6 +263,11 @@ func initFlags(ctx *server.Context) {
 		f.StringVar(&ctx.Certs, "certs", ctx.Certs, flagUsage["certs"])
 	}
 
+	{
+		f := setUserCmd.Flags()
+		f.StringVar(&authMethod, "auth-method", authMethod, flagUsage["auth-method"])
+	}
+
 	// Max results flag for scan, reverse scan, and range list.
 	for _, cmd := range []*cobra.Command{scanCmd, reverseScanCmd, lsRangesCmd} {
 		f := cmd.Flags()
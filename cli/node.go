@@ -0,0 +1,136 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+
+	"github.com/spf13/cobra"
+)
+
+// decommissionPollInterval is the interval at which the decommission
+// command reports the progress of moving replicas off the node.
+const decommissionPollInterval = 2 * time.Second
+
+// A decommissionNodeCmd command decommissions a node.
+var decommissionNodeCmd = &cobra.Command{
+	Use:   "decommission [options] <node-id>",
+	Short: "decommissions a node",
+	Long: `
+Marks the node with the given ID for decommissioning. No new replicas are
+placed on a decommissioning node and its existing replicas are moved to
+other nodes. The command reports the number of replicas remaining on the
+node until there are none left, at which point the node can safely be
+shut down and removed from the cluster.
+`,
+	Run: runDecommissionNode,
+}
+
+func runDecommissionNode(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		mustUsage(cmd)
+		return
+	}
+	id, err := strconv.ParseInt(args[0], 10, 32)
+	if err != nil || id <= 0 {
+		fmt.Fprintf(os.Stderr, "invalid node ID %q\n", args[0])
+		osExit(1)
+		return
+	}
+	nodeID := roachpb.NodeID(id)
+
+	kvDB, stopper := makeDBClient()
+	defer stopper.Stop()
+	if status, err := kvDB.Get(keys.NodeStatusKey(int32(nodeID))); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to look up node %d: %s\n", nodeID, err)
+		osExit(1)
+		return
+	} else if !status.Exists() {
+		fmt.Fprintf(os.Stderr, "node %d not found\n", nodeID)
+		osExit(1)
+		return
+	}
+	if err := kvDB.Put(keys.NodeDecommissionKey(int32(nodeID)), time.Now()); err != nil {
+		fmt.Fprintf(os.Stderr, "decommission failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	fmt.Printf("node %d marked for decommissioning\n", nodeID)
+
+	remaining := -1
+	for {
+		count, err := countNodeReplicas(kvDB, nodeID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to count replicas of node %d: %s\n", nodeID, err)
+			osExit(1)
+			return
+		}
+		if count == 0 {
+			break
+		}
+		if count != remaining {
+			fmt.Printf("node %d: %d replica(s) remaining\n", nodeID, count)
+			remaining = count
+		}
+		time.Sleep(decommissionPollInterval)
+	}
+	fmt.Printf("node %d has no replicas left and is safe to remove\n", nodeID)
+}
+
+// countNodeReplicas returns the number of replicas on the given node,
+// according to the range addressing records.
+func countNodeReplicas(kvDB *client.DB, nodeID roachpb.NodeID) (int, error) {
+	rows, err := kvDB.Scan(keys.Meta2Prefix, keys.Meta2Prefix.PrefixEnd(), 0)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, row := range rows {
+		desc := &roachpb.RangeDescriptor{}
+		if err := row.ValueProto(desc); err != nil {
+			return 0, err
+		}
+		for _, replica := range desc.Replicas {
+			if replica.NodeID == nodeID {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+var nodeCmds = []*cobra.Command{
+	decommissionNodeCmd,
+}
+
+var nodeCmd = &cobra.Command{
+	Use:   "node",
+	Short: "manage nodes",
+	Run: func(cmd *cobra.Command, args []string) {
+		mustUsage(cmd)
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeCmds...)
+}
@@ -18,7 +18,10 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util/log"
 
 	"github.com/spf13/cobra"
@@ -63,7 +66,7 @@ func runLsUsers(cmd *cobra.Command, args []string) {
 		return
 	}
 	db := makeSQLClient()
-	err := runPrettyQuery(db, `SELECT username FROM system.users`)
+	err := runPrettyQuery(db, `SHOW USERS`)
 	if err != nil {
 		log.Error(err)
 		return
@@ -86,7 +89,7 @@ func runRmUser(cmd *cobra.Command, args []string) {
 		return
 	}
 	db := makeSQLClient()
-	err := runPrettyQuery(db, `DELETE FROM system.users WHERE username=$1`, args[0])
+	err := runPrettyQuery(db, `DROP USER `+parser.Name(args[0]).String())
 	if err != nil {
 		log.Error(err)
 		return
@@ -99,13 +102,16 @@ var setUserCmd = &cobra.Command{
 	Short: "create or update a user config for key prefix",
 	Long: `
 Create or update a user config for the specified username, prompting
-for the password.
+for the password unless the user authenticates with a certificate.
 `,
 	Run: runSetUser,
 }
 
-// runSetUser prompts for a password, then inserts the user and hash
-// into the system.users table.
+// authMethod is the authentication method set by "user set".
+var authMethod = security.PasswordAuthMethod
+
+// runSetUser creates the user, or sets the password of an existing user.
+// Users authenticating with a password are prompted for it.
 // TODO(marc): once we have more fields in the user config, we will need
 // to allow changing just some of them (eg: change email, but leave password).
 func runSetUser(cmd *cobra.Command, args []string) {
@@ -113,14 +119,38 @@ func runSetUser(cmd *cobra.Command, args []string) {
 		mustUsage(cmd)
 		return
 	}
-	hashed, err := security.PromptForPasswordAndHash()
+	var password string
+	switch authMethod {
+	case security.PasswordAuthMethod:
+		var err error
+		if password, err = security.PromptForPassword(); err != nil {
+			log.Error(err)
+			return
+		}
+	case security.CertAuthMethod:
+	default:
+		log.Error(fmt.Errorf("unknown authentication method %q, expected %q or %q",
+			authMethod, security.CertAuthMethod, security.PasswordAuthMethod))
+		return
+	}
+	db := makeSQLClient()
+	_, rows, err := runQuery(db, `SELECT username FROM system.users WHERE username=$1`, args[0])
 	if err != nil {
 		log.Error(err)
 		return
 	}
-	db := makeSQLClient()
-	// TODO(marc): switch to UPSERT.
-	err = runPrettyQuery(db, `INSERT INTO system.users VALUES ($1, $2)`, args[0], hashed)
+	// The password is passed as a placeholder to keep it out of the statement.
+	name := parser.Name(args[0]).String()
+	switch {
+	case len(rows) == 0 && password == "":
+		err = runPrettyQuery(db, `CREATE USER `+name)
+	case len(rows) == 0:
+		err = runPrettyQuery(db, `CREATE USER `+name+` WITH PASSWORD $1`, password)
+	case password != "":
+		err = runPrettyQuery(db, `ALTER USER `+name+` WITH PASSWORD $1`, password)
+	default:
+		err = fmt.Errorf("user %s already exists", args[0])
+	}
 	if err != nil {
 		log.Error(err)
 		return
@@ -175,6 +175,8 @@ The zone config format has the following YAML schema:
     - attrs:  ...
   range_min_bytes: <size-in-bytes>
   range_max_bytes: <size-in-bytes>
+  range_max_qps: <requests-per-second>
+  lease_preferences: [ordered list of key=value,... localities]
 
 For example:
 
@@ -184,6 +186,7 @@ For example:
     - attrs: [us-west-1b, ssd]
   range_min_bytes: 8388608
   range_max_bytes: 67108864
+  lease_preferences: [region=us-east]
 `,
 	Run: runSetZone,
 }
@@ -172,6 +172,8 @@ func (b *Batch) fillResults(br *roachpb.BatchResponse, pErr *roachpb.Error) erro
 			case *roachpb.MergeRequest:
 			case *roachpb.TruncateLogRequest:
 			case *roachpb.LeaderLeaseRequest:
+			case *roachpb.TransferLeaseRequest:
+			case *roachpb.CheckConsistencyRequest:
 				// Nothing to do for these methods as they do not generate any
 				// rows.
 
@@ -396,6 +398,24 @@ func (b *Batch) adminMerge(key interface{}) {
 	b.initResult(1, 0, nil)
 }
 
+// checkConsistency is only exported on DB. It is here for symmetry with
+// the other operations.
+func (b *Batch) checkConsistency(key interface{}, withDiff bool) {
+	k, err := marshalKey(key)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	req := &roachpb.CheckConsistencyRequest{
+		Span: roachpb.Span{
+			Key: k,
+		},
+		WithDiff: withDiff,
+	}
+	b.reqs = append(b.reqs, req)
+	b.initResult(1, 0, nil)
+}
+
 // adminSplit is only exported on DB. It is here for symmetry with the
 // other operations.
 func (b *Batch) adminSplit(splitKey interface{}) {
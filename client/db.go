@@ -392,6 +392,19 @@ func (db *DB) AdminMerge(key interface{}) error {
 	return err
 }
 
+// CheckConsistency runs a consistency check on the range containing key,
+// comparing the data of all its replicas with the data of its leader.
+// Replicas which differ from the leader log an error. If withDiff is set,
+// they also log the key/value pairs which differ.
+//
+// key can be either a byte slice or a string.
+func (db *DB) CheckConsistency(key interface{}, withDiff bool) error {
+	b := db.NewBatch()
+	b.checkConsistency(key, withDiff)
+	_, err := runOneResult(db, b)
+	return err
+}
+
 // AdminSplit splits the range at splitkey.
 //
 // key can be either a byte slice or a string.
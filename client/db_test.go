@@ -355,6 +355,7 @@ func TestCommonMethods(t *testing.T) {
 		key{batchType, "InternalAddRequest"}:      {},
 		key{dbType, "AdminMerge"}:                 {},
 		key{dbType, "AdminSplit"}:                 {},
+		key{dbType, "CheckConsistency"}:           {},
 		key{dbType, "NewBatch"}:                   {},
 		key{dbType, "Run"}:                        {},
 		key{dbType, "RunWithResponse"}:            {},
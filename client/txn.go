@@ -45,6 +45,11 @@ var DefaultTxnRetryOptions = retry.Options{
 type txnSender Txn
 
 func (ts *txnSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+	// Each batch of transactional writes is assigned the next sequence
+	// number, which allows its writes to be rolled back to a savepoint.
+	if ba.IsTransactionWrite() {
+		ts.Proto.Sequence++
+	}
 	// Send call through wrapped sender.
 	ba.Txn = &ts.Proto
 	br, pErr := ts.wrapped.Send(ctx, ba)
@@ -342,6 +347,32 @@ func (txn *Txn) Rollback() error {
 	return txn.sendEndTxnReq(false /* commit */)
 }
 
+// Savepoint returns a savepoint for the current state of the transaction.
+// Passing it to RollbackToSavepoint discards the writes made by the
+// transaction after this call.
+func (txn *Txn) Savepoint() int32 {
+	return txn.Proto.Sequence
+}
+
+// RollbackToSavepoint discards the writes made by the transaction since
+// the given savepoint was taken. The discarded writes become invisible to
+// the transaction immediately and their intents are removed (or restored to
+// their earlier values) when the transaction's intents are resolved.
+func (txn *Txn) RollbackToSavepoint(savepoint int32) error {
+	if savepoint < 0 || savepoint > txn.Proto.Sequence {
+		return util.Errorf("invalid savepoint %d: transaction is at sequence %d", savepoint, txn.Proto.Sequence)
+	}
+	if savepoint == txn.Proto.Sequence {
+		// Nothing has been written since the savepoint.
+		return nil
+	}
+	txn.Proto.RolledBack = append(txn.Proto.RolledBack, roachpb.SequenceRange{
+		Start: savepoint + 1,
+		End:   txn.Proto.Sequence,
+	})
+	return nil
+}
+
 func (txn *Txn) sendEndTxnReq(commit bool) error {
 	_, pErr := txn.send(endTxnReq(commit, txn.systemDBTrigger))
 	return pErr.GoError()
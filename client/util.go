@@ -74,6 +74,13 @@ func marshalValue(v interface{}) (roachpb.Value, error) {
 		r.SetTime(t)
 		return r, nil
 
+	case roachpb.Value:
+		// Only the tagged bytes are copied; the checksum and timestamp are
+		// computed for the new write.
+		r.Bytes = t.Bytes
+		r.Tag = t.Tag
+		return r, nil
+
 	case proto.Message:
 		err := r.SetProto(t)
 		return r, err
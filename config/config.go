@@ -78,6 +78,18 @@ func (z *ZoneConfig) Validate() error {
 		return util.Errorf("RangeMinBytes %d is greater than or equal to RangeMaxBytes %d",
 			z.RangeMinBytes, z.RangeMaxBytes)
 	}
+	if z.RangeMaxQPS < 0 {
+		return util.Errorf("RangeMaxQPS %d is negative", z.RangeMaxQPS)
+	}
+	for _, pref := range z.LeasePreferences {
+		var locality roachpb.Locality
+		if err := locality.Set(pref); err != nil {
+			return err
+		}
+		if len(locality.Tiers) == 0 {
+			return util.Errorf("lease preference must specify at least one locality tier")
+		}
+	}
 	return nil
 }
 
@@ -65,6 +65,14 @@ type ZoneConfig struct {
 	// If GC policy is not set, uses the next highest, non-null policy
 	// in the zone config hierarchy, up to the default policy if necessary.
 	GC *GCPolicy `protobuf:"bytes,4,opt,name=gc" json:"gc,omitempty" yaml:"gc,omitempty"`
+	// RangeMaxQPS is the request rate above which a range is split at a key
+	// that balances its load. Zero disables load-based splitting.
+	RangeMaxQPS int64 `protobuf:"varint,5,opt,name=range_max_qps" json:"range_max_qps" yaml:"range_max_qps,omitempty"`
+	// LeasePreferences is an ordered list of localities, in the format
+	// accepted by roachpb.Locality.Set, in which the leader lease of a range
+	// should preferably be held. The lease is moved to a replica matching
+	// the first preference matched by any replica of the range.
+	LeasePreferences []string `protobuf:"bytes,6,rep,name=lease_preferences" json:"lease_preferences,omitempty" yaml:"lease_preferences,omitempty"`
 }
 
 func (m *ZoneConfig) Reset()         { *m = ZoneConfig{} }
@@ -99,6 +107,20 @@ func (m *ZoneConfig) GetGC() *GCPolicy {
 	return nil
 }
 
+func (m *ZoneConfig) GetRangeMaxQPS() int64 {
+	if m != nil {
+		return m.RangeMaxQPS
+	}
+	return 0
+}
+
+func (m *ZoneConfig) GetLeasePreferences() []string {
+	if m != nil {
+		return m.LeasePreferences
+	}
+	return nil
+}
+
 type SystemConfig struct {
 	Values []cockroach_roachpb1.KeyValue `protobuf:"bytes,1,rep,name=values" json:"values"`
 }
@@ -178,6 +200,24 @@ func (m *ZoneConfig) MarshalTo(data []byte) (int, error) {
 		}
 		i += n1
 	}
+	data[i] = 0x28
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.RangeMaxQPS))
+	if len(m.LeasePreferences) > 0 {
+		for _, s := range m.LeasePreferences {
+			data[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -260,6 +300,13 @@ func (m *ZoneConfig) Size() (n int) {
 		l = m.GC.Size()
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	n += 1 + sovConfig(uint64(m.RangeMaxQPS))
+	if len(m.LeasePreferences) > 0 {
+		for _, s := range m.LeasePreferences {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -488,6 +535,54 @@ func (m *ZoneConfig) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeMaxQPS", wireType)
+			}
+			m.RangeMaxQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RangeMaxQPS |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasePreferences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeasePreferences = append(m.LeasePreferences, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(data[iNdEx:])
@@ -84,6 +84,9 @@ var (
 	localRaftTruncatedStateSuffix = []byte("rftt")
 	// localRaftLastIndexSuffix is the suffix for raft's last index.
 	localRaftLastIndexSuffix = []byte("rfti")
+	// localRangeCorruptionSuffix is the suffix for the marker of a replica
+	// whose on-disk data was found to be corrupted.
+	localRangeCorruptionSuffix = []byte("rcor")
 	// localRangeGCMetadataSuffix is the suffix for a range's GC metadata.
 	localRangeGCMetadataSuffix = []byte("rgcm")
 	// localRangeLastVerificationTimestampSuffix is the suffix for a range's
@@ -155,6 +158,10 @@ var (
 	// RangeTreeRoot specifies the root range in the range tree.
 	RangeTreeRoot = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("range-tree-root")))
 
+	// DecommissionPrefix is the key prefix under which the nodes marked for
+	// decommissioning are recorded.
+	DecommissionPrefix = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("decommission-")))
+
 	// StatusPrefix specifies the key prefix to store all status details.
 	StatusPrefix = roachpb.Key(MakeKey(SystemPrefix, roachpb.RKey("status-")))
 	// StatusStorePrefix stores all status info for stores.
@@ -185,9 +192,10 @@ const (
 	// SystemDatabaseID and following are the database/table IDs for objects
 	// in the system span.
 	// NOTE: IDs should remain <= MaxReservedDescID.
-	SystemDatabaseID  = 1
-	NamespaceTableID  = 2
-	DescriptorTableID = 3
-	UsersTableID      = 4
-	ZonesTableID      = 5
+	SystemDatabaseID   = 1
+	NamespaceTableID   = 2
+	DescriptorTableID  = 3
+	UsersTableID       = 4
+	ZonesTableID       = 5
+	RoleMembersTableID = 6
 )
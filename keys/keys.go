@@ -57,6 +57,12 @@ func NodeStatusKey(nodeID int32) roachpb.Key {
 	return MakeKey(StatusNodePrefix, encoding.EncodeUvarint(nil, uint64(nodeID)))
 }
 
+// NodeDecommissionKey returns the key recording that the given node is
+// being decommissioned.
+func NodeDecommissionKey(nodeID int32) roachpb.Key {
+	return MakeKey(DecommissionPrefix, encoding.EncodeUvarint(nil, uint64(nodeID)))
+}
+
 // MakeRangeIDPrefix creates a range-local key prefix from
 // rangeID.
 func MakeRangeIDPrefix(rangeID roachpb.RangeID) roachpb.Key {
@@ -167,6 +173,12 @@ func DecodeRangeKey(key roachpb.Key) (startKey, suffix, detail roachpb.Key, err
 	return
 }
 
+// RangeCorruptionKey returns a range-local key for the marker of a
+// replica found to hold corrupted data.
+func RangeCorruptionKey(rangeID roachpb.RangeID) roachpb.Key {
+	return MakeRangeIDKey(rangeID, localRangeCorruptionSuffix, roachpb.RKey{})
+}
+
 // RangeGCMetadataKey returns a range-local key for range garbage
 // collection metadata.
 func RangeGCMetadataKey(rangeID roachpb.RangeID) roachpb.Key {
@@ -115,6 +115,9 @@ type DistSender struct {
 	// outside of tests.
 	rpcSend         rpcSendFn
 	rpcRetryOptions retry.Options
+	// followerReadMinAge is the age beyond which reads are sent to the
+	// nearest replica instead of the leader. Zero disables follower reads.
+	followerReadMinAge time.Duration
 }
 
 var _ client.Sender = &DistSender{}
@@ -142,6 +145,11 @@ type DistSenderContext struct {
 	// for testing purposes.
 	RPCSend           rpcSendFn
 	RangeDescriptorDB rangeDescriptorDB
+	// FollowerReadMinAge, if non-zero, enables follower reads: reads older
+	// than this are sent to the nearest replica instead of the leader, as
+	// followers have likely applied a closed timestamp above them. It must
+	// only be set when closed timestamps are published.
+	FollowerReadMinAge time.Duration
 }
 
 // NewDistSender returns a batch.Sender instance which connects to the
@@ -188,6 +196,7 @@ func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
 	if ctx.RPCRetryOptions != nil {
 		ds.rpcRetryOptions = *ctx.RPCRetryOptions
 	}
+	ds.followerReadMinAge = ctx.FollowerReadMinAge
 
 	return ds
 }
@@ -263,6 +272,11 @@ func (ds *DistSender) optimizeReplicaOrder(replicas replicaSlice) rpc.OrderingPo
 		// us and hence better candidates.
 		order = rpc.OrderStable
 	}
+	// Prefer replicas in the same locality, from the most to the least
+	// specific tier.
+	if replicas.SortByLocality(nodeDesc.Locality) {
+		order = rpc.OrderStable
+	}
 	// If there is a replica in local node, move it to the front.
 	if i := replicas.FindReplicaByNodeID(nodeDesc.NodeID); i > 0 {
 		replicas.MoveToFront(i)
@@ -271,6 +285,19 @@ func (ds *DistSender) optimizeReplicaOrder(replicas replicaSlice) rpc.OrderingPo
 	return order
 }
 
+// canUseFollowerRead returns whether the batch is a non-transactional
+// consistent read old enough to be served by any replica which has applied
+// the range's closed timestamp. Such reads are sent to the nearest replica
+// rather than to the leader; a replica which can't serve the read redirects
+// it to the leader.
+func (ds *DistSender) canUseFollowerRead(ba roachpb.BatchRequest) bool {
+	if ds.followerReadMinAge <= 0 || !ba.IsReadOnly() || ba.Txn != nil || ba.ReadConsistency != roachpb.CONSISTENT ||
+		ba.Timestamp.Equal(roachpb.ZeroTimestamp) {
+		return false
+	}
+	return ba.Timestamp.Less(ds.clock.Now().Add(-ds.followerReadMinAge.Nanoseconds(), 0))
+}
+
 // getNodeDescriptor returns ds.nodeDescriptor, but makes an attempt to load
 // it from the Gossip network if a nil value is found.
 // We must jump through hoops here to get the node descriptor because it's not available
@@ -417,7 +444,11 @@ func (ds *DistSender) getDescriptors(from, to roachpb.RKey, options lookupOption
 }
 
 // sendAttempt gathers and rearranges the replicas, and makes an RPC call.
-func (ds *DistSender) sendAttempt(trace *tracer.Trace, ba roachpb.BatchRequest, desc *roachpb.RangeDescriptor) (*roachpb.BatchResponse, *roachpb.Error) {
+// Reads which may be served by followers are sent to the nearest replica
+// first, unless followerReadFailed indicates that a follower already
+// redirected the batch to the leader.
+func (ds *DistSender) sendAttempt(trace *tracer.Trace, ba roachpb.BatchRequest, desc *roachpb.RangeDescriptor,
+	followerReadFailed bool) (*roachpb.BatchResponse, *roachpb.Error) {
 	defer trace.Epoch("sending RPC")()
 
 	leader := ds.leaderCache.Lookup(roachpb.RangeID(desc.RangeID))
@@ -433,7 +464,7 @@ func (ds *DistSender) sendAttempt(trace *tracer.Trace, ba roachpb.BatchRequest,
 	// If this request needs to go to a leader and we know who that is, move
 	// it to the front.
 	if !(ba.IsReadOnly() && ba.ReadConsistency == roachpb.INCONSISTENT) &&
-		leader.StoreID > 0 {
+		(followerReadFailed || !ds.canUseFollowerRead(ba)) && leader.StoreID > 0 {
 		if i := replicas.FindReplica(leader.StoreID); i >= 0 {
 			replicas.MoveToFront(i)
 			order = rpc.OrderStable
@@ -494,6 +525,7 @@ func (ds *DistSender) sendChunk(ctx context.Context, ba roachpb.BatchRequest) (*
 		var desc *roachpb.RangeDescriptor
 		var needAnother bool
 		var pErr *roachpb.Error
+		var followerReadFailed bool
 		for r := retry.Start(ds.rpcRetryOptions); r.Next(); {
 			// Get range descriptor (or, when spanning range, descriptors). Our
 			// error handling below may clear them on certain errors, so we
@@ -547,7 +579,7 @@ func (ds *DistSender) sendChunk(ctx context.Context, ba roachpb.BatchRequest) (*
 				if trErr != nil {
 					return nil, roachpb.NewError(trErr)
 				}
-				reply, err := ds.sendAttempt(trace, ba, desc)
+				reply, err := ds.sendAttempt(trace, ba, desc, followerReadFailed)
 
 				if err != nil {
 					if log.V(1) {
@@ -616,6 +648,9 @@ func (ds *DistSender) sendChunk(ctx context.Context, ba roachpb.BatchRequest) (*
 					newLeader = &roachpb.ReplicaDescriptor{}
 				}
 				ds.updateLeaderCache(roachpb.RangeID(desc.RangeID), *newLeader)
+				// A follower read which was redirected is retried at the
+				// leader; the nearest replica would only redirect it again.
+				followerReadFailed = true
 				if log.V(1) {
 					log.Warning(tErr)
 				}
@@ -403,6 +403,80 @@ func TestRetryOnNotLeaderError(t *testing.T) {
 	}
 }
 
+// TestFollowerReadRetriesAtLeader verifies that a stale read is first sent
+// to the nearest replica when follower reads are enabled, and that it is
+// sent to the leader once a follower redirected it.
+func TestFollowerReadRetriesAtLeader(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	g, s := makeTestGossip(t)
+	defer s()
+
+	descriptor := roachpb.RangeDescriptor{
+		RangeID:  1,
+		StartKey: roachpb.RKey("a"),
+		EndKey:   roachpb.RKey("z"),
+	}
+	addrs := make(map[string]roachpb.NodeID)
+	for i := 1; i <= 2; i++ {
+		addr := util.MakeUnresolvedAddr("tcp", fmt.Sprintf("node%d", i))
+		addrs[addr.String()] = roachpb.NodeID(i)
+		nd := &roachpb.NodeDescriptor{NodeID: roachpb.NodeID(i), Address: addr}
+		if err := g.AddInfoProto(gossip.MakeNodeIDKey(roachpb.NodeID(i)), nd, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		descriptor.Replicas = append(descriptor.Replicas, roachpb.ReplicaDescriptor{
+			NodeID:    roachpb.NodeID(i),
+			StoreID:   roachpb.StoreID(i),
+			ReplicaID: roachpb.ReplicaID(i),
+		})
+	}
+	leader := descriptor.Replicas[0]
+
+	// The first attempt goes to the local replica, a follower which
+	// redirects to the leader; all later attempts must go to the leader.
+	var sentTo []roachpb.NodeID
+	var testFn rpcSendFn = func(opts rpc.Options, method string, rpcAddrs []net.Addr,
+		getArgs func(addr net.Addr) proto.Message, getReply func() proto.Message,
+		_ *rpc.Context) ([]proto.Message, error) {
+		nodeID := addrs[rpcAddrs[0].String()]
+		if opts.Ordering != rpc.OrderStable {
+			t.Errorf("expected stable ordering; got %v", opts.Ordering)
+		}
+		sentTo = append(sentTo, nodeID)
+		if nodeID != leader.NodeID {
+			if len(sentTo) > 3 {
+				t.Fatalf("follower read was not redirected: %v", sentTo)
+			}
+			reply := getReply()
+			reply.(*roachpb.BatchResponse).SetGoError(
+				&roachpb.NotLeaderError{Leader: &leader, Replica: &descriptor.Replicas[1]})
+			return []proto.Message{reply}, nil
+		}
+		return []proto.Message{getArgs(nil).(*roachpb.BatchRequest).CreateReply()}, nil
+	}
+
+	ctx := &DistSenderContext{
+		nodeDescriptor: &roachpb.NodeDescriptor{NodeID: 2},
+		RPCSend:        testFn,
+		RangeDescriptorDB: mockRangeDescriptorDB(func(_ roachpb.RKey, _ lookupOptions) ([]roachpb.RangeDescriptor, error) {
+			return []roachpb.RangeDescriptor{descriptor}, nil
+		}),
+		FollowerReadMinAge: time.Second,
+	}
+	ds := NewDistSender(ctx, g)
+	// Even with the leader cached, the stale read tries the nearest replica.
+	ds.leaderCache.Update(descriptor.RangeID, leader)
+	get := roachpb.NewGet(roachpb.Key("a"))
+	if _, err := client.SendWrappedWith(ds, nil, roachpb.Header{
+		Timestamp: roachpb.Timestamp{WallTime: 1},
+	}, get); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []roachpb.NodeID{2, 1}; !reflect.DeepEqual(sentTo, expected) {
+		t.Errorf("expected attempts at nodes %v; got %v", expected, sentTo)
+	}
+}
+
 // TestRetryOnDescriptorLookupError verifies that the DistSender retries a descriptor
 // lookup on retryable errors.
 func TestRetryOnDescriptorLookupError(t *testing.T) {
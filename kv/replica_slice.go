@@ -122,6 +122,28 @@ func (rs replicaSlice) SortByCommonAttributePrefix(attrs []string) int {
 	return len(attrs)
 }
 
+// SortByLocality stably rearranges the replicaSlice so that replicas whose
+// localities are least diverse from the given locality come first. It
+// returns false, leaving the slice untouched, if the given locality has no
+// tiers.
+func (rs replicaSlice) SortByLocality(locality roachpb.Locality) bool {
+	if len(locality.Tiers) == 0 {
+		return false
+	}
+	scores := make([]float64, len(rs))
+	for i := range rs {
+		scores[i] = locality.DiversityScore(rs[i].NodeDesc.GetLocality())
+	}
+	// Insertion sort, which is stable and fast enough for replica sets.
+	for i := 1; i < len(rs); i++ {
+		for j := i; j > 0 && scores[j] < scores[j-1]; j-- {
+			rs.Swap(j, j-1)
+			scores[j], scores[j-1] = scores[j-1], scores[j]
+		}
+	}
+	return true
+}
+
 // MoveToFront moves the replica at the given index to the front
 // of the slice, keeping the order of the remaining elements stable.
 // The function will panic when invoked with an invalid index.
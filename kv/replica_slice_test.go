@@ -110,3 +110,44 @@ func TestReplicaSetMoveToFront(t *testing.T) {
 		t.Errorf("expected order %s, got %s", exp, stores)
 	}
 }
+
+func TestReplicaSetSortByLocality(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	parse := func(value string) roachpb.Locality {
+		var l roachpb.Locality
+		if err := l.Set(value); err != nil {
+			t.Fatal(err)
+		}
+		return l
+	}
+	localities := []string{
+		"region=us-west,zone=a",
+		"region=us-east,zone=b",
+		"region=us-east,zone=a",
+		"region=us-west,zone=b",
+		"region=us-east,zone=a",
+	}
+	rs := replicaSlice(nil)
+	for i, l := range localities {
+		rs = append(rs, replicaInfo{
+			ReplicaDescriptor: roachpb.ReplicaDescriptor{StoreID: roachpb.StoreID(i + 1)},
+			NodeDesc:          &roachpb.NodeDescriptor{Locality: parse(l)},
+		})
+	}
+
+	if rs.SortByLocality(roachpb.Locality{}) {
+		t.Errorf("expected no sorting without a reference locality")
+	}
+	exp := []roachpb.StoreID{1, 2, 3, 4, 5}
+	if stores := getStores(rs); !reflect.DeepEqual(stores, exp) {
+		t.Errorf("expected order %s, got %s", exp, stores)
+	}
+
+	if !rs.SortByLocality(parse("region=us-east,zone=a")) {
+		t.Errorf("expected sorting with a reference locality")
+	}
+	exp = []roachpb.StoreID{3, 5, 2, 1, 4}
+	if stores := getStores(rs); !reflect.DeepEqual(stores, exp) {
+		t.Errorf("expected order %s, got %s", exp, stores)
+	}
+}
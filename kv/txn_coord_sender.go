@@ -458,6 +458,10 @@ func (tc *TxnCoordSender) maybeBeginTxn(ba *roachpb.BatchRequest) {
 		if newTxn.Priority < ba.Txn.Priority {
 			newTxn.Priority = ba.Txn.Priority
 		}
+		// The client may already have assigned a write sequence number (and
+		// rolled back to a savepoint) before the transaction began.
+		newTxn.Sequence = ba.Txn.Sequence
+		newTxn.RolledBack = ba.Txn.RolledBack
 		ba.Txn = newTxn
 	}
 }
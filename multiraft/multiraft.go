@@ -190,6 +190,37 @@ func (ms *multiraftServer) RaftMessage(req *RaftMessageRequest) (*RaftMessageRes
 	}
 }
 
+// RaftSnapshotChunk implements ServerInterface; this method is called by
+// net/rpc when we receive a chunk of snapshot data. It returns once the
+// chunk has been staged by the storage. A request carrying the MsgSnap
+// message is enqueued like a raft message if the snapshot's data is staged,
+// and fails otherwise.
+func (ms *multiraftServer) RaftSnapshotChunk(req *RaftSnapshotChunkRequest) (*RaftSnapshotChunkResponse, error) {
+	streamer, ok := ms.Storage.(SnapshotStreamer)
+	if !ok {
+		return nil, util.Errorf("storage does not accept snapshot chunks")
+	}
+	if req.Message == nil {
+		if err := streamer.SnapshotChunk(req); err != nil {
+			return nil, err
+		}
+		return &RaftSnapshotChunkResponse{}, nil
+	}
+	if !streamer.ReserveSnapshot(req.GroupID, req.Index, req.Term) {
+		return nil, util.Errorf("data of snapshot of group %d at index %d has not been received",
+			req.GroupID, req.Index)
+	}
+	if _, err := ms.RaftMessage(&RaftMessageRequest{
+		GroupID:     req.GroupID,
+		FromReplica: req.FromReplica,
+		ToReplica:   req.ToReplica,
+		Message:     *req.Message,
+	}); err != nil {
+		return nil, err
+	}
+	return &RaftSnapshotChunkResponse{}, nil
+}
+
 func (s *state) sendEvent(event interface{}) {
 	s.pendingEvents = append(s.pendingEvents, event)
 }
@@ -582,6 +613,12 @@ func (s *state) start() {
 					// TODO(tschottdorf) still shouldn't hurt to move this part outside,
 					// but suddenly tests will start failing. Should investigate.
 					if _, ok := s.groups[req.GroupID]; !ok {
+						if s.quarantined(req.GroupID) {
+							if log.V(4) {
+								log.Infof("node %v: dropping message for quarantined group %d", s.nodeID, req.GroupID)
+							}
+							break
+						}
 						if log.V(1) {
 							log.Infof("node %v: got message for unknown group %d; creating it", s.nodeID, req.GroupID)
 						}
@@ -591,6 +628,11 @@ func (s *state) start() {
 						}
 					}
 
+					if !s.reserveSnapshot(req) {
+						log.Warningf("node %v: dropping snapshot of group %d at index %d whose data is not staged",
+							s.nodeID, req.GroupID, req.Message.Snapshot.Metadata.Index)
+						break
+					}
 					if err := s.multiNode.Step(context.Background(), uint64(req.GroupID), req.Message); err != nil {
 						if log.V(4) {
 							log.Infof("node %v: multinode step to group %v failed for message %.200s", s.nodeID, req.GroupID,
@@ -765,6 +807,9 @@ func (s *state) createGroup(groupID roachpb.RangeID, replicaID roachpb.ReplicaID
 	if _, ok := s.groups[groupID]; ok {
 		return nil
 	}
+	if s.quarantined(groupID) {
+		return util.Errorf("group %d is quarantined", groupID)
+	}
 	if log.V(3) {
 		log.Infof("node %v creating group %v", s.nodeID, groupID)
 	}
@@ -873,6 +918,13 @@ func (s *state) createGroup(groupID roachpb.RangeID, replicaID roachpb.ReplicaID
 	return nil
 }
 
+// quarantined returns whether the storage has withdrawn the given group
+// from raft; see QuarantiningStorage.
+func (s *state) quarantined(groupID roachpb.RangeID) bool {
+	qs, ok := s.Storage.(QuarantiningStorage)
+	return ok && qs.Quarantined(groupID)
+}
+
 func (s *state) removeGroup(groupID roachpb.RangeID, readyGroups map[uint64]raft.Ready) error {
 	// Group creation is lazy and idempotent; so is removal.
 	g, ok := s.groups[groupID]
@@ -1120,11 +1172,13 @@ func (s *state) sendMessage(g *group, msg raftpb.Message) {
 		toReplica, err = s.ReplicaDescriptor(groupID, roachpb.ReplicaID(msg.To))
 		if err != nil {
 			log.Warningf("failed to lookup recipient replica %d in group %d: %s", msg.To, groupID, err)
+			s.releaseSnapshot(groupID, msg)
 			return
 		}
 		fromReplica, err = s.ReplicaDescriptor(groupID, roachpb.ReplicaID(msg.From))
 		if err != nil {
 			log.Warningf("failed to lookup sender replica %d in group %d: %s", msg.From, groupID, err)
+			s.releaseSnapshot(groupID, msg)
 			return
 		}
 	}
@@ -1137,12 +1191,17 @@ func (s *state) sendMessage(g *group, msg raftpb.Message) {
 				s.nodeID, groupID, toReplica.NodeID, err)
 		}
 	}
-	err := s.Transport.Send(&RaftMessageRequest{
+	req := &RaftMessageRequest{
 		GroupID:     groupID,
 		ToReplica:   toReplica,
 		FromReplica: fromReplica,
 		Message:     msg,
-	})
+	}
+	if streamer, ok := s.Storage.(SnapshotStreamer); ok && msg.Type == raftpb.MsgSnap && groupID != noGroup {
+		s.sendSnapshot(streamer, req)
+		return
+	}
+	err := s.Transport.Send(req)
 	snapStatus := raft.SnapshotFinish
 	if err != nil {
 		log.Warningf("node %v failed to send message to %v: %s", s.nodeID, toReplica.NodeID, err)
@@ -1160,6 +1219,75 @@ func (s *state) sendMessage(g *group, msg raftpb.Message) {
 	}
 }
 
+// sendSnapshot sends the chunks of the snapshot carried by req, followed by
+// req itself. This happens in a separate goroutine so that sending a large
+// snapshot doesn't block the raft loop; the status of the snapshot is
+// reported to raft once it has been sent or has failed. The message is sent
+// as a final chunk, which the recipient rejects if the snapshot's data isn't
+// staged, so that the snapshot is reported as failed and sent again.
+func (s *state) sendSnapshot(streamer SnapshotStreamer, req *RaftMessageRequest) {
+	groupID := req.GroupID
+	msg := req.Message
+	s.stopper.RunWorker(func() {
+		var seq uint64
+		err := streamer.StreamSnapshot(groupID, msg.Snapshot, func(data []byte) error {
+			chunk := &RaftSnapshotChunkRequest{
+				GroupID:     groupID,
+				FromReplica: req.FromReplica,
+				ToReplica:   req.ToReplica,
+				Index:       msg.Snapshot.Metadata.Index,
+				Term:        msg.Snapshot.Metadata.Term,
+				Seq:         seq,
+				Data:        data,
+			}
+			seq++
+			return s.Transport.SendSnapshotChunk(chunk)
+		})
+		if err == nil {
+			err = s.Transport.SendSnapshotChunk(&RaftSnapshotChunkRequest{
+				GroupID:     groupID,
+				FromReplica: req.FromReplica,
+				ToReplica:   req.ToReplica,
+				Index:       msg.Snapshot.Metadata.Index,
+				Term:        msg.Snapshot.Metadata.Term,
+				Seq:         seq,
+				Message:     &msg,
+			})
+		}
+		snapStatus := raft.SnapshotFinish
+		if err != nil {
+			log.Warningf("node %v failed to send snapshot of group %v to %v: %s",
+				s.nodeID, groupID, req.ToReplica.NodeID, err)
+			s.multiNode.ReportUnreachable(msg.To, uint64(groupID))
+			snapStatus = raft.SnapshotFailure
+		}
+		s.multiNode.ReportSnapshot(msg.To, uint64(groupID), snapStatus)
+	})
+}
+
+// reserveSnapshot returns false if req carries a MsgSnap message whose data
+// was sent separately and is no longer staged by the storage, as happens
+// when it has timed out or been replaced by a newer snapshot, or when the
+// store restarted. Stepping such a message would fail to apply the
+// snapshot; dropping it instead lets the leader fall back to probing the
+// replica and send it a new snapshot.
+func (s *state) reserveSnapshot(req *RaftMessageRequest) bool {
+	streamer, ok := s.Storage.(SnapshotStreamer)
+	if !ok || req.Message.Type != raftpb.MsgSnap {
+		return true
+	}
+	meta := req.Message.Snapshot.Metadata
+	return streamer.ReserveSnapshot(req.GroupID, meta.Index, meta.Term)
+}
+
+// releaseSnapshot releases the snapshot carried by msg, if any, when the
+// message is not going to be sent.
+func (s *state) releaseSnapshot(groupID roachpb.RangeID, msg raftpb.Message) {
+	if streamer, ok := s.Storage.(SnapshotStreamer); ok && msg.Type == raftpb.MsgSnap {
+		streamer.ReleaseSnapshot(groupID, msg.Snapshot)
+	}
+}
+
 // maybeSendLeaderEvent processes a raft.Ready to send events in response to leadership
 // changes (this includes both sending an event to the app and retrying any pending
 // proposals).
@@ -26,3 +26,11 @@ var _ security.RequestWithUser = &RaftMessageRequest{}
 func (*RaftMessageRequest) GetUser() string {
 	return security.NodeUser
 }
+
+var _ security.RequestWithUser = &RaftSnapshotChunkRequest{}
+
+// GetUser implements security.RequestWithUser.
+// Raft snapshot chunks are always sent by the node user.
+func (*RaftSnapshotChunkRequest) GetUser() string {
+	return security.NodeUser
+}
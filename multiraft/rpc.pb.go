@@ -11,6 +11,8 @@
 	It has these top-level messages:
 		RaftMessageRequest
 		RaftMessageResponse
+		RaftSnapshotChunkRequest
+		RaftSnapshotChunkResponse
 		ConfChangeContext
 */
 package multiraft
@@ -82,6 +84,96 @@ func (m *RaftMessageResponse) Reset()         { *m = RaftMessageResponse{} }
 func (m *RaftMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*RaftMessageResponse) ProtoMessage()    {}
 
+// RaftSnapshotChunkRequest carries one chunk of the data of a raft snapshot.
+// The chunks of a snapshot are sent in order, each one after the previous
+// one has been acknowledged, ahead of the MsgSnap message which refers to
+// the snapshot.
+type RaftSnapshotChunkRequest struct {
+	GroupID     github_com_cockroachdb_cockroach_roachpb.RangeID `protobuf:"varint,1,opt,name=group_id,casttype=github.com/cockroachdb/cockroach/roachpb.RangeID" json:"group_id"`
+	FromReplica cockroach_roachpb.ReplicaDescriptor              `protobuf:"bytes,2,opt,name=from_replica" json:"from_replica"`
+	ToReplica   cockroach_roachpb.ReplicaDescriptor              `protobuf:"bytes,3,opt,name=to_replica" json:"to_replica"`
+	// Index and Term identify the snapshot the chunk belongs to.
+	Index uint64 `protobuf:"varint,4,opt,name=index" json:"index"`
+	Term  uint64 `protobuf:"varint,5,opt,name=term" json:"term"`
+	// Seq is the position of the chunk in the snapshot, starting at zero.
+	Seq  uint64 `protobuf:"varint,6,opt,name=seq" json:"seq"`
+	Data []byte `protobuf:"bytes,7,opt,name=data" json:"data,omitempty"`
+	// Message, if set, is the MsgSnap message referring to the snapshot, sent
+	// in place of a final chunk once all chunks have been acknowledged. It is
+	// rejected unless the data of the snapshot is staged by the recipient, so
+	// that the sender learns of a failure to deliver the snapshot.
+	Message *raftpb.Message `protobuf:"bytes,8,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RaftSnapshotChunkRequest) Reset()         { *m = RaftSnapshotChunkRequest{} }
+func (m *RaftSnapshotChunkRequest) String() string { return proto.CompactTextString(m) }
+func (*RaftSnapshotChunkRequest) ProtoMessage()    {}
+
+func (m *RaftSnapshotChunkRequest) GetGroupID() github_com_cockroachdb_cockroach_roachpb.RangeID {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
+func (m *RaftSnapshotChunkRequest) GetFromReplica() cockroach_roachpb.ReplicaDescriptor {
+	if m != nil {
+		return m.FromReplica
+	}
+	return cockroach_roachpb.ReplicaDescriptor{}
+}
+
+func (m *RaftSnapshotChunkRequest) GetToReplica() cockroach_roachpb.ReplicaDescriptor {
+	if m != nil {
+		return m.ToReplica
+	}
+	return cockroach_roachpb.ReplicaDescriptor{}
+}
+
+func (m *RaftSnapshotChunkRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RaftSnapshotChunkRequest) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RaftSnapshotChunkRequest) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *RaftSnapshotChunkRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RaftSnapshotChunkRequest) GetMessage() *raftpb.Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+// RaftSnapshotChunkResponse is an empty message returned by the raft
+// snapshot chunk RPC once the chunk has been staged by the recipient.
+type RaftSnapshotChunkResponse struct {
+}
+
+func (m *RaftSnapshotChunkResponse) Reset()         { *m = RaftSnapshotChunkResponse{} }
+func (m *RaftSnapshotChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*RaftSnapshotChunkResponse) ProtoMessage()    {}
+
 // ConfChangeContext is encoded in the raftpb.ConfChange.Context field.
 type ConfChangeContext struct {
 	CommandID string `protobuf:"bytes,1,opt,name=command_id" json:"command_id"`
@@ -180,6 +272,86 @@ func (m *RaftMessageResponse) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RaftSnapshotChunkRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RaftSnapshotChunkRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintRpc(data, i, uint64(m.GroupID))
+	data[i] = 0x12
+	i++
+	i = encodeVarintRpc(data, i, uint64(m.FromReplica.Size()))
+	n4, err := m.FromReplica.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	data[i] = 0x1a
+	i++
+	i = encodeVarintRpc(data, i, uint64(m.ToReplica.Size()))
+	n5, err := m.ToReplica.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	data[i] = 0x20
+	i++
+	i = encodeVarintRpc(data, i, uint64(m.Index))
+	data[i] = 0x28
+	i++
+	i = encodeVarintRpc(data, i, uint64(m.Term))
+	data[i] = 0x30
+	i++
+	i = encodeVarintRpc(data, i, uint64(m.Seq))
+	if m.Data != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintRpc(data, i, uint64(len(m.Data)))
+		i += copy(data[i:], m.Data)
+	}
+	if m.Message != nil {
+		data[i] = 0x42
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.Message.Size()))
+		n6, err := m.Message.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
+func (m *RaftSnapshotChunkResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RaftSnapshotChunkResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
 func (m *ConfChangeContext) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -208,11 +380,11 @@ func (m *ConfChangeContext) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintRpc(data, i, uint64(m.Replica.Size()))
-	n4, err := m.Replica.MarshalTo(data[i:])
+	n7, err := m.Replica.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n7
 	return i, nil
 }
 
@@ -262,6 +434,34 @@ func (m *RaftMessageResponse) Size() (n int) {
 	return n
 }
 
+func (m *RaftSnapshotChunkRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovRpc(uint64(m.GroupID))
+	l = m.FromReplica.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	l = m.ToReplica.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	n += 1 + sovRpc(uint64(m.Index))
+	n += 1 + sovRpc(uint64(m.Term))
+	n += 1 + sovRpc(uint64(m.Seq))
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	return n
+}
+
+func (m *RaftSnapshotChunkResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
 func (m *ConfChangeContext) Size() (n int) {
 	var l int
 	_ = l
@@ -498,6 +698,305 @@ func (m *RaftMessageResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+
+func (m *RaftSnapshotChunkRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftSnapshotChunkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftSnapshotChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupID |= (github_com_cockroachdb_cockroach_roachpb.RangeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromReplica", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromReplica.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToReplica", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToReplica.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Term |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Seq |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append([]byte{}, data[iNdEx:postIndex]...)
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &raftpb.Message{}
+			}
+			if err := m.Message.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RaftSnapshotChunkResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftSnapshotChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftSnapshotChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConfChangeContext) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
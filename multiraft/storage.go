@@ -47,6 +47,40 @@ type Storage interface {
 	ReplicasFromSnapshot(snap raftpb.Snapshot) ([]roachpb.ReplicaDescriptor, error)
 }
 
+// A SnapshotStreamer is a Storage which sends the data of the snapshots
+// generated by its groups in bounded chunks instead of including all of it
+// in the MsgSnap message. The chunks are sent in order, each one after the
+// previous one has been acknowledged, followed by the MsgSnap message; the
+// recipient stages them until the snapshot is applied. A MsgSnap message
+// whose data is not staged is dropped and the snapshot reported as failed.
+type SnapshotStreamer interface {
+	Storage
+	// StreamSnapshot calls send with each chunk of the data of the given
+	// snapshot of the group, in order. It is called at most once for each
+	// snapshot returned by the group's storage, in a goroutine separate
+	// from the raft loop.
+	StreamSnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot, send func(data []byte) error) error
+	// ReleaseSnapshot is called in place of StreamSnapshot for snapshots
+	// which are not going to be sent.
+	ReleaseSnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot)
+	// SnapshotChunk stages a chunk of snapshot data received for the group.
+	SnapshotChunk(req *RaftSnapshotChunkRequest) error
+	// ReserveSnapshot returns whether the data of the snapshot of the group
+	// at the given index and term is staged. If so, the data is kept from
+	// being replaced by that of another snapshot until it is applied.
+	ReserveSnapshot(groupID roachpb.RangeID, index, term uint64) bool
+}
+
+// A QuarantiningStorage is a Storage which may withdraw some of its groups
+// from raft, for instance because their data was found to be corrupted.
+// Quarantined groups are not created, and messages addressed to them are
+// dropped, so that they neither vote nor acknowledge log entries.
+type QuarantiningStorage interface {
+	Storage
+	// Quarantined returns whether the given group has been withdrawn.
+	Quarantined(groupID roachpb.RangeID) bool
+}
+
 // The StateMachine interface is supplied by the application to manage a persistent
 // state machine (in Cockroach the StateMachine and the Storage are the same thing
 // but they are logically distinct and systems like etcd keep them separate).
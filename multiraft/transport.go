@@ -46,6 +46,11 @@ type Transport interface {
 	// Send a message to the node specified in the request's To field.
 	Send(req *RaftMessageRequest) error
 
+	// SendSnapshotChunk sends a chunk of snapshot data, or the MsgSnap
+	// message following the chunks, to the store specified in the request's
+	// ToReplica field and waits for it to be acknowledged.
+	SendSnapshotChunk(req *RaftSnapshotChunkRequest) error
+
 	// Close all associated connections.
 	Close()
 }
@@ -53,10 +58,12 @@ type Transport interface {
 // ServerInterface is the methods we expose for use by net/rpc.
 type ServerInterface interface {
 	RaftMessage(req *RaftMessageRequest) (*RaftMessageResponse, error)
+	RaftSnapshotChunk(req *RaftSnapshotChunkRequest) (*RaftSnapshotChunkResponse, error)
 }
 
 var (
-	raftMessageName = "MultiRaft.RaftMessage"
+	raftMessageName       = "MultiRaft.RaftMessage"
+	raftSnapshotChunkName = "MultiRaft.RaftSnapshotChunk"
 )
 
 type localRPCTransport struct {
@@ -101,6 +108,13 @@ func (lt *localRPCTransport) Listen(id roachpb.StoreID, server ServerInterface)
 	if err != nil {
 		return err
 	}
+	err = rpcServer.Register(raftSnapshotChunkName,
+		func(argsI proto.Message) (proto.Message, error) {
+			return server.RaftSnapshotChunk(argsI.(*RaftSnapshotChunkRequest))
+		}, &RaftSnapshotChunkRequest{})
+	if err != nil {
+		return err
+	}
 
 	lt.mu.Lock()
 	if _, ok := lt.servers[id]; ok {
@@ -180,6 +194,14 @@ func (lt *localRPCTransport) Send(req *RaftMessageRequest) error {
 	}
 }
 
+func (lt *localRPCTransport) SendSnapshotChunk(req *RaftSnapshotChunkRequest) error {
+	client, err := lt.getClient(req.ToReplica.StoreID)
+	if err != nil {
+		return err
+	}
+	return client.Call(raftSnapshotChunkName, req, &RaftSnapshotChunkResponse{})
+}
+
 func (lt *localRPCTransport) Close() {
 	lt.mu.Lock()
 	defer lt.mu.Unlock()
@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
@@ -111,6 +112,17 @@ func (lt *localInterceptableTransport) Send(req *RaftMessageRequest) error {
 	return nil
 }
 
+func (lt *localInterceptableTransport) SendSnapshotChunk(req *RaftSnapshotChunkRequest) error {
+	lt.mu.Lock()
+	srv, ok := lt.listeners[req.ToReplica.StoreID]
+	lt.mu.Unlock()
+	if !ok {
+		return util.Errorf("unknown peer %v", req.ToReplica.StoreID)
+	}
+	_, err := srv.RaftSnapshotChunk(req)
+	return err
+}
+
 // an interceptMessage is sent by an interceptableClient when a message is to
 // be sent.
 type interceptMessage struct {
@@ -339,6 +339,21 @@ func (*TruncateLogRequest) Method() Method { return TruncateLog }
 // Method implements the Request interface.
 func (*LeaderLeaseRequest) Method() Method { return LeaderLease }
 
+// Method implements the Request interface.
+func (*CheckConsistencyRequest) Method() Method { return CheckConsistency }
+
+// Method implements the Request interface.
+func (*ComputeChecksumRequest) Method() Method { return ComputeChecksum }
+
+// Method implements the Request interface.
+func (*VerifyChecksumRequest) Method() Method { return VerifyChecksum }
+
+// Method implements the Request interface.
+func (*TransferLeaseRequest) Method() Method { return TransferLease }
+
+// Method implements the Request interface.
+func (*CollectChecksumRequest) Method() Method { return CollectChecksum }
+
 // CreateReply implements the Request interface.
 func (*GetRequest) CreateReply() Response { return &GetResponse{} }
 
@@ -404,6 +419,21 @@ func (*TruncateLogRequest) CreateReply() Response { return &TruncateLogResponse{
 // CreateReply implements the Request interface.
 func (*LeaderLeaseRequest) CreateReply() Response { return &LeaderLeaseResponse{} }
 
+// CreateReply implements the Request interface.
+func (*CheckConsistencyRequest) CreateReply() Response { return &CheckConsistencyResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ComputeChecksumRequest) CreateReply() Response { return &ComputeChecksumResponse{} }
+
+// CreateReply implements the Request interface.
+func (*VerifyChecksumRequest) CreateReply() Response { return &VerifyChecksumResponse{} }
+
+// CreateReply implements the Request interface.
+func (*TransferLeaseRequest) CreateReply() Response { return &TransferLeaseResponse{} }
+
+// CreateReply implements the Request interface.
+func (*CollectChecksumRequest) CreateReply() Response { return &CollectChecksumResponse{} }
+
 // NewGet returns a Request initialized to get the value at key.
 func NewGet(key Key) Request {
 	return &GetRequest{
@@ -518,3 +548,8 @@ func (*NoopRequest) flags() int               { return isRead } // slightly spec
 func (*MergeRequest) flags() int              { return isWrite }
 func (*TruncateLogRequest) flags() int        { return isWrite }
 func (*LeaderLeaseRequest) flags() int        { return isWrite }
+func (*CheckConsistencyRequest) flags() int   { return isAdmin }
+func (*ComputeChecksumRequest) flags() int    { return isWrite }
+func (*VerifyChecksumRequest) flags() int     { return isWrite }
+func (*TransferLeaseRequest) flags() int      { return isWrite }
+func (*CollectChecksumRequest) flags() int    { return isRead }
@@ -584,6 +584,12 @@ func (t *Transaction) Update(o *Transaction) {
 	if t.Epoch < o.Epoch {
 		t.Epoch = o.Epoch
 	}
+	if t.Sequence < o.Sequence {
+		t.Sequence = o.Sequence
+	}
+	// Rolling back to a savepoint only ever adds to the rolled back ranges,
+	// but either side may have seen rollbacks the other has not.
+	t.RolledBack = mergeSequenceRanges(t.RolledBack, o.RolledBack)
 	if t.Timestamp.Less(o.Timestamp) {
 		t.Timestamp = o.Timestamp
 	}
@@ -607,6 +613,46 @@ func (t *Transaction) Update(o *Transaction) {
 	}
 }
 
+// mergeSequenceRanges returns the union of the sequence ranges in a and b,
+// sorted by start and with overlapping or adjacent ranges coalesced.
+func mergeSequenceRanges(a, b []SequenceRange) []SequenceRange {
+	if len(a) == 0 && len(b) == 0 {
+		return nil
+	}
+	ranges := append(append([]SequenceRange(nil), a...), b...)
+	sort.Sort(sequenceRanges(ranges))
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End+1 {
+			if last.End < r.End {
+				last.End = r.End
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// sequenceRanges implements sort.Interface, ordering by start.
+type sequenceRanges []SequenceRange
+
+func (s sequenceRanges) Len() int           { return len(s) }
+func (s sequenceRanges) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s sequenceRanges) Less(i, j int) bool { return s[i].Start < s[j].Start }
+
+// IsRolledBack returns true if the writes made by the transaction at the
+// given sequence number have been rolled back to a savepoint.
+func (t *Transaction) IsRolledBack(seq int32) bool {
+	for _, r := range t.RolledBack {
+		if r.Start <= seq && seq <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
 // UpgradePriority sets transaction priority to the maximum of current
 // priority and the specified minPriority.
 func (t *Transaction) UpgradePriority(minPriority int32) {
@@ -540,6 +540,30 @@ func (m *NodeList) GetNodes() []int32 {
 	return nil
 }
 
+// SequenceRange is an inclusive range of transaction sequence numbers.
+type SequenceRange struct {
+	Start int32 `protobuf:"varint,1,opt,name=start" json:"start"`
+	End   int32 `protobuf:"varint,2,opt,name=end" json:"end"`
+}
+
+func (m *SequenceRange) Reset()         { *m = SequenceRange{} }
+func (m *SequenceRange) String() string { return proto.CompactTextString(m) }
+func (*SequenceRange) ProtoMessage()    {}
+
+func (m *SequenceRange) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *SequenceRange) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
 // A Transaction is a unit of work performed on the database.
 // Cockroach transactions support two isolation levels: snapshot
 // isolation and serializable snapshot isolation. Each Cockroach
@@ -598,6 +622,14 @@ type Transaction struct {
 	// Writing is true if the transaction has previously executed a successful
 	// write request, i.e. a request that may have left intents (across retries).
 	Writing bool `protobuf:"varint,13,opt,name=Writing" json:"Writing"`
+	// Sequence is incremented for every batch of writes sent by the
+	// transaction. Write intents record the sequence of the batch which
+	// wrote them.
+	Sequence int32 `protobuf:"varint,14,opt,name=sequence" json:"sequence"`
+	// The ranges of sequence numbers which have been rolled back to a
+	// savepoint. Writes made at these sequence numbers are invisible to the
+	// transaction and are discarded when its intents are resolved.
+	RolledBack []SequenceRange `protobuf:"bytes,15,rep,name=rolled_back" json:"rolled_back"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -694,6 +726,20 @@ func (m *Transaction) GetWriting() bool {
 	return false
 }
 
+func (m *Transaction) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Transaction) GetRolledBack() []SequenceRange {
+	if m != nil {
+		return m.RolledBack
+	}
+	return nil
+}
+
 // Lease contains information about leader leases including the
 // expiration and lease holder.
 type Lease struct {
@@ -1186,6 +1232,30 @@ func (m *NodeList) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SequenceRange) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SequenceRange) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.Start))
+	data[i] = 0x10
+	i++
+	i = encodeVarintData(data, i, uint64(m.End))
+	return i, nil
+}
+
 func (m *Transaction) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1279,6 +1349,21 @@ func (m *Transaction) MarshalTo(data []byte) (int, error) {
 		data[i] = 0
 	}
 	i++
+	data[i] = 0x70
+	i++
+	i = encodeVarintData(data, i, uint64(m.Sequence))
+	if len(m.RolledBack) > 0 {
+		for _, msg := range m.RolledBack {
+			data[i] = 0x7a
+			i++
+			i = encodeVarintData(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1556,6 +1641,14 @@ func (m *NodeList) Size() (n int) {
 	return n
 }
 
+func (m *SequenceRange) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.Start))
+	n += 1 + sovData(uint64(m.End))
+	return n
+}
+
 func (m *Transaction) Size() (n int) {
 	var l int
 	_ = l
@@ -1586,6 +1679,13 @@ func (m *Transaction) Size() (n int) {
 	l = m.CertainNodes.Size()
 	n += 1 + l + sovData(uint64(l))
 	n += 2
+	n += 1 + sovData(uint64(m.Sequence))
+	if len(m.RolledBack) > 0 {
+		for _, e := range m.RolledBack {
+			l = e.Size()
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2963,6 +3063,95 @@ func (m *NodeList) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+
+func (m *SequenceRange) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Start |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.End |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Transaction) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -3326,6 +3515,56 @@ func (m *Transaction) Unmarshal(data []byte) error {
 				}
 			}
 			m.Writing = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sequence |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RolledBack = append(m.RolledBack, SequenceRange{})
+			if err := m.RolledBack[len(m.RolledBack)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(data[iNdEx:])
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"math"
 	"math/rand"
+	"reflect"
 	"testing"
 	"time"
 
@@ -359,6 +360,53 @@ func TestTransactionString(t *testing.T) {
 	}
 }
 
+// TestTransactionIsRolledBack verifies that sequence numbers are tested
+// against the inclusive rolled back ranges.
+func TestTransactionIsRolledBack(t *testing.T) {
+	txn := Transaction{RolledBack: []SequenceRange{{Start: 2, End: 3}, {Start: 6, End: 6}}}
+	for seq, exp := range []bool{false, false, true, true, false, false, true, false} {
+		if rolledBack := txn.IsRolledBack(int32(seq)); rolledBack != exp {
+			t.Errorf("%d: expected rolled back %t; got %t", seq, exp, rolledBack)
+		}
+	}
+
+	// Update ratchets the sequence and picks up additional rolled back ranges.
+	other := Transaction{ID: []byte("A")}
+	other.Update(&Transaction{ID: []byte("A"), Sequence: 7, RolledBack: txn.RolledBack})
+	if other.Sequence != 7 || !other.IsRolledBack(6) {
+		t.Errorf("expected sequence and rolled back ranges to be updated: %+v", other)
+	}
+	other.Update(&Transaction{ID: []byte("A"), Sequence: 5})
+	if other.Sequence != 7 || !other.IsRolledBack(2) {
+		t.Errorf("expected sequence and rolled back ranges not to regress: %+v", other)
+	}
+	// Ranges known to only one side are kept, even if the other side has
+	// more of them.
+	other.Update(&Transaction{ID: []byte("A"), RolledBack: []SequenceRange{{Start: 4, End: 4}}})
+	if !other.IsRolledBack(2) || !other.IsRolledBack(4) || !other.IsRolledBack(6) {
+		t.Errorf("expected rolled back ranges to be merged: %+v", other)
+	}
+}
+
+func TestMergeSequenceRanges(t *testing.T) {
+	testCases := []struct {
+		a, b, expected []SequenceRange
+	}{
+		{nil, nil, nil},
+		{[]SequenceRange{{1, 2}}, nil, []SequenceRange{{1, 2}}},
+		{nil, []SequenceRange{{1, 2}}, []SequenceRange{{1, 2}}},
+		{[]SequenceRange{{1, 2}, {6, 6}}, []SequenceRange{{4, 4}}, []SequenceRange{{1, 2}, {4, 4}, {6, 6}}},
+		{[]SequenceRange{{1, 2}}, []SequenceRange{{3, 5}}, []SequenceRange{{1, 5}}},
+		{[]SequenceRange{{1, 4}}, []SequenceRange{{2, 3}, {4, 7}}, []SequenceRange{{1, 7}}},
+		{[]SequenceRange{{5, 6}}, []SequenceRange{{1, 2}, {5, 6}}, []SequenceRange{{1, 2}, {5, 6}}},
+	}
+	for i, test := range testCases {
+		if merged := mergeSequenceRanges(test.a, test.b); !reflect.DeepEqual(merged, test.expected) {
+			t.Errorf("%d: expected %v; got %v", i, test.expected, merged)
+		}
+	}
+}
+
 // TestNodeList verifies that its exported methods Add() and Contain()
 // operate as expected.
 func TestNodeList(t *testing.T) {
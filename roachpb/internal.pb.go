@@ -23,6 +23,11 @@ type RaftCommand struct {
 	RangeID       RangeID           `protobuf:"varint,1,opt,name=range_id,casttype=RangeID" json:"range_id"`
 	OriginReplica ReplicaDescriptor `protobuf:"bytes,2,opt,name=origin_replica" json:"origin_replica"`
 	Cmd           BatchRequest      `protobuf:"bytes,3,opt,name=cmd" json:"cmd"`
+	// ClosedTimestamp, if set by the lease holder proposing the command,
+	// is a timestamp at or below which no write will be applied after
+	// this command. Replicas which have applied the command may serve
+	// reads at or below it.
+	ClosedTimestamp Timestamp `protobuf:"bytes,4,opt,name=closed_timestamp" json:"closed_timestamp"`
 }
 
 func (m *RaftCommand) Reset()         { *m = RaftCommand{} }
@@ -50,6 +55,13 @@ func (m *RaftCommand) GetCmd() BatchRequest {
 	return BatchRequest{}
 }
 
+func (m *RaftCommand) GetClosedTimestamp() Timestamp {
+	if m != nil {
+		return m.ClosedTimestamp
+	}
+	return Timestamp{}
+}
+
 // InternalTimeSeriesData is a collection of data samples for some
 // measurable value, where each sample is taken over a uniform time
 // interval.
@@ -212,6 +224,10 @@ type RaftSnapshotData struct {
 	// The latest RangeDescriptor
 	RangeDescriptor RangeDescriptor              `protobuf:"bytes,1,opt,name=range_descriptor" json:"range_descriptor"`
 	KV              []*RaftSnapshotData_KeyValue `protobuf:"bytes,2,rep,name=KV" json:"KV,omitempty"`
+	// Chunked is set if the data of the snapshot is not included in KV but
+	// sent separately in chunks, each of which is itself encoded as a
+	// RaftSnapshotData.
+	Chunked bool `protobuf:"varint,3,opt,name=chunked" json:"chunked"`
 }
 
 func (m *RaftSnapshotData) Reset()         { *m = RaftSnapshotData{} }
@@ -232,6 +248,13 @@ func (m *RaftSnapshotData) GetKV() []*RaftSnapshotData_KeyValue {
 	return nil
 }
 
+func (m *RaftSnapshotData) GetChunked() bool {
+	if m != nil {
+		return m.Chunked
+	}
+	return false
+}
+
 type RaftSnapshotData_KeyValue struct {
 	Key   []byte `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
 	Value []byte `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
@@ -289,6 +312,14 @@ func (m *RaftCommand) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n2
+	data[i] = 0x22
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ClosedTimestamp.Size()))
+	n3, err := m.ClosedTimestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
 	return i, nil
 }
 
@@ -407,11 +438,11 @@ func (m *RaftSnapshotData) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RangeDescriptor.Size()))
-	n3, err := m.RangeDescriptor.MarshalTo(data[i:])
+	n4, err := m.RangeDescriptor.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	if len(m.KV) > 0 {
 		for _, msg := range m.KV {
 			data[i] = 0x12
@@ -424,6 +455,14 @@ func (m *RaftSnapshotData) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	data[i] = 0x18
+	i++
+	if m.Chunked {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -492,6 +531,8 @@ func (m *RaftCommand) Size() (n int) {
 	n += 1 + l + sovInternal(uint64(l))
 	l = m.Cmd.Size()
 	n += 1 + l + sovInternal(uint64(l))
+	l = m.ClosedTimestamp.Size()
+	n += 1 + l + sovInternal(uint64(l))
 	return n
 }
 
@@ -543,6 +584,7 @@ func (m *RaftSnapshotData) Size() (n int) {
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
+	n += 2
 	return n
 }
 
@@ -681,6 +723,36 @@ func (m *RaftCommand) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClosedTimestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(data[iNdEx:])
@@ -1143,6 +1215,26 @@ func (m *RaftSnapshotData) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Chunked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(data[iNdEx:])
@@ -20,6 +20,7 @@ package roachpb
 
 import (
 	"bytes"
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -106,6 +107,83 @@ func (a Attributes) SortedString() string {
 	return strings.Join(attrs, ",")
 }
 
+// String returns the tier as "key=value".
+func (t Tier) String() string {
+	return fmt.Sprintf("%s=%s", t.Key, t.Value)
+}
+
+// String returns a comma-separated list of the locality's tiers, in the
+// format accepted by Set.
+func (l Locality) String() string {
+	tiers := make([]string, len(l.Tiers))
+	for i, t := range l.Tiers {
+		tiers[i] = t.String()
+	}
+	return strings.Join(tiers, ",")
+}
+
+// Type implements the pflag.Value interface.
+func (l *Locality) Type() string {
+	return "Locality"
+}
+
+// Set parses a comma-separated list of "key=value" tiers, ordered from the
+// most general to the most specific, such as
+// "region=us-east,zone=us-east-1a,rack=12". It implements the pflag.Value
+// interface.
+func (l *Locality) Set(value string) error {
+	var tiers []Tier
+	if len(value) > 0 {
+		for _, tier := range strings.Split(value, ",") {
+			parts := strings.Split(tier, "=")
+			if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+				return util.Errorf("tier %q of locality %q must be of the form key=value", tier, value)
+			}
+			tiers = append(tiers, Tier{Key: parts[0], Value: parts[1]})
+		}
+	}
+	l.Tiers = tiers
+	return nil
+}
+
+// DiversityScore returns a score between 0 and 1 describing how far apart
+// two localities are. Localities which differ at their most general tier
+// (e.g. different regions) score 1, and localities which only differ at a
+// more specific tier (e.g. different racks in the same zone) score
+// proportionally less. Localities which don't differ at all, including
+// those without any tiers, score 0.
+func (l Locality) DiversityScore(other Locality) float64 {
+	length := len(l.Tiers)
+	if len(other.Tiers) < length {
+		length = len(other.Tiers)
+	}
+	for i := 0; i < length; i++ {
+		if l.Tiers[i].Value != other.Tiers[i].Value {
+			return float64(length-i) / float64(length)
+		}
+	}
+	return 0
+}
+
+// Matches returns whether the locality contains every tier of the given
+// preference, such as "region=us-east". A preference without any tiers
+// matches every locality.
+func (l Locality) Matches(pref Locality) bool {
+	for _, p := range pref.Tiers {
+		found := false
+		for _, t := range l.Tiers {
+			if t == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // ContainsKey returns whether this RangeDescriptor contains the specified key.
 // TODO(tschottdorf): RKey.
 func (r *RangeDescriptor) ContainsKey(key []byte) bool {
@@ -34,6 +34,49 @@ func (m *Attributes) GetAttrs() []string {
 	return nil
 }
 
+// Tier represents one level of the locality hierarchy.
+type Tier struct {
+	// Key is the name of the tier and should match all other nodes.
+	Key string `protobuf:"bytes,1,opt,name=key" json:"key"`
+	// Value is the node specific value corresponding to the key.
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value"`
+}
+
+func (m *Tier) Reset()      { *m = Tier{} }
+func (*Tier) ProtoMessage() {}
+
+func (m *Tier) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Tier) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Locality is an ordered set of key value Tiers that describe a node's
+// location, from the most general tier (e.g. the region) to the most
+// specific one (e.g. the rack). The tier keys should be the same across
+// all nodes.
+type Locality struct {
+	Tiers []Tier `protobuf:"bytes,1,rep,name=tiers" json:"tiers"`
+}
+
+func (m *Locality) Reset()      { *m = Locality{} }
+func (*Locality) ProtoMessage() {}
+
+func (m *Locality) GetTiers() []Tier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
 // ReplicaDescriptor describes a replica location by node ID
 // (corresponds to a host:port via lookup on gossip network) and store
 // ID (identifies the device).
@@ -200,6 +243,13 @@ type StoreCapacity struct {
 	Capacity   int64 `protobuf:"varint,1,opt,name=Capacity" json:"Capacity"`
 	Available  int64 `protobuf:"varint,2,opt,name=Available" json:"Available"`
 	RangeCount int32 `protobuf:"varint,3,opt,name=RangeCount" json:"RangeCount"`
+	LeaseCount int32 `protobuf:"varint,4,opt,name=LeaseCount" json:"LeaseCount"`
+	// QueriesPerSecond is the rate of requests served by the store's
+	// replicas.
+	QueriesPerSecond float64 `protobuf:"fixed64,5,opt,name=QueriesPerSecond" json:"QueriesPerSecond"`
+	// WriteBytesPerSecond is the rate of bytes written by requests served by
+	// the store's replicas.
+	WriteBytesPerSecond float64 `protobuf:"fixed64,6,opt,name=WriteBytesPerSecond" json:"WriteBytesPerSecond"`
 }
 
 func (m *StoreCapacity) Reset()         { *m = StoreCapacity{} }
@@ -227,11 +277,37 @@ func (m *StoreCapacity) GetRangeCount() int32 {
 	return 0
 }
 
+func (m *StoreCapacity) GetLeaseCount() int32 {
+	if m != nil {
+		return m.LeaseCount
+	}
+	return 0
+}
+
+func (m *StoreCapacity) GetQueriesPerSecond() float64 {
+	if m != nil {
+		return m.QueriesPerSecond
+	}
+	return 0
+}
+
+func (m *StoreCapacity) GetWriteBytesPerSecond() float64 {
+	if m != nil {
+		return m.WriteBytesPerSecond
+	}
+	return 0
+}
+
 // NodeDescriptor holds details on node physical/network topology.
 type NodeDescriptor struct {
-	NodeID  NodeID                        `protobuf:"varint,1,opt,name=node_id,casttype=NodeID" json:"node_id"`
-	Address cockroach_util.UnresolvedAddr `protobuf:"bytes,2,opt,name=address" json:"address"`
-	Attrs   Attributes                    `protobuf:"bytes,3,opt,name=attrs" json:"attrs"`
+	NodeID   NodeID                        `protobuf:"varint,1,opt,name=node_id,casttype=NodeID" json:"node_id"`
+	Address  cockroach_util.UnresolvedAddr `protobuf:"bytes,2,opt,name=address" json:"address"`
+	Attrs    Attributes                    `protobuf:"bytes,3,opt,name=attrs" json:"attrs"`
+	Locality Locality                      `protobuf:"bytes,4,opt,name=locality" json:"locality"`
+	// Decommissioning is set once the node has been marked for removal from
+	// the cluster. No new replicas are placed on its stores, and existing
+	// ones are moved away.
+	Decommissioning bool `protobuf:"varint,5,opt,name=decommissioning" json:"decommissioning"`
 }
 
 func (m *NodeDescriptor) Reset()         { *m = NodeDescriptor{} }
@@ -259,6 +335,20 @@ func (m *NodeDescriptor) GetAttrs() Attributes {
 	return Attributes{}
 }
 
+func (m *NodeDescriptor) GetLocality() Locality {
+	if m != nil {
+		return m.Locality
+	}
+	return Locality{}
+}
+
+func (m *NodeDescriptor) GetDecommissioning() bool {
+	if m != nil {
+		return m.Decommissioning
+	}
+	return false
+}
+
 // StoreDescriptor holds store information including store attributes, node
 // descriptor and store capacity.
 type StoreDescriptor struct {
@@ -333,6 +423,62 @@ func (m *Attributes) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Tier) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Tier) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintMetadata(data, i, uint64(len(m.Key)))
+	i += copy(data[i:], m.Key)
+	data[i] = 0x12
+	i++
+	i = encodeVarintMetadata(data, i, uint64(len(m.Value)))
+	i += copy(data[i:], m.Value)
+	return i, nil
+}
+
+func (m *Locality) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Locality) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, msg := range m.Tiers {
+			data[i] = 0xa
+			i++
+			i = encodeVarintMetadata(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *ReplicaDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -506,6 +652,15 @@ func (m *StoreCapacity) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x18
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.RangeCount))
+	data[i] = 0x20
+	i++
+	i = encodeVarintMetadata(data, i, uint64(m.LeaseCount))
+	data[i] = 0x29
+	i++
+	i = encodeFixed64Metadata(data, i, uint64(math.Float64bits(m.QueriesPerSecond)))
+	data[i] = 0x31
+	i++
+	i = encodeFixed64Metadata(data, i, uint64(math.Float64bits(m.WriteBytesPerSecond)))
 	return i, nil
 }
 
@@ -543,6 +698,22 @@ func (m *NodeDescriptor) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n2
+	data[i] = 0x22
+	i++
+	i = encodeVarintMetadata(data, i, uint64(m.Locality.Size()))
+	n3, err := m.Locality.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	data[i] = 0x28
+	i++
+	if m.Decommissioning {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -567,27 +738,27 @@ func (m *StoreDescriptor) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x12
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.Attrs.Size()))
-	n3, err := m.Attrs.MarshalTo(data[i:])
+	n4, err := m.Attrs.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	data[i] = 0x1a
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.Node.Size()))
-	n4, err := m.Node.MarshalTo(data[i:])
+	n5, err := m.Node.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	data[i] = 0x22
 	i++
 	i = encodeVarintMetadata(data, i, uint64(m.Capacity.Size()))
-	n5, err := m.Capacity.MarshalTo(data[i:])
+	n6, err := m.Capacity.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	return i, nil
 }
 
@@ -630,6 +801,28 @@ func (m *Attributes) Size() (n int) {
 	return n
 }
 
+func (m *Tier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovMetadata(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovMetadata(uint64(l))
+	return n
+}
+
+func (m *Locality) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ReplicaDescriptor) Size() (n int) {
 	var l int
 	_ = l
@@ -700,6 +893,9 @@ func (m *StoreCapacity) Size() (n int) {
 	n += 1 + sovMetadata(uint64(m.Capacity))
 	n += 1 + sovMetadata(uint64(m.Available))
 	n += 1 + sovMetadata(uint64(m.RangeCount))
+	n += 1 + sovMetadata(uint64(m.LeaseCount))
+	n += 9
+	n += 9
 	return n
 }
 
@@ -711,6 +907,9 @@ func (m *NodeDescriptor) Size() (n int) {
 	n += 1 + l + sovMetadata(uint64(l))
 	l = m.Attrs.Size()
 	n += 1 + l + sovMetadata(uint64(l))
+	l = m.Locality.Size()
+	n += 1 + l + sovMetadata(uint64(l))
+	n += 2
 	return n
 }
 
@@ -819,6 +1018,197 @@ func (m *Attributes) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+
+func (m *Tier) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Locality) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Locality: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Locality: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, Tier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ReplicaDescriptor) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -1447,6 +1837,61 @@ func (m *StoreCapacity) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseCount", wireType)
+			}
+			m.LeaseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.LeaseCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.QueriesPerSecond = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytesPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.WriteBytesPerSecond = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(data[iNdEx:])
@@ -1576,6 +2021,56 @@ func (m *NodeDescriptor) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locality", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locality.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decommissioning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decommissioning = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(data[iNdEx:])
@@ -19,6 +19,7 @@ package roachpb
 
 import (
 	"bytes"
+	"reflect"
 	"testing"
 )
 
@@ -62,6 +63,105 @@ func TestAttributesSortedString(t *testing.T) {
 	}
 }
 
+func TestLocalitySet(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected []Tier
+		err      bool
+	}{
+		{"", nil, false},
+		{"region=us-east", []Tier{{Key: "region", Value: "us-east"}}, false},
+		{"region=us-east,zone=us-east-1a,rack=12", []Tier{
+			{Key: "region", Value: "us-east"},
+			{Key: "zone", Value: "us-east-1a"},
+			{Key: "rack", Value: "12"},
+		}, false},
+		{"region", nil, true},
+		{"region=", nil, true},
+		{"=us-east", nil, true},
+		{"region=us-east,", nil, true},
+		{"region=us=east", nil, true},
+	}
+	for i, test := range testCases {
+		var l Locality
+		err := l.Set(test.value)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expected an error parsing %q", i, test.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error parsing %q: %s", i, test.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(l.Tiers, test.expected) {
+			t.Errorf("%d: expected %+v; got %+v", i, test.expected, l.Tiers)
+		}
+		if s := l.String(); s != test.value {
+			t.Errorf("%d: expected %q; got %q", i, test.value, s)
+		}
+	}
+}
+
+func TestLocalityDiversityScore(t *testing.T) {
+	parse := func(value string) Locality {
+		var l Locality
+		if err := l.Set(value); err != nil {
+			t.Fatal(err)
+		}
+		return l
+	}
+	rack1 := parse("region=us-east,zone=a,rack=1")
+	testCases := []struct {
+		other    Locality
+		expected float64
+	}{
+		{rack1, 0},
+		{parse("region=us-east,zone=a,rack=2"), 1.0 / 3},
+		{parse("region=us-east,zone=b,rack=1"), 2.0 / 3},
+		{parse("region=us-west,zone=a,rack=1"), 1},
+		{parse("region=us-east,zone=b"), 1.0 / 2},
+		{parse(""), 0},
+	}
+	for i, test := range testCases {
+		if score := rack1.DiversityScore(test.other); score != test.expected {
+			t.Errorf("%d: expected %f; got %f", i, test.expected, score)
+		}
+		if score := test.other.DiversityScore(rack1); score != test.expected {
+			t.Errorf("%d: expected symmetric score %f; got %f", i, test.expected, score)
+		}
+	}
+}
+
+func TestLocalityMatches(t *testing.T) {
+	var l Locality
+	if err := l.Set("region=us-east,zone=a,rack=1"); err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		pref     string
+		expected bool
+	}{
+		{"", true},
+		{"region=us-east", true},
+		{"zone=a", true},
+		{"region=us-east,rack=1", true},
+		{"region=us-west", false},
+		{"region=us-east,zone=b", false},
+		{"datacenter=a", false},
+	}
+	for i, test := range testCases {
+		var pref Locality
+		if err := pref.Set(test.pref); err != nil {
+			t.Fatal(err)
+		}
+		if matches := l.Matches(pref); matches != test.expected {
+			t.Errorf("%d: expected %s to match %q: %t", i, l, test.pref, test.expected)
+		}
+	}
+}
+
 func TestRangeDescriptorFindReplica(t *testing.T) {
 	desc := RangeDescriptor{
 		Replicas: []ReplicaDescriptor{
@@ -97,6 +97,25 @@ const (
 	TruncateLog
 	// LeaderLease requests a leader lease for a replica.
 	LeaderLease
+	// CheckConsistency verifies the consistency of all the replicas of a
+	// range. It is sent to the range leader, which coordinates the
+	// ComputeChecksum and VerifyChecksum commands below.
+	CheckConsistency
+	// ComputeChecksum starts the computation of a checksum of the data of
+	// a replica. The call goes through Raft, so all range replicas compute
+	// the checksum at the same applied index.
+	ComputeChecksum
+	// VerifyChecksum compares the checksum computed by a replica with the
+	// checksum computed by the range leader. The call goes through Raft.
+	VerifyChecksum
+	// TransferLease hands the leader lease of a range over from its current
+	// holder to another replica of the range.
+	TransferLease
+	// CollectChecksum is sent to the range leader by a replica whose
+	// checksum differs from the leader's. It returns the leader's snapshot
+	// of the range data, if one was retained. The call does not go through
+	// Raft.
+	CollectChecksum
 	// Batch implements batch processing of commands. This is a
 	// superset of the Batch method.
 	Batch
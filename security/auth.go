@@ -33,6 +33,17 @@ const (
 	RootUser = "root"
 )
 
+// The authentication methods which can be configured for a user in the
+// system.users table.
+const (
+	// CertAuthMethod requires a client certificate for the user. This is the
+	// default for users without an authentication method.
+	CertAuthMethod = "cert"
+	// PasswordAuthMethod requires the password of the user, which is checked
+	// against its bcrypt hash.
+	PasswordAuthMethod = "password"
+)
+
 // LogTLSState logs information about TLS state in the form:
 // "<method>: perr certs: [<Subject.CommonName>...], chain: [[<CommonName>...][..]]"
 func LogTLSState(method string, tlsState *tls.ConnectionState) {
@@ -94,6 +105,30 @@ func AuthenticationHook(insecureMode bool, tlsState *tls.ConnectionState) (
 		}
 	}
 
+	return userAuthenticationHook(insecureMode, certUser), nil
+}
+
+// PasswordAuthenticationHook builds an authentication hook for a user who
+// authenticated with a password. Verifying the password is up to the caller.
+// Passwords are only accepted over TLS, and the node user must always use
+// its certificate.
+func PasswordAuthenticationHook(insecureMode bool, tlsState *tls.ConnectionState, user string) (
+	func(request proto.Message, public bool) error, error) {
+	if !insecureMode {
+		if tlsState == nil {
+			return nil, util.Errorf("password authentication requires TLS")
+		}
+		if user == NodeUser {
+			return nil, util.Errorf("user %s cannot use password authentication", user)
+		}
+	}
+
+	return userAuthenticationHook(insecureMode, user), nil
+}
+
+// userAuthenticationHook returns the hook verifying requests against
+// authUser, the user established at connection time.
+func userAuthenticationHook(insecureMode bool, authUser string) func(request proto.Message, public bool) error {
 	return func(request proto.Message, public bool) error {
 		// RequestWithUser must be implemented.
 		requestWithUser, ok := request.(RequestWithUser)
@@ -117,13 +152,13 @@ func AuthenticationHook(insecureMode bool, tlsState *tls.ConnectionState) (
 			return nil
 		}
 
-		// The client certificate user must match the requested user,
-		// except if the certificate user is NodeUser, which is allowed to
-		// act on behalf of all other users.
-		if !(certUser == NodeUser || certUser == requestedUser) {
-			return util.Errorf("requested user is %s, but certificate is for %s", requestedUser, certUser)
+		// The authenticated user must match the requested user, except if the
+		// authenticated user is NodeUser, which is allowed to act on behalf of
+		// all other users.
+		if !(authUser == NodeUser || authUser == requestedUser) {
+			return util.Errorf("requested user is %s, but authenticated user is %s", requestedUser, authUser)
 		}
 
 		return nil
-	}, nil
+	}
 }
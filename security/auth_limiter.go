@@ -0,0 +1,146 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package security
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+const (
+	// DefaultMaxFailedAuthAttempts is the number of consecutive failed
+	// password attempts after which a user is locked out.
+	DefaultMaxFailedAuthAttempts = 5
+	// DefaultAuthLockout is the duration of a lockout. Failed attempts older
+	// than the lockout are forgotten.
+	DefaultAuthLockout = time.Minute
+
+	// maxTrackedAuthUsers bounds the number of users whose failed attempts
+	// are tracked. Once reached, expired entries are pruned and, if none
+	// were, the entry with the oldest failure is evicted.
+	maxTrackedAuthUsers = 10000
+)
+
+// failedAuthAttempts tracks the recent failed attempts of a user.
+type failedAuthAttempts struct {
+	count       int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// An AuthLimiter rate-limits password authentication. After a number of
+// consecutive failed attempts, further attempts for the same user are
+// refused until a lockout period has passed. A successful attempt resets the
+// count.
+type AuthLimiter struct {
+	maxFailures int
+	lockout     time.Duration
+	now         func() time.Time
+
+	mu    sync.Mutex
+	users map[string]*failedAuthAttempts
+}
+
+// NewAuthLimiter creates an AuthLimiter which locks a user out for lockout
+// after maxFailures consecutive failed attempts.
+func NewAuthLimiter(maxFailures int, lockout time.Duration) *AuthLimiter {
+	return &AuthLimiter{
+		maxFailures: maxFailures,
+		lockout:     lockout,
+		now:         time.Now,
+		users:       map[string]*failedAuthAttempts{},
+	}
+}
+
+// SetClock sets the function used to obtain the current time. Only for use
+// in tests.
+func (l *AuthLimiter) SetClock(now func() time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.now = now
+}
+
+// Check returns an error if the user is currently locked out.
+func (l *AuthLimiter) Check(user string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	attempts, ok := l.users[user]
+	if !ok {
+		return nil
+	}
+	if now := l.now(); now.Before(attempts.lockedUntil) {
+		return util.Errorf("too many failed authentication attempts for user %s, retry in %s",
+			user, attempts.lockedUntil.Sub(now))
+	}
+	return nil
+}
+
+// Failure records a failed attempt for the user.
+func (l *AuthLimiter) Failure(user string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	attempts, ok := l.users[user]
+	if !ok {
+		if len(l.users) >= maxTrackedAuthUsers {
+			l.pruneLocked(now)
+		}
+		if len(l.users) >= maxTrackedAuthUsers {
+			l.evictOldestLocked()
+		}
+		attempts = &failedAuthAttempts{}
+		l.users[user] = attempts
+	} else if now.Sub(attempts.lastFailure) > l.lockout {
+		attempts.count = 0
+	}
+	attempts.count++
+	attempts.lastFailure = now
+	if attempts.count >= l.maxFailures {
+		attempts.lockedUntil = now.Add(l.lockout)
+	}
+}
+
+// Success records a successful attempt for the user, which clears its failed
+// attempts.
+func (l *AuthLimiter) Success(user string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.users, user)
+}
+
+// pruneLocked forgets the users whose failed attempts have all expired.
+func (l *AuthLimiter) pruneLocked(now time.Time) {
+	for user, attempts := range l.users {
+		if now.Sub(attempts.lastFailure) > l.lockout && !now.Before(attempts.lockedUntil) {
+			delete(l.users, user)
+		}
+	}
+}
+
+// evictOldestLocked forgets the user whose last failed attempt is the
+// oldest.
+func (l *AuthLimiter) evictOldestLocked() {
+	var oldest string
+	var oldestFailure time.Time
+	for user, attempts := range l.users {
+		if oldestFailure.IsZero() || attempts.lastFailure.Before(oldestFailure) {
+			oldest, oldestFailure = user, attempts.lastFailure
+		}
+	}
+	delete(l.users, oldest)
+}
@@ -0,0 +1,91 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package security_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestAuthLimiter(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	now := time.Unix(0, 0)
+	l := security.NewAuthLimiter(3, time.Minute)
+	l.SetClock(func() time.Time { return now })
+
+	// Failures below the limit do not lock the user out.
+	for i := 0; i < 2; i++ {
+		l.Failure("foo")
+		if err := l.Check("foo"); err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+	}
+
+	// A success resets the count.
+	l.Success("foo")
+	l.Failure("foo")
+	l.Failure("foo")
+	if err := l.Check("foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Reaching the limit locks the user out, but not other users.
+	l.Failure("foo")
+	if err := l.Check("foo"); err == nil {
+		t.Fatal("expected user to be locked out")
+	}
+	if err := l.Check("bar"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The lockout expires, after which old failures are forgotten.
+	now = now.Add(time.Minute + time.Second)
+	if err := l.Check("foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	l.Failure("foo")
+	if err := l.Check("foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestAuthLimiterEvictsOldest(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	now := time.Unix(0, 0)
+	l := security.NewAuthLimiter(1, time.Hour)
+	l.SetClock(func() time.Time { return now })
+
+	l.Failure("foo")
+	if err := l.Check("foo"); err == nil {
+		t.Fatal("expected user to be locked out")
+	}
+	// Failures for as many other users as are tracked (maxTrackedAuthUsers)
+	// evict the oldest entry, although it hasn't expired.
+	for i := 0; i < 10000; i++ {
+		now = now.Add(time.Millisecond)
+		l.Failure(fmt.Sprintf("user%d", i))
+	}
+	if err := l.Check("foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := l.Check("user9999"); err == nil {
+		t.Fatal("expected user to be locked out")
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/gogo/protobuf/proto"
 )
@@ -131,3 +132,44 @@ func TestAuthenticationHook(t *testing.T) {
 		}
 	}
 }
+
+func TestPasswordAuthenticationHook(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	fooRequest := &driver.Request{User: "foo"}
+	// BatchRequest.GetUser returns the node user.
+	nodeRequest := &roachpb.BatchRequest{}
+
+	testCases := []struct {
+		insecure         bool
+		tls              *tls.ConnectionState
+		user             string
+		request          proto.Message
+		buildHookSuccess bool
+		hookSuccess      bool
+	}{
+		// Insecure mode.
+		{true, nil, "foo", nodeRequest, true, true},
+		// Secure mode, no TLS state.
+		{false, nil, "foo", fooRequest, false, false},
+		// Secure mode, node user.
+		{false, &tls.ConnectionState{}, security.NodeUser, nodeRequest, false, false},
+		// Secure mode, requested user does not match.
+		{false, &tls.ConnectionState{}, "foo", nodeRequest, true, false},
+		// Secure mode, good request.
+		{false, &tls.ConnectionState{}, "foo", fooRequest, true, true},
+	}
+
+	for tcNum, tc := range testCases {
+		hook, err := security.PasswordAuthenticationHook(tc.insecure, tc.tls, tc.user)
+		if (err == nil) != tc.buildHookSuccess {
+			t.Fatalf("#%d: expected success=%t, got err=%v", tcNum, tc.buildHookSuccess, err)
+		}
+		if err != nil {
+			continue
+		}
+		err = hook(tc.request, true /*public*/)
+		if (err == nil) != tc.hookSuccess {
+			t.Fatalf("#%d: expected success=%t, got err=%v", tcNum, tc.hookSuccess, err)
+		}
+	}
+}
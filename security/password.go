@@ -19,6 +19,7 @@ package security
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -64,21 +65,50 @@ func promptForPassword() ([]byte, error) {
 	return []byte(one), nil
 }
 
-// hashPassword takes a raw password and returns a bcrypt hashed password.
-func hashPassword(raw []byte) ([]byte, error) {
-	return bcrypt.GenerateFromPassword(raw, bcryptCost)
+// HashPassword takes a raw password and returns a bcrypt hashed password.
+func HashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
 }
 
-// PromptForPasswordAndHash prompts for a password on the stdin twice,
-// and if both match, returns a bcrypt hashed password.
-func PromptForPasswordAndHash() ([]byte, error) {
+// ErrPasswordMismatch is returned by CompareHashAndPassword when the password
+// does not match the hash.
+var ErrPasswordMismatch = errors.New("password mismatch")
+
+// CompareHashAndPassword checks that the raw password matches the bcrypt
+// hashed password. It returns nil on success and ErrPasswordMismatch if the
+// password is wrong.
+func CompareHashAndPassword(hashedPassword []byte, password string) error {
+	if err := bcrypt.CompareHashAndPassword(hashedPassword, []byte(password)); err != nil {
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return ErrPasswordMismatch
+		}
+		return err
+	}
+	return nil
+}
+
+// dummyHashedPassword is a bcrypt hash at bcryptCost, used to spend as long
+// checking the password of an unknown user as that of a known one.
+var dummyHashedPassword = []byte("$2a$10$mRTBfoppeFfgG2F35OOLRO28OC9vfkeoJuGi3USfmWSjLE/dBp62C")
+
+// CompareDummyHashAndPassword checks the raw password against a fixed bcrypt
+// hash and discards the result. It is used when there is no hash to check the
+// password against, so that the time taken does not reveal whether a user
+// exists.
+func CompareDummyHashAndPassword(password string) {
+	_ = bcrypt.CompareHashAndPassword(dummyHashedPassword, []byte(password))
+}
+
+// PromptForPassword prompts for a password on the stdin twice, and returns it
+// if both match.
+func PromptForPassword() (string, error) {
 	password, err := promptForPassword()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	// TODO(marc): we may want to have a minimum length.
 	if len(password) == 0 {
-		return nil, util.Errorf("password cannot be empty")
+		return "", util.Errorf("password cannot be empty")
 	}
-	return hashPassword(password)
+	return string(password), nil
 }
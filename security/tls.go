@@ -161,6 +161,29 @@ func newClientTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
 	}, nil
 }
 
+// LoadClientCATLSConfig creates a client TLSConfig which verifies the server
+// using the CA cert from the specified directory, but does not present a
+// client certificate. It is used by clients authenticating with a password.
+// If the path is prefixed with "embedded=", load the embedded certs.
+func LoadClientCATLSConfig(certDir string) (*tls.Config, error) {
+	caPEM, err := readFileFn(filepath.Join(certDir, "ca.crt"))
+	if err != nil {
+		return nil, err
+	}
+
+	certPool := x509.NewCertPool()
+
+	if ok := certPool.AppendCertsFromPEM(caPEM); !ok {
+		err := util.Errorf("failed to parse PEM data to pool")
+		return nil, err
+	}
+
+	return &tls.Config{
+		RootCAs:    certPool,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
 // LoadInsecureClientTLSConfig creates a TLSConfig that disables TLS.
 func LoadInsecureClientTLSConfig() *tls.Config {
 	return &tls.Config{
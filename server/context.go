@@ -45,6 +45,7 @@ const (
 	defaultMetricsFrequency   = 10 * time.Second
 	defaultTimeUntilStoreDead = 5 * time.Minute
 	defaultAllowRebalancing   = false
+	defaultClosedTSInterval   = 0
 )
 
 // Context holds parameters needed to setup a server.
@@ -75,6 +76,10 @@ type Context struct {
 	// in zone configs.
 	Attrs string
 
+	// Locality is the hierarchical location of the node (e.g. region, zone
+	// and rack), used to spread replicas across failure domains.
+	Locality roachpb.Locality
+
 	// Maximum clock offset for the cluster.
 	MaxOffset time.Duration
 
@@ -129,6 +134,12 @@ type Context struct {
 	// TimeUntilStoreDead is the time after which if there is no new gossiped
 	// information about a store, it is considered dead.
 	TimeUntilStoreDead time.Duration
+
+	// ClosedTimestampInterval is the interval at which leader lease holders
+	// publish closed timestamps, allowing followers to serve historical
+	// reads. Every publication proposes a raft command on each idle range
+	// whose lease is held by the node, so this is disabled (zero) by default.
+	ClosedTimestampInterval time.Duration
 }
 
 // NewContext returns a Context with default values.
@@ -143,6 +154,8 @@ func NewContext() *Context {
 		MetricsFrequency:   defaultMetricsFrequency,
 		TimeUntilStoreDead: defaultTimeUntilStoreDead,
 		AllowRebalancing:   defaultAllowRebalancing,
+
+		ClosedTimestampInterval: defaultClosedTSInterval,
 	}
 	// Initializes base context defaults.
 	ctx.InitDefaults()
@@ -47,6 +47,10 @@ import (
 const (
 	// gossipInterval is the interval for gossiping storage-related info.
 	gossipInterval = 1 * time.Minute
+	// decommissionCheckInterval is the interval at which a node checks
+	// whether it has been marked for decommissioning, and at which a
+	// decommissioning node queues its replicas to be moved away.
+	decommissionCheckInterval = 10 * time.Second
 	// publishStatusInterval is the interval for publishing periodic statistics
 	// from stores to the internal event feed.
 	publishStatusInterval = 10 * time.Second
@@ -69,6 +73,10 @@ type Node struct {
 	feed       status.NodeEventFeed   // Feed publisher for local events
 	status     *status.NodeStatusMonitor
 	startedAt  int64
+
+	// decommissioning is set once the node has found itself marked for
+	// decommissioning. Only accessed by the decommission check worker.
+	decommissioning bool
 }
 
 // allocateNodeID increments the node id generator key to allocate
@@ -174,10 +182,11 @@ func (n *Node) context() context.Context {
 }
 
 // initDescriptor initializes the node descriptor with the server
-// address and the node attributes.
-func (n *Node) initDescriptor(addr net.Addr, attrs roachpb.Attributes) {
+// address, the node attributes and the node locality.
+func (n *Node) initDescriptor(addr net.Addr, attrs roachpb.Attributes, locality roachpb.Locality) {
 	n.Descriptor.Address = util.MakeUnresolvedAddr(addr.Network(), addr.String())
 	n.Descriptor.Attrs = attrs
+	n.Descriptor.Locality = locality
 }
 
 // initNodeID updates the internal NodeDescriptor with the given ID. If zero is
@@ -221,8 +230,8 @@ func (n *Node) initNodeID(id roachpb.NodeID) {
 // RPC service "Node" and initializing stores for each specified
 // engine. Launches periodic store gossiping in a goroutine.
 func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
-	attrs roachpb.Attributes, stopper *stop.Stopper) error {
-	n.initDescriptor(rpcServer.Addr(), attrs)
+	attrs roachpb.Attributes, locality roachpb.Locality, stopper *stop.Stopper) error {
+	n.initDescriptor(rpcServer.Addr(), attrs, locality)
 	const method = "Node.Batch"
 	if err := rpcServer.Register(method, n.executeCmd, &roachpb.BatchRequest{}); err != nil {
 		log.Fatalf("unable to register node service with RPC server: %s", err)
@@ -246,7 +255,8 @@ func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
 
 	n.startPublishStatuses(stopper)
 	n.startGossip(stopper)
-	log.Infoc(n.context(), "Started node with %v engine(s) and attributes %v", engines, attrs.Attrs)
+	n.startDecommissionCheck(stopper)
+	log.Infoc(n.context(), "Started node with %v engine(s), attributes %v and locality %s", engines, attrs.Attrs, locality)
 	return nil
 }
 
@@ -419,6 +429,54 @@ func (n *Node) gossipStores() {
 	}
 }
 
+// startDecommissionCheck starts a loop which periodically checks whether the
+// node has been marked for decommissioning.
+func (n *Node) startDecommissionCheck(stopper *stop.Stopper) {
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(decommissionCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := n.checkDecommissioning(); err != nil {
+					log.Warningc(n.context(), "error checking for decommissioning: %s", err)
+				}
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// checkDecommissioning looks up whether the node has been marked for
+// decommissioning. Once it has, the node and its stores gossip descriptors
+// advertising it, and the stores queue their replicas so that they are
+// moved to other nodes.
+func (n *Node) checkDecommissioning() error {
+	if n.decommissioning {
+		return n.lSender.VisitStores(func(s *storage.Store) error {
+			s.QueueDecommissioningReplicas()
+			return nil
+		})
+	}
+	marker, err := n.ctx.DB.Get(keys.NodeDecommissionKey(int32(n.Descriptor.NodeID)))
+	if err != nil || !marker.Exists() {
+		return err
+	}
+	log.Infoc(n.context(), "node is being decommissioned")
+	n.decommissioning = true
+	desc := n.Descriptor
+	desc.Decommissioning = true
+	if err := n.ctx.Gossip.SetNodeDescriptor(&desc); err != nil {
+		return err
+	}
+	return n.lSender.VisitStores(func(s *storage.Store) error {
+		s.SetDecommissioning()
+		s.GossipStore()
+		return nil
+	})
+}
+
 // startPublishStatuses starts a loop which periodically instructs each store to
 // publish its current status to the event feed.
 func (n *Node) startPublishStatuses(stopper *stop.Stopper) {
@@ -34,14 +34,18 @@ import (
 )
 
 const (
-	raftServiceName = "MultiRaft"
-	raftMessageName = raftServiceName + ".RaftMessage"
+	raftServiceName       = "MultiRaft"
+	raftMessageName       = raftServiceName + ".RaftMessage"
+	raftSnapshotChunkName = raftServiceName + ".RaftSnapshotChunk"
 	// Outgoing messages are queued on a per-node basis on a channel of
 	// this size.
 	raftSendBufferSize = 500
 	// When no message has been sent to a Node for that duration, the
 	// corresponding instance of processQueue will shut down.
 	raftIdleTimeout = time.Minute
+	// raftSnapshotChunkTimeout is the time allowed for connecting to a node
+	// and for a chunk of snapshot data to be acknowledged by it.
+	raftSnapshotChunkTimeout = time.Minute
 )
 
 // rpcTransport handles the rpc messages for multiraft.
@@ -70,6 +74,10 @@ func newRPCTransport(gossip *gossip.Gossip, rpcServer *rpc.Server, rpcContext *r
 			t.RaftMessage, &multiraft.RaftMessageRequest{}); err != nil {
 			return nil, err
 		}
+		if err := t.rpcServer.Register(raftSnapshotChunkName,
+			t.RaftSnapshotChunk, &multiraft.RaftSnapshotChunkRequest{}); err != nil {
+			return nil, err
+		}
 	}
 
 	return t, nil
@@ -99,6 +107,21 @@ func (t *rpcTransport) RaftMessage(args proto.Message, callback func(proto.Messa
 	callback(resp, err)
 }
 
+// RaftSnapshotChunk proxies a chunk of snapshot data to the store it is
+// addressed to and returns once the store has staged it.
+func (t *rpcTransport) RaftSnapshotChunk(args proto.Message) (proto.Message, error) {
+	req := args.(*multiraft.RaftSnapshotChunkRequest)
+
+	t.mu.Lock()
+	server, ok := t.servers[req.ToReplica.StoreID]
+	t.mu.Unlock()
+
+	if !ok {
+		return nil, util.Errorf("Unable to proxy snapshot chunk to store: %d", req.ToReplica.StoreID)
+	}
+	return server.RaftSnapshotChunk(req)
+}
+
 // Listen implements the multiraft.Transport interface by registering a ServerInterface
 // to receive proxied messages.
 func (t *rpcTransport) Listen(id roachpb.StoreID, server multiraft.ServerInterface) error {
@@ -204,6 +227,38 @@ func (t *rpcTransport) Send(req *multiraft.RaftMessageRequest) error {
 	return nil
 }
 
+// SendSnapshotChunk sends a chunk of snapshot data to the recipient's node
+// and waits for it to be acknowledged. Chunks bypass the per-store message
+// queues, as does the MsgSnap message which refers to them; since it is only
+// sent once all of them have been acknowledged, ordering is preserved.
+func (t *rpcTransport) SendSnapshotChunk(req *multiraft.RaftSnapshotChunkRequest) error {
+	nodeID := req.ToReplica.NodeID
+	addr, err := t.gossip.GetNodeIDAddress(nodeID)
+	if err != nil {
+		return err
+	}
+	client := rpc.NewClient(addr, t.rpcContext)
+	select {
+	case <-t.rpcContext.Stopper.ShouldStop():
+		return multiraft.ErrStopped
+	case <-client.Closed:
+		return util.Errorf("raft client for node %d was closed", nodeID)
+	case <-time.After(raftSnapshotChunkTimeout):
+		return util.Errorf("raft client for node %d stuck connecting", nodeID)
+	case <-client.Healthy():
+	}
+
+	call := client.Go(raftSnapshotChunkName, req, &multiraft.RaftSnapshotChunkResponse{}, nil)
+	select {
+	case <-t.rpcContext.Stopper.ShouldStop():
+		return multiraft.ErrStopped
+	case <-time.After(raftSnapshotChunkTimeout):
+		return util.Errorf("timed out sending snapshot chunk to node %d", nodeID)
+	case <-call.Done:
+		return call.Error
+	}
+}
+
 // Close shuts down an rpcTransport.
 func (t *rpcTransport) Close() {
 	// No-op since we share the global cache of client connections.
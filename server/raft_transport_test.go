@@ -56,6 +56,10 @@ func (s channelServer) RaftMessage(req *multiraft.RaftMessageRequest) (*multiraf
 	return nil, nil
 }
 
+func (s channelServer) RaftSnapshotChunk(req *multiraft.RaftSnapshotChunkRequest) (*multiraft.RaftSnapshotChunkResponse, error) {
+	return nil, util.Errorf("unexpected snapshot chunk %+v", req)
+}
+
 func TestSendAndReceive(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper := stop.NewStopper()
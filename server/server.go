@@ -121,7 +121,14 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 	feed := util.NewFeed(stopper)
 	tracer := tracer.NewTracer(feed, addr)
 
-	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.clock}, s.gossip)
+	dsCtx := &kv.DistSenderContext{Clock: s.clock}
+	if ctx.ClosedTimestampInterval > 0 {
+		// Followers have likely applied a closed timestamp above reads
+		// older than the target lag, allowing for the interval at which
+		// timestamps are closed.
+		dsCtx.FollowerReadMinAge = storage.ClosedTimestampTargetLag + 2*ctx.ClosedTimestampInterval
+	}
+	ds := kv.NewDistSender(dsCtx, s.gossip)
 	sender := kv.NewTxnCoordSender(ds, s.clock, ctx.Linearizable, tracer, s.stopper)
 	s.db = client.NewDB(sender)
 
@@ -153,10 +160,11 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		RebalancingOptions: storage.RebalancingOptions{
 			AllowRebalance: s.ctx.AllowRebalancing,
 		},
+		ClosedTimestampInterval: s.ctx.ClosedTimestampInterval,
 	}
 	s.node = NewNode(nCtx)
 	s.admin = newAdminServer(s.db, s.stopper)
-	s.status = newStatusServer(s.db, s.gossip, ctx)
+	s.status = newStatusServer(s.db, s.gossip, s.node.lSender, ctx)
 	s.tsDB = ts.NewDB(s.db)
 	s.tsServer = ts.NewServer(s.tsDB)
 
@@ -181,7 +189,7 @@ func (s *Server) Start(selfBootstrap bool) error {
 	}
 	s.gossip.Start(s.rpc, s.stopper)
 
-	if err := s.node.start(s.rpc, s.ctx.Engines, s.ctx.NodeAttributes, s.stopper); err != nil {
+	if err := s.node.start(s.rpc, s.ctx.Engines, s.ctx.NodeAttributes, s.ctx.Locality, s.stopper); err != nil {
 		return err
 	}
 
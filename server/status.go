@@ -30,6 +30,7 @@ import (
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/server/status"
 	"github.com/cockroachdb/cockroach/storage"
@@ -51,6 +52,9 @@ const (
 		/_status/logs/:node_id           - log entries from a specific node
 		/_status/stacks/:node_id		 - exposes stack traces of running
 										   goroutines
+		/_status/inconsistencies/:node_id - replicas on a specific node found
+										   to be inconsistent with their
+										   range's leader
 		/_status/nodes				     - all nodes' status
 		/_status/nodes/:node_id		     - a specific node's status
 		/_status/stores                  - all stores' status
@@ -81,6 +85,10 @@ const (
 	// stackTraceApproxSize is the approximate size of a goroutine stack trace.
 	stackTraceApproxSize = 1024
 
+	// statusInconsistenciesPattern exposes the replicas on a node which
+	// consistency checks found to be inconsistent with their range's leader.
+	statusInconsistenciesPattern = "/_status/inconsistencies/:node_id"
+
 	// statusNodesPrefix exposes status for all nodes in the cluster.
 	statusNodesPrefix = "/_status/nodes/"
 	// statusNodePattern exposes status for a single node.
@@ -99,13 +107,14 @@ var localRE = regexp.MustCompile(`(?i)local`)
 type statusServer struct {
 	db          *client.DB
 	gossip      *gossip.Gossip
+	stores      *kv.LocalSender
 	router      *httprouter.Router
 	ctx         *Context
 	proxyClient *http.Client
 }
 
 // newStatusServer allocates and returns a statusServer.
-func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *statusServer {
+func newStatusServer(db *client.DB, gossip *gossip.Gossip, stores *kv.LocalSender, ctx *Context) *statusServer {
 	// Create an http client with a timeout
 	tlsConfig, err := ctx.GetClientTLSConfig()
 	if err != nil {
@@ -120,6 +129,7 @@ func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *status
 	server := &statusServer{
 		db:          db,
 		gossip:      gossip,
+		stores:      stores,
 		router:      httprouter.New(),
 		ctx:         ctx,
 		proxyClient: httpClient,
@@ -131,6 +141,7 @@ func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *status
 	server.router.GET(statusLogFilePattern, server.handleLogFile)
 	server.router.GET(statusLogsPattern, server.handleLogs)
 	server.router.GET(statusStacksPattern, server.handleStacks)
+	server.router.GET(statusInconsistenciesPattern, server.handleInconsistencies)
 	server.router.GET(statusNodesPrefix, server.handleNodesStatus)
 	server.router.GET(statusNodePattern, server.handleNodeStatus)
 	server.router.GET(statusStoresPrefix, server.handleStoresStatus)
@@ -534,6 +545,46 @@ func (s *statusServer) handleStacks(w http.ResponseWriter, r *http.Request, ps h
 	}
 }
 
+// handleInconsistenciesLocal handles local requests for the replicas found
+// to be inconsistent with their range's leader.
+func (s *statusServer) handleInconsistenciesLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	inconsistencies := []storage.Inconsistency{}
+	if err := s.stores.VisitStores(func(store *storage.Store) error {
+		inconsistencies = append(inconsistencies, store.Inconsistencies()...)
+		return nil
+	}); err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	b, contentType, err := util.MarshalResponse(r, inconsistencies, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, contentType)
+	if _, err := w.Write(b); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// handleInconsistencies handles GET requests for the replicas on a node
+// found to be inconsistent with their range's leader.
+func (s *statusServer) handleInconsistencies(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if local {
+		s.handleInconsistenciesLocal(w, r, ps)
+	} else {
+		s.proxyRequest(nodeID, w, r)
+	}
+}
+
 // handleNodesStatus handles GET requests for all node statuses.
 func (s *statusServer) handleNodesStatus(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	startKey := keys.StatusNodePrefix
@@ -42,6 +42,10 @@ type StoreStatusMonitor struct {
 	leaderRangeCount     int32
 	replicatedRangeCount int32
 	availableRangeCount  int32
+
+	// Number of consistency checks which found a replica on the store to be
+	// inconsistent with its range's leader.
+	consistencyFailures int64
 }
 
 // NodeStatusMonitor monitors the status of a server node. Status information
@@ -190,6 +194,14 @@ func (nsm *NodeStatusMonitor) OnReplicationStatus(event *storage.ReplicationStat
 	ssm.availableRangeCount = event.AvailableRangeCount
 }
 
+// OnConsistencyCheckFailed receives ConsistencyCheckFailedEvents retrieved
+// from a storage event subscription. This method is part of the
+// implementation of store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnConsistencyCheckFailed(event *storage.ConsistencyCheckFailedEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	atomic.AddInt64(&ssm.consistencyFailures, 1)
+}
+
 // OnStartNode receives StartNodeEvents from a node event subscription. This
 // method is part of the implementation of NodeEventListener.
 func (nsm *NodeStatusMonitor) OnStartNode(event *StartNodeEvent) {
@@ -121,6 +121,7 @@ func (nsr *NodeStatusRecorder) GetTimeSeriesData() []ts.TimeSeriesData {
 		data = append(data, ssr.recordInt("ranges.leader", int64(ssr.leaderRangeCount)))
 		data = append(data, ssr.recordInt("ranges.replicated", int64(ssr.replicatedRangeCount)))
 		data = append(data, ssr.recordInt("ranges.available", int64(ssr.availableRangeCount)))
+		data = append(data, ssr.recordInt("consistency.failures", atomic.LoadInt64(&ssr.consistencyFailures)))
 
 		// Record statistics from descriptor.
 		if ssr.desc != nil {
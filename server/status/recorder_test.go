@@ -189,6 +189,11 @@ func TestNodeStatusRecorder(t *testing.T) {
 		AvailableRangeCount:  2,
 		ReplicatedRangeCount: 0,
 	})
+	// A failed consistency check.
+	monitor.OnConsistencyCheckFailed(&storage.ConsistencyCheckFailedEvent{
+		StoreID: roachpb.StoreID(1),
+		RangeID: roachpb.RangeID(1),
+	})
 	// Node Events.
 	monitor.OnCallSuccess(&CallSuccessEvent{
 		NodeID: roachpb.NodeID(1),
@@ -247,6 +252,7 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(1, "ranges.leader", 100, 1),
 		generateStoreData(1, "ranges.available", 100, 2),
 		generateStoreData(1, "ranges.replicated", 100, 0),
+		generateStoreData(1, "consistency.failures", 100, 1),
 		generateStoreData(1, "capacity", 100, 100),
 		generateStoreData(1, "capacity.available", 100, 50),
 
@@ -266,6 +272,7 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(2, "ranges.leader", 100, 1),
 		generateStoreData(2, "ranges.available", 100, 2),
 		generateStoreData(2, "ranges.replicated", 100, 0),
+		generateStoreData(2, "consistency.failures", 100, 0),
 		generateStoreData(2, "capacity", 100, 200),
 		generateStoreData(2, "capacity.available", 100, 75),
 
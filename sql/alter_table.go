@@ -20,6 +20,7 @@ package sql
 import (
 	"fmt"
 
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util"
@@ -96,6 +97,20 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 				return nil, util.Errorf("unsupported constraint: %T", t.ConstraintDef)
 			}
 
+		case *parser.AlterTableSetAudit:
+			if p.user != security.RootUser {
+				return nil, fmt.Errorf("only %s is allowed to change the audit mode of a table",
+					security.RootUser)
+			}
+			switch t.Mode {
+			case parser.AuditModeDisable:
+				tableDesc.AuditMode = TableDescriptor_DISABLED
+			case parser.AuditModeReadWrite:
+				tableDesc.AuditMode = TableDescriptor_READWRITE
+			default:
+				return nil, util.Errorf("unsupported audit mode: %s", t.Mode)
+			}
+
 		default:
 			return nil, util.Errorf("unsupported alter cmd: %T", cmd)
 		}
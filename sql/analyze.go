@@ -1390,6 +1390,43 @@ func varEqual(a, b parser.Expr) bool {
 	return false
 }
 
+// exprImplies returns true if the expression e, which has been split into the
+// conjuncts conj, implies the expression c. The check is conservative. Either
+// c is identical to one of the conjuncts of e or it is a comparison of a
+// qvalue to a constant which does not change the simplified form of e when
+// added to it. For example, "a > 2" implies "a > 1" because "a > 2 AND a > 1"
+// simplifies to "a > 2".
+func exprImplies(e parser.Expr, conj parser.Exprs, c parser.Expr) bool {
+	s := c.String()
+	for _, x := range conj {
+		if x.String() == s {
+			return true
+		}
+	}
+
+	cmp, ok := c.(*parser.ComparisonExpr)
+	if !ok {
+		return false
+	}
+	if _, ok := cmp.Left.(*qvalue); !ok || !isDatum(cmp.Right) {
+		return false
+	}
+	switch cmp.Operator {
+	case parser.EQ, parser.LT, parser.LE, parser.GT, parser.GE, parser.In:
+	case parser.Is, parser.IsNot:
+		if cmp.Right != parser.DNull {
+			return false
+		}
+	default:
+		// Simplification of the other operators is not exact. For example,
+		// "a > 1 AND a != 5" is simplified to "a > 1" and "a LIKE 'foo%bar'" is
+		// simplified to "a >= 'foo' AND a < 'fop'".
+		return false
+	}
+
+	return fmt.Sprint(analyzeExpr(&parser.AndExpr{Left: e, Right: c})) == fmt.Sprint(analyzeExpr(e))
+}
+
 func makeIsNotNull(left parser.Expr) parser.Expr {
 	return &parser.ComparisonExpr{
 		Operator: parser.IsNot,
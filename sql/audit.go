@@ -0,0 +1,92 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	auditReadAccess  = "READ"
+	auditWriteAccess = "WRITE"
+)
+
+// auditAccess is an access of the current statement to an audited table.
+type auditAccess struct {
+	table  string
+	access string
+}
+
+// recordAuditAccess records the access to the table if it is audited. The
+// access is recorded before the privileges are checked so that denied
+// accesses are audited as well.
+func (p *planner) recordAuditAccess(descriptor descriptorProto, priv privilege.Kind) {
+	desc, ok := descriptor.(*TableDescriptor)
+	if !ok || desc.AuditMode != TableDescriptor_READWRITE {
+		return
+	}
+	var access string
+	switch priv {
+	case privilege.SELECT:
+		access = auditReadAccess
+	case privilege.INSERT, privilege.UPDATE, privilege.DELETE, privilege.DROP:
+		access = auditWriteAccess
+	default:
+		// Schema changes and grants are not audited.
+		return
+	}
+	a := auditAccess{table: desc.Name, access: access}
+	for _, existing := range p.auditAccesses {
+		if existing == a {
+			return
+		}
+	}
+	p.auditAccesses = append(p.auditAccesses, a)
+}
+
+// makeAuditEvents returns the audit events of a statement from the accesses
+// recorded while executing it.
+func (p *planner) makeAuditEvents(stmt parser.Statement, result driver.Response_Result, err error) []log.AuditEvent {
+	if len(p.auditAccesses) == 0 {
+		return nil
+	}
+	var rowsAffected int64
+	switch t := result.Union.(type) {
+	case *driver.Response_Result_RowsAffected:
+		rowsAffected = int64(t.RowsAffected)
+	case *driver.Response_Result_Rows_:
+		rowsAffected = int64(len(t.Rows.Rows))
+	}
+	var errStr string
+	if err != nil {
+		errStr = err.Error()
+	}
+	events := make([]log.AuditEvent, 0, len(p.auditAccesses))
+	for _, a := range p.auditAccesses {
+		events = append(events, log.AuditEvent{
+			User:         p.user,
+			Statement:    stmt.String(),
+			Table:        a.table,
+			Access:       a.access,
+			RowsAffected: rowsAffected,
+			Error:        errStr,
+		})
+	}
+	return events
+}
@@ -0,0 +1,74 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+func TestAuditEvents(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	p := planner{user: "foo"}
+
+	audited := &TableDescriptor{Name: "audited", AuditMode: TableDescriptor_READWRITE}
+	notAudited := &TableDescriptor{Name: "other"}
+
+	p.recordAuditAccess(notAudited, privilege.SELECT)
+	p.recordAuditAccess(&DatabaseDescriptor{Name: "db"}, privilege.SELECT)
+	p.recordAuditAccess(audited, privilege.CREATE)
+	if len(p.auditAccesses) != 0 {
+		t.Fatalf("unexpected audit accesses: %+v", p.auditAccesses)
+	}
+	if events := p.makeAuditEvents(nil, driver.Response_Result{}, nil); events != nil {
+		t.Fatalf("unexpected audit events: %+v", events)
+	}
+
+	p.recordAuditAccess(audited, privilege.SELECT)
+	p.recordAuditAccess(audited, privilege.UPDATE)
+	p.recordAuditAccess(audited, privilege.SELECT)
+
+	stmts, err := parser.ParseTraditional(`UPDATE audited SET v = 1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt := stmts[0]
+	result := driver.Response_Result{
+		Union: &driver.Response_Result_RowsAffected{RowsAffected: 2},
+	}
+	expected := []log.AuditEvent{
+		{User: "foo", Statement: stmt.String(), Table: "audited", Access: "READ", RowsAffected: 2},
+		{User: "foo", Statement: stmt.String(), Table: "audited", Access: "WRITE", RowsAffected: 2},
+	}
+	if events := p.makeAuditEvents(stmt, result, nil); !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected %+v, got %+v", expected, events)
+	}
+
+	for i := range expected {
+		expected[i].RowsAffected = 0
+		expected[i].Error = "boom"
+	}
+	if events := p.makeAuditEvents(stmt, driver.Response_Result{}, errors.New("boom")); !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected %+v, got %+v", expected, events)
+	}
+}
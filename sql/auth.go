@@ -0,0 +1,209 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// errInvalidPassword is returned for any failed password authentication, so
+// that a client cannot tell unknown users from wrong passwords.
+var errInvalidPassword = fmt.Errorf("invalid user or password")
+
+const (
+	// authMethodCacheTTL is the time for which the authentication method of
+	// a user is reused for the requests of a connection.
+	authMethodCacheTTL = time.Minute
+	// maxCachedAuthMethods bounds the number of cached authentication
+	// methods.
+	maxCachedAuthMethods = 10000
+)
+
+// userAuthInfo is the authentication configuration of a user, as stored in
+// the system.users table.
+type userAuthInfo struct {
+	hashedPassword []byte
+	method         string
+}
+
+// getUserAuthInfo reads the authentication configuration of the user from
+// the system.users table. The returned bool is false if the user does not
+// exist.
+func (e *Executor) getUserAuthInfo(username string) (userAuthInfo, bool, error) {
+	var info userAuthInfo
+	var rows []parser.DTuple
+	p := planner{
+		evalCtx: parser.EvalContext{
+			NodeID: e.nodeID,
+		},
+		systemConfig: e.getSystemConfig(),
+	}
+	p.evalCtx.GetLocation = p.session.getLocation
+	if err := e.db.Txn(func(txn *client.Txn) error {
+		p.setTxn(txn, time.Now())
+		defer p.resetTxn()
+		var err error
+		rows, err = p.queryRows(fmt.Sprintf(
+			`SELECT hashedPassword, authMethod FROM system.users WHERE username = %s`,
+			parser.DString(username)))
+		return err
+	}); err != nil || len(rows) == 0 {
+		return info, false, err
+	}
+	if v, ok := rows[0][0].(parser.DBytes); ok {
+		info.hashedPassword = []byte(v)
+	}
+	if v, ok := rows[0][1].(parser.DString); ok {
+		info.method = string(v)
+	}
+	return info, true, nil
+}
+
+// authMethodKey identifies the user of a connection.
+type authMethodKey struct {
+	conn string
+	user string
+}
+
+// authMethodEntry is a cached authentication method.
+type authMethodEntry struct {
+	method string
+	cached time.Time
+}
+
+// An authMethodCache holds the authentication methods of the users of client
+// certificate connections, so that the system.users table is read once per
+// connection rather than once per request. Connections are told apart by
+// their TLS channel binding.
+type authMethodCache struct {
+	mu      sync.Mutex
+	entries map[authMethodKey]authMethodEntry
+}
+
+func newAuthMethodCache() *authMethodCache {
+	return &authMethodCache{entries: map[authMethodKey]authMethodEntry{}}
+}
+
+// get returns the cached authentication method for the key, if it has not
+// expired.
+func (c *authMethodCache) get(key authMethodKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Since(entry.cached) > authMethodCacheTTL {
+		return "", false
+	}
+	return entry.method, true
+}
+
+// add caches the authentication method for the key. Expired entries are
+// pruned once the cache is full, and all entries are dropped if none were.
+func (c *authMethodCache) add(key authMethodKey, method string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if len(c.entries) >= maxCachedAuthMethods {
+		for k, entry := range c.entries {
+			if now.Sub(entry.cached) > authMethodCacheTTL {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxCachedAuthMethods {
+			c.entries = map[authMethodKey]authMethodEntry{}
+		}
+	}
+	c.entries[key] = authMethodEntry{method: method, cached: now}
+}
+
+// authenticatePassword verifies the password of the user against the hash
+// stored in the system.users table and returns the authentication hook for
+// its requests. The user must be configured for password authentication.
+// Failed attempts are rate-limited per user.
+func (s HTTPServer) authenticatePassword(tlsState *tls.ConnectionState, username, password string) (
+	func(request proto.Message, public bool) error, error) {
+	if tlsState == nil {
+		return nil, util.Errorf("password authentication requires TLS")
+	}
+	if err := s.authLimiter.Check(username); err != nil {
+		return nil, err
+	}
+
+	info, ok, err := s.getUserAuthInfo(username)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || info.method != security.PasswordAuthMethod || len(info.hashedPassword) == 0 {
+		// Check the password anyway, so that unknown users cannot be told
+		// apart from wrong passwords by the response time.
+		security.CompareDummyHashAndPassword(password)
+		s.authLimiter.Failure(username)
+		return nil, errInvalidPassword
+	}
+	if err := security.CompareHashAndPassword(info.hashedPassword, password); err != nil {
+		if err == security.ErrPasswordMismatch {
+			s.authLimiter.Failure(username)
+			return nil, errInvalidPassword
+		}
+		return nil, err
+	}
+	s.authLimiter.Success(username)
+
+	return security.PasswordAuthenticationHook(s.context.Insecure, tlsState, username)
+}
+
+// checkCertAuthMethod verifies that the requested user may be authenticated
+// with the client certificate. A user configured for password authentication
+// must supply its password, unless the request comes from a node. The
+// authentication method of the user is cached for the connection.
+func (s HTTPServer) checkCertAuthMethod(tlsState *tls.ConnectionState, username string) error {
+	if s.context.Insecure || username == security.RootUser || username == security.NodeUser {
+		return nil
+	}
+	certUser, err := security.GetCertificateUser(tlsState)
+	if err != nil {
+		return err
+	}
+	if certUser == security.NodeUser {
+		return nil
+	}
+	key := authMethodKey{conn: string(tlsState.TLSUnique), user: username}
+	method, ok := s.authMethods.get(key)
+	if !ok {
+		info, _, err := s.getUserAuthInfo(username)
+		if err != nil {
+			return err
+		}
+		method = info.method
+		// The channel binding is not available with every TLS version.
+		if len(key.conn) > 0 {
+			s.authMethods.add(key, method)
+		}
+	}
+	if method == security.PasswordAuthMethod {
+		return fmt.Errorf("user %s must authenticate with a password", username)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestPasswordAuthentication(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`INSERT INTO system.users VALUES ($1, $2, $3)`,
+		"testuser", hashed, security.CertAuthMethod); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(userInfo string, expectSuccess bool) {
+		db, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=test_certs",
+			userInfo, s.ServingAddr()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		if _, err := db.Exec(`SELECT 1`); (err == nil) != expectSuccess {
+			t.Fatalf("%s: expected success=%t, got err=%v", userInfo, expectSuccess, err)
+		}
+	}
+
+	// A user configured for certificate authentication cannot use its password.
+	check("testuser", true)
+	check("testuser:secret", false)
+
+	if _, err := sqlDB.Exec(`UPDATE system.users SET authMethod = $1 WHERE username = $2`,
+		security.PasswordAuthMethod, "testuser"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A user configured for password authentication cannot use its certificate.
+	check("testuser", false)
+	check("testuser:secret", true)
+	check("testuser:wrong", false)
+	check("unknown:secret", false)
+
+	// Repeated failures lock the user out, even with the right password.
+	for i := 0; i < security.DefaultMaxFailedAuthAttempts; i++ {
+		check("testuser:wrong", false)
+	}
+	check("testuser:secret", false)
+}
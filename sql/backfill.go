@@ -27,7 +27,6 @@ func (p *planner) makeBackfillBatch(tableName *parser.QualifiedName, tableDesc *
 	b := client.Batch{}
 	// Get all the rows affected.
 	// TODO(vivek): Avoid going through Select.
-	// TODO(tamird): Support partial indexes?
 	row, err := p.Select(&parser.Select{
 		Exprs: parser.SelectExprs{parser.StarSelectExpr()},
 		From:  parser.TableExprs{&parser.AliasedTableExpr{Expr: tableName}},
@@ -47,6 +46,11 @@ func (p *planner) makeBackfillBatch(tableName *parser.QualifiedName, tableDesc *
 		colIDtoRowIndex[c.ID] = i
 	}
 
+	indexExprs, err := p.makeIndexExprs(tableDesc, indexDescs)
+	if err != nil {
+		return b, err
+	}
+
 	// TODO(tamird): This will fall down in production use. We need to do
 	// something better (see #2036). In particular, this implementation
 	// has the following problems:
@@ -63,12 +67,16 @@ func (p *planner) makeBackfillBatch(tableName *parser.QualifiedName, tableDesc *
 
 		for _, indexDesc := range indexDescs {
 			secondaryIndexEntries, err := encodeSecondaryIndexes(
-				tableDesc.ID, []IndexDescriptor{indexDesc}, colIDtoRowIndex, rowVals)
+				tableDesc, []IndexDescriptor{indexDesc}, indexExprs, colIDtoRowIndex, rowVals)
 			if err != nil {
 				return b, err
 			}
 
 			for _, secondaryIndexEntry := range secondaryIndexEntries {
+				if secondaryIndexEntry.key == nil {
+					// The row is not part of a partial index.
+					continue
+				}
 				if log.V(2) {
 					log.Infof("CPut %s -> %v", prettyKey(secondaryIndexEntry.key, 0),
 						secondaryIndexEntry.value)
@@ -71,9 +71,33 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 	indexDesc := IndexDescriptor{
 		Name:             string(n.Name),
 		Unique:           n.Unique,
-		ColumnNames:      n.Columns,
 		StoreColumnNames: n.Storing,
 	}
+	var columnExprs []string
+	hasExprs := false
+	for _, elem := range n.Columns {
+		if elem.Expr == nil {
+			indexDesc.ColumnNames = append(indexDesc.ColumnNames, string(elem.Column))
+			columnExprs = append(columnExprs, "")
+			continue
+		}
+		expr := elem.Expr.String()
+		if err := checkIndexExpr(tableDesc, expr, false); err != nil {
+			return nil, err
+		}
+		indexDesc.ColumnNames = append(indexDesc.ColumnNames, expr)
+		columnExprs = append(columnExprs, expr)
+		hasExprs = true
+	}
+	if hasExprs {
+		indexDesc.ColumnExprs = columnExprs
+	}
+	if n.Where != nil {
+		indexDesc.Predicate = n.Where.Expr.String()
+		if err := checkIndexExpr(tableDesc, indexDesc.Predicate, true); err != nil {
+			return nil, err
+		}
+	}
 	if err := tableDesc.AddIndex(indexDesc, false); err != nil {
 		return nil, err
 	}
@@ -82,6 +106,16 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 		return nil, err
 	}
 
+	var parentDesc *TableDescriptor
+	if n.Interleave != nil {
+		index := &tableDesc.Indexes[len(tableDesc.Indexes)-1]
+		if parentDesc, err = p.addInterleave(tableDesc, index, n.Interleave); err != nil {
+			return nil, err
+		}
+		parentDesc.PrimaryIndex.InterleavedBy = append(parentDesc.PrimaryIndex.InterleavedBy,
+			IndexReference{TableID: tableDesc.ID, IndexID: index.ID})
+	}
+
 	// `indexDesc` changed on us when we called `tableDesc.AllocateIDs()`.
 	indexDesc = tableDesc.Indexes[len(tableDesc.Indexes)-1]
 
@@ -91,6 +125,9 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 	}
 
 	b.Put(MakeDescMetadataKey(tableDesc.GetID()), tableDesc)
+	if parentDesc != nil {
+		b.Put(MakeDescMetadataKey(parentDesc.GetID()), parentDesc)
+	}
 
 	if err := p.txn.Run(&b); err != nil {
 		return nil, convertBatchError(tableDesc, b, err)
@@ -127,8 +164,85 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 		return nil, err
 	}
 
+	var parentDesc *TableDescriptor
+	if n.Interleave != nil {
+		if parentDesc, err = p.addInterleave(&desc, &desc.PrimaryIndex, n.Interleave); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := p.createDescriptor(tableKey{dbDesc.ID, n.Table.Table()}, &desc, n.IfNotExists); err != nil {
 		return nil, err
 	}
+
+	if parentDesc != nil && desc.ID != 0 {
+		// The table was created; record it in its parent.
+		parentDesc.PrimaryIndex.InterleavedBy = append(parentDesc.PrimaryIndex.InterleavedBy,
+			IndexReference{TableID: desc.ID, IndexID: desc.PrimaryIndex.ID})
+		if err := p.txn.Put(MakeDescMetadataKey(parentDesc.GetID()), parentDesc); err != nil {
+			return nil, err
+		}
+	}
 	return &valuesNode{}, nil
 }
+
+// addInterleave interleaves index, which belongs to desc, into the primary
+// index of the parent table named by interleave. The columns named by
+// interleave must be the leading columns of index and must match the types of
+// the parent's primary key columns. The parent's descriptor is returned; the
+// caller is responsible for recording the interleaved index in it.
+func (p *planner) addInterleave(desc *TableDescriptor, index *IndexDescriptor,
+	interleave *parser.InterleaveDef) (*TableDescriptor, error) {
+	parentDesc, err := p.getTableDesc(interleave.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if parentDesc.ID == desc.ID {
+		return nil, fmt.Errorf("cannot interleave table %q into itself", desc.Name)
+	}
+	if err := p.checkPrivilege(parentDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
+	parentIndex := &parentDesc.PrimaryIndex
+	if len(interleave.Fields) != len(parentIndex.ColumnIDs) {
+		return nil, fmt.Errorf("interleaved columns must match the primary key of parent table %q",
+			parentDesc.Name)
+	}
+	if len(interleave.Fields) > len(index.ColumnIDs) {
+		return nil, fmt.Errorf("interleaved columns must be a prefix of the columns of index %q",
+			index.Name)
+	}
+	for i, parentColID := range parentIndex.ColumnIDs {
+		if !equalName(string(interleave.Fields[i]), index.ColumnNames[i]) {
+			return nil, fmt.Errorf("interleaved columns must be a prefix of the columns of index %q",
+				index.Name)
+		}
+		col, err := desc.FindColumnByID(index.ColumnIDs[i])
+		if err != nil {
+			return nil, err
+		}
+		parentCol, err := parentDesc.FindColumnByID(parentColID)
+		if err != nil {
+			return nil, err
+		}
+		if col.Type.Kind != parentCol.Type.Kind {
+			return nil, fmt.Errorf("interleaved column %q (%s) does not match the type of column %q (%s) of parent table %q",
+				col.Name, col.Type.Kind, parentCol.Name, parentCol.Type.Kind, parentDesc.Name)
+		}
+	}
+
+	// The index shares the columns of its parent's ancestors in addition to
+	// the remaining columns of its parent's primary key.
+	var sharedPrefixLen uint32
+	ancestors := append([]InterleaveDescriptor_Ancestor(nil), parentIndex.Interleave.Ancestors...)
+	for _, ancestor := range ancestors {
+		sharedPrefixLen += ancestor.SharedPrefixLen
+	}
+	index.Interleave.Ancestors = append(ancestors, InterleaveDescriptor_Ancestor{
+		TableID:         parentDesc.ID,
+		IndexID:         parentIndex.ID,
+		SharedPrefixLen: uint32(len(parentIndex.ColumnIDs)) - sharedPrefixLen,
+	})
+	return parentDesc, nil
+}
@@ -64,7 +64,11 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	}
 
 	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+
+	indexExprs, err := p.makeIndexExprs(tableDesc, tableDesc.Indexes)
+	if err != nil {
+		return nil, err
+	}
 
 	b := client.Batch{}
 	result := &valuesNode{}
@@ -72,20 +76,24 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		rowVals := rows.Values()
 		result.rows = append(result.rows, parser.DTuple(nil))
 
-		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
+		primaryIndexKey, _, err := makeIndexKey(
+			tableDesc, &primaryIndex, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
 
 		// Delete the secondary indexes.
 		secondaryIndexEntries, err := encodeSecondaryIndexes(
-			tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, rowVals)
+			tableDesc, tableDesc.Indexes, indexExprs, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, secondaryIndexEntry := range secondaryIndexEntries {
+			if secondaryIndexEntry.key == nil {
+				// The row is not part of a partial index.
+				continue
+			}
 			if log.V(2) {
 				log.Infof("Del %s", prettyKey(secondaryIndexEntry.key, 0))
 			}
@@ -95,6 +103,10 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		// Delete the row.
 		rowStartKey := roachpb.Key(primaryIndexKey)
 		rowEndKey := rowStartKey.PrefixEnd()
+		if len(primaryIndex.InterleavedBy) > 0 {
+			// Stop short of the rows interleaved into this row.
+			rowEndKey = append(append(roachpb.Key(nil), rowStartKey...), interleavedSentinel)
+		}
 		if log.V(2) {
 			log.Infof("DelRange %s - %s", prettyKey(rowStartKey, 0), prettyKey(rowEndKey, 0))
 		}
@@ -61,9 +61,20 @@ type descriptorProto interface {
 	Validate() error
 }
 
-// checkPrivilege verifies that p.user has `privilege` on `descriptor`.
+// checkPrivilege verifies that p.user has `privilege` on `descriptor`, either
+// directly or through the roles it is a member of.
 func (p *planner) checkPrivilege(descriptor descriptorProto, privilege privilege.Kind) error {
-	if descriptor.GetPrivileges().CheckPrivilege(p.user, privilege) {
+	p.recordAuditAccess(descriptor, privilege)
+	// The role memberships are only read when the user lacks the privilege
+	// itself.
+	if descriptor.GetPrivileges().CheckPrivilege(p.user, nil, privilege) {
+		return nil
+	}
+	memberships, err := p.getRoleMemberships()
+	if err != nil {
+		return err
+	}
+	if descriptor.GetPrivileges().CheckPrivilege(p.user, memberships, privilege) {
 		return nil
 	}
 	return fmt.Errorf("user %s does not have %s privilege on %s %s",
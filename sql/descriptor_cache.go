@@ -34,7 +34,7 @@ var TestingDisableDescriptorCache bool
 //
 // Returns: the table descriptor, whether it comes from the cache, and an error.
 func (p *planner) getCachedTableDesc(qname *parser.QualifiedName) (*TableDescriptor, bool, error) {
-	if err := qname.NormalizeTableName(p.session.Database); err != nil {
+	if err := p.normalizeTableName(qname); err != nil {
 		return nil, false, err
 	}
 	dbDesc, cached, err := p.getCachedDatabaseDesc(qname.Database())
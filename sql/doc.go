@@ -113,20 +113,31 @@ corresponds to the primary index:
 
 The column data associated with a row in a table is stored within the primary
 index which is the index associated with the primary key. Every column has a
-unique ID (that is local to the table). The value for a column is stored at the
-key:
+unique ID (that is local to the table) and belongs to a column family. The
+values of the columns in a family are stored together in a single key:
 
-  /TableID/PrimaryIndexID/parentID/name/ColumnID -> Value
+  /TableID/PrimaryIndexID/parentID/name/FamilyID -> Value
 
-A column containing a NULL value is not stored in the monolithic map. In order
-to detect rows which only contain NULL values in non-primary key columns, every
-row has a sentinel key indicating its existence. The sentinel key is simply the
-primary index key:
+The value packs together the non-NULL column values of the family, each
+prefixed by its column ID. By default, a table has a single family, so all of
+the column values of a row are stored in a single key. Splitting the columns
+into multiple families allows updating a column without rewriting the values
+of columns in other families. A family containing only NULL values is not
+stored in the monolithic map. In order to detect rows which only contain NULL
+values in non-primary key columns, every row has a sentinel key indicating its
+existence. The sentinel key is simply the primary index key, which is also the
+key of the family with ID 0:
 
-  /TableID/PrimaryIndexID/parentID/name -> NULL
+  /TableID/PrimaryIndexID/parentID/name -> Value
 
 As an optimization, columns that are part of the primary key are not stored
-separately as their data can be decoded from the sentinel value.
+separately as their data can be decoded from the sentinel key.
+
+The system tables, whose keys are constructed directly, store each column in
+a family of its own whose ID is the column ID, and the value is simply the
+column value. For example, the descriptor ID of a table is stored at:
+
+  /TableID/PrimaryIndexID/parentID/name/ColumnID -> Value
 
 Secondary Indexes
 
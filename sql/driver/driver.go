@@ -45,6 +45,9 @@ func (roachDriver) Open(dsn string) (driver.Conn, error) {
 	ctx.InitDefaults()
 	if u.User != nil {
 		ctx.User = u.User.Username()
+		if password, ok := u.User.Password(); ok {
+			ctx.Password = password
+		}
 	}
 	q := u.Query()
 	params := make(map[string]string)
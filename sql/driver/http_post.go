@@ -100,6 +100,9 @@ func httpPost(c postContext, request, response proto.Message, method fmt.Stringe
 		req.Header.Add(util.ContentTypeHeader, util.ProtoContentType)
 		req.Header.Add(util.AcceptHeader, util.ProtoContentType)
 		req.Header.Add(util.AcceptEncodingHeader, util.SnappyEncoding)
+		if c.Context.Password != "" {
+			req.SetBasicAuth(c.Context.User, c.Context.Password)
+		}
 
 		resp, err = client.Do(req)
 		if err != nil {
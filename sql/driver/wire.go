@@ -18,7 +18,9 @@
 package driver
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"fmt"
 	"time"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -59,6 +61,16 @@ func makeDatum(val driver.Value) (Datum, error) {
 		datum.Payload = &Datum_DateVal{
 			&timestamp,
 		}
+	case Array:
+		array := &Datum_Array{Values: make([]Datum, 0, len(t))}
+		for _, v := range t {
+			d, err := makeDatum(v)
+			if err != nil {
+				return datum, err
+			}
+			array.Values = append(array.Values, d)
+		}
+		datum.Payload = &Datum_ArrayVal{array}
 	default:
 		return datum, util.Errorf("unsupported type %T", t)
 	}
@@ -83,6 +95,30 @@ func (d Date) String() string {
 	return d.Format("2006-01-02")
 }
 
+// Array is a one-dimensional array of values.
+type Array []driver.Value
+
+// String returns the array formatted the way postgres does, e.g. "{1,NULL,3}".
+func (a Array) String() string {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, v := range a {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		switch t := v.(type) {
+		case nil:
+			buf.WriteString("NULL")
+		case []byte:
+			buf.Write(t)
+		default:
+			fmt.Fprint(&buf, t)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.String()
+}
+
 // Value implements the driver.Valuer interface.
 func (d Datum) Value() (driver.Value, error) {
 	var val driver.Value
@@ -106,6 +142,16 @@ func (d Datum) Value() (driver.Value, error) {
 		val = t.TimeVal.GoTime()
 	case *Datum_IntervalVal:
 		val = time.Duration(t.IntervalVal)
+	case *Datum_ArrayVal:
+		array := make(Array, 0, len(t.ArrayVal.Values))
+		for _, d := range t.ArrayVal.Values {
+			v, err := d.Value()
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, v)
+		}
+		val = array
 	default:
 		return nil, util.Errorf("unsupported type %T", t)
 	}
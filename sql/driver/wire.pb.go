@@ -42,6 +42,7 @@ type Datum struct {
 	//	*Datum_DateVal
 	//	*Datum_TimeVal
 	//	*Datum_IntervalVal
+	//	*Datum_ArrayVal
 	Payload isDatum_Payload `protobuf_oneof:"payload"`
 }
 
@@ -79,6 +80,9 @@ type Datum_TimeVal struct {
 type Datum_IntervalVal struct {
 	IntervalVal int64 `protobuf:"varint,8,opt,name=interval_val,oneof"`
 }
+type Datum_ArrayVal struct {
+	ArrayVal *Datum_Array `protobuf:"bytes,9,opt,name=array_val,oneof"`
+}
 
 func (*Datum_BoolVal) isDatum_Payload()     {}
 func (*Datum_IntVal) isDatum_Payload()      {}
@@ -88,6 +92,7 @@ func (*Datum_StringVal) isDatum_Payload()   {}
 func (*Datum_DateVal) isDatum_Payload()     {}
 func (*Datum_TimeVal) isDatum_Payload()     {}
 func (*Datum_IntervalVal) isDatum_Payload() {}
+func (*Datum_ArrayVal) isDatum_Payload()    {}
 
 func (m *Datum) GetPayload() isDatum_Payload {
 	if m != nil {
@@ -152,6 +157,13 @@ func (m *Datum) GetIntervalVal() int64 {
 	return 0
 }
 
+func (m *Datum) GetArrayVal() *Datum_Array {
+	if x, ok := m.GetPayload().(*Datum_ArrayVal); ok {
+		return x.ArrayVal
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Datum) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), []interface{}) {
 	return _Datum_OneofMarshaler, _Datum_OneofUnmarshaler, []interface{}{
@@ -163,6 +175,7 @@ func (*Datum) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Datum_DateVal)(nil),
 		(*Datum_TimeVal)(nil),
 		(*Datum_IntervalVal)(nil),
+		(*Datum_ArrayVal)(nil),
 	}
 }
 
@@ -202,6 +215,11 @@ func _Datum_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 	case *Datum_IntervalVal:
 		_ = b.EncodeVarint(8<<3 | proto.WireVarint)
 		_ = b.EncodeVarint(uint64(x.IntervalVal))
+	case *Datum_ArrayVal:
+		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ArrayVal); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Datum.Payload has unexpected type %T", x)
@@ -270,6 +288,14 @@ func _Datum_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		x, err := b.DecodeVarint()
 		m.Payload = &Datum_IntervalVal{int64(x)}
 		return true, err
+	case 9: // payload.array_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Datum_Array)
+		err := b.DecodeMessage(msg)
+		m.Payload = &Datum_ArrayVal{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -302,6 +328,22 @@ func (m *Datum_Timestamp) GetNsec() uint32 {
 	return 0
 }
 
+// Array holds the elements of a one-dimensional array.
+type Datum_Array struct {
+	Values []Datum `protobuf:"bytes,1,rep,name=values" json:"values"`
+}
+
+func (m *Datum_Array) Reset()         { *m = Datum_Array{} }
+func (m *Datum_Array) String() string { return proto.CompactTextString(m) }
+func (*Datum_Array) ProtoMessage()    {}
+
+func (m *Datum_Array) GetValues() []Datum {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 // An SQL request to cockroach. A transaction can consist of multiple
 // requests.
 type Request struct {
@@ -667,6 +709,20 @@ func (m *Datum_IntervalVal) MarshalTo(data []byte) (int, error) {
 	i = encodeVarintWire(data, i, uint64(m.IntervalVal))
 	return i, nil
 }
+func (m *Datum_ArrayVal) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.ArrayVal != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintWire(data, i, uint64(m.ArrayVal.Size()))
+		n4, err := m.ArrayVal.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
 func (m *Datum_Timestamp) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -691,6 +747,36 @@ func (m *Datum_Timestamp) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Datum_Array) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Datum_Array) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			data[i] = 0xa
+			i++
+			i = encodeVarintWire(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *Request) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1024,6 +1110,15 @@ func (m *Datum_IntervalVal) Size() (n int) {
 	n += 1 + sovWire(uint64(m.IntervalVal))
 	return n
 }
+func (m *Datum_ArrayVal) Size() (n int) {
+	var l int
+	_ = l
+	if m.ArrayVal != nil {
+		l = m.ArrayVal.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
 func (m *Datum_Timestamp) Size() (n int) {
 	var l int
 	_ = l
@@ -1032,6 +1127,18 @@ func (m *Datum_Timestamp) Size() (n int) {
 	return n
 }
 
+func (m *Datum_Array) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Request) Size() (n int) {
 	var l int
 	_ = l
@@ -1385,6 +1492,38 @@ func (m *Datum) Unmarshal(data []byte) error {
 				}
 			}
 			m.Payload = &Datum_IntervalVal{v}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayVal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Datum_Array{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Datum_ArrayVal{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(data[iNdEx:])
@@ -1494,6 +1633,87 @@ func (m *Datum_Timestamp) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *Datum_Array) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Array: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Array: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, Datum{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Request) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -22,11 +22,9 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
-	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util"
-	"github.com/cockroachdb/cockroach/util/log"
 )
 
 // DropDatabase drops a database.
@@ -120,15 +118,15 @@ func (p *planner) DropIndex(n *parser.DropIndex) (planNode, error) {
 			return nil, err
 		}
 
-		indexPrefix := MakeIndexKeyPrefix(tableDesc.ID, idx.ID)
-
 		// Delete the index.
-		indexStartKey := roachpb.Key(indexPrefix)
-		indexEndKey := indexStartKey.PrefixEnd()
-		if log.V(2) {
-			log.Infof("DelRange %s - %s", prettyKey(indexStartKey, 0), prettyKey(indexEndKey, 0))
+		if err := p.deleteIndexData(&b, tableDesc, idx); err != nil {
+			return nil, err
+		}
+		if len(idx.Interleave.Ancestors) > 0 {
+			if err := p.removeInterleave(tableDesc, idx); err != nil {
+				return nil, err
+			}
 		}
-		b.DelRange(indexStartKey, indexEndKey)
 
 		found := false
 		for i := range tableDesc.Indexes {
@@ -204,10 +202,32 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 			return nil, err
 		}
 
+		indexes := append([]IndexDescriptor{tableDesc.PrimaryIndex}, tableDesc.Indexes...)
+		for _, index := range indexes {
+			for _, ref := range index.InterleavedBy {
+				if ref.TableID == tableDesc.ID {
+					continue
+				}
+				childDesc, err := p.getTableDescByID(ref.TableID)
+				if err != nil {
+					return nil, err
+				}
+				return nil, fmt.Errorf("table %q is interleaved by table %q", tableDesc.Name, childDesc.Name)
+			}
+		}
+
 		if _, err := p.Truncate(&parser.Truncate{Tables: n.Names[i : i+1]}); err != nil {
 			return nil, err
 		}
 
+		for j := range indexes {
+			if len(indexes[j].Interleave.Ancestors) > 0 {
+				if err := p.removeInterleave(&tableDesc, &indexes[j]); err != nil {
+					return nil, err
+				}
+			}
+		}
+
 		// Delete table descriptor
 		b := &client.Batch{}
 		b.Del(descKey)
@@ -221,3 +241,24 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 	}
 	return &valuesNode{}, nil
 }
+
+// removeInterleave removes the reference to an interleaved index from the
+// primary index of the parent table it is interleaved into.
+func (p *planner) removeInterleave(tableDesc *TableDescriptor, index *IndexDescriptor) error {
+	parent := index.Interleave.Ancestors[len(index.Interleave.Ancestors)-1]
+	parentDesc, err := p.getTableDescByID(parent.TableID)
+	if err != nil {
+		return err
+	}
+	parentIndex := &parentDesc.PrimaryIndex
+	if parentIndex.ID != parent.IndexID {
+		return util.Errorf("%s: index %d is not the primary index", parentDesc.Name, parent.IndexID)
+	}
+	for i, ref := range parentIndex.InterleavedBy {
+		if ref.TableID == tableDesc.ID && ref.IndexID == index.ID {
+			parentIndex.InterleavedBy = append(parentIndex.InterleavedBy[:i], parentIndex.InterleavedBy[i+1:]...)
+			break
+		}
+	}
+	return p.txn.Put(MakeDescMetadataKey(parentDesc.GetID()), parentDesc)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
 )
 
 type errUniquenessConstraintViolation struct {
@@ -58,11 +59,7 @@ func convertBatchError(tableDesc *TableDescriptor, b client.Batch, err error) er
 	result := b.Results[index]
 	if _, ok := err.(*roachpb.ConditionFailedError); ok {
 		for _, row := range result.Rows {
-			indexID, key, err := decodeIndexKeyPrefix(tableDesc, row.Key)
-			if err != nil {
-				return err
-			}
-			index, err := tableDesc.FindIndexByID(indexID)
+			index, err := findIndexForKey(tableDesc, row.Key)
 			if err != nil {
 				return err
 			}
@@ -71,7 +68,7 @@ func convertBatchError(tableDesc *TableDescriptor, b client.Batch, err error) er
 				return err
 			}
 			vals := make([]parser.Datum, len(valTypes))
-			if _, err := decodeKeyVals(valTypes, vals, key); err != nil {
+			if _, _, err := decodeIndexKey(tableDesc, *index, valTypes, vals, row.Key); err != nil {
 				return err
 			}
 
@@ -80,3 +77,27 @@ func convertBatchError(tableDesc *TableDescriptor, b client.Batch, err error) er
 	}
 	return err
 }
+
+// findIndexForKey returns the index of tableDesc which key belongs to.
+func findIndexForKey(tableDesc *TableDescriptor, key roachpb.Key) (*IndexDescriptor, error) {
+	indexes := append([]IndexDescriptor{tableDesc.PrimaryIndex}, tableDesc.Indexes...)
+	for i := range indexes {
+		index := &indexes[i]
+		if len(index.Interleave.Ancestors) == 0 {
+			indexID, _, err := decodeIndexKeyPrefix(tableDesc, key)
+			if err != nil || indexID != index.ID {
+				continue
+			}
+			return index, nil
+		}
+		valTypes, err := makeKeyVals(tableDesc, index.ColumnIDs)
+		if err != nil {
+			return nil, err
+		}
+		vals := make([]parser.Datum, len(valTypes))
+		if _, ok, err := decodeIndexKey(tableDesc, *index, valTypes, vals, key); err == nil && ok {
+			return index, nil
+		}
+	}
+	return nil, util.Errorf("%s: no index found for key: %q", tableDesc.Name, key)
+}
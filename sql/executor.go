@@ -31,12 +31,14 @@ import (
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/gogo/protobuf/proto"
 )
 
 var errNoTransactionInProgress = errors.New("there is no transaction in progress")
 var errTransactionAborted = errors.New("current transaction is aborted, commands ignored until end of transaction block")
 var errTransactionInProgress = errors.New("there is already a transaction in progress")
+var errStatementTimeout = errors.New("statement timeout exceeded")
 
 // An Executor executes SQL statements.
 type Executor struct {
@@ -98,17 +100,31 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 			txn.SetSystemDBTrigger()
 		}
 		planMaker.setTxn(txn, planMaker.session.Txn.Timestamp.GoTime())
+		planMaker.savepoints = planMaker.session.Txn.Savepoints
+		planMaker.txnFailed = planMaker.session.Txn.Failed
 	}
 	planMaker.evalCtx.GetLocation = planMaker.session.getLocation
 
 	// Send the Request for SQL execution and set the application-level error
 	// for each result in the reply.
-	reply := e.execStmts(args.Sql, parameters(args.Params), &planMaker)
+	var reply driver.Response
+	if params, err := makeParameters(args.Params); err != nil {
+		// The arguments are decoded before any statement is run, so an
+		// invalid argument fails the request as a whole.
+		reply.Results = append(reply.Results, makeResultFromError(&planMaker, err))
+	} else {
+		reply = e.execStmts(args.Sql, params, &planMaker)
+	}
 
 	// Send back the session state even if there were application-level errors.
 	// Add transaction to session state.
 	if planMaker.txn != nil {
-		planMaker.session.Txn = &Session_Transaction{Txn: planMaker.txn.Proto, Timestamp: driver.Timestamp(planMaker.evalCtx.TxnTimestamp.Time)}
+		planMaker.session.Txn = &Session_Transaction{
+			Txn:        planMaker.txn.Proto,
+			Timestamp:  driver.Timestamp(planMaker.evalCtx.TxnTimestamp.Time),
+			Savepoints: planMaker.savepoints,
+			Failed:     planMaker.txnFailed,
+		}
 		planMaker.session.MutatesSystemDB = planMaker.txn.SystemDBTrigger()
 	} else {
 		planMaker.session.Txn = nil
@@ -135,7 +151,11 @@ func (e *Executor) execStmts(sql string, params parameters, planMaker *planner)
 		return resp
 	}
 	for _, stmt := range stmts {
+		planMaker.auditAccesses = nil
 		result, err := e.execStmt(stmt, params, planMaker)
+		for _, event := range planMaker.makeAuditEvents(stmt, result, err) {
+			log.Audit(event)
+		}
 		if err != nil {
 			result = makeResultFromError(planMaker, err)
 		}
@@ -155,6 +175,9 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 		// transaction from being called within an auto-transaction below.
 		planMaker.setTxn(client.NewTxn(e.db), time.Now())
 		planMaker.txn.SetDebugName("sql", 0)
+		if err := planMaker.txn.SetIsolation(planMaker.session.DefaultIsolation); err != nil {
+			return result, err
+		}
 	case *parser.CommitTransaction, *parser.RollbackTransaction:
 		if planMaker.txn == nil {
 			return result, errNoTransactionInProgress
@@ -162,13 +185,32 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 			// Reset to allow starting a new transaction.
 			planMaker.resetTxn()
 			return result, nil
+		} else if planMaker.txnFailed {
+			// A failed transaction cannot commit; roll it back instead.
+			err := planMaker.txn.Rollback()
+			planMaker.resetTxn()
+			return result, err
 		}
 	case *parser.SetTransaction:
 		if planMaker.txn == nil {
 			return result, errNoTransactionInProgress
 		}
+	case *parser.RollbackToSavepoint:
+		// Rolling back to a savepoint is the one statement permitted in a
+		// failed transaction.
+		if planMaker.txn == nil {
+			return result, errNoTransactionInProgress
+		} else if planMaker.txn.Proto.Status == roachpb.ABORTED {
+			return result, errTransactionAborted
+		}
+	case *parser.Savepoint, *parser.ReleaseSavepoint:
+		if planMaker.txn == nil {
+			return result, errNoTransactionInProgress
+		} else if planMaker.txn.Proto.Status == roachpb.ABORTED || planMaker.txnFailed {
+			return result, errTransactionAborted
+		}
 	default:
-		if planMaker.txn != nil && planMaker.txn.Proto.Status == roachpb.ABORTED {
+		if planMaker.txn != nil && (planMaker.txn.Proto.Status == roachpb.ABORTED || planMaker.txnFailed) {
 			return result, errTransactionAborted
 		}
 	}
@@ -178,6 +220,13 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 		return result, err
 	}
 
+	// A non-zero statement timeout bounds the time spent executing the plan,
+	// including the scans feeding plans which buffer their input.
+	if timeout := planMaker.session.StatementTimeout; timeout > 0 {
+		planMaker.deadline = time.Now().Add(time.Duration(timeout))
+		defer func() { planMaker.deadline = time.Time{} }()
+	}
+
 	// Create a function which both makes and executes the plan, populating
 	// result.
 	//
@@ -185,6 +234,10 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 	// some of the common code back out into execStmts and have execStmt contain
 	// only the body of this closure.
 	f := func(timestamp time.Time) error {
+		// Only the accesses of the last attempt are audited.
+		planMaker.auditAccesses = nil
+		planMaker.locks = nil
+		planMaker.roleMemberships = nil
 		planMaker.evalCtx.StmtTimestamp = parser.DTimestamp{Time: timestamp}
 		plan, err := planMaker.makePlan(stmt)
 		if err != nil {
@@ -198,6 +251,9 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 			resultRowsAffected := driver.Response_Result_RowsAffected{}
 			result.Union = &resultRowsAffected
 			for plan.Next() {
+				if err := planMaker.checkDeadline(); err != nil {
+					return err
+				}
 				resultRowsAffected.RowsAffected++
 			}
 
@@ -209,63 +265,36 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 			result.Union = &driver.Response_Result_Rows_{
 				Rows: resultRows,
 			}
+			rowLimit := planMaker.session.ResultRowLimit
+			loc, err := planMaker.session.getLocation()
+			if err != nil {
+				return err
+			}
 			for plan.Next() {
+				if err := planMaker.checkDeadline(); err != nil {
+					return err
+				}
 				values := plan.Values()
 				row := driver.Response_Result_Rows_Row{Values: make([]driver.Datum, 0, len(values))}
 				for _, val := range values {
-					if val == parser.DNull {
-						row.Values = append(row.Values, driver.Datum{})
-						continue
-					}
-
-					switch vt := val.(type) {
-					case parser.DBool:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_BoolVal{BoolVal: bool(vt)},
-						})
-					case parser.DInt:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_IntVal{IntVal: int64(vt)},
-						})
-					case parser.DFloat:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_FloatVal{FloatVal: float64(vt)},
-						})
-					case parser.DBytes:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_BytesVal{BytesVal: []byte(vt)},
-						})
-					case parser.DString:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_StringVal{StringVal: string(vt)},
-						})
-					case parser.DDate:
-						wireTimestamp := driver.Timestamp(vt.Time)
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_DateVal{
-								DateVal: &wireTimestamp,
-							},
-						})
-					case parser.DTimestamp:
-						wireTimestamp := driver.Timestamp(vt.Time)
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_TimeVal{
-								TimeVal: &wireTimestamp,
-							},
-						})
-					case parser.DInterval:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_IntervalVal{IntervalVal: vt.Nanoseconds()},
-						})
-					default:
-						return fmt.Errorf("unsupported result type: %s", val.Type())
+					wireVal, err := makeWireDatum(val, loc)
+					if err != nil {
+						return err
 					}
+					row.Values = append(row.Values, wireVal)
 				}
 				resultRows.Rows = append(resultRows.Rows, row)
+				if rowLimit > 0 && int64(len(resultRows.Rows)) >= rowLimit {
+					break
+				}
 			}
 		}
 
-		return plan.Err()
+		if err := plan.Err(); err != nil {
+			return err
+		}
+		// Write the locks taken by the rows of the statement.
+		return planMaker.flushLocks()
 	}
 
 	// If there is a pending transaction.
@@ -277,6 +306,9 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 	// No transaction. Run the command as a retryable block in an
 	// auto-transaction.
 	err := e.db.Txn(func(txn *client.Txn) error {
+		if err := txn.SetIsolation(planMaker.session.DefaultIsolation); err != nil {
+			return err
+		}
 		timestamp := time.Now()
 		planMaker.setTxn(txn, timestamp)
 		err := f(timestamp)
@@ -288,19 +320,174 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 
 // If we hit an error and there is a pending transaction, rollback
 // the transaction before returning. The client does not have to
-// deal with cleaning up transaction state.
+// deal with cleaning up transaction state. A transaction containing
+// savepoints is instead marked as failed so that it can be rolled back to
+// one of them, unless the error requires the transaction to restart.
 func makeResultFromError(planMaker *planner, err error) driver.Response_Result {
 	if planMaker.txn != nil {
 		if err != errTransactionAborted {
-			planMaker.txn.Cleanup(err)
+			if _, ok := err.(roachpb.TransactionRestartError); !ok &&
+				len(planMaker.savepoints) > 0 && planMaker.txn.Proto.Status == roachpb.PENDING {
+				planMaker.txnFailed = true
+			} else {
+				planMaker.txn.Cleanup(err)
+			}
 		}
 	}
 	errString := err.Error()
 	return driver.Response_Result{Error: &errString}
 }
 
+// makeWireDatum converts a result datum to its wire representation.
+// Timestamps with time zone are displayed in loc, the session time zone.
+func makeWireDatum(val parser.Datum, loc *time.Location) (driver.Datum, error) {
+	if val == parser.DNull {
+		return driver.Datum{}, nil
+	}
+
+	switch vt := val.(type) {
+	case parser.DBool:
+		return driver.Datum{
+			Payload: &driver.Datum_BoolVal{BoolVal: bool(vt)},
+		}, nil
+	case parser.DInt:
+		return driver.Datum{
+			Payload: &driver.Datum_IntVal{IntVal: int64(vt)},
+		}, nil
+	case parser.DFloat:
+		return driver.Datum{
+			Payload: &driver.Datum_FloatVal{FloatVal: float64(vt)},
+		}, nil
+	case parser.DBytes:
+		return driver.Datum{
+			Payload: &driver.Datum_BytesVal{BytesVal: []byte(vt)},
+		}, nil
+	case parser.DString:
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: string(vt)},
+		}, nil
+	case parser.DDate:
+		wireTimestamp := driver.Timestamp(vt.Time)
+		return driver.Datum{
+			Payload: &driver.Datum_DateVal{
+				DateVal: &wireTimestamp,
+			},
+		}, nil
+	case parser.DTimestamp:
+		wireTimestamp := driver.Timestamp(vt.Time)
+		return driver.Datum{
+			Payload: &driver.Datum_TimeVal{
+				TimeVal: &wireTimestamp,
+			},
+		}, nil
+	case parser.DTimestampTZ:
+		// The wire timestamp is always in UTC, so timestamps with time zone
+		// are sent as text formatted in the session time zone.
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{
+				StringVal: parser.DTimestampTZ{Time: vt.In(loc)}.String(),
+			},
+		}, nil
+	case parser.DTime:
+		// There is no wire type for times of day, so they are sent as text.
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: vt.String()},
+		}, nil
+	case parser.DInterval:
+		return driver.Datum{
+			Payload: &driver.Datum_IntervalVal{IntervalVal: vt.Nanoseconds()},
+		}, nil
+	case *parser.DArray:
+		array := &driver.Datum_Array{Values: make([]driver.Datum, 0, len(vt.Elements))}
+		for _, d := range vt.Elements {
+			wireVal, err := makeWireDatum(d, loc)
+			if err != nil {
+				return driver.Datum{}, err
+			}
+			array.Values = append(array.Values, wireVal)
+		}
+		return driver.Datum{
+			Payload: &driver.Datum_ArrayVal{ArrayVal: array},
+		}, nil
+	case *parser.DCollatedString:
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: vt.Contents},
+		}, nil
+	case *parser.DJSON:
+		// JSONB values are sent to clients as their canonical text.
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: vt.JSONText()},
+		}, nil
+	case parser.DUUID:
+		// UUID and INET values are sent to clients as text.
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: vt.UUIDText()},
+		}, nil
+	case parser.DInet:
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: vt.InetText()},
+		}, nil
+	default:
+		return driver.Datum{}, fmt.Errorf("unsupported result type: %s", val.Type())
+	}
+}
+
+// makeDatumFromWire converts a datum from its wire representation. An error
+// is returned for arrays whose elements do not have the same type.
+func makeDatumFromWire(d driver.Datum) (parser.Datum, error) {
+	arg := d.Payload
+	if arg == nil {
+		return parser.DNull, nil
+	}
+	switch t := arg.(type) {
+	case *driver.Datum_BoolVal:
+		return parser.DBool(t.BoolVal), nil
+	case *driver.Datum_IntVal:
+		return parser.DInt(t.IntVal), nil
+	case *driver.Datum_FloatVal:
+		return parser.DFloat(t.FloatVal), nil
+	case *driver.Datum_BytesVal:
+		return parser.DBytes(t.BytesVal), nil
+	case *driver.Datum_StringVal:
+		return parser.DString(t.StringVal), nil
+	case *driver.Datum_DateVal:
+		return parser.MakeDDate(t.DateVal.GoTime()), nil
+	case *driver.Datum_TimeVal:
+		return parser.DTimestamp{Time: t.TimeVal.GoTime()}, nil
+	case *driver.Datum_IntervalVal:
+		return parser.DInterval{Duration: time.Duration(t.IntervalVal)}, nil
+	case *driver.Datum_ArrayVal:
+		elements := make([]parser.Datum, 0, len(t.ArrayVal.Values))
+		for _, v := range t.ArrayVal.Values {
+			elem, err := makeDatumFromWire(v)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, elem)
+		}
+		return parser.NewDArray(elements)
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
 // parameters implements the parser.Args interface.
-type parameters []driver.Datum
+type parameters []parser.Datum
+
+// makeParameters decodes the wire arguments of a request. Decoding up front
+// lets an invalid argument, such as an array whose elements do not have the
+// same type, be reported as such instead of as a missing argument.
+func makeParameters(args []driver.Datum) (parameters, error) {
+	params := make(parameters, 0, len(args))
+	for i, arg := range args {
+		d, err := makeDatumFromWire(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument $%d: %s", i+1, err)
+		}
+		params = append(params, d)
+	}
+	return params, nil
+}
 
 // Arg implements the parser.Args interface.
 func (p parameters) Arg(name string) (parser.Datum, bool) {
@@ -321,28 +508,5 @@ func (p parameters) Arg(name string) (parser.Datum, bool) {
 	if i < 1 || int(i) > len(p) {
 		return nil, false
 	}
-	arg := p[i-1].Payload
-	if arg == nil {
-		return parser.DNull, true
-	}
-	switch t := arg.(type) {
-	case *driver.Datum_BoolVal:
-		return parser.DBool(t.BoolVal), true
-	case *driver.Datum_IntVal:
-		return parser.DInt(t.IntVal), true
-	case *driver.Datum_FloatVal:
-		return parser.DFloat(t.FloatVal), true
-	case *driver.Datum_BytesVal:
-		return parser.DBytes(t.BytesVal), true
-	case *driver.Datum_StringVal:
-		return parser.DString(t.StringVal), true
-	case *driver.Datum_DateVal:
-		return parser.MakeDDate(t.DateVal.GoTime()), true
-	case *driver.Datum_TimeVal:
-		return parser.DTimestamp{Time: t.TimeVal.GoTime()}, true
-	case *driver.Datum_IntervalVal:
-		return parser.DInterval{Duration: time.Duration(t.IntervalVal)}, true
-	default:
-		panic(fmt.Sprintf("unexpected type %T", t))
-	}
+	return p[i-1], true
 }
@@ -25,6 +25,7 @@ import (
 // Grant adds privileges to users.
 // Current status:
 // - Target: single database or table.
+// - Grantees: existing users or roles.
 // TODO(marc): open questions:
 // - should we have root always allowed and not present in the permissions list?
 // - should we make users case-insensitive?
@@ -42,6 +43,9 @@ func (p *planner) Grant(n *parser.Grant) (planNode, error) {
 	}
 
 	for _, grantee := range n.Grantees {
+		if err := p.checkUserExists(grantee); err != nil {
+			return nil, err
+		}
 		descriptor.GetPrivileges().Grant(grantee, n.Privileges)
 	}
 
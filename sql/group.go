@@ -26,11 +26,12 @@ import (
 )
 
 var aggregates = map[string]aggregateImpl{
-	"avg":   &avgAggregate{},
-	"count": &countAggregate{},
-	"max":   &maxAggregate{},
-	"min":   &minAggregate{},
-	"sum":   &sumAggregate{},
+	"array_agg": &arrayAggAggregate{},
+	"avg":       &avgAggregate{},
+	"count":     &countAggregate{},
+	"max":       &maxAggregate{},
+	"min":       &minAggregate{},
+	"sum":       &sumAggregate{},
 }
 
 func (p *planner) groupBy(n *parser.Select, s *scanNode) (*groupNode, error) {
@@ -336,12 +337,34 @@ type aggregateImpl interface {
 	Result() (parser.Datum, error)
 }
 
+var _ aggregateImpl = &arrayAggAggregate{}
 var _ aggregateImpl = &avgAggregate{}
 var _ aggregateImpl = &countAggregate{}
 var _ aggregateImpl = &maxAggregate{}
 var _ aggregateImpl = &minAggregate{}
 var _ aggregateImpl = &sumAggregate{}
 
+type arrayAggAggregate struct {
+	elements []parser.Datum
+}
+
+func (a *arrayAggAggregate) New() aggregateImpl {
+	return &arrayAggAggregate{}
+}
+
+func (a *arrayAggAggregate) Add(datum parser.Datum) error {
+	// NULL values are collected as well.
+	a.elements = append(a.elements, datum)
+	return nil
+}
+
+func (a *arrayAggAggregate) Result() (parser.Datum, error) {
+	if a.elements == nil {
+		return parser.DNull, nil
+	}
+	return parser.NewDArray(a.elements)
+}
+
 type avgAggregate struct {
 	sumAggregate
 	count int
@@ -29,6 +29,7 @@ import (
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/gogo/protobuf/proto"
 )
 
 var allowedEncodings = []util.EncodingType{util.JSONEncoding, util.ProtoEncoding}
@@ -38,11 +39,22 @@ var allowedEncodings = []util.EncodingType{util.JSONEncoding, util.ProtoEncoding
 type HTTPServer struct {
 	context *base.Context
 	*Executor
+	// authLimiter rate-limits failed password authentication attempts.
+	authLimiter *security.AuthLimiter
+	// authMethods caches the authentication methods of the users of client
+	// certificate connections.
+	authMethods *authMethodCache
 }
 
 // MakeHTTPServer creates an HTTPServer.
 func MakeHTTPServer(ctx *base.Context, db client.DB, gossip *gossip.Gossip) HTTPServer {
-	return HTTPServer{context: ctx, Executor: NewExecutor(db, gossip)}
+	return HTTPServer{
+		context:  ctx,
+		Executor: NewExecutor(db, gossip),
+		authLimiter: security.NewAuthLimiter(security.DefaultMaxFailedAuthAttempts,
+			security.DefaultAuthLockout),
+		authMethods: newAuthMethodCache(),
+	}
 }
 
 // ServeHTTP serves the SQL API by treating the request URL path
@@ -53,6 +65,10 @@ func MakeHTTPServer(ctx *base.Context, db client.DB, gossip *gossip.Gossip) HTTP
 // encoded according to the request's Accept header, or if not
 // present, in the same format as the request's incoming Content-Type
 // header.
+//
+// Clients authenticate with their certificate, or, for users configured for
+// password authentication, with the user's password passed using HTTP basic
+// authentication over TLS.
 func (s HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 	method := r.URL.Path
@@ -61,8 +77,16 @@ func (s HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Check TLS settings.
-	authenticationHook, err := security.AuthenticationHook(s.context.Insecure, r.TLS)
+	// Check TLS settings, or the password if one was supplied.
+	var authenticationHook func(request proto.Message, public bool) error
+	var err error
+	username, password, usePassword := r.BasicAuth()
+	usePassword = usePassword && !s.context.Insecure
+	if usePassword {
+		authenticationHook, err = s.authenticatePassword(r.TLS, username, password)
+	} else {
+		authenticationHook, err = security.AuthenticationHook(s.context.Insecure, r.TLS)
+	}
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusUnauthorized)
 		return
@@ -87,11 +111,17 @@ func (s HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Check request user against client certificate user.
+	// Check request user against the authenticated user.
 	if err := authenticationHook(&args, true /*public*/); err != nil {
 		http.Error(w, err.Error(), http.StatusUnauthorized)
 		return
 	}
+	if !usePassword {
+		if err := s.checkCertAuthMethod(r.TLS, args.User); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
 
 	reply, code, err := s.Execute(args)
 	if err != nil {
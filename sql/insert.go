@@ -66,12 +66,10 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 	}
 
 	// Verify we have at least the columns that are part of the primary key.
-	primaryKeyCols := map[ColumnID]struct{}{}
 	for i, id := range tableDesc.PrimaryIndex.ColumnIDs {
 		if _, ok := colIDtoRowIndex[id]; !ok {
 			return nil, fmt.Errorf("missing %q primary key column", tableDesc.PrimaryIndex.ColumnNames[i])
 		}
-		primaryKeyCols[id] = struct{}{}
 	}
 
 	// Construct the default expressions. The returned slice will be nil if no
@@ -94,9 +92,11 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 	}
 
 	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
 
-	marshalled := make([]interface{}, len(cols))
+	indexExprs, err := p.makeIndexExprs(tableDesc, tableDesc.Indexes)
+	if err != nil {
+		return nil, err
+	}
 
 	b := client.Batch{}
 	result := &valuesNode{}
@@ -133,26 +133,29 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		// cannot be used as index values.
 		for i, val := range rowVals {
 			// Make sure the value can be written to the column before proceeding.
-			var err error
-			if marshalled[i], err = marshalColumnValue(cols[i], val); err != nil {
+			if _, err := marshalColumnValue(cols[i], val); err != nil {
 				return nil, err
 			}
 		}
 
-		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
+		primaryIndexKey, _, err := makeIndexKey(
+			tableDesc, &primaryIndex, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
 
 		// Write the secondary indexes.
 		secondaryIndexEntries, err := encodeSecondaryIndexes(
-			tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, rowVals)
+			tableDesc, tableDesc.Indexes, indexExprs, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, secondaryIndexEntry := range secondaryIndexEntries {
+			if secondaryIndexEntry.key == nil {
+				// The row is not part of a partial index.
+				continue
+			}
 			if log.V(2) {
 				log.Infof("CPut %s -> %v", prettyKey(secondaryIndexEntry.key, 0),
 					secondaryIndexEntry.value)
@@ -160,34 +163,26 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 			b.CPut(secondaryIndexEntry.key, secondaryIndexEntry.value, nil)
 		}
 
-		// Write the row sentinel.
-		if log.V(2) {
-			log.Infof("CPut %s -> NULL", prettyKey(primaryIndexKey, 0))
-		}
-		b.CPut(primaryIndexKey, nil, nil)
-
-		// Write the row columns.
-		for i, val := range rowVals {
-			col := cols[i]
-			if _, ok := primaryKeyCols[col.ID]; ok {
-				// Skip primary key columns as their values are encoded in the row
-				// sentinel key which is guaranteed to exist for as long as the row
-				// exists.
+		// Write the column families. The key of family 0 is the row sentinel,
+		// which is written even if all of the columns of the family are NULL. The
+		// other families are only written if they contain a non-NULL value.
+		// Non-existent families are considered NULL during scanning and the row
+		// sentinel ensures we know the row exists.
+		for i := range tableDesc.Families {
+			family := &tableDesc.Families[i]
+			value, err := encodeFamilyValue(tableDesc, family, colIDtoRowIndex, rowVals)
+			if err != nil {
+				return nil, err
+			}
+			if value == nil && family.ID != 0 {
 				continue
 			}
 
-			if marshalled[i] != nil {
-				// We only output non-NULL values. Non-existent column keys are
-				// considered NULL during scanning and the row sentinel ensures we know
-				// the row exists.
-
-				key := MakeColumnKey(col.ID, primaryIndexKey)
-				if log.V(2) {
-					log.Infof("CPut %s -> %v", prettyKey(key, 0), val)
-				}
-
-				b.CPut(key, marshalled[i], nil)
+			key := MakeFamilyKey(family.ID, primaryIndexKey)
+			if log.V(2) {
+				log.Infof("CPut %s -> %v", prettyKey(key, 0), value)
 			}
+			b.CPut(key, value, nil)
 		}
 	}
 	if err := rows.Err(); err != nil {
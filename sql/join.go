@@ -31,11 +31,10 @@ const joinBatchSize = 100
 // joinBatchSize rows from the index and use the primary key to construct spans
 // that are looked up in the table.
 type indexJoinNode struct {
-	index            *scanNode
-	table            *scanNode
-	primaryKeyPrefix roachpb.Key
-	colIDtoRowIndex  map[ColumnID]int
-	err              error
+	index           *scanNode
+	table           *scanNode
+	colIDtoRowIndex map[ColumnID]int
+	err             error
 }
 
 func makeIndexJoin(indexScan *scanNode, exactPrefix int) (*indexJoinNode, error) {
@@ -65,7 +64,11 @@ func makeIndexJoin(indexScan *scanNode, exactPrefix int) (*indexJoinNode, error)
 	for _, colID := range table.desc.PrimaryIndex.ColumnIDs {
 		colIDtoRowIndex[colID] = -1
 	}
-	for _, colID := range indexScan.index.ColumnIDs {
+	for i, colID := range indexScan.index.ColumnIDs {
+		if indexScan.index.isExpr(i) {
+			// The value of an index expression is not a column of the table.
+			continue
+		}
 		colIDtoRowIndex[colID] = -1
 	}
 
@@ -98,13 +101,10 @@ func makeIndexJoin(indexScan *scanNode, exactPrefix int) (*indexJoinNode, error)
 
 	indexScan.initOrdering(exactPrefix)
 
-	primaryKeyPrefix := roachpb.Key(MakeIndexKeyPrefix(table.desc.ID, table.index.ID))
-
 	return &indexJoinNode{
-		index:            indexScan,
-		table:            table,
-		primaryKeyPrefix: primaryKeyPrefix,
-		colIDtoRowIndex:  colIDtoRowIndex,
+		index:           indexScan,
+		table:           table,
+		colIDtoRowIndex: colIDtoRowIndex,
 	}, nil
 }
 
@@ -155,8 +155,8 @@ func (n *indexJoinNode) Next() bool {
 
 			vals := n.index.Values()
 			var primaryIndexKey []byte
-			primaryIndexKey, _, n.err = encodeIndexKey(
-				n.table.index.ColumnIDs, n.colIDtoRowIndex, vals, n.primaryKeyPrefix)
+			primaryIndexKey, _, n.err = makeIndexKey(
+				n.table.desc, n.table.index, n.colIDtoRowIndex, vals)
 			if n.err != nil {
 				return false
 			}
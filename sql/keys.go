@@ -99,11 +99,16 @@ func MakeZoneKey(id ID) roachpb.Key {
 	return k
 }
 
-// MakeColumnKey returns the key for the column in the given row.
-func MakeColumnKey(colID ColumnID, primaryKey []byte) roachpb.Key {
+// MakeFamilyKey returns the key for the column family in the given row. The
+// key of family 0 is the primary key itself, which doubles as the row
+// sentinel.
+func MakeFamilyKey(familyID FamilyID, primaryKey []byte) roachpb.Key {
 	var key []byte
 	key = append(key, primaryKey...)
-	return encoding.EncodeUvarint(key, uint64(colID))
+	if familyID == 0 {
+		return key
+	}
+	return encoding.EncodeUvarint(key, uint64(familyID))
 }
 
 // MakeIndexKeyPrefix returns the key prefix used for the index's data.
@@ -114,3 +119,48 @@ func MakeIndexKeyPrefix(tableID ID, indexID IndexID) []byte {
 	key = encoding.EncodeUvarint(key, uint64(indexID))
 	return key
 }
+
+// interleavedSentinel separates the columns an interleaved index shares with
+// an ancestor from the remainder of the interleaved key. It sorts after the
+// encoded column IDs of the ancestor's row so that the key/value pairs of the
+// ancestor's row remain contiguous and precede those of its interleaved rows.
+const interleavedSentinel byte = 0xfe
+
+// makeIndexSpanPrefix returns the prefix of the span containing the index's
+// data. For an interleaved index this is the prefix of the root ancestor's
+// index, which the index's entries are interleaved with.
+func makeIndexSpanPrefix(desc *TableDescriptor, index *IndexDescriptor) roachpb.Key {
+	if len(index.Interleave.Ancestors) > 0 {
+		root := index.Interleave.Ancestors[0]
+		return roachpb.Key(MakeIndexKeyPrefix(root.TableID, root.IndexID))
+	}
+	return roachpb.Key(MakeIndexKeyPrefix(desc.ID, index.ID))
+}
+
+// interleavedSeparators returns, by position in the index, the bytes which
+// precede the encoding of the index's columns in the keys of an interleaved
+// index, beyond the prefix returned by makeIndexSpanPrefix: the interleaved
+// sentinel followed by the IDs of the next ancestor, or of the index itself,
+// after the last column shared with each ancestor. Returns nil if the index
+// is not interleaved.
+func interleavedSeparators(desc *TableDescriptor, index *IndexDescriptor) map[int][]byte {
+	ancestors := index.Interleave.Ancestors
+	if len(ancestors) == 0 {
+		return nil
+	}
+	seps := map[int][]byte{}
+	col := 0
+	for i, ancestor := range ancestors {
+		col += int(ancestor.SharedPrefixLen)
+		sep := append(seps[col], interleavedSentinel)
+		if i+1 < len(ancestors) {
+			sep = encoding.EncodeUvarint(sep, uint64(ancestors[i+1].TableID))
+			sep = encoding.EncodeUvarint(sep, uint64(ancestors[i+1].IndexID))
+		} else {
+			sep = encoding.EncodeUvarint(sep, uint64(desc.ID))
+			sep = encoding.EncodeUvarint(sep, uint64(index.ID))
+		}
+		seps[col] = sep
+	}
+	return seps
+}
@@ -63,6 +63,7 @@ func (*AlterTableAddColumn) alterTableCmd()      {}
 func (*AlterTableAddConstraint) alterTableCmd()  {}
 func (*AlterTableDropColumn) alterTableCmd()     {}
 func (*AlterTableDropConstraint) alterTableCmd() {}
+func (*AlterTableSetAudit) alterTableCmd()       {}
 
 // AlterTableAddColumn represents an ADD COLUMN command.
 type AlterTableAddColumn struct {
@@ -122,3 +123,31 @@ type AlterTableDropConstraint struct {
 func (node *AlterTableDropConstraint) String() string {
 	return fmt.Sprintf("DROP CONSTRAINT %s", node.Constraint)
 }
+
+// AuditMode represents a table audit mode.
+type AuditMode int
+
+// AuditMode values.
+const (
+	AuditModeDisable AuditMode = iota
+	AuditModeReadWrite
+)
+
+func (mode AuditMode) String() string {
+	switch mode {
+	case AuditModeDisable:
+		return "OFF"
+	case AuditModeReadWrite:
+		return "READ WRITE"
+	}
+	return fmt.Sprintf("AuditMode(%d)", mode)
+}
+
+// AlterTableSetAudit represents an EXPERIMENTAL_AUDIT SET command.
+type AlterTableSetAudit struct {
+	Mode AuditMode
+}
+
+func (node *AlterTableSetAudit) String() string {
+	return fmt.Sprintf("EXPERIMENTAL_AUDIT SET %s", node.Mode)
+}
@@ -412,6 +412,39 @@ var builtins = map[string][]builtin{
 		},
 	},
 
+	"gen_random_uuid": {
+		builtin{
+			types:      typeList{},
+			returnType: DummyUUID,
+			impure:     true,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return MakeDUUID(uuid.NewUUID4())
+			},
+		},
+	},
+
+	// INET functions.
+
+	"host": {
+		builtin{
+			types:      typeList{inetType},
+			returnType: DummyString,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DString(args[0].(DInet).IP().String()), nil
+			},
+		},
+	},
+
+	"masklen": {
+		builtin{
+			types:      typeList{inetType},
+			returnType: DummyInt,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DInt(args[0].(DInet).MaskLen), nil
+			},
+		},
+	},
+
 	// Timestamp/Date functions.
 
 	"age": {
@@ -472,61 +505,168 @@ var builtins = map[string][]builtin{
 			returnType: DummyInt,
 			fn: func(_ EvalContext, args DTuple) (Datum, error) {
 				// extract timeSpan fromTime.
-				fromTime := args[1].(DTimestamp)
+				return extractTime(args[0].(DString), args[1].(DTimestamp).Time)
+			},
+		},
+		builtin{
+			types:      typeList{stringType, timestampTZType},
+			returnType: DummyInt,
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				loc, err := ctx.location()
+				if err != nil {
+					return DNull, err
+				}
+				return extractTime(args[0].(DString), args[1].(DTimestampTZ).In(loc))
+			},
+		},
+		builtin{
+			types:      typeList{stringType, timeType},
+			returnType: DummyInt,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
 				timeSpan := strings.ToLower(string(args[0].(DString)))
 				switch timeSpan {
-				case "year":
-					return DInt(fromTime.Year()), nil
-
-				case "quarter":
-					return DInt(fromTime.Month()/4 + 1), nil
-
-				case "month":
-					return DInt(fromTime.Month()), nil
-
-				case "week":
-					_, week := fromTime.ISOWeek()
-					return DInt(week), nil
-
-				case "day":
-					return DInt(fromTime.Day()), nil
-
-				case "dayofweek", "dow":
-					return DInt(fromTime.Weekday()), nil
-
-				case "dayofyear", "doy":
-					return DInt(fromTime.YearDay()), nil
-
-				case "hour":
-					return DInt(fromTime.Hour()), nil
-
-				case "minute":
-					return DInt(fromTime.Minute()), nil
-
-				case "second":
-					return DInt(fromTime.Second()), nil
+				case "hour", "minute", "second", "millisecond", "microsecond", "nanosecond", "epoch":
+				default:
+					return DNull, fmt.Errorf("unsupported timespan for time: %s", timeSpan)
+				}
+				return extractTime(args[0].(DString), args[1].(DTime).toTime())
+			},
+		},
+	},
 
-				case "millisecond":
-					return DInt(fromTime.Nanosecond() / int(time.Millisecond)), nil
+	"date_trunc": {
+		builtin{
+			types:      typeList{stringType, timestampType},
+			returnType: DummyTimestamp,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				t, err := truncateTime(args[0].(DString), args[1].(DTimestamp).Time)
+				if err != nil {
+					return DNull, err
+				}
+				return DTimestamp{Time: t}, nil
+			},
+		},
+		builtin{
+			types:      typeList{stringType, timestampTZType},
+			returnType: DummyTimestampTZ,
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				// Truncation happens in the session time zone so that, e.g., days
+				// start at local midnight.
+				loc, err := ctx.location()
+				if err != nil {
+					return DNull, err
+				}
+				t, err := truncateTime(args[0].(DString), args[1].(DTimestampTZ).In(loc))
+				if err != nil {
+					return DNull, err
+				}
+				return DTimestampTZ{Time: t}, nil
+			},
+		},
+	},
 
-				case "microsecond":
-					return DInt(fromTime.Nanosecond() / int(time.Microsecond)), nil
+	"to_char": {
+		builtin{
+			types:      typeList{timestampType, stringType},
+			returnType: DummyString,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DString(formatTime(args[0].(DTimestamp).Time, string(args[1].(DString)))), nil
+			},
+		},
+		builtin{
+			types:      typeList{timestampTZType, stringType},
+			returnType: DummyString,
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				loc, err := ctx.location()
+				if err != nil {
+					return DNull, err
+				}
+				return DString(formatTime(args[0].(DTimestampTZ).In(loc), string(args[1].(DString)))), nil
+			},
+		},
+		builtin{
+			types:      typeList{dateType, stringType},
+			returnType: DummyString,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DString(formatTime(args[0].(DDate).Time, string(args[1].(DString)))), nil
+			},
+		},
+	},
 
-				case "nanosecond":
-					return DInt(fromTime.Nanosecond()), nil
+	// timezone implements AT TIME ZONE. A timestamp is interpreted as a wall
+	// clock time in the given zone, while a timestamp with time zone is
+	// converted to the wall clock time in the given zone.
+	"timezone": {
+		builtin{
+			types:      typeList{stringType, timestampType},
+			returnType: DummyTimestampTZ,
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				loc, err := time.LoadLocation(string(args[0].(DString)))
+				if err != nil {
+					return DNull, err
+				}
+				return timestampTZInZone(ctx, args[1].(DTimestamp), loc)
+			},
+		},
+		builtin{
+			types:      typeList{stringType, timestampTZType},
+			returnType: DummyTimestamp,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				loc, err := time.LoadLocation(string(args[0].(DString)))
+				if err != nil {
+					return DNull, err
+				}
+				return DTimestamp{Time: wallClockIn(args[1].(DTimestampTZ).In(loc), time.UTC)}, nil
+			},
+		},
+		builtin{
+			types:      typeList{intervalType, timestampType},
+			returnType: DummyTimestampTZ,
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				return timestampTZInZone(ctx, args[1].(DTimestamp), intervalLocation(args[0].(DInterval)))
+			},
+		},
+		builtin{
+			types:      typeList{intervalType, timestampTZType},
+			returnType: DummyTimestamp,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				loc := intervalLocation(args[0].(DInterval))
+				return DTimestamp{Time: wallClockIn(args[1].(DTimestampTZ).In(loc), time.UTC)}, nil
+			},
+		},
+	},
 
-				case "epoch":
-					return DInt(fromTime.Unix()), nil
+	// Array functions.
 
-				default:
-					return DNull, fmt.Errorf("unsupported timespan: %s", timeSpan)
+	"array_length": {
+		builtin{
+			types:      typeList{arrayType, intType},
+			returnType: DummyInt,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				// Only one-dimensional arrays are supported and the length of an empty
+				// array is NULL, as in PostgreSQL.
+				array := args[0].(*DArray)
+				if len(array.Elements) == 0 || args[1].(DInt) != 1 {
+					return DNull, nil
 				}
+				return DInt(len(array.Elements)), nil
 			},
 		},
 	},
 
+	"json_build_object":  {jsonBuildObjectImpl},
+	"jsonb_build_object": {jsonBuildObjectImpl},
+
+	"json_extract_path":  {jsonExtractPathImpl},
+	"jsonb_extract_path": {jsonExtractPathImpl},
+
+	"json_typeof":  {jsonTypeOfImpl},
+	"jsonb_typeof": {jsonTypeOfImpl},
+
 	// Aggregate functions.
 
+	"array_agg": arrayAggImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, timestampTZType, timeType, intervalType),
+
 	"avg": {
 		builtin{
 			types:      typeList{intType},
@@ -550,8 +690,8 @@ var builtins = map[string][]builtin{
 
 	"count": countImpls(),
 
-	"max": aggregateImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType),
-	"min": aggregateImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType),
+	"max": aggregateImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, timestampTZType, timeType, intervalType, uuidType, inetType),
+	"min": aggregateImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, timestampTZType, timeType, intervalType, uuidType, inetType),
 	"sum": aggregateImpls(intType, floatType),
 
 	// Math functions
@@ -772,9 +912,24 @@ func aggregateImpls(types ...reflect.Type) []builtin {
 	return r
 }
 
+func arrayAggImpls(types ...reflect.Type) []builtin {
+	var r []builtin
+	for _, t := range types {
+		returnType := DummyArrayOf(arrayParamTypes[t])
+		r = append(r, builtin{
+			types:      typeList{t},
+			returnType: returnType,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return returnType, nil
+			},
+		})
+	}
+	return r
+}
+
 func countImpls() []builtin {
 	var r []builtin
-	types := typeList{boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType, tupleType}
+	types := typeList{boolType, intType, floatType, stringType, bytesType, dateType, timestampType, timestampTZType, timeType, intervalType, tupleType, jsonType, uuidType, inetType}
 	for _, t := range types {
 		r = append(r, builtin{
 			types:      typeList{t},
@@ -791,6 +946,84 @@ func countImpls() []builtin {
 	return r
 }
 
+// jsonBuildObjectImpl builds a JSON object from a list of alternating keys
+// and values.
+var jsonBuildObjectImpl = builtin{
+	returnType: DummyJSON,
+	fn: func(_ EvalContext, args DTuple) (Datum, error) {
+		if len(args)%2 != 0 {
+			return DNull, fmt.Errorf("argument list must have even number of elements")
+		}
+		m := make(map[string]interface{}, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			var key string
+			switch t := args[i].(type) {
+			case dNull:
+				return DNull, fmt.Errorf("argument %d cannot be null", i+1)
+			case DString:
+				key = string(t)
+			default:
+				key = t.String()
+			}
+			v, err := datumToJSON(args[i+1])
+			if err != nil {
+				return DNull, err
+			}
+			m[key] = v
+		}
+		return &DJSON{Value: m}, nil
+	},
+}
+
+// jsonExtractPathImpl returns the value at the path given by its string
+// arguments, like a chain of -> operators. Path elements applied to arrays
+// must be integers.
+var jsonExtractPathImpl = builtin{
+	returnType: DummyJSON,
+	fn: func(_ EvalContext, args DTuple) (Datum, error) {
+		if len(args) == 0 {
+			return DNull, fmt.Errorf("unknown signature for json_extract_path: json_extract_path()")
+		}
+		if args[0] == DNull {
+			return DNull, nil
+		}
+		j, ok := args[0].(*DJSON)
+		if !ok {
+			return DNull, fmt.Errorf("unknown signature for json_extract_path: json_extract_path(%s, ...)", args[0].Type())
+		}
+		v := j.Value
+		for _, arg := range args[1:] {
+			if arg == DNull {
+				return DNull, nil
+			}
+			s, ok := arg.(DString)
+			if !ok {
+				return DNull, fmt.Errorf("path elements must be strings: %s", arg.Type())
+			}
+			var key Datum = s
+			if _, ok := v.([]interface{}); ok {
+				i, err := strconv.Atoi(string(s))
+				if err != nil {
+					return DNull, nil
+				}
+				key = DInt(i)
+			}
+			if v, ok = jsonFetch(v, key); !ok {
+				return DNull, nil
+			}
+		}
+		return &DJSON{Value: v}, nil
+	},
+}
+
+var jsonTypeOfImpl = builtin{
+	types:      typeList{jsonType},
+	returnType: DummyString,
+	fn: func(_ EvalContext, args DTuple) (Datum, error) {
+		return DString(jsonTypeName(args[0].(*DJSON).Value)), nil
+	},
+}
+
 var substringImpls = []builtin{
 	{
 		types:      typeList{stringType, intType},
@@ -852,6 +1085,189 @@ var nowImpl = builtin{
 	},
 }
 
+// extractTime returns the field of t named by timeSpan.
+func extractTime(timeSpan DString, fromTime time.Time) (Datum, error) {
+	switch span := strings.ToLower(string(timeSpan)); span {
+	case "year":
+		return DInt(fromTime.Year()), nil
+
+	case "quarter":
+		return DInt(fromTime.Month()/4 + 1), nil
+
+	case "month":
+		return DInt(fromTime.Month()), nil
+
+	case "week":
+		_, week := fromTime.ISOWeek()
+		return DInt(week), nil
+
+	case "day":
+		return DInt(fromTime.Day()), nil
+
+	case "dayofweek", "dow":
+		return DInt(fromTime.Weekday()), nil
+
+	case "dayofyear", "doy":
+		return DInt(fromTime.YearDay()), nil
+
+	case "hour":
+		return DInt(fromTime.Hour()), nil
+
+	case "minute":
+		return DInt(fromTime.Minute()), nil
+
+	case "second":
+		return DInt(fromTime.Second()), nil
+
+	case "millisecond":
+		return DInt(fromTime.Nanosecond() / int(time.Millisecond)), nil
+
+	case "microsecond":
+		return DInt(fromTime.Nanosecond() / int(time.Microsecond)), nil
+
+	case "nanosecond":
+		return DInt(fromTime.Nanosecond()), nil
+
+	case "epoch":
+		return DInt(fromTime.Unix()), nil
+
+	default:
+		return DNull, fmt.Errorf("unsupported timespan: %s", span)
+	}
+}
+
+// truncateTime truncates t to the precision named by timeSpan. Fields are
+// truncated in the location of t.
+func truncateTime(timeSpan DString, t time.Time) (time.Time, error) {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	nsec := t.Nanosecond()
+	switch span := strings.ToLower(string(timeSpan)); span {
+	case "year":
+		month, day, hour, min, sec, nsec = time.January, 1, 0, 0, 0, 0
+	case "quarter":
+		month = (month-1)/3*3 + 1
+		day, hour, min, sec, nsec = 1, 0, 0, 0, 0
+	case "month":
+		day, hour, min, sec, nsec = 1, 0, 0, 0, 0
+	case "week":
+		// Weeks start on Monday, as in ISO 8601.
+		day -= (int(t.Weekday()) + 6) % 7
+		hour, min, sec, nsec = 0, 0, 0, 0
+	case "day":
+		hour, min, sec, nsec = 0, 0, 0, 0
+	case "hour":
+		min, sec, nsec = 0, 0, 0
+	case "minute":
+		sec, nsec = 0, 0
+	case "second":
+		nsec = 0
+	case "millisecond":
+		nsec -= nsec % int(time.Millisecond)
+	case "microsecond":
+		nsec -= nsec % int(time.Microsecond)
+	default:
+		return time.Time{}, fmt.Errorf("unsupported timespan: %s", span)
+	}
+	return time.Date(year, month, day, hour, min, sec, nsec, t.Location()), nil
+}
+
+// toCharPatterns are the template patterns understood by to_char, longest
+// first so that, e.g., "HH24" is not read as "HH" followed by "24".
+var toCharPatterns = []struct {
+	pattern string
+	format  func(t time.Time) string
+}{
+	{"YYYY", func(t time.Time) string { return fmt.Sprintf("%04d", t.Year()) }},
+	{"HH24", func(t time.Time) string { return fmt.Sprintf("%02d", t.Hour()) }},
+	{"HH12", func(t time.Time) string { return t.Format("03") }},
+	{"MONTH", func(t time.Time) string { return strings.ToUpper(fmt.Sprintf("%-9s", t.Month())) }},
+	{"Month", func(t time.Time) string { return fmt.Sprintf("%-9s", t.Month()) }},
+	{"month", func(t time.Time) string { return strings.ToLower(fmt.Sprintf("%-9s", t.Month())) }},
+	{"DAY", func(t time.Time) string { return strings.ToUpper(fmt.Sprintf("%-9s", t.Weekday())) }},
+	{"Day", func(t time.Time) string { return fmt.Sprintf("%-9s", t.Weekday()) }},
+	{"day", func(t time.Time) string { return strings.ToLower(fmt.Sprintf("%-9s", t.Weekday())) }},
+	{"MON", func(t time.Time) string { return strings.ToUpper(t.Format("Jan")) }},
+	{"Mon", func(t time.Time) string { return t.Format("Jan") }},
+	{"mon", func(t time.Time) string { return strings.ToLower(t.Format("Jan")) }},
+	{"DDD", func(t time.Time) string { return fmt.Sprintf("%03d", t.YearDay()) }},
+	{"DY", func(t time.Time) string { return strings.ToUpper(t.Format("Mon")) }},
+	{"Dy", func(t time.Time) string { return t.Format("Mon") }},
+	{"dy", func(t time.Time) string { return strings.ToLower(t.Format("Mon")) }},
+	{"YY", func(t time.Time) string { return fmt.Sprintf("%02d", t.Year()%100) }},
+	{"MM", func(t time.Time) string { return fmt.Sprintf("%02d", t.Month()) }},
+	{"DD", func(t time.Time) string { return fmt.Sprintf("%02d", t.Day()) }},
+	{"HH", func(t time.Time) string { return t.Format("03") }},
+	{"MI", func(t time.Time) string { return fmt.Sprintf("%02d", t.Minute()) }},
+	{"SS", func(t time.Time) string { return fmt.Sprintf("%02d", t.Second()) }},
+	{"MS", func(t time.Time) string { return fmt.Sprintf("%03d", t.Nanosecond()/int(time.Millisecond)) }},
+	{"US", func(t time.Time) string { return fmt.Sprintf("%06d", t.Nanosecond()/int(time.Microsecond)) }},
+	{"AM", func(t time.Time) string { return t.Format("PM") }},
+	{"PM", func(t time.Time) string { return t.Format("PM") }},
+	{"am", func(t time.Time) string { return t.Format("pm") }},
+	{"pm", func(t time.Time) string { return t.Format("pm") }},
+	{"TZ", func(t time.Time) string { return t.Format("MST") }},
+	{"tz", func(t time.Time) string { return strings.ToLower(t.Format("MST")) }},
+	{"OF", func(t time.Time) string { return t.Format("-07:00") }},
+	{"Q", func(t time.Time) string { return strconv.Itoa((int(t.Month())-1)/3 + 1) }},
+	{"D", func(t time.Time) string { return strconv.Itoa(int(t.Weekday()) + 1) }},
+}
+
+// formatTime formats t using the PostgreSQL to_char template format. Text
+// in double quotes is copied verbatim, as is any character that does not
+// start a pattern.
+func formatTime(t time.Time, format string) string {
+	var buf bytes.Buffer
+	for len(format) > 0 {
+		if format[0] == '"' {
+			end := strings.IndexByte(format[1:], '"')
+			if end < 0 {
+				buf.WriteString(format[1:])
+				break
+			}
+			buf.WriteString(format[1 : end+1])
+			format = format[end+2:]
+			continue
+		}
+		matched := false
+		for _, p := range toCharPatterns {
+			if strings.HasPrefix(format, p.pattern) {
+				buf.WriteString(p.format(t))
+				format = format[len(p.pattern):]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			_ = buf.WriteByte(format[0])
+			format = format[1:]
+		}
+	}
+	return buf.String()
+}
+
+// wallClockIn returns the time in loc with the same wall clock as t.
+func wallClockIn(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), loc)
+}
+
+// timestampTZInZone returns the instant at which the wall clock in loc shows
+// the time of ts. The result is in the session time zone.
+func timestampTZInZone(ctx EvalContext, ts DTimestamp, loc *time.Location) (Datum, error) {
+	sessionLoc, err := ctx.location()
+	if err != nil {
+		return DNull, err
+	}
+	return DTimestampTZ{Time: wallClockIn(ts.UTC(), loc).In(sessionLoc)}, nil
+}
+
+// intervalLocation returns the fixed zone which is d east of UTC.
+func intervalLocation(d DInterval) *time.Location {
+	return time.FixedZone(d.String(), int(d.Duration/time.Second))
+}
+
 var powImpl = floatBuiltin2(func(x, y float64) (Datum, error) {
 	return DFloat(math.Pow(x, y)), nil
 })
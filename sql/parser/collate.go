@@ -0,0 +1,81 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// collationEnv caches a collator and a placeholder DCollatedString per
+// locale. Collators are not safe for concurrent use, so they are only used
+// with the mutex held.
+var collationEnv struct {
+	sync.Mutex
+	collators map[string]*collate.Collator
+	dummies   map[string]*DCollatedString
+	buf       collate.Buffer
+}
+
+// CanonicalLocale returns the canonical form of the BCP 47 language tag
+// locale, e.g. "de-DE" for "de_de".
+func CanonicalLocale(locale string) (string, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", fmt.Errorf("invalid locale %q: %v", locale, err)
+	}
+	return tag.String(), nil
+}
+
+// collationKey returns the collation key of s in locale, which must be
+// canonical. The byte-wise order of the keys is the collation order of the
+// strings.
+func collationKey(s, locale string) []byte {
+	collationEnv.Lock()
+	defer collationEnv.Unlock()
+	c, ok := collationEnv.collators[locale]
+	if !ok {
+		if collationEnv.collators == nil {
+			collationEnv.collators = map[string]*collate.Collator{}
+		}
+		c = collate.New(language.Make(locale))
+		collationEnv.collators[locale] = c
+	}
+	// The key is only valid until the buffer is reset, so copy it.
+	key := append([]byte(nil), c.KeyFromString(&collationEnv.buf, s)...)
+	collationEnv.buf.Reset()
+	return key
+}
+
+// DummyCollatedString returns the placeholder DCollatedString value for
+// locale, which must be canonical. Type checking compares placeholders by
+// identity, so there is a single placeholder per locale.
+func DummyCollatedString(locale string) *DCollatedString {
+	collationEnv.Lock()
+	defer collationEnv.Unlock()
+	d, ok := collationEnv.dummies[locale]
+	if !ok {
+		if collationEnv.dummies == nil {
+			collationEnv.dummies = map[string]*DCollatedString{}
+		}
+		d = &DCollatedString{Locale: locale}
+		collationEnv.dummies[locale] = d
+	}
+	return d
+}
@@ -50,8 +50,10 @@ type CreateIndex struct {
 	Table       *QualifiedName
 	Unique      bool
 	IfNotExists bool
-	Columns     NameList
+	Columns     IndexElemList
 	Storing     NameList
+	Interleave  *InterleaveDef
+	Where       *Where
 }
 
 func (node *CreateIndex) String() string {
@@ -71,6 +73,41 @@ func (node *CreateIndex) String() string {
 	if node.Storing != nil {
 		fmt.Fprintf(&buf, " STORING (%s)", node.Storing)
 	}
+	if node.Interleave != nil {
+		fmt.Fprintf(&buf, " %s", node.Interleave)
+	}
+	buf.WriteString(node.Where.String())
+	return buf.String()
+}
+
+// IndexElem represents an element of the column list of an index: either a
+// column or, for an expression index, an expression.
+type IndexElem struct {
+	Column Name
+	Expr   Expr
+}
+
+func (node IndexElem) String() string {
+	switch node.Expr.(type) {
+	case nil:
+		return node.Column.String()
+	case *FuncExpr:
+		return node.Expr.String()
+	}
+	return fmt.Sprintf("(%s)", node.Expr)
+}
+
+// IndexElemList is a list of index elements.
+type IndexElemList []IndexElem
+
+func (l IndexElemList) String() string {
+	var buf bytes.Buffer
+	for i, e := range l {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(e.String())
+	}
 	return buf.String()
 }
 
@@ -85,6 +122,7 @@ type TableDef interface {
 
 func (*ColumnTableDef) tableDef() {}
 func (*IndexTableDef) tableDef()  {}
+func (*FamilyTableDef) tableDef() {}
 
 // TableDefs represents a list of table definitions.
 type TableDefs []TableDef
@@ -122,7 +160,7 @@ type ColumnTableDef struct {
 }
 
 func newColumnTableDef(name Name, typ ColumnType,
-	qualifications []ColumnQualification) *ColumnTableDef {
+	qualifications []ColumnQualification) (*ColumnTableDef, error) {
 	d := &ColumnTableDef{
 		Name:     name,
 		Type:     typ,
@@ -140,11 +178,17 @@ func newColumnTableDef(name Name, typ ColumnType,
 			d.PrimaryKey = true
 		case UniqueConstraint:
 			d.Unique = true
+		case ColumnCollation:
+			s, ok := d.Type.(*StringType)
+			if !ok {
+				return nil, fmt.Errorf("COLLATE declaration for non-string-typed column %q", name)
+			}
+			d.Type = &CollatedStringType{Name: s.Name, N: s.N, Locale: string(t)}
 		default:
 			panic(fmt.Sprintf("unexpected column qualification: %T", c))
 		}
 	}
-	return d
+	return d, nil
 }
 
 func (node *ColumnTableDef) setName(name Name) {
@@ -181,6 +225,7 @@ func (NotNullConstraint) columnQualification()    {}
 func (NullConstraint) columnQualification()       {}
 func (PrimaryKeyConstraint) columnQualification() {}
 func (UniqueConstraint) columnQualification()     {}
+func (ColumnCollation) columnQualification()      {}
 
 // ColumnDefault represents a DEFAULT clause for a column.
 type ColumnDefault struct {
@@ -199,6 +244,9 @@ type PrimaryKeyConstraint struct{}
 // UniqueConstraint represents UNIQUE on a column.
 type UniqueConstraint struct{}
 
+// ColumnCollation represents a COLLATE clause for a column.
+type ColumnCollation string
+
 // IndexTableDef represents an index definition within a CREATE TABLE
 // statement.
 type IndexTableDef struct {
@@ -224,6 +272,27 @@ func (node *IndexTableDef) String() string {
 	return buf.String()
 }
 
+// FamilyTableDef represents a column family definition within a CREATE TABLE
+// statement.
+type FamilyTableDef struct {
+	Name    Name
+	Columns NameList
+}
+
+func (node *FamilyTableDef) setName(name Name) {
+	node.Name = name
+}
+
+func (node *FamilyTableDef) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("FAMILY ")
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "%s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "(%s)", node.Columns)
+	return buf.String()
+}
+
 // ConstraintTableDef represents a constraint definition within a CREATE TABLE
 // statement.
 type ConstraintTableDef interface {
@@ -259,11 +328,21 @@ func (node *UniqueConstraintTableDef) String() string {
 	return buf.String()
 }
 
+// CreateRole represents a CREATE ROLE statement.
+type CreateRole struct {
+	Name Name
+}
+
+func (node *CreateRole) String() string {
+	return fmt.Sprintf("CREATE ROLE %s", node.Name)
+}
+
 // CreateTable represents a CREATE TABLE statement.
 type CreateTable struct {
 	IfNotExists bool
 	Table       *QualifiedName
 	Defs        TableDefs
+	Interleave  *InterleaveDef
 }
 
 func (node *CreateTable) String() string {
@@ -273,5 +352,34 @@ func (node *CreateTable) String() string {
 		buf.WriteString(" IF NOT EXISTS")
 	}
 	fmt.Fprintf(&buf, " %s (%s)", node.Table, node.Defs)
+	if node.Interleave != nil {
+		fmt.Fprintf(&buf, " %s", node.Interleave)
+	}
 	return buf.String()
 }
+
+// InterleaveDef represents an interleave definition within a CREATE TABLE
+// or CREATE INDEX statement. Fields names the leading columns of the new
+// table's primary key (or of the new index) which correspond to the parent
+// table's primary key.
+type InterleaveDef struct {
+	Parent *QualifiedName
+	Fields NameList
+}
+
+func (node *InterleaveDef) String() string {
+	return fmt.Sprintf("INTERLEAVE IN PARENT %s (%s)", node.Parent, node.Fields)
+}
+
+// CreateUser represents a CREATE USER statement.
+type CreateUser struct {
+	Name     Name
+	Password Expr
+}
+
+func (node *CreateUser) String() string {
+	if node.Password == nil {
+		return fmt.Sprintf("CREATE USER %s", node.Name)
+	}
+	return fmt.Sprintf("CREATE USER %s WITH PASSWORD %s", node.Name, node.Password)
+}
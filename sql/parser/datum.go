@@ -21,12 +21,14 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"net"
 	"reflect"
 	"sort"
 	"strconv"
 	"time"
 
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/uuid"
 )
 
 var (
@@ -44,10 +46,23 @@ var (
 	DummyDate = DDate{}
 	// DummyTimestamp is a placeholder DTimestamp value.
 	DummyTimestamp = DTimestamp{}
+	// DummyTimestampTZ is a placeholder DTimestampTZ value.
+	DummyTimestampTZ = DTimestampTZ{}
+	// DummyTime is a placeholder DTime value.
+	DummyTime = DTime{}
 	// DummyInterval is a placeholder DInterval value.
 	DummyInterval = DInterval{}
 	// DummyTuple is a placeholder DTuple value.
 	DummyTuple = DTuple{}
+	// DummyArray is a placeholder DArray value of unknown element type. Use
+	// DummyArrayOf for the placeholders of the other element types.
+	DummyArray = &DArray{ParamType: DNull}
+	// DummyJSON is a placeholder DJSON value.
+	DummyJSON = &DJSON{}
+	// DummyUUID is a placeholder DUUID value.
+	DummyUUID = DUUID{}
+	// DummyInet is a placeholder DInet value.
+	DummyInet = DInet{}
 
 	// DNull is the NULL Datum.
 	DNull = dNull{}
@@ -59,19 +74,59 @@ var (
 	_ Datum = DummyBytes
 	_ Datum = DummyDate
 	_ Datum = DummyTimestamp
+	_ Datum = DummyTimestampTZ
+	_ Datum = DummyTime
 	_ Datum = DummyInterval
 	_ Datum = DummyTuple
+	_ Datum = DummyArray
+	_ Datum = DummyJSON
+	_ Datum = DummyUUID
+	_ Datum = DummyInet
 	_ Datum = DNull
 
-	boolType      = reflect.TypeOf(DummyBool)
-	intType       = reflect.TypeOf(DummyInt)
-	floatType     = reflect.TypeOf(DummyFloat)
-	stringType    = reflect.TypeOf(DummyString)
-	bytesType     = reflect.TypeOf(DummyBytes)
-	dateType      = reflect.TypeOf(DummyDate)
-	timestampType = reflect.TypeOf(DummyTimestamp)
-	intervalType  = reflect.TypeOf(DummyInterval)
-	tupleType     = reflect.TypeOf(DummyTuple)
+	boolType        = reflect.TypeOf(DummyBool)
+	intType         = reflect.TypeOf(DummyInt)
+	floatType       = reflect.TypeOf(DummyFloat)
+	stringType      = reflect.TypeOf(DummyString)
+	bytesType       = reflect.TypeOf(DummyBytes)
+	dateType        = reflect.TypeOf(DummyDate)
+	timestampType   = reflect.TypeOf(DummyTimestamp)
+	timestampTZType = reflect.TypeOf(DummyTimestampTZ)
+	timeType        = reflect.TypeOf(DummyTime)
+	intervalType    = reflect.TypeOf(DummyInterval)
+	tupleType       = reflect.TypeOf(DummyTuple)
+	arrayType       = reflect.TypeOf(DummyArray)
+	jsonType        = reflect.TypeOf(DummyJSON)
+	uuidType        = reflect.TypeOf(DummyUUID)
+	inetType        = reflect.TypeOf(DummyInet)
+
+	collatedStringType = reflect.TypeOf(&DCollatedString{})
+
+	// arrayParamTypes maps the types which can be array elements to their
+	// placeholder values.
+	arrayParamTypes = map[reflect.Type]Datum{
+		boolType:        DummyBool,
+		intType:         DummyInt,
+		floatType:       DummyFloat,
+		stringType:      DummyString,
+		bytesType:       DummyBytes,
+		dateType:        DummyDate,
+		timestampType:   DummyTimestamp,
+		timestampTZType: DummyTimestampTZ,
+		timeType:        DummyTime,
+		intervalType:    DummyInterval,
+	}
+
+	// dummyArrays holds the placeholder DArray values, indexed by element
+	// type. Type checking compares placeholders by identity, so there is a
+	// single placeholder per element type.
+	dummyArrays = func() map[reflect.Type]*DArray {
+		m := map[reflect.Type]*DArray{reflect.TypeOf(DNull): DummyArray}
+		for t, d := range arrayParamTypes {
+			m[t] = &DArray{ParamType: d}
+		}
+		return m
+	}()
 )
 
 // A Datum holds either a bool, int64, float64, string or []Datum.
@@ -440,6 +495,119 @@ func (d DTimestamp) String() string {
 	return d.Format(TimestampWithOffsetZoneFormat)
 }
 
+// DTimestampTZ is the timestamp with time zone Datum. It represents an
+// instant; the location of the time.Time only affects how it is displayed,
+// which is normally in the session time zone.
+type DTimestampTZ struct {
+	time.Time
+}
+
+// Type implements the Datum interface.
+func (d DTimestampTZ) Type() string {
+	return "timestamptz"
+}
+
+// Compare implements the Datum interface.
+func (d DTimestampTZ) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(DTimestampTZ)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	if d.Before(v.Time) {
+		return -1
+	}
+	if v.Before(d.Time) {
+		return 1
+	}
+	return 0
+}
+
+// Next implements the Datum interface.
+func (d DTimestampTZ) Next() Datum {
+	return DTimestampTZ{Time: d.Add(1)}
+}
+
+// IsMax implements the Datum interface.
+func (d DTimestampTZ) IsMax() bool {
+	// Adding 1 overflows to a smaller value
+	return d.After(d.Next().(DTimestampTZ).Time)
+}
+
+// IsMin implements the Datum interface.
+func (d DTimestampTZ) IsMin() bool {
+	// Subtracting 1 underflows to a larger value.
+	return d.Before(d.Add(-1))
+}
+
+func (d DTimestampTZ) String() string {
+	return d.Format(TimestampWithOffsetZoneFormat)
+}
+
+// DTime is the time of day Datum. It holds the time elapsed since midnight,
+// which is always in [0, 24h).
+type DTime struct {
+	time.Duration
+}
+
+// MakeDTime constructs a DTime from the wall clock of a time.Time.
+func MakeDTime(t time.Time) DTime {
+	hour, min, sec := t.Clock()
+	return DTime{Duration: time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(t.Nanosecond())}
+}
+
+// Type implements the Datum interface.
+func (d DTime) Type() string {
+	return "time"
+}
+
+// Compare implements the Datum interface.
+func (d DTime) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(DTime)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	if d.Duration < v.Duration {
+		return -1
+	}
+	if v.Duration < d.Duration {
+		return 1
+	}
+	return 0
+}
+
+// Next implements the Datum interface.
+func (d DTime) Next() Datum {
+	return DTime{Duration: d.Duration + 1}
+}
+
+// IsMax implements the Datum interface.
+func (d DTime) IsMax() bool {
+	return d.Duration == 24*time.Hour-1
+}
+
+// IsMin implements the Datum interface.
+func (d DTime) IsMin() bool {
+	return d.Duration == 0
+}
+
+func (d DTime) String() string {
+	return d.toTime().Format(timeFormat)
+}
+
+// toTime returns d as a time on January 1, 1970 UTC.
+func (d DTime) toTime() time.Time {
+	return time.Unix(0, 0).UTC().Add(d.Duration)
+}
+
 // DInterval is the interval Datum.
 type DInterval struct {
 	time.Duration
@@ -629,3 +797,395 @@ func (d dNull) String() string {
 type DReference interface {
 	Datum() Datum
 }
+
+// DArray is the array Datum. Only one-dimensional arrays are supported. The
+// elements are either NULL or of type ParamType.
+type DArray struct {
+	// ParamType is a placeholder value of the type of the elements. It is
+	// DNull when the type is unknown, for example when all the elements are
+	// NULL.
+	ParamType Datum
+	Elements  []Datum
+}
+
+// DummyArrayOf returns the placeholder DArray value with elements of the
+// type of paramType. It returns nil if arrays of that type are not
+// supported.
+func DummyArrayOf(paramType Datum) *DArray {
+	return dummyArrays[reflect.TypeOf(paramType)]
+}
+
+// NewDArray returns an array holding elements, which must all be NULL or of
+// the same type.
+func NewDArray(elements []Datum) (*DArray, error) {
+	a := &DArray{ParamType: DNull, Elements: elements}
+	for _, d := range elements {
+		if d == DNull {
+			continue
+		}
+		paramType, ok := arrayParamTypes[reflect.TypeOf(d)]
+		if !ok {
+			return nil, fmt.Errorf("arrays of %s are not supported", d.Type())
+		}
+		if a.ParamType == DNull {
+			a.ParamType = paramType
+		} else if a.ParamType != paramType {
+			return nil, fmt.Errorf("array elements must have the same type: %s, %s",
+				a.ParamType.Type(), d.Type())
+		}
+	}
+	return a, nil
+}
+
+// Type implements the Datum interface.
+func (d *DArray) Type() string {
+	if d.ParamType == DNull {
+		return "array"
+	}
+	return d.ParamType.Type() + "[]"
+}
+
+// Compare implements the Datum interface.
+func (d *DArray) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(*DArray)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	n := len(d.Elements)
+	if n > len(v.Elements) {
+		n = len(v.Elements)
+	}
+	for i := 0; i < n; i++ {
+		c := d.Elements[i].Compare(v.Elements[i])
+		if c != 0 {
+			return c
+		}
+	}
+	if len(d.Elements) < len(v.Elements) {
+		return -1
+	}
+	if len(d.Elements) > len(v.Elements) {
+		return 1
+	}
+	return 0
+}
+
+// Next implements the Datum interface.
+func (d *DArray) Next() Datum {
+	// NULL sorts before any other element, so the next array is the receiver
+	// with a NULL appended.
+	n := &DArray{ParamType: d.ParamType, Elements: make([]Datum, len(d.Elements), len(d.Elements)+1)}
+	copy(n.Elements, d.Elements)
+	n.Elements = append(n.Elements, DNull)
+	return n
+}
+
+// IsMax implements the Datum interface.
+func (d *DArray) IsMax() bool {
+	return false
+}
+
+// IsMin implements the Datum interface.
+func (d *DArray) IsMin() bool {
+	return len(d.Elements) == 0
+}
+
+func (d *DArray) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ARRAY[")
+	for i, v := range d.Elements {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(v.String())
+	}
+	_ = buf.WriteByte(']')
+	return buf.String()
+}
+
+// DJSON is the JSONB Datum. Value holds the parsed document: nil, bool,
+// json.Number, string, []interface{} or map[string]interface{}.
+type DJSON struct {
+	Value interface{}
+}
+
+// ParseDJSON parses s as a JSON document.
+func ParseDJSON(s string) (*DJSON, error) {
+	v, err := parseJSON(s)
+	if err != nil {
+		return nil, err
+	}
+	return &DJSON{Value: v}, nil
+}
+
+// Type implements the Datum interface.
+func (d *DJSON) Type() string {
+	return "jsonb"
+}
+
+// Compare implements the Datum interface.
+func (d *DJSON) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(*DJSON)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	return compareJSON(d.Value, v.Value)
+}
+
+// Next implements the Datum interface.
+func (d *DJSON) Next() Datum {
+	panic("DJSON.Next not supported")
+}
+
+// IsMax implements the Datum interface.
+func (d *DJSON) IsMax() bool {
+	return false
+}
+
+// IsMin implements the Datum interface.
+func (d *DJSON) IsMin() bool {
+	// The JSON null value sorts before all other JSON values.
+	return d.Value == nil
+}
+
+// JSONText returns the canonical text of the document. Object keys are
+// sorted and duplicate keys have been removed, so equal documents have the
+// same text.
+func (d *DJSON) JSONText() string {
+	var buf bytes.Buffer
+	writeJSON(&buf, d.Value)
+	return buf.String()
+}
+
+func (d *DJSON) String() string {
+	return encodeSQLString(d.JSONText())
+}
+
+// DCollatedString is the Datum for strings with a collation. Key is the
+// collation key of Contents in Locale, which is a canonical BCP 47 language
+// tag. Collated strings are ordered by their keys and, as in PostgreSQL,
+// strings which collate equally are ordered byte-wise.
+type DCollatedString struct {
+	Contents string
+	Locale   string
+	Key      []byte
+}
+
+// NewDCollatedString returns contents collated in locale.
+func NewDCollatedString(contents, locale string) (*DCollatedString, error) {
+	locale, err := CanonicalLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	return &DCollatedString{Contents: contents, Locale: locale, Key: collationKey(contents, locale)}, nil
+}
+
+// Type implements the Datum interface.
+func (d *DCollatedString) Type() string {
+	return fmt.Sprintf("collatedstring{%s}", d.Locale)
+}
+
+// Compare implements the Datum interface.
+func (d *DCollatedString) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(*DCollatedString)
+	if !ok || v.Locale != d.Locale {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	if c := bytes.Compare(d.Key, v.Key); c != 0 {
+		return c
+	}
+	return DString(d.Contents).Compare(DString(v.Contents))
+}
+
+// Next implements the Datum interface.
+func (d *DCollatedString) Next() Datum {
+	// The key is kept so that the result is the immediate successor of d: it
+	// has the same key and the next contents in byte-wise order.
+	return &DCollatedString{Contents: d.Contents + "\x00", Locale: d.Locale, Key: d.Key}
+}
+
+// IsMax implements the Datum interface.
+func (d *DCollatedString) IsMax() bool {
+	return false
+}
+
+// IsMin implements the Datum interface.
+func (d *DCollatedString) IsMin() bool {
+	return len(d.Contents) == 0
+}
+
+func (d *DCollatedString) String() string {
+	return fmt.Sprintf("%s COLLATE %s", encodeSQLString(d.Contents), Name(d.Locale))
+}
+
+// DUUID is the UUID Datum.
+type DUUID [uuid.UUIDSize]byte
+
+// ParseDUUID parses s as a UUID.
+func ParseDUUID(s string) (DUUID, error) {
+	u, err := uuid.FromString(s)
+	if err != nil {
+		return DUUID{}, err
+	}
+	return MakeDUUID(u)
+}
+
+// MakeDUUID constructs a DUUID from its 16 byte representation.
+func MakeDUUID(b []byte) (DUUID, error) {
+	var d DUUID
+	if len(b) != len(d) {
+		return DUUID{}, fmt.Errorf("invalid UUID length: %d", len(b))
+	}
+	copy(d[:], b)
+	return d, nil
+}
+
+// Type implements the Datum interface.
+func (d DUUID) Type() string {
+	return "uuid"
+}
+
+// Compare implements the Datum interface.
+func (d DUUID) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(DUUID)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	return bytes.Compare(d[:], v[:])
+}
+
+// Next implements the Datum interface.
+func (d DUUID) Next() Datum {
+	for i := len(d) - 1; i >= 0; i-- {
+		d[i]++
+		if d[i] != 0 {
+			return d
+		}
+	}
+	panic("DUUID.Next on maximum value")
+}
+
+// IsMax implements the Datum interface.
+func (d DUUID) IsMax() bool {
+	for _, b := range d {
+		if b != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMin implements the Datum interface.
+func (d DUUID) IsMin() bool {
+	return d == DUUID{}
+}
+
+// UUIDText returns the canonical text form of d.
+func (d DUUID) UUIDText() string {
+	return uuid.UUID(d[:]).String()
+}
+
+func (d DUUID) String() string {
+	return encodeSQLString(d.UUIDText())
+}
+
+// DInet is the INET Datum. It holds an IPv4 or IPv6 host address together
+// with the length of its network prefix. IPv4 addresses are stored in the
+// first 4 bytes of Addr.
+type DInet struct {
+	Family  uint8
+	Addr    [net.IPv6len]byte
+	MaskLen uint8
+}
+
+// Type implements the Datum interface.
+func (d DInet) Type() string {
+	return "inet"
+}
+
+// Compare implements the Datum interface. Addresses are ordered by family,
+// then by address and then by prefix length.
+func (d DInet) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(DInet)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	if d.Family != v.Family {
+		if d.Family < v.Family {
+			return -1
+		}
+		return 1
+	}
+	if c := bytes.Compare(d.Addr[:], v.Addr[:]); c != 0 {
+		return c
+	}
+	if d.MaskLen < v.MaskLen {
+		return -1
+	}
+	if d.MaskLen > v.MaskLen {
+		return 1
+	}
+	return 0
+}
+
+// Next implements the Datum interface.
+func (d DInet) Next() Datum {
+	if int(d.MaskLen) < d.bits() {
+		d.MaskLen++
+		return d
+	}
+	d.MaskLen = 0
+	addr := d.Addr[:d.bits()/8]
+	for i := len(addr) - 1; i >= 0; i-- {
+		addr[i]++
+		if addr[i] != 0 {
+			return d
+		}
+	}
+	if d.Family == inetFamilyIPv4 {
+		return DInet{Family: inetFamilyIPv6}
+	}
+	panic("DInet.Next on maximum value")
+}
+
+// IsMax implements the Datum interface.
+func (d DInet) IsMax() bool {
+	if d.Family != inetFamilyIPv6 || int(d.MaskLen) != d.bits() {
+		return false
+	}
+	for _, b := range d.Addr {
+		if b != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMin implements the Datum interface.
+func (d DInet) IsMin() bool {
+	return d == DInet{Family: inetFamilyIPv4}
+}
+
+func (d DInet) String() string {
+	return encodeSQLString(d.InetText())
+}
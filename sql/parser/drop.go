@@ -72,3 +72,27 @@ func (node *DropTable) String() string {
 	buf.WriteString(node.Names.String())
 	return buf.String()
 }
+
+// DropRole represents a DROP ROLE statement.
+type DropRole struct {
+	Names NameList
+}
+
+func (node *DropRole) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("DROP ROLE ")
+	buf.WriteString(node.Names.String())
+	return buf.String()
+}
+
+// DropUser represents a DROP USER statement.
+type DropUser struct {
+	Names NameList
+}
+
+func (node *DropUser) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("DROP USER ")
+	buf.WriteString(node.Names.String())
+	return buf.String()
+}
@@ -157,6 +157,30 @@ var binOps = map[binArgs]binOp{
 			return DTimestamp{Time: right.(DTimestamp).Add(left.(DInterval).Duration)}, nil
 		},
 	},
+	binArgs{Plus, timestampTZType, intervalType}: {
+		returnType: DummyTimestampTZ,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return DTimestampTZ{Time: left.(DTimestampTZ).Add(right.(DInterval).Duration)}, nil
+		},
+	},
+	binArgs{Plus, intervalType, timestampTZType}: {
+		returnType: DummyTimestampTZ,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return DTimestampTZ{Time: right.(DTimestampTZ).Add(left.(DInterval).Duration)}, nil
+		},
+	},
+	binArgs{Plus, timeType, intervalType}: {
+		returnType: DummyTime,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return addTimeInterval(left.(DTime), right.(DInterval).Duration), nil
+		},
+	},
+	binArgs{Plus, intervalType, timeType}: {
+		returnType: DummyTime,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return addTimeInterval(right.(DTime), left.(DInterval).Duration), nil
+		},
+	},
 	binArgs{Plus, intervalType, intervalType}: {
 		returnType: DummyInterval,
 		fn: func(left Datum, right Datum) (Datum, error) {
@@ -212,6 +236,30 @@ var binOps = map[binArgs]binOp{
 			return DTimestamp{Time: left.(DTimestamp).Add(-right.(DInterval).Duration)}, nil
 		},
 	},
+	binArgs{Minus, timestampTZType, timestampTZType}: {
+		returnType: DummyInterval,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return DInterval{Duration: left.(DTimestampTZ).Sub(right.(DTimestampTZ).Time)}, nil
+		},
+	},
+	binArgs{Minus, timestampTZType, intervalType}: {
+		returnType: DummyTimestampTZ,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return DTimestampTZ{Time: left.(DTimestampTZ).Add(-right.(DInterval).Duration)}, nil
+		},
+	},
+	binArgs{Minus, timeType, timeType}: {
+		returnType: DummyInterval,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return DInterval{Duration: left.(DTime).Duration - right.(DTime).Duration}, nil
+		},
+	},
+	binArgs{Minus, timeType, intervalType}: {
+		returnType: DummyTime,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return addTimeInterval(left.(DTime), -right.(DInterval).Duration), nil
+		},
+	},
 	binArgs{Minus, intervalType, intervalType}: {
 		returnType: DummyInterval,
 		fn: func(left Datum, right Datum) (Datum, error) {
@@ -314,6 +362,65 @@ var binOps = map[binArgs]binOp{
 			return left.(DInt) >> uint(right.(DInt)), nil
 		},
 	},
+	binArgs{LShift, inetType, inetType}: {
+		returnType: DummyBool,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return DBool(inetContainedBy(left.(DInet), right.(DInet), false)), nil
+		},
+	},
+	binArgs{RShift, inetType, inetType}: {
+		returnType: DummyBool,
+		fn: func(left Datum, right Datum) (Datum, error) {
+			return DBool(inetContainedBy(right.(DInet), left.(DInet), false)), nil
+		},
+	},
+
+	binArgs{FetchVal, jsonType, stringType}: {
+		returnType: DummyJSON,
+		fn:         evalJSONFetchVal,
+	},
+	binArgs{FetchVal, jsonType, intType}: {
+		returnType: DummyJSON,
+		fn:         evalJSONFetchVal,
+	},
+
+	binArgs{FetchText, jsonType, stringType}: {
+		returnType: DummyString,
+		fn:         evalJSONFetchText,
+	},
+	binArgs{FetchText, jsonType, intType}: {
+		returnType: DummyString,
+		fn:         evalJSONFetchText,
+	},
+}
+
+// addTimeInterval adds an interval to a time of day, wrapping around midnight.
+func addTimeInterval(t DTime, d time.Duration) DTime {
+	r := (t.Duration + d) % (24 * time.Hour)
+	if r < 0 {
+		r += 24 * time.Hour
+	}
+	return DTime{Duration: r}
+}
+
+// evalJSONFetchVal implements "json -> key". The result is NULL if the field
+// or element does not exist.
+func evalJSONFetchVal(left Datum, right Datum) (Datum, error) {
+	v, ok := jsonFetch(left.(*DJSON).Value, right)
+	if !ok {
+		return DNull, nil
+	}
+	return &DJSON{Value: v}, nil
+}
+
+// evalJSONFetchText implements "json ->> key", which is like "json -> key"
+// but returns the result as a string.
+func evalJSONFetchText(left Datum, right Datum) (Datum, error) {
+	v, ok := jsonFetch(left.(*DJSON).Value, right)
+	if !ok {
+		return DNull, nil
+	}
+	return jsonFetchText(v), nil
 }
 
 type cmpArgs struct {
@@ -366,11 +473,41 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return DBool(left.(DTimestamp).Equal(right.(DTimestamp).Time)), nil
 		},
 	},
+	cmpArgs{EQ, timestampTZType, timestampTZType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.(DTimestampTZ).Equal(right.(DTimestampTZ).Time)), nil
+		},
+	},
+	cmpArgs{EQ, timeType, timeType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.(DTime) == right.(DTime)), nil
+		},
+	},
 	cmpArgs{EQ, intervalType, intervalType}: {
 		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
 			return DBool(left.(DInterval) == right.(DInterval)), nil
 		},
 	},
+	cmpArgs{EQ, jsonType, jsonType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) == 0), nil
+		},
+	},
+	cmpArgs{EQ, collatedStringType, collatedStringType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) == 0), nil
+		},
+	},
+	cmpArgs{EQ, uuidType, uuidType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) == 0), nil
+		},
+	},
+	cmpArgs{EQ, inetType, inetType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) == 0), nil
+		},
+	},
 
 	cmpArgs{LT, stringType, stringType}: {
 		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
@@ -407,11 +544,41 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return DBool(left.(DTimestamp).Before(right.(DTimestamp).Time)), nil
 		},
 	},
+	cmpArgs{LT, timestampTZType, timestampTZType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.(DTimestampTZ).Before(right.(DTimestampTZ).Time)), nil
+		},
+	},
+	cmpArgs{LT, timeType, timeType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.(DTime).Duration < right.(DTime).Duration), nil
+		},
+	},
 	cmpArgs{LT, intervalType, intervalType}: {
 		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
 			return DBool(left.(DInterval).Duration < right.(DInterval).Duration), nil
 		},
 	},
+	cmpArgs{LT, jsonType, jsonType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) < 0), nil
+		},
+	},
+	cmpArgs{LT, collatedStringType, collatedStringType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) < 0), nil
+		},
+	},
+	cmpArgs{LT, uuidType, uuidType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) < 0), nil
+		},
+	},
+	cmpArgs{LT, inetType, inetType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) < 0), nil
+		},
+	},
 
 	cmpArgs{LE, stringType, stringType}: {
 		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
@@ -448,11 +615,41 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return !DBool(right.(DTimestamp).Before(left.(DTimestamp).Time)), nil
 		},
 	},
+	cmpArgs{LE, timestampTZType, timestampTZType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return !DBool(right.(DTimestampTZ).Before(left.(DTimestampTZ).Time)), nil
+		},
+	},
+	cmpArgs{LE, timeType, timeType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.(DTime).Duration <= right.(DTime).Duration), nil
+		},
+	},
 	cmpArgs{LE, intervalType, intervalType}: {
 		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
 			return DBool(left.(DInterval).Duration <= right.(DInterval).Duration), nil
 		},
 	},
+	cmpArgs{LE, jsonType, jsonType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		},
+	},
+	cmpArgs{LE, collatedStringType, collatedStringType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		},
+	},
+	cmpArgs{LE, uuidType, uuidType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		},
+	},
+	cmpArgs{LE, inetType, inetType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		},
+	},
 
 	cmpArgs{Like, stringType, stringType}: {
 		fn: func(left Datum, right Datum, cache *interface{}) (DBool, error) {
@@ -497,6 +694,30 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return DBool(re.MatchString(string(left.(DString)))), nil
 		},
 	},
+
+	cmpArgs{Contains, jsonType, jsonType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(jsonContains(left.(*DJSON).Value, right.(*DJSON).Value, true)), nil
+		},
+	},
+
+	cmpArgs{HasKey, jsonType, stringType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(jsonHasKey(left.(*DJSON).Value, string(right.(DString)))), nil
+		},
+	},
+
+	cmpArgs{ContainedByOrEquals, inetType, inetType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(inetContainedBy(left.(DInet), right.(DInet), true)), nil
+		},
+	},
+
+	cmpArgs{Overlaps, inetType, inetType}: {
+		fn: func(left Datum, right Datum, _ *interface{}) (DBool, error) {
+			return DBool(inetOverlaps(left.(DInet), right.(DInet))), nil
+		},
+	},
 }
 
 var evalTupleEQ = cmpOp{
@@ -534,6 +755,24 @@ var evalTupleIN = cmpOp{
 	},
 }
 
+// evalArrayAny implements "x = ANY (array)". NULL elements never compare equal
+// to the argument, so unlike in PostgreSQL the result is false rather than
+// NULL when the argument is not found in an array containing NULLs.
+var evalArrayAny = cmpOp{
+	fn: func(arg, values Datum, _ *interface{}) (DBool, error) {
+		for _, v := range values.(*DArray).Elements {
+			d, err := evalComparisonEq(arg, v)
+			if err != nil {
+				return DummyBool, err
+			}
+			if d == DBool(true) {
+				return DBool(true), nil
+			}
+		}
+		return DBool(false), nil
+	},
+}
+
 func init() {
 	// This avoids an init-loop if we try to initialize this operation when
 	// cmpOps is declared. The loop is caused by evalTupleEQ using cmpOps
@@ -547,8 +786,17 @@ func init() {
 	cmpOps[cmpArgs{In, bytesType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, dateType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, timestampType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, timestampTZType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, timeType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, intervalType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, collatedStringType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, uuidType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, inetType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, tupleType, tupleType}] = evalTupleIN
+
+	for t := range arrayParamTypes {
+		cmpOps[cmpArgs{Any, t, arrayType}] = evalArrayAny
+	}
 }
 
 // EvalContext defines the context in which to evaluate an expression, allowing
@@ -643,6 +891,20 @@ func (ctx EvalContext) EvalExpr(expr Expr) (Datum, error) {
 		}
 		return tuple, nil
 
+	case Array:
+		elements := make([]Datum, 0, len(t))
+		for _, v := range t {
+			d, err := ctx.EvalExpr(v)
+			if err != nil {
+				return DNull, err
+			}
+			elements = append(elements, d)
+		}
+		return NewDArray(elements)
+
+	case *IndirectionExpr:
+		return ctx.evalIndirectionExpr(t)
+
 	case DReference:
 		return t.Datum(), nil
 
@@ -668,6 +930,9 @@ func (ctx EvalContext) EvalExpr(expr Expr) (Datum, error) {
 	case *CastExpr:
 		return ctx.evalCastExpr(t)
 
+	case *CollateExpr:
+		return ctx.evalCollateExpr(t)
+
 	default:
 		return DNull, util.Errorf("eval: unsupported expression: %T", expr)
 	}
@@ -808,12 +1073,40 @@ func (ctx EvalContext) evalIsOfTypeExpr(expr *IsOfTypeExpr) (Datum, error) {
 			}
 		}
 
+	case DTimestampTZ:
+		for _, t := range expr.Types {
+			if _, ok := t.(*TimestampTZType); ok {
+				return result, nil
+			}
+		}
+
+	case DTime:
+		for _, t := range expr.Types {
+			if _, ok := t.(*TimeType); ok {
+				return result, nil
+			}
+		}
+
 	case DInterval:
 		for _, t := range expr.Types {
 			if _, ok := t.(*IntervalType); ok {
 				return result, nil
 			}
 		}
+
+	case DUUID:
+		for _, t := range expr.Types {
+			if _, ok := t.(*UUIDType); ok {
+				return result, nil
+			}
+		}
+
+	case DInet:
+		for _, t := range expr.Types {
+			if _, ok := t.(*INetType); ok {
+				return result, nil
+			}
+		}
 	}
 
 	return !result, nil
@@ -1035,6 +1328,22 @@ func (ctx EvalContext) evalCaseExpr(expr *CaseExpr) (Datum, error) {
 	return DNull, nil
 }
 
+func (ctx EvalContext) evalCollateExpr(expr *CollateExpr) (Datum, error) {
+	d, err := ctx.EvalExpr(expr.Expr)
+	if err != nil {
+		return DNull, err
+	}
+	switch t := d.(type) {
+	case dNull:
+		return DNull, nil
+	case DString:
+		return NewDCollatedString(string(t), expr.Locale)
+	case *DCollatedString:
+		return NewDCollatedString(t.Contents, expr.Locale)
+	}
+	return DNull, fmt.Errorf("incompatible COLLATE argument type %s", d.Type())
+}
+
 func (ctx EvalContext) evalCastExpr(expr *CastExpr) (Datum, error) {
 	d, err := ctx.EvalExpr(expr.Expr)
 	if err != nil {
@@ -1110,6 +1419,23 @@ func (ctx EvalContext) evalCastExpr(expr *CastExpr) (Datum, error) {
 				return DNull, fmt.Errorf("invalid utf8: %q", string(t))
 			}
 			s = DString(t)
+		case *DJSON:
+			s = DString(t.JSONText())
+		case *DCollatedString:
+			s = DString(t.Contents)
+		case DTimestampTZ:
+			// Timestamps with time zone are displayed in the session time zone.
+			loc, err := ctx.location()
+			if err != nil {
+				return DNull, err
+			}
+			s = DString(DTimestampTZ{Time: t.In(loc)}.String())
+		case DTime:
+			s = DString(t.String())
+		case DUUID:
+			s = DString(t.UUIDText())
+		case DInet:
+			s = DString(t.InetText())
 		}
 		if c, ok := expr.Type.(*StringType); ok {
 			// If the CHAR type specifies a limit we truncate to that limit:
@@ -1126,6 +1452,8 @@ func (ctx EvalContext) evalCastExpr(expr *CastExpr) (Datum, error) {
 			return DBytes(t), nil
 		case DBytes:
 			return d, nil
+		case DUUID:
+			return DBytes(t[:]), nil
 		}
 
 	case *DateType:
@@ -1138,6 +1466,12 @@ func (ctx EvalContext) evalCastExpr(expr *CastExpr) (Datum, error) {
 				return DNull, err
 			}
 			return MakeDDate(d.Time.In(loc)), nil
+		case DTimestampTZ:
+			loc, err := ctx.location()
+			if err != nil {
+				return DNull, err
+			}
+			return MakeDDate(d.Time.In(loc)), nil
 		}
 
 	case *TimestampType:
@@ -1146,6 +1480,46 @@ func (ctx EvalContext) evalCastExpr(expr *CastExpr) (Datum, error) {
 			return ctx.ParseTimestamp(d)
 		case DDate:
 			return DTimestamp{Time: d.Time}, nil
+		case DTimestampTZ:
+			return DTimestamp{Time: d.Time}, nil
+		}
+
+	case *TimestampTZType:
+		switch d := d.(type) {
+		case DString:
+			return ctx.ParseTimestampTZ(d)
+		case DDate:
+			// A date is converted to midnight in the session time zone.
+			loc, err := ctx.location()
+			if err != nil {
+				return DNull, err
+			}
+			year, month, day := d.Date()
+			return DTimestampTZ{Time: time.Date(year, month, day, 0, 0, 0, 0, loc)}, nil
+		case DTimestamp:
+			return DTimestampTZ{Time: d.Time}, nil
+		case DTimestampTZ:
+			return d, nil
+		}
+
+	case *TimeType:
+		switch d := d.(type) {
+		case DString:
+			return ParseTime(d)
+		case DTimestamp:
+			loc, err := ctx.location()
+			if err != nil {
+				return DNull, err
+			}
+			return MakeDTime(d.Time.In(loc)), nil
+		case DTimestampTZ:
+			loc, err := ctx.location()
+			if err != nil {
+				return DNull, err
+			}
+			return MakeDTime(d.Time.In(loc)), nil
+		case DTime:
+			return d, nil
 		}
 
 	case *IntervalType:
@@ -1159,6 +1533,40 @@ func (ctx EvalContext) evalCastExpr(expr *CastExpr) (Datum, error) {
 		case DInt:
 			// An integer duration represents a duration in nanoseconds.
 			return DInterval{Duration: time.Duration(d.(DInt))}, nil
+
+		case DTime:
+			// A time of day is converted to the interval since midnight.
+			return DInterval{Duration: d.(DTime).Duration}, nil
+		}
+
+	case *JSONType:
+		switch t := d.(type) {
+		case DString:
+			j, err := ParseDJSON(string(t))
+			if err != nil {
+				return DNull, err
+			}
+			return j, nil
+		case *DJSON:
+			return d, nil
+		}
+
+	case *UUIDType:
+		switch t := d.(type) {
+		case DString:
+			return ParseDUUID(string(t))
+		case DBytes:
+			return MakeDUUID([]byte(t))
+		case DUUID:
+			return d, nil
+		}
+
+	case *INetType:
+		switch t := d.(type) {
+		case DString:
+			return ParseDInet(string(t))
+		case DInet:
+			return d, nil
 		}
 		// TODO(pmattis): unimplemented.
 		// case *DecimalType:
@@ -1167,6 +1575,70 @@ func (ctx EvalContext) evalCastExpr(expr *CastExpr) (Datum, error) {
 	return DNull, fmt.Errorf("invalid cast: %s -> %s", d.Type(), expr.Type)
 }
 
+func (ctx EvalContext) evalIndirectionExpr(expr *IndirectionExpr) (Datum, error) {
+	subscript, err := arraySubscript(expr)
+	if err != nil {
+		return DNull, err
+	}
+	d, err := ctx.EvalExpr(expr.Expr)
+	if err != nil {
+		return DNull, err
+	}
+	if d == DNull {
+		return DNull, nil
+	}
+	array, ok := d.(*DArray)
+	if !ok {
+		return DNull, fmt.Errorf("cannot subscript type %s because it is not an array", d.Type())
+	}
+
+	// Array subscripts start at 1.
+	begin, err := ctx.evalArraySubscript(subscript.Begin)
+	if err != nil || begin == DNull {
+		return DNull, err
+	}
+	if subscript.End == nil {
+		i := int(begin.(DInt))
+		if i < 1 || i > len(array.Elements) {
+			return DNull, nil
+		}
+		return array.Elements[i-1], nil
+	}
+
+	// The bounds of a slice are inclusive and clamped to the array bounds.
+	end, err := ctx.evalArraySubscript(subscript.End)
+	if err != nil || end == DNull {
+		return DNull, err
+	}
+	i, j := int(begin.(DInt)), int(end.(DInt))
+	if i < 1 {
+		i = 1
+	}
+	if j > len(array.Elements) {
+		j = len(array.Elements)
+	}
+	if i > j {
+		return &DArray{ParamType: array.ParamType}, nil
+	}
+	return &DArray{ParamType: array.ParamType, Elements: array.Elements[i-1 : j]}, nil
+}
+
+// evalArraySubscript evaluates an array subscript, which is either an int or
+// NULL.
+func (ctx EvalContext) evalArraySubscript(expr Expr) (Datum, error) {
+	d, err := ctx.EvalExpr(expr)
+	if err != nil {
+		return DNull, err
+	}
+	switch d.(type) {
+	case DInt:
+		return d, nil
+	case dNull:
+		return DNull, nil
+	}
+	return DNull, fmt.Errorf("array subscript must be type int: %s", d.Type())
+}
+
 func evalComparisonEq(left, right Datum) (Datum, error) {
 	if left == DNull || right == DNull {
 		return DNull, nil
@@ -1194,6 +1666,10 @@ func foldComparisonExpr(op ComparisonOp, dummyLeft, dummyRight Datum) (Compariso
 	case GE:
 		// GE(left, right) is implemented as LE(right, left)
 		return LE, dummyRight, dummyLeft, false
+	case ContainsOrEquals:
+		// ContainsOrEquals(left, right) is implemented as
+		// ContainedByOrEquals(right, left)
+		return ContainedByOrEquals, dummyRight, dummyLeft, false
 	case NotIn:
 		// NotIn(left, right) is implemented as !IN(left, right)
 		return In, dummyLeft, dummyRight, true
@@ -1237,6 +1713,8 @@ const (
 	timestampFormat               = "2006-01-02 15:04:05.999999999"
 	TimestampWithOffsetZoneFormat = "2006-01-02 15:04:05.999999999-07:00"
 	timestampWithNamedZoneFormat  = "2006-01-02 15:04:05.999999999 MST"
+	timeFormat                    = "15:04:05.999999999"
+	timeWithoutSecondsFormat      = "15:04"
 )
 
 // ParseDate parses a date.
@@ -1250,18 +1728,22 @@ func ParseDate(s DString) (DDate, error) {
 	return MakeDDate(t), nil
 }
 
+// location returns the session time zone, defaulting to UTC.
+func (ctx EvalContext) location() (*time.Location, error) {
+	if ctx.GetLocation == nil {
+		return time.UTC, nil
+	}
+	return ctx.GetLocation()
+}
+
 // ParseTimestamp parses the timestamp.
 func (ctx EvalContext) ParseTimestamp(s DString) (DTimestamp, error) {
-	loc := time.UTC
-	if ctx.GetLocation != nil {
-		var err error
-		if loc, err = ctx.GetLocation(); err != nil {
-			return DummyTimestamp, err
-		}
+	loc, err := ctx.location()
+	if err != nil {
+		return DummyTimestamp, err
 	}
 
 	str := string(s)
-	var err error
 
 	for _, format := range []string{
 		dateFormat,
@@ -1279,6 +1761,28 @@ func (ctx EvalContext) ParseTimestamp(s DString) (DTimestamp, error) {
 	return DummyTimestamp, err
 }
 
+// ParseTimestampTZ parses the timestamp with time zone. Timestamps without an
+// explicit zone are in the session time zone.
+func (ctx EvalContext) ParseTimestampTZ(s DString) (DTimestampTZ, error) {
+	t, err := ctx.ParseTimestamp(s)
+	if err != nil {
+		return DummyTimestampTZ, err
+	}
+	return DTimestampTZ{Time: t.Time}, nil
+}
+
+// ParseTime parses a time of day.
+func ParseTime(s DString) (DTime, error) {
+	var err error
+	for _, format := range []string{timeFormat, timeWithoutSecondsFormat} {
+		var t time.Time
+		if t, err = time.Parse(format, string(s)); err == nil {
+			return MakeDTime(t), nil
+		}
+	}
+	return DummyTime, err
+}
+
 // SimilarEscape converts a SQL:2008 regexp pattern to POSIX style, so it can
 // be used by our regexp engine.
 func SimilarEscape(pattern string) string {
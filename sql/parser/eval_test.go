@@ -168,6 +168,9 @@ func TestEvalExpr(t *testing.T) {
 		{`'2012-09-21'::date IS OF (DATE)`, `true`},
 		{`'2010-09-28 12:00:00.1'::timestamp IS OF (TIMESTAMP)`, `true`},
 		{`'34h'::interval IS OF (INTERVAL)`, `true`},
+		{`'2010-09-28 12:00:00.1'::timestamptz IS OF (TIMESTAMPTZ)`, `true`},
+		{`'2010-09-28 12:00:00.1'::timestamptz IS OF (TIMESTAMP)`, `false`},
+		{`'12:00:00'::time IS OF (TIME)`, `true`},
 		{`1 IS OF (STRING)`, `false`},
 		{`1 IS OF (BOOL, INT)`, `true`},
 		{`1 IS NOT OF (INT)`, `false`},
@@ -271,6 +274,34 @@ func TestEvalExpr(t *testing.T) {
 		{`'1h'::interval - '12h2m1s23ms'::interval`, `-11h2m1.023s`},
 		{`3 * '1h2m'::interval * 3`, `9h18m0s`},
 		{`'3h'::interval / 2`, `1h30m0s`},
+		{`'2010-09-28 12:00:00.1-04:00'::timestamptz`, `2010-09-28 16:00:00.1+00:00`},
+		{`'2010-09-28 12:00:00.1-04:00'::timestamptz::string`, `'2010-09-28 16:00:00.1+00:00'`},
+		{`'2010-09-28 12:00:00.1-04:00'::timestamptz::date`, `2010-09-28`},
+		{`'2010-09-28'::date::timestamptz`, `2010-09-28 00:00:00+00:00`},
+		{`'2010-09-28 12:00:00.1-04:00'::timestamptz + '12h'::interval`, `2010-09-29 04:00:00.1+00:00`},
+		{`'2010-09-28 12:00:00-04:00'::timestamptz - '2010-09-28 12:00:00'::timestamptz`, `4h0m0s`},
+		{`'2010-09-28 12:00:00'::timestamptz = '2010-09-28 14:00:00+02:00'::timestamptz`, `true`},
+		{`'2010-09-28 12:00:00'::timestamp AT TIME ZONE 'America/New_York'`, `2010-09-28 16:00:00+00:00`},
+		{`'2010-09-28 12:00:00'::timestamptz AT TIME ZONE 'America/New_York'`, `2010-09-28 08:00:00+00:00`},
+		{`'2010-09-28 12:00:00'::timestamptz AT TIME ZONE '-2h'::interval`, `2010-09-28 10:00:00+00:00`},
+		{`'12:34:56.789'::time`, `12:34:56.789`},
+		{`'12:34'::time`, `12:34:00`},
+		{`'2010-09-28 12:34:56'::timestamp::time`, `12:34:56`},
+		{`'12:34'::time::string`, `'12:34:00'`},
+		{`'12:34'::time::interval`, `12h34m0s`},
+		{`'23:00'::time + '2h'::interval`, `01:00:00`},
+		{`'01:00'::time - '2h'::interval`, `23:00:00`},
+		{`'12:00'::time - '10:30'::time`, `1h30m0s`},
+		{`'12:00'::time < '12:00:01'::time`, `true`},
+		{`extract(hour FROM '2010-09-28 12:34:56-04:00'::timestamptz)`, `16`},
+		{`extract(minute FROM '12:34:56'::time)`, `34`},
+		{`date_trunc('month', '2010-09-28 12:34:56'::timestamp)`, `2010-09-01 00:00:00+00:00`},
+		{`date_trunc('week', '2010-09-28 12:34:56'::timestamp)`, `2010-09-27 00:00:00+00:00`},
+		{`date_trunc('quarter', '2010-09-28 12:34:56'::timestamptz)`, `2010-07-01 00:00:00+00:00`},
+		{`date_trunc('minute', '2010-09-28 12:34:56'::timestamp)`, `2010-09-28 12:34:00+00:00`},
+		{`to_char('2010-09-28 15:04:05.123'::timestamp, 'YYYY-MM-DD HH24:MI:SS.MS')`, `'2010-09-28 15:04:05.123'`},
+		{`to_char('2010-09-28 15:04:05'::timestamp, 'Dy, DD Mon YY HH12 AM "at" TZ')`, `'Tue, 28 Sep 10 03 PM at UTC'`},
+		{`to_char('2010-09-28'::date, 'Day Month')`, `'Tuesday   September'`},
 		// Conditional expressions.
 		{`IF(true, 1, 2/0)`, `1`},
 		{`IF(false, 1/0, 2)`, `2`},
@@ -286,6 +317,99 @@ func TestEvalExpr(t *testing.T) {
 		{`COALESCE(NULL, 2, 3, 4/0)`, `2`},
 		{`COALESCE(NULL, NULL, NULL, 4)`, `4`},
 		{`COALESCE(NULL, NULL, NULL, NULL)`, `NULL`},
+		// Arrays.
+		{`ARRAY[1, 2, 3]`, `ARRAY[1, 2, 3]`},
+		{`ARRAY[1, NULL]`, `ARRAY[1, NULL]`},
+		{`ARRAY[]`, `ARRAY[]`},
+		{`(ARRAY['a', 'b'])[1]`, `'a'`},
+		{`(ARRAY['a', 'b'])[3]`, `NULL`},
+		{`(ARRAY['a', 'b'])[0]`, `NULL`},
+		{`(ARRAY['a', 'b'])[NULL]`, `NULL`},
+		{`(ARRAY[1, 2, 3])[2:3]`, `ARRAY[2, 3]`},
+		{`(ARRAY[1, 2, 3])[0:2]`, `ARRAY[1, 2]`},
+		{`(ARRAY[1, 2, 3])[3:2]`, `ARRAY[]`},
+		{`array_length(ARRAY[1, 2, 3], 1)`, `3`},
+		{`array_length(ARRAY[1, 2, 3], 2)`, `NULL`},
+		{`array_length(ARRAY[], 1)`, `NULL`},
+		{`2 = ANY (ARRAY[1, 2, 3])`, `true`},
+		{`4 = ANY (ARRAY[1, 2, 3])`, `false`},
+		{`4 = ANY (ARRAY[1, NULL])`, `false`},
+		{`4 = ANY (ARRAY[])`, `false`},
+		{`NULL = ANY (ARRAY[1, 2])`, `NULL`},
+		// JSON.
+		{`'{"b": 2, "a": [1, "x"], "b": 3}'::jsonb`, `'{"a": [1, "x"], "b": 3}'`},
+		{`'{"a": 1}'::jsonb::string`, `'{"a": 1}'`},
+		{`'{"a": {"b": 1}}'::jsonb -> 'a'`, `'{"b": 1}'`},
+		{`'{"a": [1, 2]}'::jsonb -> 'a' -> 1`, `'2'`},
+		{`'[1, 2]'::jsonb -> -1`, `'2'`},
+		{`'[1, 2]'::jsonb -> 2`, `NULL`},
+		{`'{"a": 1}'::jsonb -> 'b'`, `NULL`},
+		{`'{"a": "x"}'::jsonb ->> 'a'`, `'x'`},
+		{`'{"a": [true]}'::jsonb ->> 'a'`, `'[true]'`},
+		{`'{"a": null}'::jsonb ->> 'a'`, `NULL`},
+		{`'{"a": 1, "b": 2}'::jsonb @> '{"a": 1}'::jsonb`, `true`},
+		{`'{"a": 1, "b": 2}'::jsonb @> '{"a": 2}'::jsonb`, `false`},
+		{`'[1, [2, 3]]'::jsonb @> '[[3]]'::jsonb`, `true`},
+		{`'["a", "b"]'::jsonb @> '"a"'::jsonb`, `true`},
+		{`'[["a"]]'::jsonb @> '"a"'::jsonb`, `false`},
+		{`'{"a": 1}'::jsonb ? 'a'`, `true`},
+		{`'["a"]'::jsonb ? 'b'`, `false`},
+		{`'{"a": 1}'::jsonb = '{ "a" : 1 }'::jsonb`, `true`},
+		{`'1'::jsonb < '"a"'::jsonb`, `false`},
+		{`'[1, 2]'::jsonb < '[3]'::jsonb`, `false`},
+		{`'1.5'::jsonb < '2'::jsonb`, `true`},
+		{`jsonb_typeof('[1]'::jsonb)`, `'array'`},
+		// Collated strings.
+		{`'a' COLLATE en`, `'a' COLLATE en`},
+		{`'a' COLLATE de_DE`, `'a' COLLATE "de-DE"`},
+		{`('a' COLLATE de) COLLATE sv`, `'a' COLLATE sv`},
+		{`('b' COLLATE en)::string`, `'b'`},
+		{`'ä' < 'b'`, `false`},
+		{`'ä' COLLATE de < 'b' COLLATE de`, `true`},
+		{`'ä' COLLATE sv < 'b' COLLATE sv`, `false`},
+		{`'a' COLLATE en < 'B' COLLATE en`, `true`},
+		{`'a' COLLATE en = 'a' COLLATE en`, `true`},
+		{`'a' COLLATE en = 'A' COLLATE en`, `false`},
+		{`'B' COLLATE en IN ('a' COLLATE en, 'b' COLLATE en)`, `false`},
+		{`'b' COLLATE en IN ('a' COLLATE en, 'b' COLLATE en)`, `true`},
+		{`NULL COLLATE en`, `NULL`},
+		// UUID.
+		{`'63616665-6630-3064-6465-616462656566'::uuid`, `'63616665-6630-3064-6465-616462656566'`},
+		{`'{63616665-6630-3064-6465-616462656566}'::uuid`, `'63616665-6630-3064-6465-616462656566'`},
+		{`'636166656630306464656164626565AA'::uuid`, `'63616665-6630-3064-6465-6164626565aa'`},
+		{`b'cafef00ddeadbeef'::uuid`, `'63616665-6630-3064-6465-616462656566'`},
+		{`'63616665-6630-3064-6465-616462656566'::uuid::bytes`, `b'cafef00ddeadbeef'`},
+		{`'63616665-6630-3064-6465-616462656566'::uuid::string`, `'63616665-6630-3064-6465-616462656566'`},
+		{`'00000000-0000-0000-0000-000000000001'::uuid < '10000000-0000-0000-0000-000000000000'::uuid`, `true`},
+		{`'63616665-6630-3064-6465-616462656566'::uuid = '63616665663030646465616462656566'::uuid`, `true`},
+		// INET.
+		{`'192.168.1.2'::inet`, `'192.168.1.2'`},
+		{`'192.168.1.2/24'::inet`, `'192.168.1.2/24'`},
+		{`'192.168.1.2/32'::inet`, `'192.168.1.2'`},
+		{`'2001:DB8::1/64'::inet`, `'2001:db8::1/64'`},
+		{`'::ffff:1.2.3.4'::inet`, `'1.2.3.4'`},
+		{`'::ffff:1.2.3.4/120'::inet`, `'1.2.3.4/24'`},
+		{`'::ffff:1.2.3.4'::inet = '1.2.3.4'::inet`, `true`},
+		{`'10.1.0.0/16'::inet::string`, `'10.1.0.0/16'`},
+		{`'10.1.2.3'::inet << '10.0.0.0/8'::inet`, `true`},
+		{`'10.0.0.0/8'::inet << '10.0.0.0/8'::inet`, `false`},
+		{`'10.0.0.0/8'::inet <<= '10.0.0.0/8'::inet`, `true`},
+		{`'11.1.2.3'::inet <<= '10.0.0.0/8'::inet`, `false`},
+		{`'10.0.0.0/8'::inet >> '10.1.2.3'::inet`, `true`},
+		{`'10.0.0.0/8'::inet >>= '10.0.0.0/8'::inet`, `true`},
+		{`'10.1.0.0/16'::inet >>= '10.0.0.0/8'::inet`, `false`},
+		{`'10.0.0.0/7'::inet && '11.1.0.0/16'::inet`, `true`},
+		{`'10.0.0.0/8'::inet && '11.1.0.0/16'::inet`, `false`},
+		{`'::1'::inet << '0.0.0.0/0'::inet`, `false`},
+		{`'10.0.0.1'::inet < '10.0.0.2'::inet`, `true`},
+		{`'10.0.0.1/8'::inet < '10.0.0.1'::inet`, `true`},
+		{`'255.255.255.255'::inet < '::'::inet`, `true`},
+		{`host('10.1.2.3/8'::inet)`, `'10.1.2.3'`},
+		{`masklen('10.1.2.3/8'::inet)`, `8`},
+		{`jsonb_typeof('null'::jsonb)`, `'null'`},
+		{`json_extract_path('{"a": [{"b": 1}]}'::jsonb, 'a', '0', 'b')`, `'1'`},
+		{`json_extract_path('{"a": [{"b": 1}]}'::jsonb, 'a', 'b')`, `NULL`},
+		{`json_build_object('a', 1, 'b', ARRAY['x'], 'c', NULL)`, `'{"a": 1, "b": ["x"], "c": null}'`},
 	}
 	for _, d := range testData {
 		q, err := ParseTraditional("SELECT " + d.expr)
@@ -318,6 +442,24 @@ func TestEvalExprError(t *testing.T) {
 		{`'11h2m'::interval / 0`, `division by zero`},
 		{`'hello' || b'world'`, `unsupported binary operator: <string> || <bytes>`},
 		{`b'\xff\xfe\xfd'::string`, `invalid utf8: "\xff\xfe\xfd"`},
+		{`ARRAY[1, 'a']`, `array elements must have the same type: int, string`},
+		{`ARRAY[(1, 2)]`, `arrays of tuple are not supported`},
+		{`(ARRAY[1, 2])['a']`, `array subscript must be type int: string`},
+		{`(1)[1]`, `cannot subscript type int because it is not an array`},
+		{`'{"a":'::jsonb`, `could not parse JSON: unexpected EOF`},
+		{`'25:00'::time`, `hour out of range`},
+		{`date_trunc('fortnight', '2010-09-28'::timestamp)`, `unsupported timespan: fortnight`},
+		{`extract(day FROM '12:00'::time)`, `unsupported timespan for time: day`},
+		{`'2010-09-28'::timestamp AT TIME ZONE 'Nowhere/Special'`, `unknown time zone Nowhere/Special`},
+		{`'1 2'::jsonb`, `could not parse JSON: trailing data after value`},
+		{`'foo'::uuid`, `invalid UUID: "foo"`},
+		{`'63616665-6630-3064-6465-61646265656'::uuid`, `invalid UUID: "63616665-6630-3064-6465-61646265656"`},
+		{`b'cafe'::uuid`, `invalid UUID length: 4`},
+		{`'10.1.2'::inet`, `could not parse "10.1.2" as inet`},
+		{`'10.1.2.3/33'::inet`, `could not parse "10.1.2.3/33" as inet`},
+		{`'::ffff:1.2.3.4/64'::inet`, `could not parse "::ffff:1.2.3.4/64" as inet`},
+		{`json_build_object('a')`, `json_build_object: argument list must have even number of elements`},
+		{`json_build_object(NULL, 1)`, `json_build_object: argument 1 cannot be null`},
 		// TODO(pmattis): Check for overflow.
 		// {`~0 + 1`, `0`},
 	}
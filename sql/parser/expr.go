@@ -31,41 +31,50 @@ type Expr interface {
 	expr()
 }
 
-func (*AndExpr) expr()        {}
-func (*OrExpr) expr()         {}
-func (*NotExpr) expr()        {}
-func (*ParenExpr) expr()      {}
-func (*ComparisonExpr) expr() {}
-func (*RangeCond) expr()      {}
-func (*IsOfTypeExpr) expr()   {}
-func (*ExistsExpr) expr()     {}
-func (*IfExpr) expr()         {}
-func (*NullIfExpr) expr()     {}
-func (*CoalesceExpr) expr()   {}
-func (IntVal) expr()          {}
-func (NumVal) expr()          {}
-func (DefaultVal) expr()      {}
-func (ValArg) expr()          {}
-func (*QualifiedName) expr()  {}
-func (Tuple) expr()           {}
-func (Row) expr()             {}
-func (Array) expr()           {}
-func (*Subquery) expr()       {}
-func (*BinaryExpr) expr()     {}
-func (*UnaryExpr) expr()      {}
-func (*FuncExpr) expr()       {}
-func (*CaseExpr) expr()       {}
-func (*CastExpr) expr()       {}
-func (DBool) expr()           {}
-func (DInt) expr()            {}
-func (DFloat) expr()          {}
-func (DString) expr()         {}
-func (DBytes) expr()          {}
-func (DDate) expr()           {}
-func (DTimestamp) expr()      {}
-func (DInterval) expr()       {}
-func (DTuple) expr()          {}
-func (dNull) expr()           {}
+func (*AndExpr) expr()         {}
+func (*OrExpr) expr()          {}
+func (*NotExpr) expr()         {}
+func (*ParenExpr) expr()       {}
+func (*ComparisonExpr) expr()  {}
+func (*RangeCond) expr()       {}
+func (*IsOfTypeExpr) expr()    {}
+func (*ExistsExpr) expr()      {}
+func (*IfExpr) expr()          {}
+func (*NullIfExpr) expr()      {}
+func (*CoalesceExpr) expr()    {}
+func (IntVal) expr()           {}
+func (NumVal) expr()           {}
+func (DefaultVal) expr()       {}
+func (ValArg) expr()           {}
+func (*QualifiedName) expr()   {}
+func (Tuple) expr()            {}
+func (Row) expr()              {}
+func (Array) expr()            {}
+func (*Subquery) expr()        {}
+func (*BinaryExpr) expr()      {}
+func (*UnaryExpr) expr()       {}
+func (*FuncExpr) expr()        {}
+func (*CaseExpr) expr()        {}
+func (*CastExpr) expr()        {}
+func (*CollateExpr) expr()     {}
+func (*IndirectionExpr) expr() {}
+func (DBool) expr()            {}
+func (DInt) expr()             {}
+func (DFloat) expr()           {}
+func (DString) expr()          {}
+func (DBytes) expr()           {}
+func (DDate) expr()            {}
+func (DTimestamp) expr()       {}
+func (DTimestampTZ) expr()     {}
+func (DTime) expr()            {}
+func (DInterval) expr()        {}
+func (DTuple) expr()           {}
+func (*DArray) expr()          {}
+func (*DJSON) expr()           {}
+func (*DCollatedString) expr() {}
+func (DUUID) expr()            {}
+func (DInet) expr()            {}
+func (dNull) expr()            {}
 
 // AndExpr represents an AND expression.
 type AndExpr struct {
@@ -124,25 +133,37 @@ const (
 	IsNotDistinctFrom
 	Is
 	IsNot
+	Any
+	Contains
+	HasKey
+	ContainedByOrEquals
+	ContainsOrEquals
+	Overlaps
 )
 
 var comparisonOpName = [...]string{
-	EQ:                "=",
-	LT:                "<",
-	GT:                ">",
-	LE:                "<=",
-	GE:                ">=",
-	NE:                "!=",
-	In:                "IN",
-	NotIn:             "NOT IN",
-	Like:              "LIKE",
-	NotLike:           "NOT LIKE",
-	SimilarTo:         "SIMILAR TO",
-	NotSimilarTo:      "NOT SIMILAR TO",
-	IsDistinctFrom:    "IS DISTINCT FROM",
-	IsNotDistinctFrom: "IS NOT DISTINCT FROM",
-	Is:                "IS",
-	IsNot:             "IS NOT",
+	EQ:                  "=",
+	LT:                  "<",
+	GT:                  ">",
+	LE:                  "<=",
+	GE:                  ">=",
+	NE:                  "!=",
+	In:                  "IN",
+	NotIn:               "NOT IN",
+	Like:                "LIKE",
+	NotLike:             "NOT LIKE",
+	SimilarTo:           "SIMILAR TO",
+	NotSimilarTo:        "NOT SIMILAR TO",
+	IsDistinctFrom:      "IS DISTINCT FROM",
+	IsNotDistinctFrom:   "IS NOT DISTINCT FROM",
+	Is:                  "IS",
+	IsNot:               "IS NOT",
+	Any:                 "= ANY",
+	Contains:            "@>",
+	HasKey:              "?",
+	ContainedByOrEquals: "<<=",
+	ContainsOrEquals:    ">>=",
+	Overlaps:            "&&",
 }
 
 func (i ComparisonOp) String() string {
@@ -161,6 +182,9 @@ type ComparisonExpr struct {
 }
 
 func (node *ComparisonExpr) String() string {
+	if node.Operator == Any {
+		return fmt.Sprintf("%s %s (%s)", node.Left, node.Operator, node.Right)
+	}
 	return fmt.Sprintf("%s %s %s", node.Left, node.Operator, node.Right)
 }
 
@@ -526,6 +550,17 @@ func (node Array) String() string {
 	return fmt.Sprintf("ARRAY[%s]", Exprs(node))
 }
 
+// IndirectionExpr represents a subscript or a slice of an array valued
+// expression. Only array indirections are allowed.
+type IndirectionExpr struct {
+	Expr        Expr
+	Indirection Indirection
+}
+
+func (node *IndirectionExpr) String() string {
+	return fmt.Sprintf("(%s)%s", node.Expr, node.Indirection)
+}
+
 // Exprs represents a list of value expressions. It's not a valid expression
 // because it's not parenthesized.
 type Exprs []Expr
@@ -565,20 +600,24 @@ const (
 	Concat
 	LShift
 	RShift
+	FetchVal
+	FetchText
 )
 
 var binaryOpName = [...]string{
-	Bitand: "&",
-	Bitor:  "|",
-	Bitxor: "^",
-	Plus:   "+",
-	Minus:  "-",
-	Mult:   "*",
-	Div:    "/",
-	Mod:    "%",
-	Concat: "||",
-	LShift: "<<",
-	RShift: ">>",
+	Bitand:    "&",
+	Bitor:     "|",
+	Bitxor:    "^",
+	Plus:      "+",
+	Minus:     "-",
+	Mult:      "*",
+	Div:       "/",
+	Mod:       "%",
+	Concat:    "||",
+	LShift:    "<<",
+	RShift:    ">>",
+	FetchVal:  "->",
+	FetchText: "->>",
 }
 
 func (i BinaryOp) String() string {
@@ -685,6 +724,16 @@ func (node *When) String() string {
 	return fmt.Sprintf("WHEN %s THEN %s", node.Cond, node.Val)
 }
 
+// CollateExpr represents an (expr COLLATE locale) expression.
+type CollateExpr struct {
+	Expr   Expr
+	Locale string
+}
+
+func (node *CollateExpr) String() string {
+	return fmt.Sprintf("%s COLLATE %s", node.Expr, Name(node.Locale))
+}
+
 // CastExpr represents a CAST(expr AS type) expression.
 type CastExpr struct {
 	Expr Expr
@@ -74,3 +74,14 @@ func (node *Grant) String() string {
 		node.Targets,
 		node.Grantees)
 }
+
+// GrantRole represents a GRANT statement granting roles to users or other
+// roles.
+type GrantRole struct {
+	Roles   NameList
+	Members NameList
+}
+
+func (node *GrantRole) String() string {
+	return fmt.Sprintf("GRANT %s TO %s", node.Roles, node.Members)
+}
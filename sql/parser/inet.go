@@ -0,0 +1,131 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// The values of DInet.Family.
+const (
+	inetFamilyIPv4 = 4
+	inetFamilyIPv6 = 6
+)
+
+// ParseDInet parses s as an IPv4 or IPv6 address with an optional prefix
+// length, e.g. "192.168.0.1", "10.0.0.0/8" or "2001:db8::/32". The host bits
+// of the address are preserved. IPv4-mapped IPv6 addresses are converted to
+// IPv4 addresses.
+func ParseDInet(s string) (DInet, error) {
+	var ip net.IP
+	maskLen, bits := -1, 0
+	if strings.Contains(s, "/") {
+		var ipNet *net.IPNet
+		var err error
+		ip, ipNet, err = net.ParseCIDR(s)
+		if err != nil {
+			return DInet{}, fmt.Errorf("could not parse %q as inet", s)
+		}
+		maskLen, bits = ipNet.Mask.Size()
+	} else if ip = net.ParseIP(s); ip == nil {
+		return DInet{}, fmt.Errorf("could not parse %q as inet", s)
+	}
+
+	var d DInet
+	if ip4 := ip.To4(); ip4 != nil {
+		d.Family = inetFamilyIPv4
+		copy(d.Addr[:], ip4)
+		if bits == 8*net.IPv6len {
+			// The prefix length of an IPv4-mapped address counts the 96 bits of
+			// the mapping prefix.
+			if maskLen -= 8 * (net.IPv6len - net.IPv4len); maskLen < 0 {
+				return DInet{}, fmt.Errorf("could not parse %q as inet", s)
+			}
+		}
+	} else {
+		d.Family = inetFamilyIPv6
+		copy(d.Addr[:], ip.To16())
+	}
+	if maskLen < 0 {
+		maskLen = d.bits()
+	}
+	d.MaskLen = uint8(maskLen)
+	return d, nil
+}
+
+// bits returns the number of bits in an address of d's family.
+func (d DInet) bits() int {
+	if d.Family == inetFamilyIPv4 {
+		return 8 * net.IPv4len
+	}
+	return 8 * net.IPv6len
+}
+
+// IP returns the host address of d.
+func (d DInet) IP() net.IP {
+	return net.IP(append([]byte(nil), d.Addr[:d.bits()/8]...))
+}
+
+// InetText returns the text form of d: the host address, followed by the
+// prefix length unless the address is a single host.
+func (d DInet) InetText() string {
+	if int(d.MaskLen) == d.bits() {
+		return d.IP().String()
+	}
+	return fmt.Sprintf("%s/%d", d.IP(), d.MaskLen)
+}
+
+// inetContainedBy implements the << and <<= operators: it returns true if
+// the network of a is within the network of b. If orEquals is false the
+// networks may not be equal.
+func inetContainedBy(a, b DInet, orEquals bool) bool {
+	if a.Family != b.Family || a.MaskLen < b.MaskLen {
+		return false
+	}
+	if !orEquals && a.MaskLen == b.MaskLen {
+		return false
+	}
+	return inetPrefixEqual(a, b, int(b.MaskLen))
+}
+
+// inetOverlaps implements the && operator: it returns true if either network
+// contains or equals the other.
+func inetOverlaps(a, b DInet) bool {
+	if a.Family != b.Family {
+		return false
+	}
+	n := a.MaskLen
+	if b.MaskLen < n {
+		n = b.MaskLen
+	}
+	return inetPrefixEqual(a, b, int(n))
+}
+
+// inetPrefixEqual returns true if the first n bits of the addresses of a and
+// b are equal.
+func inetPrefixEqual(a, b DInet, n int) bool {
+	if !bytes.Equal(a.Addr[:n/8], b.Addr[:n/8]) {
+		return false
+	}
+	if r := uint(n % 8); r != 0 {
+		mask := byte(0xff << (8 - r))
+		return a.Addr[n/8]&mask == b.Addr[n/8]&mask
+	}
+	return true
+}
@@ -0,0 +1,364 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// parseJSON parses s as a single JSON value. Numbers are kept as json.Number
+// so that their text is preserved.
+func parseJSON(s string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("could not parse JSON: %v", err)
+	}
+	var extra interface{}
+	if err := dec.Decode(&extra); err != io.EOF {
+		return nil, fmt.Errorf("could not parse JSON: trailing data after value: %q", s)
+	}
+	return v, nil
+}
+
+// writeJSON writes the canonical text of v to buf. Object keys are written in
+// sorted order.
+func writeJSON(buf *bytes.Buffer, v interface{}) {
+	switch t := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(t))
+	case json.Number:
+		buf.WriteString(string(t))
+	case string:
+		writeJSONString(buf, t)
+	case []interface{}:
+		_ = buf.WriteByte('[')
+		for i, e := range t {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeJSON(buf, e)
+		}
+		_ = buf.WriteByte(']')
+	case map[string]interface{}:
+		_ = buf.WriteByte('{')
+		for i, k := range sortedJSONKeys(t) {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeJSONString(buf, k)
+			buf.WriteString(": ")
+			writeJSON(buf, t[k])
+		}
+		_ = buf.WriteByte('}')
+	default:
+		panic(fmt.Sprintf("unexpected JSON value: %T", v))
+	}
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	_ = buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			_ = buf.WriteByte('\\')
+			_, _ = buf.WriteRune(r)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				_, _ = buf.WriteRune(r)
+			}
+		}
+	}
+	_ = buf.WriteByte('"')
+}
+
+func sortedJSONKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonTypeName returns the name of the type of v as reported by
+// jsonb_typeof.
+func jsonTypeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	panic(fmt.Sprintf("unexpected JSON value: %T", v))
+}
+
+// jsonTypeRank orders the JSON types as PostgreSQL does:
+// null < string < number < boolean < array < object.
+func jsonTypeRank(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case string:
+		return 1
+	case json.Number:
+		return 2
+	case bool:
+		return 3
+	case []interface{}:
+		return 4
+	case map[string]interface{}:
+		return 5
+	}
+	panic(fmt.Sprintf("unexpected JSON value: %T", v))
+}
+
+// compareJSON returns -1, 0 or +1 if a is less than, equal to or greater
+// than b. Values of different types are ordered by jsonTypeRank. Arrays and
+// objects with fewer elements sort first; otherwise arrays compare element
+// by element and objects compare their sorted keys and then the values of
+// those keys.
+func compareJSON(a, b interface{}) int {
+	ra, rb := jsonTypeRank(a), jsonTypeRank(b)
+	if ra != rb {
+		if ra < rb {
+			return -1
+		}
+		return 1
+	}
+	switch ta := a.(type) {
+	case nil:
+		return 0
+	case bool:
+		return DBool(ta).Compare(DBool(b.(bool)))
+	case json.Number:
+		return compareJSONNumbers(ta, b.(json.Number))
+	case string:
+		return DString(ta).Compare(DString(b.(string)))
+	case []interface{}:
+		tb := b.([]interface{})
+		if c := DInt(len(ta)).Compare(DInt(len(tb))); c != 0 {
+			return c
+		}
+		for i := range ta {
+			if c := compareJSON(ta[i], tb[i]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case map[string]interface{}:
+		tb := b.(map[string]interface{})
+		if c := DInt(len(ta)).Compare(DInt(len(tb))); c != 0 {
+			return c
+		}
+		ka, kb := sortedJSONKeys(ta), sortedJSONKeys(tb)
+		for i := range ka {
+			if c := DString(ka[i]).Compare(DString(kb[i])); c != 0 {
+				return c
+			}
+		}
+		for _, k := range ka {
+			if c := compareJSON(ta[k], tb[k]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+	panic(fmt.Sprintf("unexpected JSON value: %T", a))
+}
+
+func compareJSONNumbers(a, b json.Number) int {
+	// The numbers were validated by the decoder, so parsing cannot fail.
+	fa, _ := a.Float64()
+	fb, _ := b.Float64()
+	return DFloat(fa).Compare(DFloat(fb))
+}
+
+// jsonContains implements the @> operator: it returns true if every path and
+// value in b is also present in a. An array contains another array if every
+// element of the latter is contained in some element of the former, and, at
+// the top level only, an array contains a scalar which is one of its
+// elements.
+func jsonContains(a, b interface{}, topLevel bool) bool {
+	switch ta := a.(type) {
+	case []interface{}:
+		var tb []interface{}
+		switch t := b.(type) {
+		case []interface{}:
+			tb = t
+		case map[string]interface{}:
+			return false
+		default:
+			if !topLevel {
+				return false
+			}
+			tb = []interface{}{b}
+		}
+		for _, eb := range tb {
+			found := false
+			for _, ea := range ta {
+				if jsonContains(ea, eb, false) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		tb, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, vb := range tb {
+			va, ok := ta[k]
+			if !ok || !jsonContains(va, vb, false) {
+				return false
+			}
+		}
+		return true
+	}
+	return jsonTypeRank(a) == jsonTypeRank(b) && compareJSON(a, b) == 0
+}
+
+// jsonHasKey implements the ? operator: it returns true if key is a key of the
+// object v, a string element of the array v or equal to the string v.
+func jsonHasKey(v interface{}, key string) bool {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		_, ok := t[key]
+		return ok
+	case []interface{}:
+		for _, e := range t {
+			if s, ok := e.(string); ok && s == key {
+				return true
+			}
+		}
+	case string:
+		return t == key
+	}
+	return false
+}
+
+// jsonFetch returns the field of the object v named by a DString key or the
+// element of the array v at a DInt index. Negative indexes count from the end
+// of the array. The second return value is false if there is no such field or
+// element.
+func jsonFetch(v interface{}, key Datum) (interface{}, bool) {
+	switch k := key.(type) {
+	case DString:
+		if m, ok := v.(map[string]interface{}); ok {
+			e, ok := m[string(k)]
+			return e, ok
+		}
+	case DInt:
+		if a, ok := v.([]interface{}); ok {
+			i := int(k)
+			if i < 0 {
+				i += len(a)
+			}
+			if i >= 0 && i < len(a) {
+				return a[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// jsonFetchText returns the text of a fetched JSON value as returned by the
+// ->> operator: strings are unquoted and the JSON null is the SQL NULL.
+func jsonFetchText(v interface{}) Datum {
+	switch t := v.(type) {
+	case nil:
+		return DNull
+	case string:
+		return DString(t)
+	}
+	return DString((&DJSON{Value: v}).JSONText())
+}
+
+// datumToJSON converts d to a JSON value. SQL NULL becomes the JSON null and
+// values without a JSON counterpart are converted to strings.
+func datumToJSON(d Datum) (interface{}, error) {
+	switch t := d.(type) {
+	case dNull:
+		return nil, nil
+	case DBool:
+		return bool(t), nil
+	case DInt:
+		return json.Number(strconv.FormatInt(int64(t), 10)), nil
+	case DFloat:
+		if f := float64(t); math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("cannot convert %s to JSON", t)
+		}
+		return json.Number(strconv.FormatFloat(float64(t), 'g', -1, 64)), nil
+	case DString:
+		return string(t), nil
+	case DBytes:
+		if !utf8.ValidString(string(t)) {
+			return nil, fmt.Errorf("invalid utf8: %q", string(t))
+		}
+		return string(t), nil
+	case DDate, DTimestamp, DInterval:
+		return d.String(), nil
+	case *DArray:
+		a := make([]interface{}, len(t.Elements))
+		for i, e := range t.Elements {
+			v, err := datumToJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			a[i] = v
+		}
+		return a, nil
+	case *DJSON:
+		return t.Value, nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to JSON", d.Type())
+}
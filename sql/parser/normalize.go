@@ -439,3 +439,30 @@ func ContainsVars(expr Expr) bool {
 	expr = WalkExpr(&v, expr)
 	return v.containsVars
 }
+
+type containsImpureFuncsVisitor struct {
+	containsImpureFuncs bool
+}
+
+var _ Visitor = &containsImpureFuncsVisitor{}
+
+func (v *containsImpureFuncsVisitor) Visit(expr Expr, pre bool) (Visitor, Expr) {
+	if pre && !v.containsImpureFuncs {
+		if t, ok := expr.(*FuncExpr); ok {
+			// typeCheckFuncExpr populates t.fn.impure.
+			if _, err := typeCheckFuncExpr(t); err == nil && t.fn.impure {
+				v.containsImpureFuncs = true
+				return nil, expr
+			}
+		}
+	}
+	return v, expr
+}
+
+// ContainsImpureFuncs returns true if the expression calls a function which
+// can return a different value each time it is called, such as random().
+func ContainsImpureFuncs(expr Expr) bool {
+	v := containsImpureFuncsVisitor{containsImpureFuncs: false}
+	expr = WalkExpr(&v, expr)
+	return v.containsImpureFuncs
+}
@@ -39,8 +39,17 @@ func TestParse(t *testing.T) {
 		{`BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
 		{`COMMIT TRANSACTION`},
 		{`ROLLBACK TRANSACTION`},
+		{`SAVEPOINT a`},
+		{`RELEASE SAVEPOINT a`},
+		{`ROLLBACK TRANSACTION TO SAVEPOINT a`},
 
 		{`CREATE DATABASE a`},
+		{`CREATE ROLE a`},
+		{`CREATE USER foo`},
+		{`CREATE USER foo WITH PASSWORD 'bar'`},
+		{`CREATE USER foo WITH PASSWORD $1`},
+		{`ALTER USER foo WITH PASSWORD 'bar'`},
+		{`ALTER USER foo WITH PASSWORD $1`},
 		{`CREATE DATABASE IF NOT EXISTS a`},
 
 		{`CREATE INDEX a ON b (c)`},
@@ -50,10 +59,19 @@ func TestParse(t *testing.T) {
 		{`CREATE UNIQUE INDEX a ON b (c)`},
 		{`CREATE UNIQUE INDEX a ON b (c) STORING (d)`},
 		{`CREATE UNIQUE INDEX a ON b.c (d)`},
+		{`CREATE INDEX a ON b (c, d) INTERLEAVE IN PARENT e (c)`},
+		{`CREATE UNIQUE INDEX a ON b (c) STORING (d) INTERLEAVE IN PARENT e (c)`},
+		{`CREATE INDEX a ON b (lower(c))`},
+		{`CREATE INDEX a ON b (c, (d + 1))`},
+		{`CREATE UNIQUE INDEX a ON b (lower(c)) STORING (d)`},
+		{`CREATE INDEX a ON b (c) WHERE d > 1`},
+		{`CREATE UNIQUE INDEX a ON b (c) STORING (d) WHERE d IS NULL`},
+		{`CREATE INDEX IF NOT EXISTS a ON b (lower(c)) WHERE d`},
 
 		{`CREATE TABLE a ()`},
 		{`CREATE TABLE a (b INT)`},
 		{`CREATE TABLE a (b INT, c INT)`},
+		{`CREATE TABLE a (b UUID, c INET)`},
 		{`CREATE TABLE a (b CHAR)`},
 		{`CREATE TABLE a (b CHAR(3))`},
 		{`CREATE TABLE a (b FLOAT)`},
@@ -67,12 +85,17 @@ func TestParse(t *testing.T) {
 		// "0" lost quotes previously.
 		{`CREATE TABLE a (b INT, c TEXT, PRIMARY KEY (b, c, "0"))`},
 		{`CREATE TABLE a (b INT, c TEXT, INDEX (b, c))`},
+		{`CREATE TABLE a (b INT, c INT, PRIMARY KEY (b, c)) INTERLEAVE IN PARENT d (b)`},
+		{`CREATE TABLE IF NOT EXISTS a (b INT PRIMARY KEY) INTERLEAVE IN PARENT d.e (b)`},
 		{`CREATE TABLE a (b INT, c TEXT, INDEX d (b, c))`},
 		{`CREATE TABLE a (b INT, c TEXT, CONSTRAINT d UNIQUE (b, c))`},
 		{`CREATE TABLE a (b INT, UNIQUE (b))`},
 		{`CREATE TABLE a (b INT, UNIQUE (b) STORING (c))`},
 		{`CREATE TABLE a (b INT, INDEX (b))`},
 		{`CREATE TABLE a (b INT, INDEX (b) STORING (c))`},
+		{`CREATE TABLE a (b INT, c INT, FAMILY (b, c))`},
+		{`CREATE TABLE a (b INT, c INT, d INT, FAMILY foo (b), FAMILY bar (c, d))`},
+		{`CREATE TABLE a (b INT[], c STRING[])`},
 		{`CREATE TABLE a.b (b INT)`},
 		{`CREATE TABLE IF NOT EXISTS a (b INT)`},
 
@@ -88,6 +111,10 @@ func TestParse(t *testing.T) {
 		{`DROP TABLE IF EXISTS a`},
 		{`DROP INDEX a.b@c`},
 		{`DROP INDEX IF EXISTS a.b@c`},
+		{`DROP ROLE a`},
+		{`DROP ROLE a, b`},
+		{`DROP USER a`},
+		{`DROP USER a, b`},
 
 		{`EXPLAIN SELECT 1`},
 		{`EXPLAIN (DEBUG) SELECT 1`},
@@ -96,11 +123,13 @@ func TestParse(t *testing.T) {
 		{`SHOW BARFOO`},
 		{`SHOW DATABASE`},
 		{`SHOW SYNTAX`},
+		{`SHOW ALL`},
 
 		{`SHOW DATABASES`},
 		{`SHOW TABLES`},
 		{`SHOW TABLES FROM a`},
 		{`SHOW TABLES FROM a.b.c`},
+		{`SHOW USERS`},
 		{`SHOW COLUMNS FROM a`},
 		{`SHOW COLUMNS FROM a.b.c`},
 		{`SHOW INDEX FROM a`},
@@ -127,6 +156,8 @@ func TestParse(t *testing.T) {
 		{`GRANT SELECT, INSERT ON DATABASE bar TO foo, bar, baz`},
 		{`GRANT SELECT, INSERT ON DATABASE db1, db2 TO foo, bar, baz`},
 		{`GRANT SELECT, INSERT ON DATABASE db1, db2 TO "test-user"`},
+		{`GRANT foo TO bar`},
+		{`GRANT foo, "test-role" TO bar, baz`},
 
 		// Tables are the default, but can also be specified with
 		// REVOKE x ON TABLE y. However, the stringer does not output TABLE.
@@ -136,6 +167,8 @@ func TestParse(t *testing.T) {
 		{`REVOKE ALL ON DATABASE foo FROM root, test`},
 		{`REVOKE SELECT, INSERT ON DATABASE bar FROM foo, bar, baz`},
 		{`REVOKE SELECT, INSERT ON DATABASE db1, db2 FROM foo, bar, baz`},
+		{`REVOKE foo FROM bar`},
+		{`REVOKE foo, "test-role" FROM bar, baz`},
 
 		{`INSERT INTO a VALUES (1)`},
 		{`INSERT INTO a.b VALUES (1)`},
@@ -188,6 +221,11 @@ func TestParse(t *testing.T) {
 		{`SELECT a.b.* FROM t`},
 		{`SELECT a.b[1] FROM t`},
 		{`SELECT a.b[1 + 1:4][3] FROM t`},
+		{`SELECT ARRAY[1, 2, 3]`},
+		{`SELECT (ARRAY[1, 2, 3])[2]`},
+		{`SELECT (ARRAY[1, 2, 3])[1:2]`},
+		{`SELECT a FROM unnest(ARRAY[1, 2])`},
+		{`SELECT a FROM unnest(ARRAY[1, 2]) AS a`},
 		{`SELECT 'a' FROM t`},
 		{`SELECT 'a' FROM t@bar`},
 
@@ -207,6 +245,8 @@ func TestParse(t *testing.T) {
 
 		{`SELECT "FROM" FROM t`},
 		{`SELECT CAST(1 AS TEXT)`},
+		{`SELECT a COLLATE de_DE FROM t`},
+		{`SELECT a COLLATE "de-DE" < b FROM t`},
 		{`SELECT FROM t AS bar`},
 		{`SELECT FROM (SELECT 1 FROM t)`},
 		{`SELECT FROM (SELECT 1 FROM t) AS bar`},
@@ -229,6 +269,17 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t WHERE a IN (b, c)`},
 		{`SELECT FROM t WHERE a IN (SELECT FROM t)`},
 		{`SELECT FROM t WHERE a NOT IN (b, c)`},
+		{`SELECT FROM t WHERE a = ANY (b)`},
+		{`SELECT FROM t WHERE a = ANY (ARRAY[1, 2])`},
+		{`SELECT FROM t WHERE a -> 'b' = c`},
+		{`SELECT FROM t WHERE a ->> 1 = 'c'`},
+		{`SELECT FROM t WHERE a @> b`},
+		{`SELECT FROM t WHERE a ? 'b'`},
+		{`SELECT FROM t WHERE a << b`},
+		{`SELECT FROM t WHERE a <<= b`},
+		{`SELECT FROM t WHERE a >> b`},
+		{`SELECT FROM t WHERE a >>= b`},
+		{`SELECT FROM t WHERE a && b`},
 		{`SELECT FROM t WHERE a LIKE b`},
 		{`SELECT FROM t WHERE a NOT LIKE b`},
 		{`SELECT FROM t WHERE a SIMILAR TO b`},
@@ -307,6 +358,9 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t LIMIT a`},
 		{`SELECT FROM t OFFSET b`},
 		{`SELECT FROM t LIMIT a OFFSET b`},
+		{`SELECT FROM t FOR UPDATE`},
+		{`SELECT FROM t FOR SHARE`},
+		{`SELECT FROM t WHERE a = b ORDER BY a LIMIT b FOR UPDATE`},
 		{`SELECT DISTINCT * FROM t`},
 		{`SELECT DISTINCT a, b FROM t`},
 		{`SET a = 3`},
@@ -314,6 +368,7 @@ func TestParse(t *testing.T) {
 		{`SET a = '3'`},
 		{`SET a = 3.0`},
 		{`SET a = $1`},
+		{`SET a = DEFAULT`},
 		{`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`},
 		{`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
 		{`SET TIME ZONE 'pst8pdt'`},
@@ -365,6 +420,9 @@ func TestParse(t *testing.T) {
 		{`ALTER TABLE a DROP COLUMN IF EXISTS b, DROP CONSTRAINT a_idx`},
 		{`ALTER TABLE IF EXISTS a DROP COLUMN b, DROP CONSTRAINT a_idx`},
 		{`ALTER TABLE IF EXISTS a DROP COLUMN IF EXISTS b, DROP CONSTRAINT a_idx`},
+
+		{`ALTER TABLE a EXPERIMENTAL_AUDIT SET READ WRITE`},
+		{`ALTER TABLE a EXPERIMENTAL_AUDIT SET OFF`},
 	}
 	for _, d := range testData {
 		stmts, err := ParseTraditional(d.sql)
@@ -390,6 +448,14 @@ func TestParse2(t *testing.T) {
 		{`CREATE TABLE a (b INT, UNIQUE INDEX foo (b))`,
 			`CREATE TABLE a (b INT, CONSTRAINT foo UNIQUE (b))`},
 		{`CREATE INDEX ON a (b) COVERING (c)`, `CREATE INDEX ON a (b) STORING (c)`},
+		{`CREATE INDEX ON a ((lower(b)))`, `CREATE INDEX ON a (lower(b))`},
+		{`CREATE TABLE a (b INT[3], c INT ARRAY, d INT ARRAY[3])`,
+			`CREATE TABLE a (b INT[], c INT[], d INT[])`},
+		{`SELECT FROM t WHERE a = SOME (b)`, `SELECT FROM t WHERE a = ANY (b)`},
+		{`SELECT a->'b'->>'c' FROM t`, `SELECT a -> 'b' ->> 'c' FROM t`},
+		{`SELECT a@>b FROM t`, `SELECT a @> b FROM t`},
+		{`SELECT a<<=b, a>>=b, a&&b FROM t`, `SELECT a <<= b, a >>= b, a && b FROM t`},
+		{`CREATE TABLE a (b STRING NOT NULL COLLATE "de-DE")`, `CREATE TABLE a (b STRING COLLATE "de-DE" NOT NULL)`},
 
 		{`SELECT BOOL 'foo'`, `SELECT CAST('foo' AS BOOL)`},
 		{`SELECT INT 'foo'`, `SELECT CAST('foo' AS INT)`},
@@ -397,6 +463,10 @@ func TestParse2(t *testing.T) {
 		{`SELECT DECIMAL 'foo'`, `SELECT CAST('foo' AS DECIMAL)`},
 		{`SELECT DATE 'foo'`, `SELECT CAST('foo' AS DATE)`},
 		{`SELECT TIMESTAMP 'foo'`, `SELECT CAST('foo' AS TIMESTAMP)`},
+		{`SELECT TIMESTAMPTZ 'foo'`, `SELECT CAST('foo' AS TIMESTAMP WITH TIME ZONE)`},
+		{`SELECT TIMESTAMP WITHOUT TIME ZONE 'foo'`, `SELECT CAST('foo' AS TIMESTAMP)`},
+		{`SELECT TIME 'foo'`, `SELECT CAST('foo' AS TIME)`},
+		{`SELECT a AT TIME ZONE 'UTC' FROM t`, `SELECT timezone('UTC', a) FROM t`},
 		{`SELECT INTERVAL 'foo'`, `SELECT CAST('foo' AS INTERVAL)`},
 		{`SELECT CHAR 'foo'`, `SELECT CAST('foo' AS CHAR)`},
 
@@ -441,6 +511,11 @@ func TestParse2(t *testing.T) {
 		// We allow OFFSET before LIMIT, but always output LIMIT first.
 		{`SELECT FROM t OFFSET a LIMIT b`,
 			`SELECT FROM t LIMIT b OFFSET a`},
+		// We allow the locking clause before LIMIT, but always output it last.
+		{`SELECT FROM t FOR UPDATE LIMIT a`,
+			`SELECT FROM t LIMIT a FOR UPDATE`},
+		{`SELECT FROM t ORDER BY a FOR SHARE OFFSET b`,
+			`SELECT FROM t ORDER BY a OFFSET b FOR SHARE`},
 		// Shorthand type cast.
 		{`SELECT '1'::INT`,
 			`SELECT CAST('1' AS INT)`},
@@ -466,12 +541,21 @@ func TestParse2(t *testing.T) {
 			`SELECT FROM t EXCEPT SELECT 1 FROM t`},
 		{`SELECT FROM t INTERSECT DISTINCT SELECT 1 FROM t`,
 			`SELECT FROM t INTERSECT SELECT 1 FROM t`},
+		{`RELEASE a`, `RELEASE SAVEPOINT a`},
+		{`RELEASE SAVEPOINT savepoint`, `RELEASE SAVEPOINT savepoint`},
+		{`ROLLBACK TO a`, `ROLLBACK TRANSACTION TO SAVEPOINT a`},
+		{`ROLLBACK TO SAVEPOINT a`, `ROLLBACK TRANSACTION TO SAVEPOINT a`},
+		{`ROLLBACK TRANSACTION TO a`, `ROLLBACK TRANSACTION TO SAVEPOINT a`},
 		{`SET TIME ZONE pst8pdt`,
 			`SET TIME ZONE 'pst8pdt'`},
 		{`SET TIME ZONE "Europe/Rome"`,
 			`SET TIME ZONE 'Europe/Rome'`},
 		{`SET TIME ZONE INTERVAL '-7h'`,
 			`SET TIME ZONE INTERVAL '-7h0m0s'`},
+		{`SET a TO DEFAULT`, `SET a = DEFAULT`},
+		{`RESET a`, `SET a = DEFAULT`},
+		{`RESET a.b`, `SET a.b = DEFAULT`},
+		{`RESET TIME ZONE`, `SET TIME ZONE DEFAULT`},
 	}
 	for _, d := range testData {
 		stmts, err := ParseTraditional(d.sql)
@@ -533,6 +617,14 @@ b = 2
 		{`SET TIME ZONE INTERVAL 'foobar'`, `cannot evaluate to an interval type at or near "EOF"
 SET TIME ZONE INTERVAL 'foobar'
                                ^
+`},
+		{`SELECT TIME WITH TIME ZONE 'foo'`, `TIME WITH TIME ZONE is not supported at or near "ZONE"
+SELECT TIME WITH TIME ZONE 'foo'
+                      ^
+`},
+		{`CREATE TABLE a (b INT COLLATE de)`, `COLLATE declaration for non-string-typed column "b" at or near ")"
+CREATE TABLE a (b INT COLLATE de)
+                                ^
 `},
 		{`SELECT 1 /* hello`, `unterminated comment
 SELECT 1 /* hello
@@ -599,6 +691,13 @@ CREATE TABLE a (b INT DEFAULT c)
 			`default expression contains a subquery at or near ")"
 CREATE TABLE a (b INT DEFAULT (SELECT 1))
                                         ^
+`,
+		},
+		{
+			`SELECT 1 UNION SELECT 2 FOR UPDATE`,
+			`locking clause is not allowed with UNION/INTERSECT/EXCEPT at or near "EOF"
+SELECT 1 UNION SELECT 2 FOR UPDATE
+                                  ^
 `,
 		},
 	}
@@ -43,3 +43,14 @@ func (node *Revoke) String() string {
 		node.Targets,
 		node.Grantees)
 }
+
+// RevokeRole represents a REVOKE statement revoking the membership of users
+// or other roles in roles.
+type RevokeRole struct {
+	Roles   NameList
+	Members NameList
+}
+
+func (node *RevokeRole) String() string {
+	return fmt.Sprintf("REVOKE %s FROM %s", node.Roles, node.Members)
+}
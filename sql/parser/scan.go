@@ -266,8 +266,13 @@ func (s *scanner) scan(lval *sqlSymType) {
 
 	case '<':
 		switch s.peek() {
-		case '<': // <<
-			s.pos++
+		case '<':
+			if s.peekN(1) == '=' { // <<=
+				s.pos += 2
+				lval.id = INET_CONTAINED_BY_OR_EQUALS
+				return
+			}
+			s.pos++ // <<
 			lval.id = LSHIFT
 			return
 		case '>': // <>
@@ -283,8 +288,13 @@ func (s *scanner) scan(lval *sqlSymType) {
 
 	case '>':
 		switch s.peek() {
-		case '>': // >>
-			s.pos++
+		case '>':
+			if s.peekN(1) == '=' { // >>=
+				s.pos += 2
+				lval.id = INET_CONTAINS_OR_EQUALS
+				return
+			}
+			s.pos++ // >>
 			lval.id = RSHIFT
 			return
 		case '=': // >=
@@ -294,6 +304,38 @@ func (s *scanner) scan(lval *sqlSymType) {
 		}
 		return
 
+	case '-':
+		switch s.peek() {
+		case '>':
+			if s.peekN(1) == '>' { // ->>
+				s.pos += 2
+				lval.id = FETCHTEXT
+				return
+			}
+			s.pos++ // ->
+			lval.id = FETCHVAL
+			return
+		}
+		return
+
+	case '&':
+		switch s.peek() {
+		case '&': // &&
+			s.pos++
+			lval.id = AND_AND
+			return
+		}
+		return
+
+	case '@':
+		switch s.peek() {
+		case '>': // @>
+			s.pos++
+			lval.id = CONTAINS
+			return
+		}
+		return
+
 	case ':':
 		switch s.peek() {
 		case ':': // ::
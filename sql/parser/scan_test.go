@@ -42,9 +42,11 @@ func TestScanner(t *testing.T) {
 		{`<>`, []int{NOT_EQUALS}},
 		{`<=`, []int{LESS_EQUALS}},
 		{`<<`, []int{LSHIFT}},
+		{`<<=`, []int{INET_CONTAINED_BY_OR_EQUALS}},
 		{`>`, []int{'>'}},
 		{`>=`, []int{GREATER_EQUALS}},
 		{`>>`, []int{RSHIFT}},
+		{`>>=`, []int{INET_CONTAINS_OR_EQUALS}},
 		{`=`, []int{'='}},
 		{`:`, []int{':'}},
 		{`::`, []int{TYPECAST}},
@@ -57,14 +59,21 @@ func TestScanner(t *testing.T) {
 		{`;`, []int{';'}},
 		{`+`, []int{'+'}},
 		{`-`, []int{'-'}},
+		{`->`, []int{FETCHVAL}},
+		{`->>`, []int{FETCHTEXT}},
+		{`-> >`, []int{FETCHVAL, '>'}},
 		{`*`, []int{'*'}},
 		{`/`, []int{'/'}},
 		{`%`, []int{'%'}},
 		{`^`, []int{'^'}},
 		{`$`, []int{'$'}},
 		{`&`, []int{'&'}},
+		{`&&`, []int{AND_AND}},
 		{`|`, []int{'|'}},
 		{`||`, []int{CONCAT}},
+		{`@`, []int{'@'}},
+		{`@>`, []int{CONTAINS}},
+		{`?`, []int{'?'}},
 		{`#`, []int{'#'}},
 		{`~`, []int{'~'}},
 		{`$1`, []int{PARAM}},
@@ -58,7 +58,7 @@ type Select struct {
 	Having      *Where
 	OrderBy     OrderBy
 	Limit       *Limit
-	Lock        string
+	Lock        LockingStrength
 	tableSelect bool
 }
 
@@ -70,11 +70,36 @@ func (node *Select) String() string {
 	if node.Distinct {
 		distinct = " DISTINCT"
 	}
+	var lock string
+	if node.Lock != LockNone {
+		lock = " " + node.Lock.String()
+	}
 	return fmt.Sprintf("SELECT%s%s%s%s%s%s%s%s%s",
 		distinct, node.Exprs,
 		node.From, node.Where,
 		node.GroupBy, node.Having, node.OrderBy,
-		node.Limit, node.Lock)
+		node.Limit, lock)
+}
+
+// LockingStrength represents the row locking requested by the locking clause
+// of a SELECT.
+type LockingStrength int
+
+// LockingStrength values
+const (
+	LockNone LockingStrength = iota
+	LockForShare
+	LockForUpdate
+)
+
+func (s LockingStrength) String() string {
+	switch s {
+	case LockForShare:
+		return "FOR SHARE"
+	case LockForUpdate:
+		return "FOR UPDATE"
+	}
+	return ""
 }
 
 // SelectExprs represents SELECT expressions.
@@ -161,6 +186,7 @@ type SimpleTableExpr interface {
 
 func (QualifiedName) simpleTableExpr() {}
 func (*Subquery) simpleTableExpr()     {}
+func (*FuncExpr) simpleTableExpr()     {}
 
 // ParenTableExpr represents a parenthesized TableExpr.
 type ParenTableExpr struct {
@@ -80,6 +80,14 @@ func (node *ShowTables) String() string {
 	return buf.String()
 }
 
+// ShowUsers represents a SHOW USERS statement.
+type ShowUsers struct {
+}
+
+func (node *ShowUsers) String() string {
+	return "SHOW USERS"
+}
+
 // ShowGrants represents a SHOW GRANTS statement.
 // TargetList is defined in grant.go.
 type ShowGrants struct {
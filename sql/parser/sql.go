@@ -59,7 +59,12 @@ type sqlSymType struct {
 	dir            Direction
 	alterTableCmd  AlterTableCmd
 	alterTableCmds AlterTableCmds
+	auditMode      AuditMode
 	isoLevel       IsolationLevel
+	lock           LockingStrength
+	interleave     *InterleaveDef
+	idxElem        IndexElem
+	idxElems       IndexElemList
 }
 
 const IDENT = 57346
@@ -70,239 +75,262 @@ const ICONST = 57350
 const PARAM = 57351
 const TYPECAST = 57352
 const DOT_DOT = 57353
-const LESS_EQUALS = 57354
-const GREATER_EQUALS = 57355
-const NOT_EQUALS = 57356
-const ERROR = 57357
-const ACTION = 57358
-const ADD = 57359
-const ALL = 57360
-const ALTER = 57361
-const ANALYSE = 57362
-const ANALYZE = 57363
-const AND = 57364
-const ANY = 57365
-const ARRAY = 57366
-const AS = 57367
-const ASC = 57368
-const ASYMMETRIC = 57369
-const AT = 57370
-const BEGIN = 57371
-const BETWEEN = 57372
-const BIGINT = 57373
-const BIT = 57374
-const BLOB = 57375
-const BOOL = 57376
-const BOOLEAN = 57377
-const BOTH = 57378
-const BY = 57379
-const BYTES = 57380
-const CASCADE = 57381
-const CASE = 57382
-const CAST = 57383
-const CHAR = 57384
-const CHARACTER = 57385
-const CHECK = 57386
-const COALESCE = 57387
-const COLLATE = 57388
-const COLLATION = 57389
-const COLUMN = 57390
-const COLUMNS = 57391
-const COMMIT = 57392
-const COMMITTED = 57393
-const CONCAT = 57394
-const CONFLICT = 57395
-const CONSTRAINT = 57396
-const COVERING = 57397
-const CREATE = 57398
-const CROSS = 57399
-const CUBE = 57400
-const CURRENT = 57401
-const CURRENT_CATALOG = 57402
-const CURRENT_DATE = 57403
-const CURRENT_ROLE = 57404
-const CURRENT_TIME = 57405
-const CURRENT_TIMESTAMP = 57406
-const CURRENT_USER = 57407
-const CYCLE = 57408
-const DATA = 57409
-const DATABASE = 57410
-const DATABASES = 57411
-const DATE = 57412
-const DAY = 57413
-const DEC = 57414
-const DECIMAL = 57415
-const DEFAULT = 57416
-const DEFERRABLE = 57417
-const DELETE = 57418
-const DESC = 57419
-const DISTINCT = 57420
-const DO = 57421
-const DOUBLE = 57422
-const DROP = 57423
-const ELSE = 57424
-const END = 57425
-const ESCAPE = 57426
-const EXCEPT = 57427
-const EXISTS = 57428
-const EXPLAIN = 57429
-const EXTRACT = 57430
-const FALSE = 57431
-const FETCH = 57432
-const FILTER = 57433
-const FIRST = 57434
-const FLOAT = 57435
-const FOLLOWING = 57436
-const FOR = 57437
-const FOREIGN = 57438
-const FROM = 57439
-const FULL = 57440
-const GRANT = 57441
-const GRANTS = 57442
-const GREATEST = 57443
-const GROUP = 57444
-const GROUPING = 57445
-const HAVING = 57446
-const HOUR = 57447
-const IF = 57448
-const IFNULL = 57449
-const IN = 57450
-const INDEX = 57451
-const INITIALLY = 57452
-const INNER = 57453
-const INSERT = 57454
-const INT = 57455
-const INT64 = 57456
-const INTEGER = 57457
-const INTERSECT = 57458
-const INTERVAL = 57459
-const INTO = 57460
-const IS = 57461
-const ISOLATION = 57462
-const JOIN = 57463
-const KEY = 57464
-const LATERAL = 57465
-const LEADING = 57466
-const LEAST = 57467
-const LEFT = 57468
-const LEVEL = 57469
-const LIKE = 57470
-const LIMIT = 57471
-const LOCAL = 57472
-const LOCALTIME = 57473
-const LOCALTIMESTAMP = 57474
-const LSHIFT = 57475
-const MATCH = 57476
-const MINUTE = 57477
-const MONTH = 57478
-const NAME = 57479
-const NAMES = 57480
-const NATURAL = 57481
-const NEXT = 57482
-const NO = 57483
-const NOT = 57484
-const NOTHING = 57485
-const NULL = 57486
-const NULLIF = 57487
-const NULLS = 57488
-const NUMERIC = 57489
-const OF = 57490
-const OFF = 57491
-const OFFSET = 57492
-const ON = 57493
-const ONLY = 57494
-const OR = 57495
-const ORDER = 57496
-const ORDINALITY = 57497
-const OUT = 57498
-const OUTER = 57499
-const OVER = 57500
-const OVERLAPS = 57501
-const OVERLAY = 57502
-const PARTIAL = 57503
-const PARTITION = 57504
-const PLACING = 57505
-const POSITION = 57506
-const PRECEDING = 57507
-const PRECISION = 57508
-const PRIMARY = 57509
-const RANGE = 57510
-const READ = 57511
-const REAL = 57512
-const RECURSIVE = 57513
-const REF = 57514
-const REFERENCES = 57515
-const RENAME = 57516
-const REPEATABLE = 57517
-const RESTRICT = 57518
-const RETURNING = 57519
-const REVOKE = 57520
-const RIGHT = 57521
-const ROLLBACK = 57522
-const ROLLUP = 57523
-const ROW = 57524
-const ROWS = 57525
-const RSHIFT = 57526
-const SEARCH = 57527
-const SECOND = 57528
-const SELECT = 57529
-const SERIALIZABLE = 57530
-const SESSION = 57531
-const SESSION_USER = 57532
-const SET = 57533
-const SHOW = 57534
-const SIMILAR = 57535
-const SIMPLE = 57536
-const SMALLINT = 57537
-const SNAPSHOT = 57538
-const SOME = 57539
-const SQL = 57540
-const STRICT = 57541
-const STRING = 57542
-const STORING = 57543
-const SUBSTRING = 57544
-const SYMMETRIC = 57545
-const TABLE = 57546
-const TABLES = 57547
-const TEXT = 57548
-const THEN = 57549
-const TIME = 57550
-const TIMESTAMP = 57551
-const TO = 57552
-const TRAILING = 57553
-const TRANSACTION = 57554
-const TREAT = 57555
-const TRIM = 57556
-const TRUE = 57557
-const TRUNCATE = 57558
-const TYPE = 57559
-const UNBOUNDED = 57560
-const UNCOMMITTED = 57561
-const UNION = 57562
-const UNIQUE = 57563
-const UNKNOWN = 57564
-const UPDATE = 57565
-const USER = 57566
-const USING = 57567
-const VALID = 57568
-const VALIDATE = 57569
-const VALUE = 57570
-const VALUES = 57571
-const VARCHAR = 57572
-const VARIADIC = 57573
-const VARYING = 57574
-const WHEN = 57575
-const WHERE = 57576
-const WINDOW = 57577
-const WITH = 57578
-const WITHIN = 57579
-const WITHOUT = 57580
-const YEAR = 57581
-const ZONE = 57582
-const NOT_LA = 57583
-const WITH_LA = 57584
-const POSTFIXOP = 57585
-const UMINUS = 57586
+const FETCHVAL = 57354
+const FETCHTEXT = 57355
+const CONTAINS = 57356
+const INET_CONTAINED_BY_OR_EQUALS = 57357
+const INET_CONTAINS_OR_EQUALS = 57358
+const AND_AND = 57359
+const LESS_EQUALS = 57360
+const GREATER_EQUALS = 57361
+const NOT_EQUALS = 57362
+const ERROR = 57363
+const ACTION = 57364
+const ADD = 57365
+const ALL = 57366
+const ALTER = 57367
+const ANALYSE = 57368
+const ANALYZE = 57369
+const AND = 57370
+const ANY = 57371
+const ARRAY = 57372
+const AS = 57373
+const ASC = 57374
+const ASYMMETRIC = 57375
+const AT = 57376
+const BEGIN = 57377
+const BETWEEN = 57378
+const BIGINT = 57379
+const BIT = 57380
+const BLOB = 57381
+const BOOL = 57382
+const BOOLEAN = 57383
+const BOTH = 57384
+const BY = 57385
+const BYTES = 57386
+const CASCADE = 57387
+const CASE = 57388
+const CAST = 57389
+const CHAR = 57390
+const CHARACTER = 57391
+const CHECK = 57392
+const COALESCE = 57393
+const COLLATE = 57394
+const COLLATION = 57395
+const COLUMN = 57396
+const COLUMNS = 57397
+const COMMIT = 57398
+const COMMITTED = 57399
+const CONCAT = 57400
+const CONFLICT = 57401
+const CONSTRAINT = 57402
+const COVERING = 57403
+const CREATE = 57404
+const CROSS = 57405
+const CUBE = 57406
+const CURRENT = 57407
+const CURRENT_CATALOG = 57408
+const CURRENT_DATE = 57409
+const CURRENT_ROLE = 57410
+const CURRENT_TIME = 57411
+const CURRENT_TIMESTAMP = 57412
+const CURRENT_USER = 57413
+const CYCLE = 57414
+const DATA = 57415
+const DATABASE = 57416
+const DATABASES = 57417
+const DATE = 57418
+const DAY = 57419
+const DEC = 57420
+const DECIMAL = 57421
+const DEFAULT = 57422
+const DEFERRABLE = 57423
+const DELETE = 57424
+const DESC = 57425
+const DISTINCT = 57426
+const DO = 57427
+const DOUBLE = 57428
+const DROP = 57429
+const ELSE = 57430
+const END = 57431
+const ESCAPE = 57432
+const EXCEPT = 57433
+const EXISTS = 57434
+const EXPERIMENTAL_AUDIT = 57435
+const EXPLAIN = 57436
+const EXTRACT = 57437
+const FALSE = 57438
+const FAMILY = 57439
+const FETCH = 57440
+const FILTER = 57441
+const FIRST = 57442
+const FLOAT = 57443
+const FOLLOWING = 57444
+const FOR = 57445
+const FOREIGN = 57446
+const FROM = 57447
+const FULL = 57448
+const GRANT = 57449
+const GRANTS = 57450
+const GREATEST = 57451
+const GROUP = 57452
+const GROUPING = 57453
+const HAVING = 57454
+const HOUR = 57455
+const IF = 57456
+const IFNULL = 57457
+const IN = 57458
+const INDEX = 57459
+const INITIALLY = 57460
+const INET = 57461
+const INNER = 57462
+const INSERT = 57463
+const INT = 57464
+const INT64 = 57465
+const INTEGER = 57466
+const INTERLEAVE = 57467
+const INTERSECT = 57468
+const INTERVAL = 57469
+const INTO = 57470
+const IS = 57471
+const ISOLATION = 57472
+const JOIN = 57473
+const JSON = 57474
+const JSONB = 57475
+const KEY = 57476
+const LATERAL = 57477
+const LEADING = 57478
+const LEAST = 57479
+const LEFT = 57480
+const LEVEL = 57481
+const LIKE = 57482
+const LIMIT = 57483
+const LOCAL = 57484
+const LOCALTIME = 57485
+const LOCALTIMESTAMP = 57486
+const LSHIFT = 57487
+const MATCH = 57488
+const MINUTE = 57489
+const MONTH = 57490
+const NAME = 57491
+const NAMES = 57492
+const NATURAL = 57493
+const NEXT = 57494
+const NO = 57495
+const NOT = 57496
+const NOTHING = 57497
+const NULL = 57498
+const NULLIF = 57499
+const NULLS = 57500
+const NUMERIC = 57501
+const OF = 57502
+const OFF = 57503
+const OFFSET = 57504
+const ON = 57505
+const ONLY = 57506
+const OR = 57507
+const ORDER = 57508
+const ORDINALITY = 57509
+const OUT = 57510
+const OUTER = 57511
+const OVER = 57512
+const OVERLAPS = 57513
+const OVERLAY = 57514
+const PARENT = 57515
+const PARTIAL = 57516
+const PARTITION = 57517
+const PASSWORD = 57518
+const PLACING = 57519
+const POSITION = 57520
+const PRECEDING = 57521
+const PRECISION = 57522
+const PRIMARY = 57523
+const RANGE = 57524
+const READ = 57525
+const REAL = 57526
+const RECURSIVE = 57527
+const REF = 57528
+const REFERENCES = 57529
+const RELEASE = 57530
+const RENAME = 57531
+const REPEATABLE = 57532
+const RESET = 57533
+const RESTRICT = 57534
+const RETURNING = 57535
+const REVOKE = 57536
+const RIGHT = 57537
+const ROLE = 57538
+const ROLLBACK = 57539
+const ROLLUP = 57540
+const ROW = 57541
+const ROWS = 57542
+const RSHIFT = 57543
+const SAVEPOINT = 57544
+const SEARCH = 57545
+const SECOND = 57546
+const SELECT = 57547
+const SERIALIZABLE = 57548
+const SESSION = 57549
+const SESSION_USER = 57550
+const SET = 57551
+const SHARE = 57552
+const SHOW = 57553
+const SIMILAR = 57554
+const SIMPLE = 57555
+const SMALLINT = 57556
+const SNAPSHOT = 57557
+const SOME = 57558
+const SQL = 57559
+const STRICT = 57560
+const STRING = 57561
+const STORING = 57562
+const SUBSTRING = 57563
+const SYMMETRIC = 57564
+const TABLE = 57565
+const TABLES = 57566
+const TEXT = 57567
+const THEN = 57568
+const TIME = 57569
+const TIMESTAMP = 57570
+const TIMESTAMPTZ = 57571
+const TO = 57572
+const TRAILING = 57573
+const TRANSACTION = 57574
+const TREAT = 57575
+const TRIM = 57576
+const TRUE = 57577
+const TRUNCATE = 57578
+const TYPE = 57579
+const UNBOUNDED = 57580
+const UNCOMMITTED = 57581
+const UNION = 57582
+const UNIQUE = 57583
+const UNKNOWN = 57584
+const UPDATE = 57585
+const USER = 57586
+const USERS = 57587
+const USING = 57588
+const UUID = 57589
+const VALID = 57590
+const VALIDATE = 57591
+const VALUE = 57592
+const VALUES = 57593
+const VARCHAR = 57594
+const VARIADIC = 57595
+const VARYING = 57596
+const WHEN = 57597
+const WHERE = 57598
+const WINDOW = 57599
+const WITH = 57600
+const WITHIN = 57601
+const WITHOUT = 57602
+const WRITE = 57603
+const YEAR = 57604
+const ZONE = 57605
+const NOT_LA = 57606
+const WITH_LA = 57607
+const POSTFIXOP = 57608
+const UMINUS = 57609
 
 var sqlToknames = [...]string{
 	"$end",
@@ -316,6 +344,12 @@ var sqlToknames = [...]string{
 	"PARAM",
 	"TYPECAST",
 	"DOT_DOT",
+	"FETCHVAL",
+	"FETCHTEXT",
+	"CONTAINS",
+	"INET_CONTAINED_BY_OR_EQUALS",
+	"INET_CONTAINS_OR_EQUALS",
+	"AND_AND",
 	"LESS_EQUALS",
 	"GREATER_EQUALS",
 	"NOT_EQUALS",
@@ -391,9 +425,11 @@ var sqlToknames = [...]string{
 	"ESCAPE",
 	"EXCEPT",
 	"EXISTS",
+	"EXPERIMENTAL_AUDIT",
 	"EXPLAIN",
 	"EXTRACT",
 	"FALSE",
+	"FAMILY",
 	"FETCH",
 	"FILTER",
 	"FIRST",
@@ -415,17 +451,21 @@ var sqlToknames = [...]string{
 	"IN",
 	"INDEX",
 	"INITIALLY",
+	"INET",
 	"INNER",
 	"INSERT",
 	"INT",
 	"INT64",
 	"INTEGER",
+	"INTERLEAVE",
 	"INTERSECT",
 	"INTERVAL",
 	"INTO",
 	"IS",
 	"ISOLATION",
 	"JOIN",
+	"JSON",
+	"JSONB",
 	"KEY",
 	"LATERAL",
 	"LEADING",
@@ -465,8 +505,10 @@ var sqlToknames = [...]string{
 	"OVER",
 	"OVERLAPS",
 	"OVERLAY",
+	"PARENT",
 	"PARTIAL",
 	"PARTITION",
+	"PASSWORD",
 	"PLACING",
 	"POSITION",
 	"PRECEDING",
@@ -478,17 +520,21 @@ var sqlToknames = [...]string{
 	"RECURSIVE",
 	"REF",
 	"REFERENCES",
+	"RELEASE",
 	"RENAME",
 	"REPEATABLE",
+	"RESET",
 	"RESTRICT",
 	"RETURNING",
 	"REVOKE",
 	"RIGHT",
+	"ROLE",
 	"ROLLBACK",
 	"ROLLUP",
 	"ROW",
 	"ROWS",
 	"RSHIFT",
+	"SAVEPOINT",
 	"SEARCH",
 	"SECOND",
 	"SELECT",
@@ -496,6 +542,7 @@ var sqlToknames = [...]string{
 	"SESSION",
 	"SESSION_USER",
 	"SET",
+	"SHARE",
 	"SHOW",
 	"SIMILAR",
 	"SIMPLE",
@@ -514,6 +561,7 @@ var sqlToknames = [...]string{
 	"THEN",
 	"TIME",
 	"TIMESTAMP",
+	"TIMESTAMPTZ",
 	"TO",
 	"TRAILING",
 	"TRANSACTION",
@@ -529,7 +577,9 @@ var sqlToknames = [...]string{
 	"UNKNOWN",
 	"UPDATE",
 	"USER",
+	"USERS",
 	"USING",
+	"UUID",
 	"VALID",
 	"VALIDATE",
 	"VALUE",
@@ -543,6 +593,7 @@ var sqlToknames = [...]string{
 	"WITH",
 	"WITHIN",
 	"WITHOUT",
+	"WRITE",
 	"YEAR",
 	"ZONE",
 	"NOT_LA",
@@ -551,6 +602,7 @@ var sqlToknames = [...]string{
 	"'>'",
 	"'='",
 	"POSTFIXOP",
+	"'?'",
 	"'|'",
 	"'^'",
 	"'#'",
@@ -578,2164 +630,2489 @@ const sqlEofCode = 1
 const sqlErrCode = 2
 const sqlMaxDepth = 200
 
-//line sql.y:3695
+//line sql.y:4102
 
 //line yacctab:1
 var sqlExca = [...]int{
 	-1, 0,
-	1, 19,
-	263, 19,
-	-2, 289,
+	1, 21,
+	287, 21,
+	-2, 325,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 29,
-	1, 260,
-	151, 260,
-	261, 260,
-	263, 260,
-	-2, 270,
-	-1, 38,
-	1, 263,
-	151, 263,
-	261, 263,
-	263, 263,
-	-2, 269,
-	-1, 47,
-	1, 19,
-	263, 19,
-	-2, 289,
-	-1, 83,
-	1, 127,
-	263, 127,
-	-2, 738,
-	-1, 234,
-	129, 299,
-	150, 299,
-	-2, 266,
-	-1, 237,
-	129, 298,
-	150, 298,
-	-2, 264,
-	-1, 339,
-	129, 298,
-	150, 298,
-	-2, 267,
-	-1, 396,
-	260, 688,
-	-2, 683,
-	-1, 397,
-	260, 689,
-	-2, 684,
-	-1, 403,
-	6, 417,
-	260, 417,
-	-2, 811,
-	-1, 425,
-	6, 387,
-	-2, 790,
-	-1, 426,
-	6, 414,
-	260, 414,
-	-2, 791,
-	-1, 427,
-	6, 395,
-	-2, 792,
-	-1, 428,
-	6, 394,
-	-2, 793,
-	-1, 429,
-	6, 414,
-	260, 414,
-	-2, 795,
-	-1, 430,
-	6, 414,
-	260, 414,
-	-2, 796,
-	-1, 431,
-	6, 415,
-	-2, 798,
-	-1, 432,
-	6, 382,
-	-2, 799,
-	-1, 433,
-	6, 382,
-	-2, 800,
-	-1, 434,
-	6, 397,
-	-2, 803,
-	-1, 435,
-	6, 383,
+	-1, 34,
+	1, 293,
+	163, 293,
+	285, 293,
+	287, 293,
+	-2, 306,
+	-1, 45,
+	1, 296,
+	163, 296,
+	285, 296,
+	287, 296,
+	-2, 305,
+	-1, 54,
+	1, 21,
+	287, 21,
+	-2, 325,
+	-1, 249,
+	1, 144,
+	287, 144,
 	-2, 808,
-	-1, 436,
-	6, 384,
-	-2, 809,
-	-1, 437,
-	6, 385,
-	-2, 810,
-	-1, 438,
-	6, 382,
-	-2, 814,
-	-1, 439,
-	6, 388,
-	-2, 819,
-	-1, 440,
-	6, 386,
-	-2, 821,
-	-1, 441,
-	6, 416,
-	-2, 825,
-	-1, 442,
-	6, 412,
-	260, 412,
-	-2, 829,
-	-1, 683,
-	85, 270,
-	116, 270,
-	129, 270,
-	150, 270,
-	154, 270,
-	220, 270,
-	-2, 519,
-	-1, 691,
-	260, 668,
-	-2, 662,
-	-1, 876,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 450,
-	-1, 877,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 451,
-	-1, 878,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 452,
-	-1, 882,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 456,
-	-1, 883,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 457,
-	-1, 884,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 458,
-	-1, 887,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 463,
-	-1, 918,
-	159, 589,
-	-2, 592,
-	-1, 1064,
-	85, 270,
-	116, 270,
-	129, 270,
-	150, 270,
-	154, 270,
-	220, 270,
-	-2, 340,
-	-1, 1072,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 464,
-	-1, 1077,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 465,
-	-1, 1096,
-	159, 588,
-	-2, 591,
-	-1, 1233,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 466,
-	-1, 1238,
-	119, 0,
-	-2, 476,
-	-1, 1247,
-	159, 590,
-	-2, 593,
-	-1, 1287,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
+	-1, 277,
+	141, 337,
+	162, 337,
+	-2, 302,
+	-1, 280,
+	103, 336,
+	141, 336,
+	162, 336,
+	-2, 297,
+	-1, 389,
+	141, 336,
+	162, 336,
+	-2, 303,
+	-1, 448,
+	284, 753,
+	-2, 748,
+	-1, 449,
+	284, 754,
+	-2, 749,
+	-1, 455,
+	6, 465,
+	284, 465,
+	-2, 891,
+	-1, 477,
+	6, 430,
+	-2, 870,
+	-1, 478,
+	6, 457,
+	284, 457,
+	-2, 871,
+	-1, 479,
+	6, 438,
+	-2, 872,
+	-1, 480,
+	6, 437,
+	-2, 873,
+	-1, 481,
+	6, 457,
+	284, 457,
+	-2, 875,
+	-1, 482,
+	6, 457,
+	284, 457,
+	-2, 876,
+	-1, 483,
+	6, 458,
+	-2, 878,
+	-1, 484,
+	6, 425,
+	-2, 879,
+	-1, 485,
+	6, 425,
+	-2, 880,
+	-1, 486,
+	6, 440,
+	-2, 883,
+	-1, 487,
+	6, 426,
+	-2, 888,
+	-1, 488,
+	6, 427,
+	-2, 889,
+	-1, 489,
+	6, 428,
+	-2, 890,
+	-1, 490,
+	6, 425,
+	-2, 894,
+	-1, 491,
+	6, 431,
+	-2, 899,
+	-1, 492,
+	6, 429,
+	-2, 901,
+	-1, 493,
+	6, 464,
+	-2, 904,
+	-1, 494,
+	6, 464,
+	-2, 905,
+	-1, 495,
+	6, 460,
+	-2, 906,
+	-1, 496,
+	6, 455,
+	284, 455,
+	-2, 910,
+	-1, 759,
+	91, 306,
+	103, 306,
+	126, 306,
+	141, 306,
+	162, 306,
+	166, 306,
+	240, 306,
+	-2, 584,
+	-1, 767,
+	284, 733,
+	-2, 727,
+	-1, 970,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 498,
+	-1, 971,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 499,
+	-1, 972,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
 	-2, 500,
-	-1, 1288,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 501,
-	-1, 1289,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 502,
-	-1, 1293,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 506,
-	-1, 1294,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 507,
-	-1, 1295,
-	12, 0,
-	13, 0,
-	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 508,
-	-1, 1387,
-	119, 0,
-	-2, 477,
-	-1, 1391,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 480,
-	-1, 1392,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 482,
-	-1, 1471,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 481,
-	-1, 1472,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 483,
-	-1, 1480,
-	119, 0,
-	-2, 509,
-	-1, 1517,
-	119, 0,
-	-2, 510,
-	-1, 1562,
-	30, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 789,
+	-1, 985,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 511,
+	-1, 986,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 512,
+	-1, 987,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 513,
+	-1, 990,
+	36, 0,
+	116, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 518,
+	-1, 1021,
+	171, 654,
+	-2, 657,
+	-1, 1177,
+	91, 306,
+	103, 306,
+	126, 306,
+	141, 306,
+	162, 306,
+	166, 306,
+	240, 306,
+	-2, 379,
+	-1, 1189,
+	36, 0,
+	116, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 519,
+	-1, 1194,
+	36, 0,
+	116, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 520,
+	-1, 1214,
+	171, 653,
+	-2, 656,
+	-1, 1367,
+	36, 0,
+	116, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 521,
+	-1, 1372,
+	129, 0,
+	-2, 531,
+	-1, 1381,
+	171, 655,
+	-2, 658,
+	-1, 1421,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 557,
+	-1, 1422,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 558,
+	-1, 1423,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 559,
+	-1, 1434,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 570,
+	-1, 1435,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 571,
+	-1, 1436,
+	18, 0,
+	19, 0,
+	20, 0,
+	266, 0,
+	267, 0,
+	268, 0,
+	-2, 572,
+	-1, 1531,
+	129, 0,
+	-2, 532,
+	-1, 1535,
+	36, 0,
+	116, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 535,
+	-1, 1536,
+	36, 0,
+	116, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 537,
+	-1, 1616,
+	36, 0,
+	116, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 536,
+	-1, 1617,
+	36, 0,
+	116, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 538,
+	-1, 1625,
+	129, 0,
+	-2, 573,
+	-1, 1663,
+	129, 0,
+	-2, 574,
+	-1, 1710,
+	36, 0,
+	140, 0,
+	212, 0,
+	264, 0,
+	-2, 869,
 }
 
-const sqlNprod = 921
+const sqlNprod = 1003
 const sqlPrivate = 57344
 
 var sqlTokenNames []string
 var sqlStates []string
 
-const sqlLast = 18232
+const sqlLast = 21396
 
 var sqlAct = [...]int{
 
-	915, 1544, 1561, 1582, 370, 1522, 1546, 1428, 1545, 1560,
-	817, 1488, 769, 1267, 1358, 1453, 395, 1325, 394, 265,
-	387, 1461, 1373, 1359, 686, 238, 1060, 1213, 762, 801,
-	1153, 1239, 1154, 243, 28, 688, 1052, 481, 1222, 455,
-	1099, 803, 84, 931, 460, 739, 770, 1367, 748, 621,
-	262, 1048, 804, 262, 935, 271, 903, 828, 262, 28,
-	281, 970, 900, 1063, 13, 717, 499, 245, 37, 637,
-	925, 798, 463, 721, 643, 1240, 465, 369, 18, 10,
-	237, 28, 248, 6, 360, 57, 88, 510, 826, 641,
-	59, 286, 526, 37, 806, 342, 282, 973, 343, 284,
-	490, 825, 38, 341, 61, 60, 497, 501, 397, 62,
-	66, 1455, 275, 443, 39, 37, 242, 483, 458, 458,
-	81, 353, 456, 456, 483, 457, 457, 491, 1558, 767,
-	763, 1452, 928, 1552, 261, 1551, 821, 268, 821, 87,
-	235, 1543, 276, 242, 1390, 290, 287, 644, 1092, 644,
-	87, 87, 234, 1538, 87, 1510, 821, 87, 87, 87,
-	1021, 1300, 87, 87, 87, 87, 929, 289, 1519, 1246,
-	1513, 1390, 291, 821, 1501, 1498, 389, 821, 1452, 1473,
-	1032, 1468, 1390, 279, 821, 87, 87, 1451, 1448, 1433,
-	1452, 821, 821, 737, 1050, 1432, 930, 927, 821, 1413,
-	1393, 1389, 1092, 1092, 1390, 1335, 1243, 1204, 821, 1092,
-	482, 1034, 821, 1200, 43, 956, 482, 1171, 1169, 1168,
-	1172, 1092, 1092, 482, 1167, 1096, 1098, 1092, 1092, 1094,
-	1093, 45, 486, 262, 1095, 1092, 822, 736, 488, 821,
-	735, 489, 43, 911, 816, 792, 645, 932, 354, 307,
-	260, 47, 525, 1092, 484, 321, 46, 361, 361, 45,
-	340, 484, 1559, 41, 1557, 1514, 1450, 461, 452, 42,
-	1418, 1414, 1406, 334, 1405, 339, 1400, 43, 262, 476,
-	450, 1399, 1398, 1397, 46, 1384, 43, 40, 1352, 454,
-	1315, 41, 1310, 1126, 45, 1309, 1308, 42, 1250, 1228,
-	926, 1212, 1174, 45, 1173, 1161, 1152, 1125, 1122, 1120,
-	1109, 281, 1103, 281, 1021, 766, 645, 333, 1036, 46,
-	1033, 1070, 1489, 458, 445, 985, 41, 456, 46, 281,
-	457, 942, 42, 941, 444, 908, 694, 87, 353, 87,
-	352, 87, 482, 1269, 1509, 629, 631, 1490, 235, 618,
-	58, 1482, 638, 1464, 1458, 1447, 87, 1425, 1411, 40,
-	234, 1378, 276, 1356, 1382, 677, 678, 679, 680, 681,
-	617, 1237, 87, 1227, 684, 1210, 1209, 1207, 1186, 1185,
-	1151, 1117, 87, 87, 1116, 87, 474, 1108, 1089, 1085,
-	905, 722, 725, 646, 697, 999, 998, 1351, 980, 1126,
-	290, 290, 940, 820, 727, 715, 714, 691, 529, 495,
-	713, 648, 712, 909, 711, 87, 1140, 87, 494, 710,
-	709, 514, 289, 289, 521, 613, 708, 291, 291, 647,
-	528, 87, 646, 87, 87, 530, 87, 639, 707, 627,
-	706, 626, 705, 610, 625, 87, 614, 704, 615, 235,
-	648, 703, 235, 235, 702, 646, 701, 692, 729, 734,
-	690, 633, 40, 87, 634, 635, 87, 1141, 647, 999,
-	619, 685, 266, 648, 402, 262, 357, 1470, 761, 1469,
-	689, 1230, 773, 1229, 359, 451, 1354, 777, 1022, 1071,
-	281, 647, 328, 520, 730, 719, 720, 281, 316, 346,
-	699, 1368, 763, 1270, 936, 646, 718, 723, 1018, 28,
-	1528, 246, 726, 753, 755, 1571, 1343, 1497, 225, 742,
-	1112, 1028, 28, 648, 728, 1441, 1440, 1198, 1178, 779,
-	284, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 1177,
-	765, 647, 311, 529, 529, 466, 1107, 467, 232, 731,
-	733, 363, 1106, 59, 255, 662, 37, 1105, 1126, 758,
-	1104, 745, 87, 1126, 1073, 528, 528, 61, 60, 315,
-	530, 530, 62, 1572, 781, 87, 290, 287, 662, 87,
-	892, 782, 87, 785, 780, 783, 87, 760, 87, 87,
-	695, 87, 759, 866, 87, 87, 87, 1496, 289, 741,
-	229, 87, 87, 291, 1530, 1197, 663, 902, 1188, 468,
-	741, 51, 529, 778, 447, 1381, 740, 446, 797, 466,
-	784, 467, 466, 262, 467, 477, 932, 1259, 662, 663,
-	814, 815, 1430, 399, 528, 1579, 651, 652, 653, 530,
-	1127, 1128, 1129, 1130, 1131, 902, 361, 52, 1013, 262,
-	867, 868, 869, 870, 871, 872, 873, 874, 875, 876,
-	877, 878, 879, 880, 881, 882, 883, 884, 885, 886,
-	887, 936, 823, 649, 650, 651, 652, 653, 932, 663,
-	472, 1140, 313, 468, 230, 471, 468, 890, 1491, 1027,
-	865, 1585, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 233, 1010, 54, 943, 928, 954, 800, 964, 966,
-	971, 974, 975, 976, 749, 1029, 646, 314, 1189, 1478,
-	1571, 87, 831, 1540, 1578, 916, 730, 87, 87, 946,
-	830, 730, 1141, 483, 648, 716, 461, 682, 1541, 929,
-	349, 350, 449, 990, 55, 656, 649, 650, 651, 652,
-	653, 1126, 647, 87, 1115, 469, 87, 984, 53, 355,
-	907, 1195, 529, 1223, 1014, 891, 752, 331, 906, 930,
-	927, 281, 738, 646, 242, 1547, 464, 49, 1431, 281,
-	988, 345, 1548, 1075, 528, 888, 1435, 912, 917, 530,
-	920, 648, 1570, 1583, 1568, 1577, 949, 994, 1134, 1127,
-	1128, 1129, 1130, 1131, 1366, 965, 1129, 1130, 1131, 647,
-	1016, 977, 978, 979, 989, 1038, 1024, 638, 50, 788,
-	932, 901, 996, 837, 810, 789, 324, 308, 1584, 469,
-	950, 1009, 469, 306, 262, 1409, 1434, 751, 791, 56,
-	241, 1423, 1339, 1586, 1020, 1549, 790, 87, 87, 87,
-	889, 1256, 1037, 87, 28, 1025, 87, 1035, 1026, 1030,
-	951, 948, 87, 87, 87, 87, 87, 1031, 87, 87,
-	484, 240, 1592, 926, 1140, 87, 1180, 87, 1550, 290,
-	1296, 1257, 64, 87, 993, 1043, 1041, 1065, 37, 1072,
-	1059, 750, 87, 1077, 1017, 87, 662, 632, 811, 1045,
-	1044, 289, 1023, 624, 1046, 1410, 291, 620, 1069, 242,
-	1338, 952, 1091, 48, 1255, 1523, 87, 344, 87, 87,
-	67, 87, 1100, 837, 723, 1141, 726, 616, 720, 719,
-	87, 1066, 932, 496, 344, 87, 87, 1113, 87, 1424,
-	72, 1118, 1591, 1001, 1297, 68, 1097, 663, 1076, 1342,
-	1298, 1000, 1376, 1074, 1055, 345, 1341, 649, 650, 651,
-	652, 653, 684, 69, 947, 1218, 67, 1058, 971, 971,
-	971, 856, 1217, 312, 898, 239, 71, 1221, 1051, 329,
-	274, 855, 1056, 240, 336, 896, 72, 1214, 1176, 1111,
-	1049, 68, 1127, 1128, 1129, 1130, 1131, 939, 1481, 1183,
-	1408, 1155, 1236, 1088, 1121, 1084, 786, 1090, 644, 69,
-	327, 654, 655, 656, 649, 650, 651, 652, 653, 1055,
-	1101, 1102, 71, 461, 1340, 325, 773, 322, 273, 1158,
-	1159, 1160, 1058, 1156, 700, 1057, 1175, 612, 894, 938,
-	893, 1322, 1053, 1192, 899, 1194, 1182, 1056, 1193, 1201,
-	1191, 70, 1179, 1082, 1039, 262, 1196, 812, 262, 1150,
-	1054, 1264, 809, 487, 1080, 485, 480, 1203, 1202, 473,
-	1163, 856, 1232, 470, 1233, 1442, 1206, 347, 258, 1572,
-	516, 855, 741, 818, 741, 1238, 1208, 73, 756, 1184,
-	754, 1220, 318, 1248, 75, 1224, 1225, 70, 1444, 1248,
-	1057, 757, 1199, 646, 1455, 3, 646, 1493, 87, 1516,
-	1215, 895, 351, 1265, 1511, 768, 1086, 1087, 897, 1078,
-	640, 836, 1274, 1083, 648, 1276, 1252, 1253, 1254, 1068,
-	87, 1249, 1589, 73, 819, 1590, 63, 348, 259, 647,
-	1126, 87, 647, 87, 224, 87, 646, 1383, 87, 1258,
-	1260, 1261, 1216, 267, 1275, 1219, 1305, 1306, 1271, 87,
-	319, 1126, 87, 382, 74, 1312, 1313, 1314, 1316, 1262,
-	87, 309, 310, 87, 1147, 1148, 1149, 793, 226, 227,
-	794, 1231, 1170, 983, 982, 1304, 1303, 1244, 981, 933,
-	1079, 795, 1395, 1263, 85, 1273, 796, 1081, 693, 228,
-	1346, 1429, 1277, 1317, 65, 249, 249, 611, 323, 264,
-	1402, 1539, 264, 270, 264, 1369, 1114, 264, 277, 264,
-	85, 836, 262, 262, 87, 1477, 262, 1364, 1460, 1363,
-	937, 1365, 1321, 1307, 28, 698, 23, 1387, 1361, 1357,
-	85, 85, 1391, 1392, 375, 1323, 1181, 1394, 805, 1301,
-	531, 517, 1396, 506, 398, 1380, 326, 500, 957, 509,
-	1311, 858, 837, 945, 857, 1353, 448, 1401, 400, 1388,
-	834, 1404, 401, 835, 724, 1336, 1337, 388, 832, 285,
-	833, 771, 934, 1110, 1140, 696, 87, 87, 87, 374,
-	1234, 1235, 380, 379, 87, 87, 837, 1355, 913, 371,
-	87, 1412, 87, 837, 87, 87, 87, 87, 1407, 79,
-	80, 1015, 1350, 1370, 764, 813, 87, 1379, 87, 1371,
-	1372, 628, 1190, 1377, 231, 1123, 87, 87, 963, 955,
-	87, 953, 332, 459, 837, 1141, 87, 87, 1427, 772,
-	358, 320, 1436, 1278, 1279, 1280, 1281, 1282, 1283, 1284,
-	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
-	1295, 858, 1299, 1457, 857, 1419, 1443, 944, 1364, 1422,
-	1363, 1459, 1365, 824, 1445, 1420, 1465, 1456, 87, 1067,
-	833, 262, 1438, 1439, 356, 1454, 1471, 1472, 636, 257,
-	256, 802, 264, 317, 85, 1463, 337, 787, 1135, 1132,
-	1133, 1134, 1127, 1128, 1129, 1130, 1131, 475, 330, 1492,
-	856, 249, 1527, 1187, 837, 44, 1485, 17, 16, 1437,
-	855, 15, 1476, 14, 12, 11, 1487, 264, 1042, 9,
-	8, 87, 7, 87, 22, 87, 21, 264, 264, 1486,
-	478, 20, 87, 1483, 856, 5, 1449, 4, 461, 2,
-	1, 856, 957, 957, 855, 0, 1500, 0, 0, 1502,
-	0, 855, 0, 0, 1504, 87, 1474, 1506, 1467, 1364,
-	264, 1363, 264, 1365, 0, 87, 1503, 87, 1466, 0,
-	0, 0, 856, 0, 0, 87, 85, 87, 264, 85,
-	0, 85, 855, 0, 730, 0, 1526, 0, 1505, 0,
-	623, 0, 0, 1515, 0, 0, 0, 0, 0, 1532,
-	957, 957, 957, 0, 1518, 0, 0, 0, 249, 0,
-	837, 642, 0, 1533, 1535, 1537, 1364, 1531, 1363, 1554,
-	1365, 0, 1536, 0, 1534, 773, 1529, 0, 1553, 0,
-	0, 1565, 1565, 1555, 1556, 0, 0, 1331, 1426, 87,
-	87, 1566, 1567, 87, 1512, 1569, 1508, 0, 0, 1573,
-	836, 0, 856, 1565, 87, 1576, 1575, 837, 0, 0,
-	0, 0, 855, 87, 0, 1588, 1587, 1332, 0, 1524,
-	1525, 0, 0, 0, 1574, 0, 0, 0, 837, 0,
-	1565, 0, 1593, 0, 836, 0, 0, 0, 87, 87,
-	87, 836, 87, 519, 507, 518, 0, 512, 0, 0,
-	1331, 0, 1326, 0, 1542, 0, 0, 264, 0, 87,
-	1324, 0, 0, 0, 1480, 0, 957, 957, 0, 0,
-	746, 0, 836, 0, 264, 0, 0, 264, 0, 87,
-	1332, 264, 0, 775, 776, 1327, 264, 1328, 0, 264,
-	85, 85, 0, 0, 0, 0, 264, 642, 0, 837,
-	0, 0, 0, 1051, 0, 0, 0, 0, 856, 0,
-	1330, 0, 0, 522, 0, 0, 1333, 0, 855, 957,
-	957, 957, 957, 957, 957, 957, 957, 957, 957, 957,
-	957, 957, 957, 957, 957, 957, 957, 1517, 957, 0,
-	858, 0, 0, 857, 1055, 0, 0, 0, 1327, 0,
-	1328, 0, 836, 0, 0, 856, 524, 1058, 0, 833,
-	0, 0, 0, 0, 1329, 855, 0, 1053, 0, 523,
-	0, 0, 1056, 1330, 858, 0, 856, 857, 0, 1333,
-	0, 858, 0, 0, 857, 1054, 855, 0, 0, 0,
-	0, 0, 0, 833, 376, 29, 0, 1375, 0, 0,
-	833, 0, 0, 0, 214, 0, 0, 0, 0, 0,
-	0, 0, 858, 0, 0, 857, 799, 0, 223, 0,
-	29, 0, 264, 746, 0, 1057, 0, 1329, 0, 0,
-	0, 833, 236, 0, 0, 244, 0, 0, 0, 0,
-	0, 0, 29, 0, 0, 0, 0, 856, 264, 216,
-	0, 85, 0, 244, 0, 0, 0, 855, 836, 0,
-	1126, 0, 1142, 1143, 1144, 0, 0, 0, 215, 217,
-	0, 1374, 1386, 0, 0, 0, 646, 0, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	513, 508, 858, 0, 648, 857, 673, 0, 0, 0,
-	218, 0, 1139, 0, 0, 836, 0, 0, 0, 219,
-	0, 833, 647, 0, 0, 0, 0, 0, 661, 0,
-	0, 0, 0, 0, 957, 0, 836, 0, 0, 0,
+	1018, 1333, 1709, 1731, 844, 1668, 1691, 1692, 1708, 1572,
+	1693, 904, 1606, 851, 1633, 1401, 1373, 1599, 447, 89,
+	1502, 1501, 1466, 1515, 762, 888, 885, 1509, 1279, 509,
+	911, 303, 286, 33, 1343, 15, 1172, 764, 441, 499,
+	1034, 1076, 446, 1278, 439, 1352, 537, 514, 887, 852,
+	1217, 1164, 819, 828, 1160, 1003, 1038, 1006, 803, 281,
+	1073, 685, 33, 1175, 797, 69, 793, 498, 915, 707,
+	1119, 288, 44, 1028, 646, 421, 20, 551, 517, 11,
+	7, 548, 519, 556, 323, 712, 412, 33, 67, 321,
+	1374, 891, 291, 93, 45, 393, 391, 280, 912, 46,
+	657, 44, 392, 328, 247, 648, 71, 77, 547, 70,
+	72, 325, 394, 78, 644, 314, 411, 1601, 512, 1031,
+	512, 405, 510, 539, 510, 511, 44, 511, 539, 1723,
+	849, 845, 544, 1210, 289, 1706, 87, 21, 1598, 278,
+	1699, 285, 285, 544, 277, 318, 713, 37, 713, 497,
+	1698, 299, 1212, 544, 306, 1032, 1690, 1213, 356, 1534,
+	315, 422, 332, 1132, 454, 1656, 1211, 1685, 38, 329,
+	544, 1210, 1665, 1441, 43, 1534, 1244, 1380, 1258, 1259,
+	1260, 1262, 1263, 1264, 293, 1659, 869, 817, 544, 1033,
+	1030, 1647, 1643, 1618, 544, 1598, 1534, 1613, 1597, 28,
+	544, 1598, 1595, 1162, 1593, 544, 29, 544, 1577, 1576,
+	1557, 544, 544, 1210, 300, 1145, 349, 300, 333, 30,
+	310, 544, 538, 300, 1257, 320, 1537, 1533, 1476, 1210,
+	1534, 544, 1377, 50, 1332, 1210, 1328, 538, 1296, 538,
+	1294, 1297, 1293, 1210, 1292, 1210, 1035, 1210, 542, 1214,
+	908, 52, 1210, 544, 816, 50, 1014, 815, 545, 501,
+	903, 546, 877, 1216, 1210, 714, 406, 350, 351, 298,
+	540, 54, 398, 52, 350, 540, 50, 555, 354, 53,
+	1707, 1660, 1614, 1596, 1562, 1558, 48, 1550, 1549, 1544,
+	1543, 382, 1542, 49, 52, 413, 413, 1541, 1526, 1456,
+	41, 53, 1493, 31, 1029, 515, 32, 1451, 48, 39,
+	1450, 1265, 47, 1147, 40, 49, 1449, 50, 715, 1384,
+	1358, 35, 53, 36, 1342, 1301, 1185, 508, 389, 1132,
+	504, 714, 1298, 1286, 848, 52, 1277, 390, 770, 1243,
+	1240, 350, 717, 1238, 1227, 500, 1221, 512, 42, 1144,
+	1011, 510, 1090, 1045, 511, 47, 1182, 1044, 405, 404,
+	716, 1634, 1615, 53, 1403, 1678, 538, 1266, 381, 1655,
+	48, 682, 1635, 1627, 1609, 1591, 1569, 49, 1555, 1520,
+	1498, 385, 1371, 278, 1362, 1357, 1340, 1339, 277, 1244,
+	1338, 1336, 1313, 1312, 1276, 1235, 47, 699, 701, 1244,
+	1234, 1226, 1206, 667, 708, 1202, 1008, 1188, 1187, 715,
+	798, 315, 503, 801, 735, 736, 737, 753, 754, 755,
+	756, 757, 1180, 1492, 1104, 407, 760, 681, 530, 1103,
+	1083, 300, 1043, 717, 642, 1012, 1261, 1253, 1250, 1251,
+	1252, 1245, 1246, 1247, 1248, 1249, 773, 907, 559, 807,
+	1525, 716, 791, 738, 50, 332, 332, 790, 553, 767,
+	789, 788, 787, 506, 641, 554, 786, 761, 785, 784,
+	668, 783, 52, 672, 300, 532, 676, 661, 677, 675,
+	1104, 782, 1244, 781, 780, 779, 778, 691, 777, 693,
+	695, 768, 766, 278, 692, 715, 278, 278, 703, 709,
+	53, 704, 705, 715, 560, 47, 694, 48, 683, 739,
+	320, 333, 333, 814, 49, 304, 415, 320, 409, 717,
+	765, 711, 1360, 505, 1265, 1495, 1244, 717, 1133, 1300,
+	805, 1299, 320, 68, 1265, 804, 1186, 716, 810, 374,
+	364, 352, 1322, 359, 738, 716, 822, 775, 795, 796,
+	799, 689, 526, 806, 1510, 802, 401, 402, 845, 1404,
+	1039, 794, 861, 323, 33, 1129, 1674, 847, 697, 666,
+	654, 665, 1719, 659, 833, 835, 1484, 241, 33, 449,
+	1266, 69, 725, 718, 719, 720, 721, 722, 262, 808,
+	1266, 1140, 1230, 1642, 811, 813, 1720, 1585, 1584, 1326,
+	739, 696, 1305, 821, 559, 559, 1574, 1304, 1183, 1325,
+	1225, 92, 825, 1224, 451, 92, 771, 44, 1223, 1087,
+	92, 92, 71, 860, 839, 70, 72, 1222, 92, 92,
+	738, 867, 92, 862, 864, 92, 92, 92, 92, 870,
+	332, 92, 92, 92, 92, 669, 92, 329, 331, 559,
+	865, 1250, 1251, 1252, 1245, 1246, 1247, 1248, 1249, 1361,
+	560, 560, 868, 1252, 1245, 1246, 1247, 1248, 1249, 809,
+	726, 723, 724, 725, 718, 719, 720, 721, 722, 363,
+	1190, 1641, 821, 995, 866, 842, 739, 300, 820, 275,
+	841, 843, 671, 378, 1086, 855, 333, 960, 1005, 1005,
+	859, 270, 1199, 320, 1315, 560, 1676, 670, 838, 533,
+	993, 320, 829, 1035, 1197, 413, 1728, 909, 1393, 961,
+	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
+	972, 975, 976, 977, 978, 979, 980, 981, 982, 983,
+	984, 985, 986, 987, 988, 989, 990, 1245, 1246, 1247,
+	1248, 1249, 882, 57, 925, 948, 914, 1719, 917, 1039,
+	718, 719, 720, 721, 722, 1575, 832, 715, 718, 719,
+	720, 721, 722, 1139, 1195, 1035, 62, 539, 1200, 1323,
+	1046, 884, 1057, 947, 1067, 1069, 1074, 1077, 1078, 1079,
+	994, 717, 810, 1247, 1248, 1249, 58, 810, 901, 902,
+	1124, 918, 528, 1019, 361, 377, 919, 1015, 1020, 716,
+	1023, 273, 1031, 1089, 991, 520, 515, 521, 1687, 63,
+	357, 358, 92, 92, 527, 1068, 1316, 1636, 1141, 59,
+	1244, 1080, 1081, 1082, 1010, 1009, 1688, 792, 271, 1116,
+	660, 655, 362, 831, 300, 559, 1125, 92, 1032, 92,
+	1099, 92, 80, 1196, 92, 92, 276, 898, 818, 274,
+	1198, 1115, 520, 1093, 521, 925, 948, 840, 60, 1623,
+	92, 1390, 85, 1592, 758, 300, 1233, 81, 1353, 522,
+	924, 92, 1033, 1030, 1244, 524, 992, 1192, 1004, 1262,
+	1263, 1264, 92, 92, 947, 92, 1734, 82, 65, 873,
+	1094, 560, 55, 830, 708, 1391, 874, 1135, 285, 1695,
+	397, 84, 1694, 1718, 702, 1149, 1716, 1101, 1117, 1508,
+	1049, 1727, 876, 56, 540, 64, 522, 1127, 92, 92,
+	1128, 875, 524, 896, 558, 92, 92, 1131, 1134, 1035,
+	33, 331, 331, 1156, 1136, 396, 66, 1179, 92, 1138,
+	92, 92, 1142, 92, 1146, 1143, 1148, 370, 92, 914,
+	355, 917, 1035, 395, 92, 1265, 397, 1579, 348, 1152,
+	1553, 332, 1437, 1154, 1578, 950, 1171, 1696, 1176, 44,
+	1095, 1118, 1567, 1742, 1158, 1178, 92, 1157, 1159, 92,
+	61, 924, 1189, 1052, 1307, 83, 1194, 1029, 1726, 1184,
+	1480, 396, 1098, 897, 1732, 1389, 250, 799, 690, 802,
+	320, 806, 684, 1669, 396, 1209, 1697, 678, 320, 1265,
+	643, 1266, 397, 796, 795, 1218, 260, 333, 518, 1053,
+	1483, 1568, 1106, 86, 720, 721, 722, 1482, 1208, 1105,
+	1231, 523, 1438, 1733, 1236, 1518, 1554, 360, 1439, 1191,
+	1205, 375, 1193, 1348, 1207, 1347, 313, 252, 1150, 1741,
+	1735, 949, 283, 1054, 1051, 760, 1334, 1219, 1220, 384,
+	1496, 1074, 1074, 1074, 1479, 1266, 251, 253, 300, 284,
+	1344, 1161, 1059, 1215, 1042, 1626, 950, 92, 523, 1552,
+	558, 558, 1280, 1303, 1229, 1245, 1246, 1247, 1248, 1249,
+	92, 1370, 1239, 1201, 1310, 92, 1275, 1281, 871, 92,
+	254, 1481, 713, 92, 283, 92, 92, 1288, 92, 255,
+	1055, 92, 92, 92, 92, 373, 331, 371, 368, 92,
+	92, 1283, 1284, 1285, 515, 558, 1329, 312, 776, 395,
+	674, 79, 1041, 1463, 1302, 1253, 1250, 1251, 1252, 1245,
+	1246, 1247, 1248, 1249, 285, 1320, 1309, 1319, 1318, 1321,
+	1167, 75, 1306, 520, 1151, 521, 899, 1472, 895, 1467,
+	543, 1324, 949, 541, 1170, 536, 529, 1465, 1050, 525,
+	1398, 1331, 1330, 1472, 1586, 1351, 399, 1364, 1365, 1366,
+	296, 1367, 1168, 1346, 1335, 1720, 1349, 1473, 366, 80,
+	663, 905, 1372, 74, 1588, 914, 821, 917, 914, 1337,
+	917, 1382, 836, 1473, 1354, 1355, 1311, 1382, 1350, 85,
+	837, 261, 1359, 1327, 81, 715, 256, 522, 282, 258,
+	1601, 1399, 715, 524, 259, 1638, 240, 1662, 810, 821,
+	1408, 925, 948, 1410, 82, 834, 400, 257, 1378, 1169,
+	297, 1383, 906, 3, 92, 1345, 717, 403, 84, 92,
+	263, 264, 92, 92, 1392, 1394, 1395, 716, 73, 324,
+	947, 1468, 367, 1469, 716, 1657, 925, 948, 1386, 1387,
+	1388, 1446, 1447, 925, 948, 1409, 1405, 1468, 850, 1469,
+	1453, 1454, 1455, 710, 855, 1739, 92, 1740, 1471, 1060,
+	1244, 239, 715, 1120, 1474, 947, 1121, 1444, 305, 1524,
+	878, 1457, 947, 879, 1471, 1396, 1363, 1295, 1442, 1088,
+	1474, 925, 948, 1445, 300, 1085, 1084, 300, 1407, 1452,
+	921, 558, 1462, 1036, 880, 1411, 1539, 1458, 1397, 881,
+	769, 79, 83, 269, 1573, 1511, 76, 673, 369, 1546,
+	947, 1686, 1232, 1622, 1605, 1040, 774, 1001, 1470, 1507,
+	27, 33, 1504, 427, 1494, 1464, 1500, 924, 1308, 999,
+	890, 1531, 889, 1448, 1470, 561, 1535, 1536, 1513, 1514,
+	86, 1538, 1519, 1506, 688, 917, 1540, 1512, 1532, 523,
+	914, 914, 917, 917, 914, 1522, 917, 664, 92, 92,
+	92, 1545, 924, 653, 92, 1548, 450, 92, 372, 924,
+	925, 948, 647, 92, 92, 92, 92, 92, 656, 1523,
+	1048, 92, 92, 502, 1477, 1478, 452, 997, 92, 996,
+	92, 922, 453, 1002, 923, 800, 92, 440, 1551, 947,
+	920, 921, 1556, 327, 853, 1037, 92, 924, 1497, 92,
+	1499, 92, 1228, 772, 426, 432, 431, 331, 1016, 423,
+	245, 246, 950, 1126, 1491, 846, 900, 698, 1317, 1521,
+	272, 1241, 92, 1066, 92, 92, 92, 1058, 1056, 92,
+	380, 513, 854, 1580, 410, 1487, 1563, 353, 1047, 1564,
+	910, 1181, 92, 92, 92, 408, 706, 950, 295, 294,
+	886, 365, 872, 531, 950, 376, 1603, 1637, 998, 300,
+	300, 1507, 1114, 300, 1587, 1000, 1673, 1314, 1610, 51,
+	1060, 1060, 1602, 925, 948, 19, 18, 1589, 1600, 17,
+	1616, 1617, 1582, 1583, 16, 1506, 924, 917, 1608, 14,
+	13, 12, 950, 715, 1155, 10, 9, 8, 949, 1611,
+	26, 25, 947, 24, 23, 22, 6, 715, 5, 4,
+	1630, 914, 2, 917, 1, 0, 0, 717, 0, 0,
+	1632, 0, 1628, 925, 948, 1581, 0, 0, 1060, 1060,
+	1060, 717, 0, 949, 0, 716, 0, 0, 1631, 0,
+	949, 0, 515, 0, 925, 948, 0, 434, 1648, 716,
+	1621, 0, 947, 1646, 0, 0, 0, 1649, 0, 0,
+	1594, 1651, 0, 0, 1653, 0, 0, 1507, 0, 1650,
+	0, 1652, 0, 947, 0, 1619, 0, 0, 949, 90,
+	810, 950, 1612, 90, 0, 0, 1571, 0, 265, 268,
+	0, 1506, 0, 917, 1664, 0, 292, 292, 1677, 924,
+	302, 1680, 0, 302, 308, 309, 302, 1679, 1675, 302,
+	316, 302, 90, 0, 326, 1244, 925, 948, 0, 1683,
+	1604, 0, 1681, 1684, 1507, 1701, 0, 1682, 738, 0,
+	300, 0, 1661, 0, 92, 1704, 0, 1700, 1713, 1713,
+	1702, 0, 738, 1705, 0, 947, 0, 1714, 1506, 924,
+	917, 1717, 0, 1715, 1689, 0, 1721, 1722, 1060, 1060,
+	0, 1713, 92, 1725, 0, 0, 1724, 949, 0, 0,
+	924, 1658, 0, 0, 1736, 92, 1738, 92, 1737, 0,
+	0, 92, 0, 0, 739, 0, 0, 0, 0, 0,
+	1713, 0, 1744, 1743, 950, 0, 1670, 1671, 739, 0,
+	0, 0, 0, 92, 1645, 0, 0, 92, 0, 1163,
+	0, 0, 1060, 1060, 1060, 1060, 1060, 1060, 1060, 1060,
+	1060, 1060, 1060, 1060, 1060, 1060, 1060, 1060, 1060, 1060,
+	1060, 1060, 1060, 1060, 1060, 1060, 1060, 0, 1060, 1163,
+	1703, 0, 924, 0, 950, 0, 0, 0, 1672, 0,
+	1265, 0, 1167, 0, 726, 723, 724, 725, 718, 719,
+	720, 721, 722, 92, 0, 950, 1170, 921, 0, 723,
+	724, 725, 718, 719, 720, 721, 722, 1165, 0, 0,
+	949, 0, 1167, 0, 1168, 0, 0, 0, 855, 0,
+	90, 90, 0, 0, 0, 0, 1170, 1166, 0, 0,
+	0, 0, 921, 0, 0, 0, 1266, 1165, 0, 921,
+	0, 0, 0, 0, 1168, 379, 0, 302, 0, 90,
+	0, 0, 386, 387, 0, 0, 0, 1166, 0, 0,
+	949, 0, 0, 0, 92, 92, 92, 950, 292, 1517,
+	0, 1169, 92, 92, 0, 0, 0, 921, 92, 302,
+	92, 949, 0, 92, 92, 92, 92, 0, 0, 0,
+	302, 302, 0, 534, 0, 0, 92, 0, 92, 92,
+	0, 1169, 0, 0, 0, 0, 1253, 1250, 1251, 1252,
+	1245, 1246, 1247, 1248, 1249, 0, 0, 92, 92, 0,
+	0, 0, 0, 0, 0, 0, 302, 552, 0, 0,
+	0, 0, 0, 302, 552, 0, 0, 428, 34, 0,
+	0, 0, 0, 0, 0, 0, 90, 0, 302, 90,
+	0, 90, 1516, 949, 0, 0, 680, 0, 0, 0,
+	0, 0, 687, 92, 0, 0, 921, 34, 0, 0,
+	1060, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 292, 287, 0, 326, 0, 0,
+	0, 0, 34, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
+	0, 715, 0, 731, 732, 733, 735, 736, 737, 740,
+	741, 742, 0, 92, 0, 92, 0, 92, 0, 743,
+	0, 0, 0, 0, 92, 717, 715, 749, 731, 732,
+	733, 735, 736, 737, 0, 0, 0, 0, 0, 1060,
+	0, 0, 0, 716, 0, 0, 0, 0, 92, 730,
+	717, 0, 0, 0, 0, 0, 1203, 1204, 92, 0,
+	92, 0, 0, 0, 0, 0, 0, 0, 716, 921,
+	92, 0, 0, 0, 730, 302, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 826, 0,
+	0, 0, 0, 302, 0, 0, 0, 302, 0, 0,
+	0, 302, 0, 857, 858, 0, 302, 750, 0, 302,
+	90, 90, 863, 1060, 1272, 1273, 1274, 302, 326, 921,
+	748, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 745, 0, 0, 92, 92, 738, 0, 92, 0,
+	921, 0, 92, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 0, 0, 0, 0, 744, 0, 0, 92,
+	0, 738, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 92, 92, 92, 0, 92, 0,
+	0, 0, 739, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 747, 0, 0, 92, 0, 0, 0,
+	0, 0, 921, 0, 0, 0, 279, 739, 0, 0,
+	0, 0, 0, 0, 0, 0, 92, 0, 92, 0,
+	715, 0, 731, 732, 733, 735, 736, 737, 740, 741,
+	742, 0, 552, 0, 1368, 1369, 0, 883, 743, 0,
+	302, 826, 0, 0, 717, 746, 749, 727, 728, 729,
+	0, 734, 726, 723, 724, 725, 718, 719, 720, 721,
+	722, 0, 716, 0, 1091, 0, 0, 0, 730, 0,
+	0, 1092, 0, 0, 959, 0, 734, 726, 723, 724,
+	725, 718, 719, 720, 721, 722, 0, 0, 1412, 1413,
+	1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423,
+	1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433,
+	1434, 1435, 1436, 0, 1440, 0, 279, 0, 0, 279,
+	279, 0, 0, 0, 0, 0, 750, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 748,
+	0, 0, 0, 759, 0, 0, 0, 763, 0, 0,
+	745, 0, 0, 0, 0, 738, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 744, 302, 1096, 1097, 0,
+	0, 0, 826, 0, 0, 1102, 0, 0, 0, 0,
+	0, 1107, 1108, 1110, 1112, 1113, 0, 0, 0, 1122,
+	1123, 0, 0, 0, 0, 0, 302, 0, 1130, 0,
+	0, 739, 0, 0, 302, 0, 0, 0, 0, 0,
+	0, 0, 747, 0, 552, 0, 0, 1137, 0, 552,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 34,
+	687, 0, 687, 90, 302, 0, 0, 1153, 0, 0,
+	0, 0, 0, 34, 0, 0, 0, 0, 0, 0,
+	1174, 1174, 1174, 0, 746, 0, 727, 728, 729, 0,
+	734, 726, 723, 724, 725, 718, 719, 720, 721, 722,
+	0, 0, 0, 0, 0, 0, 0, 0, 1559, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 715, 1570, 731, 732, 733,
+	735, 736, 737, 740, 741, 742, 0, 0, 0, 0,
+	0, 0, 0, 743, 0, 0, 0, 0, 0, 717,
+	0, 749, 715, 0, 731, 732, 733, 735, 736, 737,
+	740, 741, 742, 0, 0, 0, 0, 716, 0, 0,
+	743, 0, 0, 730, 0, 0, 717, 0, 749, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 716, 0, 0, 0, 0, 0,
+	730, 0, 0, 0, 0, 1625, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 750, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 913, 0, 0, 748, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 745, 0, 0, 750, 0,
+	738, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 748, 326, 0, 0, 0, 0, 0, 1007, 1663,
+	744, 0, 745, 0, 0, 0, 0, 738, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	302, 0, 0, 0, 0, 0, 0, 744, 0, 0,
+	0, 0, 0, 826, 0, 687, 739, 0, 0, 1341,
+	0, 0, 0, 0, 0, 0, 0, 747, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1356, 0, 739, 0, 1174, 0, 0, 0, 0,
+	0, 0, 0, 0, 747, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 287, 0, 0, 746,
+	0, 727, 728, 729, 0, 734, 726, 723, 724, 725,
+	718, 719, 720, 721, 722, 0, 0, 0, 0, 0,
+	0, 1402, 0, 1291, 0, 0, 746, 0, 727, 728,
+	729, 0, 734, 726, 723, 724, 725, 718, 719, 720,
+	721, 722, 0, 0, 0, 0, 0, 0, 0, 0,
+	1290, 0, 0, 0, 0, 34, 0, 0, 0, 0,
+	0, 0, 0, 0, 1177, 0, 0, 0, 0, 0,
+	0, 0, 0, 715, 0, 731, 732, 733, 735, 736,
+	737, 740, 741, 742, 0, 0, 0, 0, 0, 0,
+	0, 743, 1460, 1461, 826, 0, 0, 717, 0, 749,
+	326, 326, 0, 0, 0, 0, 1485, 0, 1486, 0,
+	0, 302, 1488, 1489, 1490, 716, 0, 0, 0, 0,
+	0, 730, 0, 0, 326, 0, 326, 826, 1503, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1007, 0, 0, 326, 1174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 759, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 750,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1547, 748, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 745, 0, 0, 0, 0, 738, 0,
+	0, 0, 759, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 744, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 264, 991, 992, 0, 0, 0, 746, 0,
-	0, 997, 0, 0, 0, 0, 0, 1002, 1003, 1005,
-	1007, 1008, 0, 1011, 1012, 0, 0, 0, 0, 1145,
-	264, 0, 1019, 0, 674, 0, 0, 0, 264, 0,
-	0, 0, 0, 1140, 0, 672, 0, 799, 0, 0,
-	799, 0, 0, 0, 669, 0, 0, 836, 858, 662,
-	957, 857, 0, 0, 0, 220, 0, 0, 221, 0,
-	19, 623, 222, 85, 264, 0, 1040, 833, 0, 668,
-	32, 0, 0, 0, 0, 1047, 0, 0, 0, 0,
-	1062, 1062, 0, 264, 1141, 646, 0, 0, 0, 0,
-	236, 33, 0, 0, 0, 858, 0, 36, 857, 0,
-	663, 0, 0, 648, 1126, 673, 1142, 1143, 1144, 671,
-	0, 0, 0, 0, 833, 0, 858, 0, 0, 857,
-	0, 647, 24, 957, 0, 0, 0, 661, 25, 0,
-	0, 0, 0, 0, 0, 833, 0, 0, 0, 0,
-	26, 0, 0, 1136, 1137, 1138, 1139, 1135, 1132, 1133,
-	1134, 1127, 1128, 1129, 1130, 1131, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 986, 0, 0, 0, 0,
-	0, 0, 987, 674, 0, 0, 0, 858, 0, 0,
-	857, 236, 0, 0, 236, 236, 0, 0, 0, 0,
-	0, 0, 1146, 669, 0, 0, 833, 0, 662, 0,
-	0, 0, 0, 1145, 0, 0, 0, 0, 683, 27,
-	0, 34, 687, 0, 0, 0, 0, 1140, 43, 0,
-	0, 0, 30, 31, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 45, 0, 0, 0, 0,
-	0, 0, 0, 642, 0, 0, 0, 35, 0, 663,
-	0, 646, 0, 664, 665, 666, 0, 0, 671, 0,
-	46, 0, 0, 667, 0, 264, 0, 41, 1141, 648,
-	0, 673, 0, 42, 0, 0, 1205, 0, 746, 0,
-	623, 0, 0, 1211, 0, 0, 0, 647, 0, 0,
-	0, 40, 0, 661, 264, 0, 0, 264, 0, 0,
-	0, 0, 0, 0, 0, 1226, 670, 0, 1062, 0,
-	29, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 0, 0, 29, 0, 0, 0, 1136, 1137, 1138,
-	0, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 674,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1268,
-	672, 0, 0, 0, 0, 0, 0, 0, 0, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 0,
+	0, 826, 0, 1565, 0, 1566, 0, 0, 0, 0,
+	0, 0, 302, 715, 739, 731, 732, 733, 735, 736,
+	737, 740, 741, 742, 0, 747, 0, 0, 0, 0,
+	1503, 743, 0, 0, 0, 0, 326, 717, 0, 749,
+	0, 0, 0, 0, 0, 0, 302, 0, 1607, 0,
+	0, 0, 0, 0, 0, 716, 0, 0, 326, 0,
+	913, 730, 0, 913, 0, 0, 1244, 0, 1258, 1259,
+	1260, 1262, 1263, 1264, 1267, 1268, 1269, 746, 0, 727,
+	728, 729, 0, 734, 726, 723, 724, 725, 718, 719,
+	720, 721, 722, 0, 0, 0, 0, 0, 0, 0,
+	0, 1289, 0, 715, 0, 731, 732, 733, 735, 736,
+	737, 740, 741, 742, 1257, 0, 0, 0, 0, 750,
+	0, 743, 1639, 1640, 0, 0, 1644, 717, 0, 749,
+	302, 0, 748, 0, 0, 0, 1503, 0, 0, 90,
+	0, 0, 0, 745, 0, 716, 0, 326, 738, 0,
+	0, 730, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 744, 0,
+	0, 0, 326, 326, 302, 0, 90, 0, 0, 0,
+	0, 0, 0, 0, 0, 1270, 0, 0, 0, 0,
+	0, 0, 0, 1503, 1607, 0, 0, 0, 0, 0,
+	0, 1265, 0, 0, 739, 0, 0, 0, 0, 750,
+	0, 0, 0, 0, 302, 747, 326, 0, 0, 0,
+	0, 0, 748, 0, 0, 0, 34, 0, 0, 0,
+	0, 0, 0, 745, 0, 0, 0, 0, 738, 0,
+	0, 0, 0, 0, 0, 913, 913, 0, 0, 913,
+	0, 0, 0, 0, 0, 0, 0, 1266, 744, 0,
+	0, 0, 0, 0, 0, 0, 0, 746, 0, 727,
+	728, 729, 0, 734, 726, 723, 724, 725, 718, 719,
+	720, 721, 722, 0, 0, 0, 0, 0, 1667, 0,
+	0, 0, 0, 0, 739, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 747, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 0, 0, 0, 0,
-	667, 1319, 1320, 746, 0, 663, 648, 0, 673, 642,
-	642, 646, 0, 0, 671, 1344, 0, 1345, 0, 264,
-	1347, 1348, 1349, 0, 647, 0, 0, 0, 0, 648,
-	661, 642, 0, 746, 1360, 0, 0, 0, 0, 0,
-	0, 264, 264, 0, 0, 264, 0, 647, 0, 0,
-	0, 642, 1062, 661, 0, 0, 0, 0, 0, 827,
-	0, 0, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 0, 0, 0, 1126, 1415, 674, 0, 0, 904,
-	0, 0, 0, 1403, 0, 0, 0, 672, 0, 0,
-	0, 0, 0, 0, 0, 0, 669, 0, 0, 0,
-	0, 662, 0, 0, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 1139, 0, 0, 0,
-	0, 668, 0, 0, 662, 0, 648, 0, 673, 0,
-	0, 0, 0, 0, 0, 0, 746, 0, 1421, 0,
-	85, 0, 0, 0, 647, 0, 0, 264, 0, 0,
-	661, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 0, 0, 0, 1360, 0, 0, 0, 0,
-	642, 244, 0, 0, 0, 663, 0, 0, 0, 0,
-	264, 0, 1462, 0, 0, 0, 0, 0, 0, 0,
-	264, 0, 642, 0, 0, 0, 0, 1140, 0, 0,
-	0, 0, 0, 0, 0, 0, 674, 0, 0, 670,
-	0, 658, 659, 660, 0, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 0, 29, 669, 0, 0, 0,
-	0, 662, 1166, 0, 1064, 0, 0, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 1141, 0,
-	0, 0, 0, 0, 1494, 1495, 0, 0, 1499, 0,
-	0, 0, 0, 0, 0, 0, 1360, 0, 0, 85,
-	0, 0, 0, 0, 0, 0, 0, 0, 642, 0,
-	0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 0, 0, 0, 0, 904, 0, 0, 0,
-	0, 0, 0, 642, 642, 264, 0, 85, 0, 0,
-	683, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131,
-	0, 0, 0, 1360, 1462, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 670,
-	0, 658, 659, 660, 264, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 0, 0, 0, 0, 0, 0,
-	396, 384, 385, 386, 383, 372, 683, 0, 0, 0,
-	0, 0, 89, 90, 922, 91, 0, 0, 0, 0,
-	378, 0, 0, 0, 92, 93, 174, 425, 426, 94,
-	427, 428, 0, 95, 179, 96, 393, 411, 429, 430,
-	0, 421, 0, 404, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 294, 102, 103, 0, 405, 407, 0,
-	406, 408, 104, 105, 106, 107, 431, 108, 432, 433,
-	0, 0, 109, 0, 923, 0, 424, 111, 0, 0,
-	0, 0, 377, 112, 412, 391, 0, 113, 114, 434,
-	115, 0, 0, 0, 295, 827, 116, 422, 827, 190,
-	0, 117, 418, 420, 0, 0, 0, 296, 118, 435,
-	436, 437, 0, 403, 0, 297, 119, 298, 120, 0,
-	0, 423, 299, 121, 300, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 367, 128,
-	392, 419, 129, 438, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 302, 133, 303, 413, 134, 135, 0,
-	414, 136, 203, 0, 137, 138, 439, 139, 140, 0,
-	141, 142, 143, 0, 144, 304, 145, 146, 381, 147,
-	0, 148, 149, 0, 150, 252, 409, 151, 152, 305,
-	153, 440, 154, 0, 155, 157, 207, 156, 415, 0,
-	0, 158, 159, 0, 254, 441, 0, 0, 253, 416,
-	417, 390, 160, 161, 162, 163, 0, 0, 164, 165,
-	410, 0, 166, 167, 168, 212, 442, 921, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 368, 0, 0,
-	0, 0, 0, 0, 0, 29, 0, 364, 365, 924,
-	0, 0, 0, 366, 0, 0, 373, 919, 0, 0,
-	0, 0, 827, 827, 0, 0, 827, 0, 0, 0,
+	0, 0, 1254, 1255, 1256, 0, 1261, 1253, 1250, 1251,
+	1252, 1245, 1246, 1247, 1248, 1249, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 746, 0, 727,
+	728, 729, 0, 734, 726, 723, 724, 725, 718, 719,
+	720, 721, 722, 0, 0, 0, 0, 0, 1666, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1590, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 557, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 913, 0, 0, 0,
+	0, 94, 95, 562, 96, 563, 564, 565, 566, 567,
+	568, 569, 570, 97, 98, 198, 199, 200, 99, 201,
+	202, 571, 100, 203, 101, 572, 573, 204, 205, 574,
+	206, 575, 335, 576, 102, 103, 104, 0, 105, 577,
+	106, 578, 336, 107, 108, 579, 580, 581, 582, 583,
+	584, 109, 110, 111, 112, 207, 113, 208, 209, 585,
+	586, 114, 587, 588, 589, 115, 116, 590, 591, 759,
+	592, 210, 117, 118, 211, 593, 594, 595, 119, 120,
+	212, 121, 596, 597, 598, 337, 599, 122, 213, 600,
+	214, 601, 123, 215, 216, 602, 603, 604, 124, 338,
+	125, 217, 218, 219, 126, 605, 220, 606, 339, 127,
+	340, 128, 129, 130, 607, 608, 221, 341, 131, 342,
+	609, 132, 610, 611, 0, 133, 134, 135, 136, 137,
+	343, 138, 139, 612, 140, 613, 222, 141, 223, 142,
+	143, 614, 615, 616, 617, 618, 144, 224, 344, 145,
+	345, 225, 146, 147, 148, 149, 619, 226, 150, 227,
+	620, 151, 152, 228, 153, 154, 621, 155, 156, 157,
+	158, 159, 622, 160, 346, 161, 162, 163, 229, 164,
+	0, 165, 166, 167, 623, 168, 169, 624, 170, 171,
+	172, 347, 173, 230, 174, 625, 175, 177, 231, 176,
+	232, 626, 627, 178, 179, 628, 266, 233, 234, 629,
+	630, 180, 235, 236, 631, 181, 182, 183, 184, 632,
+	633, 185, 186, 634, 187, 635, 188, 189, 190, 191,
+	237, 238, 636, 192, 637, 638, 639, 640, 193, 194,
+	195, 196, 197, 557, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 812, 0, 0, 0,
+	0, 94, 95, 562, 96, 563, 564, 565, 566, 567,
+	568, 569, 570, 97, 98, 198, 199, 200, 99, 201,
+	202, 571, 100, 203, 101, 572, 573, 204, 205, 574,
+	206, 575, 335, 576, 102, 103, 104, 0, 105, 577,
+	106, 578, 336, 107, 108, 579, 580, 581, 582, 583,
+	584, 109, 110, 111, 112, 207, 113, 208, 209, 585,
+	586, 114, 587, 588, 589, 115, 116, 590, 591, 0,
+	592, 210, 117, 118, 211, 593, 594, 595, 119, 120,
+	212, 121, 596, 597, 598, 337, 599, 122, 213, 600,
+	214, 601, 123, 215, 216, 602, 603, 604, 124, 338,
+	125, 217, 218, 219, 126, 605, 220, 606, 339, 127,
+	340, 128, 129, 130, 607, 608, 221, 341, 131, 342,
+	609, 132, 610, 611, 0, 133, 134, 135, 136, 137,
+	343, 138, 139, 612, 140, 613, 222, 141, 223, 142,
+	143, 614, 615, 616, 617, 618, 144, 224, 344, 145,
+	345, 225, 146, 147, 148, 149, 619, 226, 150, 227,
+	620, 151, 152, 228, 153, 154, 621, 155, 156, 157,
+	158, 159, 622, 160, 346, 161, 162, 163, 229, 164,
+	0, 165, 166, 167, 623, 168, 169, 624, 170, 171,
+	172, 347, 173, 230, 174, 625, 175, 177, 231, 176,
+	232, 626, 627, 178, 179, 628, 266, 233, 234, 629,
+	630, 180, 235, 236, 631, 181, 182, 183, 184, 632,
+	633, 185, 186, 634, 187, 635, 188, 189, 190, 191,
+	237, 238, 636, 192, 637, 638, 639, 640, 193, 194,
+	195, 196, 197, 448, 436, 437, 438, 435, 424, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 94, 95, 1025, 96, 0, 0, 0, 0, 430,
+	0, 0, 0, 97, 98, 198, 477, 478, 99, 479,
+	480, 0, 100, 203, 101, 445, 463, 481, 482, 0,
+	473, 0, 456, 0, 102, 103, 104, 0, 105, 0,
+	106, 0, 336, 107, 108, 0, 457, 459, 0, 458,
+	460, 109, 110, 111, 112, 483, 113, 484, 485, 0,
+	0, 114, 0, 1026, 0, 476, 116, 0, 0, 0,
+	0, 429, 117, 118, 464, 443, 0, 0, 119, 120,
+	486, 121, 0, 0, 0, 337, 0, 122, 474, 0,
+	214, 0, 123, 470, 472, 0, 0, 0, 124, 338,
+	125, 487, 488, 489, 126, 0, 455, 0, 339, 127,
+	340, 128, 129, 130, 0, 0, 475, 341, 131, 342,
+	0, 132, 0, 0, 0, 133, 134, 135, 136, 137,
+	343, 138, 139, 419, 140, 444, 471, 141, 490, 142,
+	143, 0, 0, 0, 0, 0, 144, 224, 344, 145,
+	345, 465, 146, 147, 148, 149, 0, 466, 150, 227,
+	0, 151, 152, 491, 153, 154, 0, 155, 156, 157,
+	158, 159, 0, 160, 346, 161, 162, 163, 433, 164,
+	0, 165, 166, 167, 0, 168, 169, 461, 170, 171,
+	172, 347, 173, 492, 174, 0, 175, 177, 231, 176,
+	467, 0, 0, 178, 179, 0, 493, 494, 495, 0,
+	0, 180, 468, 469, 442, 181, 182, 183, 184, 0,
+	0, 185, 186, 462, 187, 0, 188, 189, 190, 191,
+	237, 496, 1024, 192, 0, 0, 0, 0, 193, 194,
+	195, 196, 197, 420, 0, 0, 0, 448, 436, 437,
+	438, 435, 424, 0, 416, 417, 1027, 0, 0, 0,
+	418, 0, 0, 425, 1022, 94, 95, 0, 96, 0,
+	0, 0, 0, 430, 0, 0, 0, 97, 98, 198,
+	477, 478, 99, 479, 480, 0, 100, 203, 101, 445,
+	463, 481, 482, 0, 473, 0, 456, 0, 102, 103,
+	104, 0, 105, 0, 106, 0, 336, 107, 108, 0,
+	457, 459, 0, 458, 460, 109, 110, 111, 112, 483,
+	113, 484, 485, 516, 0, 114, 0, 0, 0, 476,
+	116, 0, 0, 0, 0, 429, 117, 118, 464, 443,
+	0, 0, 119, 120, 486, 121, 0, 0, 0, 337,
+	0, 122, 474, 0, 214, 0, 123, 470, 472, 0,
+	0, 0, 124, 338, 125, 487, 488, 489, 126, 0,
+	455, 0, 339, 127, 340, 128, 129, 130, 0, 0,
+	475, 341, 131, 342, 0, 132, 0, 0, 0, 133,
+	134, 135, 136, 137, 343, 138, 139, 419, 140, 444,
+	471, 141, 490, 142, 143, 0, 0, 0, 0, 0,
+	144, 224, 344, 145, 345, 465, 146, 147, 148, 149,
+	0, 466, 150, 227, 0, 151, 152, 491, 153, 154,
+	0, 155, 156, 157, 158, 159, 0, 160, 346, 161,
+	162, 163, 433, 164, 0, 165, 166, 167, 50, 168,
+	169, 461, 170, 171, 172, 347, 173, 492, 174, 0,
+	175, 177, 231, 176, 467, 0, 52, 178, 179, 0,
+	493, 494, 495, 0, 0, 180, 468, 469, 442, 181,
+	182, 183, 184, 0, 0, 185, 186, 462, 187, 0,
+	188, 189, 190, 191, 334, 496, 0, 192, 0, 0,
+	0, 48, 193, 194, 195, 196, 197, 420, 49, 0,
+	448, 436, 437, 438, 435, 424, 0, 0, 416, 417,
+	0, 0, 0, 0, 418, 0, 0, 425, 94, 95,
+	0, 96, 0, 0, 0, 0, 430, 0, 0, 0,
+	97, 98, 198, 477, 478, 99, 479, 480, 0, 100,
+	203, 101, 445, 463, 481, 482, 0, 473, 0, 456,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 336,
+	107, 108, 0, 457, 459, 0, 458, 460, 109, 110,
+	111, 112, 483, 113, 484, 485, 0, 0, 114, 0,
+	0, 0, 476, 116, 0, 0, 0, 0, 429, 117,
+	118, 464, 443, 0, 0, 119, 120, 486, 121, 0,
+	0, 0, 337, 0, 122, 474, 0, 214, 0, 123,
+	470, 472, 0, 0, 0, 124, 338, 125, 487, 488,
+	489, 126, 0, 455, 0, 339, 127, 340, 128, 129,
+	130, 0, 0, 475, 341, 131, 342, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 343, 138, 139,
+	419, 140, 444, 471, 141, 490, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 344, 145, 345, 465, 146,
+	147, 148, 149, 0, 466, 150, 227, 0, 151, 152,
+	491, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 346, 161, 162, 163, 433, 164, 0, 165, 166,
+	167, 50, 168, 169, 461, 170, 171, 172, 347, 173,
+	492, 174, 0, 175, 177, 231, 176, 467, 0, 52,
+	178, 179, 0, 493, 494, 495, 0, 0, 180, 468,
+	469, 442, 181, 182, 183, 184, 0, 0, 185, 186,
+	462, 187, 0, 188, 189, 190, 191, 334, 496, 0,
+	192, 0, 0, 0, 48, 193, 194, 195, 196, 197,
+	420, 49, 0, 448, 436, 437, 438, 435, 424, 0,
+	0, 416, 417, 0, 0, 0, 0, 418, 0, 0,
+	425, 94, 95, 0, 96, 0, 0, 0, 0, 430,
+	0, 0, 0, 97, 98, 198, 477, 478, 99, 479,
+	480, 1070, 100, 203, 101, 445, 463, 481, 482, 0,
+	473, 0, 456, 0, 102, 103, 104, 0, 105, 0,
+	106, 0, 336, 107, 108, 0, 457, 459, 0, 458,
+	460, 109, 110, 111, 112, 483, 113, 484, 485, 0,
+	0, 114, 0, 0, 0, 476, 116, 0, 0, 0,
+	0, 429, 117, 118, 464, 443, 0, 0, 119, 120,
+	486, 121, 0, 0, 1075, 337, 0, 122, 474, 0,
+	214, 0, 123, 470, 472, 0, 0, 0, 124, 338,
+	125, 487, 488, 489, 126, 0, 455, 0, 339, 127,
+	340, 128, 129, 130, 0, 1071, 475, 341, 131, 342,
+	0, 132, 0, 0, 0, 133, 134, 135, 136, 137,
+	343, 138, 139, 419, 140, 444, 471, 141, 490, 142,
+	143, 0, 0, 0, 0, 0, 144, 224, 344, 145,
+	345, 465, 146, 147, 148, 149, 0, 466, 150, 227,
+	0, 151, 152, 491, 153, 154, 0, 155, 156, 157,
+	158, 159, 0, 160, 346, 161, 162, 163, 433, 164,
+	0, 165, 166, 167, 0, 168, 169, 461, 170, 171,
+	172, 347, 173, 492, 174, 0, 175, 177, 231, 176,
+	467, 0, 0, 178, 179, 0, 493, 494, 495, 0,
+	1072, 180, 468, 469, 442, 181, 182, 183, 184, 0,
+	0, 185, 186, 462, 187, 0, 188, 189, 190, 191,
+	237, 496, 0, 192, 0, 0, 0, 0, 193, 194,
+	195, 196, 197, 420, 0, 0, 448, 436, 437, 438,
+	435, 424, 0, 0, 416, 417, 0, 0, 0, 0,
+	418, 0, 0, 425, 94, 95, 0, 96, 0, 0,
+	0, 0, 430, 0, 0, 0, 97, 98, 198, 477,
+	478, 99, 479, 480, 0, 100, 203, 101, 445, 463,
+	481, 482, 0, 473, 0, 456, 0, 102, 103, 104,
+	0, 105, 0, 106, 0, 336, 107, 108, 0, 457,
+	459, 0, 458, 460, 109, 110, 111, 112, 483, 113,
+	484, 485, 0, 0, 114, 0, 0, 0, 476, 116,
+	0, 0, 0, 0, 429, 117, 118, 464, 443, 0,
+	0, 119, 120, 486, 121, 0, 0, 0, 337, 0,
+	122, 474, 0, 214, 0, 123, 470, 472, 0, 0,
+	0, 124, 338, 125, 487, 488, 489, 126, 0, 455,
+	0, 339, 127, 340, 128, 129, 130, 0, 0, 475,
+	341, 131, 342, 0, 132, 0, 0, 0, 133, 134,
+	135, 136, 137, 343, 138, 139, 419, 140, 444, 471,
+	141, 490, 142, 143, 0, 0, 0, 0, 0, 144,
+	224, 344, 145, 345, 465, 146, 147, 148, 149, 0,
+	466, 150, 227, 0, 151, 152, 491, 153, 154, 0,
+	155, 156, 157, 158, 159, 0, 160, 346, 161, 162,
+	163, 433, 164, 0, 165, 166, 167, 0, 168, 169,
+	461, 170, 171, 172, 347, 173, 492, 174, 0, 175,
+	177, 231, 176, 467, 0, 0, 178, 179, 0, 493,
+	494, 495, 0, 0, 180, 468, 469, 442, 181, 182,
+	183, 184, 0, 0, 185, 186, 462, 187, 0, 188,
+	189, 190, 191, 237, 496, 0, 192, 0, 0, 0,
+	0, 193, 194, 195, 196, 197, 420, 0, 0, 0,
+	448, 436, 437, 438, 435, 424, 0, 416, 417, 0,
+	0, 0, 0, 418, 0, 0, 425, 1443, 94, 95,
+	0, 96, 0, 0, 0, 0, 430, 0, 0, 0,
+	97, 98, 198, 477, 478, 99, 479, 480, 0, 100,
+	203, 101, 445, 463, 481, 482, 0, 473, 0, 456,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 336,
+	107, 108, 0, 457, 459, 0, 458, 460, 109, 110,
+	111, 112, 483, 113, 484, 485, 0, 0, 114, 0,
+	0, 0, 476, 116, 0, 0, 0, 0, 429, 117,
+	118, 464, 443, 0, 0, 119, 120, 486, 121, 0,
+	0, 0, 337, 0, 122, 474, 0, 214, 0, 123,
+	470, 472, 0, 0, 0, 124, 338, 125, 487, 488,
+	489, 126, 0, 455, 0, 339, 127, 340, 128, 129,
+	130, 0, 0, 475, 341, 131, 342, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 343, 138, 139,
+	419, 140, 444, 471, 141, 490, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 344, 145, 345, 465, 146,
+	147, 148, 149, 0, 466, 150, 227, 0, 151, 152,
+	491, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 346, 161, 162, 163, 433, 164, 0, 165, 166,
+	167, 0, 168, 169, 461, 170, 171, 172, 347, 173,
+	492, 174, 0, 175, 177, 231, 176, 467, 0, 0,
+	178, 179, 0, 493, 494, 495, 0, 0, 180, 468,
+	469, 442, 181, 182, 183, 184, 0, 0, 185, 186,
+	462, 187, 0, 188, 189, 190, 191, 237, 496, 0,
+	192, 0, 0, 0, 0, 193, 194, 195, 196, 197,
+	420, 0, 0, 0, 448, 436, 437, 438, 435, 424,
+	0, 416, 417, 0, 0, 0, 0, 418, 0, 0,
+	425, 1379, 94, 95, 0, 96, 0, 0, 0, 0,
+	430, 0, 0, 0, 97, 98, 198, 477, 478, 99,
+	479, 480, 0, 100, 203, 101, 445, 463, 481, 482,
+	0, 473, 0, 456, 0, 102, 103, 104, 0, 105,
+	0, 106, 0, 336, 107, 108, 0, 457, 459, 0,
+	458, 460, 109, 110, 111, 112, 483, 113, 484, 485,
+	0, 0, 114, 0, 0, 0, 476, 116, 0, 0,
+	0, 0, 429, 117, 118, 464, 443, 0, 0, 119,
+	120, 486, 121, 0, 0, 0, 337, 0, 122, 474,
+	0, 214, 0, 123, 470, 472, 0, 0, 0, 124,
+	338, 125, 487, 488, 489, 126, 0, 455, 0, 339,
+	127, 340, 128, 129, 130, 0, 0, 475, 341, 131,
+	342, 0, 132, 0, 0, 0, 133, 134, 135, 136,
+	137, 343, 138, 139, 419, 140, 444, 471, 141, 490,
+	142, 143, 0, 0, 0, 0, 0, 144, 224, 344,
+	145, 345, 465, 146, 147, 148, 149, 0, 466, 150,
+	227, 0, 151, 152, 491, 153, 154, 0, 155, 156,
+	157, 158, 159, 0, 160, 346, 161, 162, 163, 433,
+	164, 0, 165, 166, 167, 0, 168, 169, 461, 170,
+	171, 172, 347, 173, 492, 174, 0, 175, 177, 231,
+	176, 467, 0, 0, 178, 179, 0, 493, 494, 495,
+	0, 0, 180, 468, 469, 442, 181, 182, 183, 184,
+	0, 0, 185, 186, 462, 187, 0, 188, 189, 190,
+	191, 237, 496, 0, 192, 0, 0, 0, 0, 193,
+	194, 195, 196, 197, 420, 0, 0, 0, 448, 436,
+	437, 438, 435, 424, 0, 416, 417, 0, 0, 0,
+	0, 418, 0, 0, 425, 1021, 94, 95, 0, 96,
+	0, 0, 0, 0, 430, 0, 0, 0, 97, 98,
+	198, 477, 478, 99, 479, 480, 0, 100, 203, 101,
+	445, 463, 481, 482, 0, 473, 0, 456, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 336, 107, 108,
+	0, 457, 459, 0, 458, 460, 109, 110, 111, 112,
+	483, 113, 484, 485, 0, 0, 114, 0, 0, 0,
+	476, 116, 0, 0, 0, 0, 429, 117, 118, 464,
+	443, 0, 0, 119, 120, 486, 121, 0, 0, 0,
+	337, 0, 122, 474, 0, 214, 0, 123, 470, 472,
+	0, 0, 0, 124, 338, 125, 487, 488, 489, 126,
+	0, 455, 0, 339, 127, 340, 128, 129, 130, 0,
+	0, 475, 341, 131, 342, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 343, 138, 139, 419, 140,
+	444, 471, 141, 490, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 344, 145, 345, 465, 146, 147, 148,
+	149, 0, 466, 150, 227, 0, 151, 152, 491, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 346,
+	161, 162, 163, 433, 164, 0, 165, 166, 167, 0,
+	168, 169, 461, 170, 171, 172, 347, 173, 492, 174,
+	0, 175, 177, 231, 176, 467, 0, 0, 178, 179,
+	0, 493, 494, 495, 0, 0, 180, 468, 469, 442,
+	181, 182, 183, 184, 0, 0, 185, 186, 462, 187,
+	0, 188, 189, 190, 191, 237, 496, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 420, 0,
+	0, 448, 436, 437, 438, 435, 424, 0, 0, 416,
+	417, 0, 0, 0, 0, 418, 765, 1017, 425, 94,
+	95, 0, 96, 0, 0, 0, 973, 430, 0, 0,
+	0, 97, 98, 198, 477, 478, 99, 479, 480, 0,
+	100, 203, 101, 445, 463, 481, 482, 0, 473, 0,
+	456, 0, 102, 103, 104, 0, 105, 0, 106, 0,
+	336, 107, 108, 0, 457, 459, 0, 458, 460, 109,
+	110, 111, 112, 483, 113, 484, 485, 0, 0, 114,
+	0, 0, 0, 476, 116, 0, 0, 0, 0, 429,
+	117, 118, 464, 443, 0, 0, 119, 120, 486, 121,
+	0, 0, 0, 337, 0, 122, 474, 0, 214, 0,
+	123, 470, 472, 0, 0, 0, 124, 338, 125, 487,
+	488, 489, 126, 0, 455, 0, 339, 127, 340, 128,
+	129, 130, 0, 0, 475, 341, 131, 342, 0, 132,
+	0, 0, 0, 133, 134, 135, 136, 137, 343, 138,
+	139, 419, 140, 444, 471, 141, 490, 142, 143, 0,
+	0, 0, 0, 0, 144, 224, 344, 145, 345, 465,
+	146, 147, 148, 149, 0, 466, 150, 227, 0, 151,
+	152, 491, 153, 154, 0, 155, 156, 157, 158, 159,
+	0, 160, 346, 161, 162, 163, 433, 164, 0, 165,
+	166, 167, 0, 168, 169, 461, 170, 171, 172, 347,
+	173, 492, 174, 974, 175, 177, 231, 176, 467, 0,
+	0, 178, 179, 0, 493, 494, 495, 0, 0, 180,
+	468, 469, 442, 181, 182, 183, 184, 0, 0, 185,
+	186, 462, 187, 0, 188, 189, 190, 191, 237, 496,
+	0, 192, 0, 0, 0, 0, 193, 194, 195, 196,
+	197, 420, 0, 0, 448, 436, 437, 438, 435, 424,
+	0, 0, 416, 417, 0, 0, 0, 0, 418, 0,
+	0, 425, 94, 95, 0, 96, 0, 0, 0, 0,
+	430, 0, 0, 0, 97, 98, 198, 477, 478, 99,
+	479, 480, 0, 100, 203, 101, 445, 463, 481, 482,
+	0, 473, 0, 456, 0, 102, 103, 104, 0, 105,
+	0, 106, 0, 336, 107, 108, 0, 457, 459, 0,
+	458, 460, 109, 110, 111, 112, 483, 113, 484, 485,
+	0, 0, 114, 0, 0, 0, 476, 116, 0, 0,
+	0, 0, 429, 117, 118, 464, 443, 0, 0, 119,
+	120, 486, 121, 0, 0, 0, 337, 0, 122, 474,
+	0, 214, 0, 123, 470, 472, 0, 0, 0, 124,
+	338, 125, 487, 488, 489, 126, 0, 455, 0, 339,
+	127, 340, 128, 129, 130, 0, 0, 475, 341, 131,
+	342, 0, 132, 0, 0, 0, 133, 134, 135, 136,
+	137, 343, 138, 139, 419, 140, 444, 471, 141, 490,
+	142, 143, 0, 0, 0, 0, 0, 144, 224, 344,
+	145, 345, 465, 146, 147, 148, 149, 0, 466, 150,
+	227, 0, 151, 152, 491, 153, 154, 0, 155, 156,
+	157, 158, 159, 0, 160, 346, 161, 162, 163, 433,
+	164, 0, 165, 166, 167, 0, 168, 169, 461, 170,
+	171, 172, 347, 173, 492, 174, 0, 175, 177, 231,
+	176, 467, 0, 0, 178, 179, 0, 493, 494, 495,
+	0, 0, 180, 468, 469, 442, 181, 182, 183, 184,
+	0, 0, 185, 186, 462, 187, 0, 188, 189, 190,
+	191, 237, 496, 1385, 192, 0, 0, 0, 0, 193,
+	194, 195, 196, 197, 420, 0, 0, 448, 436, 437,
+	438, 435, 424, 0, 0, 416, 417, 0, 0, 0,
+	0, 418, 0, 0, 425, 94, 95, 0, 96, 0,
+	0, 0, 0, 430, 0, 0, 0, 97, 98, 198,
+	477, 478, 99, 479, 480, 0, 100, 203, 101, 445,
+	463, 481, 482, 0, 473, 0, 456, 0, 102, 103,
+	104, 0, 105, 0, 106, 0, 336, 107, 108, 0,
+	457, 459, 0, 458, 460, 109, 110, 111, 112, 483,
+	113, 484, 485, 516, 0, 114, 0, 0, 0, 476,
+	116, 0, 0, 0, 0, 429, 117, 118, 464, 443,
+	0, 0, 119, 120, 486, 121, 0, 0, 0, 337,
+	0, 122, 474, 0, 214, 0, 123, 470, 472, 0,
+	0, 0, 124, 338, 125, 487, 488, 489, 126, 0,
+	455, 0, 339, 127, 340, 128, 129, 130, 0, 0,
+	475, 341, 131, 342, 0, 132, 0, 0, 0, 133,
+	134, 135, 136, 137, 343, 138, 139, 419, 140, 444,
+	471, 141, 490, 142, 143, 0, 0, 0, 0, 0,
+	144, 224, 344, 145, 345, 465, 146, 147, 148, 149,
+	0, 466, 150, 227, 0, 151, 152, 491, 153, 154,
+	0, 155, 156, 157, 158, 159, 0, 160, 346, 161,
+	162, 163, 433, 164, 0, 165, 166, 167, 0, 168,
+	169, 461, 170, 171, 172, 347, 173, 492, 174, 0,
+	175, 177, 231, 176, 467, 0, 0, 178, 179, 0,
+	493, 494, 495, 0, 0, 180, 468, 469, 442, 181,
+	182, 183, 184, 0, 0, 185, 186, 462, 187, 0,
+	188, 189, 190, 191, 237, 496, 0, 192, 0, 0,
+	0, 0, 193, 194, 195, 196, 197, 420, 0, 0,
+	448, 436, 437, 438, 435, 424, 0, 0, 416, 417,
+	0, 0, 0, 0, 418, 0, 0, 425, 94, 95,
+	0, 96, 0, 0, 0, 0, 430, 0, 0, 0,
+	97, 98, 198, 477, 478, 99, 479, 480, 0, 100,
+	203, 101, 445, 463, 481, 482, 0, 473, 0, 456,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 336,
+	107, 108, 0, 457, 459, 0, 458, 460, 109, 110,
+	111, 112, 483, 113, 484, 485, 0, 0, 114, 0,
+	0, 0, 476, 116, 0, 0, 0, 0, 429, 117,
+	118, 464, 443, 0, 0, 119, 120, 486, 121, 0,
+	0, 1075, 337, 0, 122, 474, 0, 214, 0, 123,
+	470, 472, 0, 0, 0, 124, 338, 125, 487, 488,
+	489, 126, 0, 455, 0, 339, 127, 340, 128, 129,
+	130, 0, 0, 475, 341, 131, 342, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 343, 138, 139,
+	419, 140, 444, 471, 141, 490, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 344, 145, 345, 465, 146,
+	147, 148, 149, 0, 466, 150, 227, 0, 151, 152,
+	491, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 346, 161, 162, 163, 433, 164, 0, 165, 166,
+	167, 0, 168, 169, 461, 170, 171, 172, 347, 173,
+	492, 174, 0, 175, 177, 231, 176, 467, 0, 0,
+	178, 179, 0, 493, 494, 495, 0, 0, 180, 468,
+	469, 442, 181, 182, 183, 184, 0, 0, 185, 186,
+	462, 187, 0, 188, 189, 190, 191, 237, 496, 0,
+	192, 0, 0, 0, 0, 193, 194, 195, 196, 197,
+	420, 0, 0, 448, 436, 437, 438, 435, 424, 0,
+	0, 416, 417, 0, 0, 0, 0, 418, 0, 0,
+	425, 94, 95, 0, 96, 0, 0, 0, 0, 430,
+	0, 0, 0, 97, 98, 198, 477, 478, 99, 479,
+	480, 0, 100, 203, 101, 445, 463, 481, 482, 0,
+	473, 0, 456, 0, 102, 103, 104, 0, 105, 0,
+	106, 0, 336, 107, 108, 0, 457, 459, 0, 458,
+	460, 109, 110, 111, 112, 483, 113, 484, 485, 0,
+	0, 114, 0, 0, 0, 476, 116, 0, 0, 0,
+	0, 429, 117, 118, 464, 443, 0, 0, 119, 120,
+	486, 121, 0, 0, 0, 337, 0, 122, 474, 0,
+	214, 0, 123, 470, 472, 0, 0, 0, 124, 338,
+	125, 487, 488, 489, 126, 0, 455, 0, 339, 127,
+	340, 128, 129, 130, 0, 0, 475, 341, 131, 342,
+	0, 132, 0, 0, 0, 133, 134, 135, 136, 137,
+	343, 138, 139, 419, 140, 444, 471, 141, 490, 142,
+	143, 0, 0, 0, 0, 0, 144, 224, 344, 145,
+	345, 465, 146, 147, 148, 149, 0, 466, 150, 227,
+	0, 151, 152, 491, 153, 154, 0, 155, 156, 157,
+	158, 159, 0, 160, 346, 161, 162, 163, 433, 164,
+	0, 165, 166, 167, 0, 168, 169, 461, 170, 171,
+	172, 347, 173, 492, 174, 0, 175, 177, 231, 176,
+	467, 0, 0, 178, 179, 0, 493, 494, 495, 0,
+	0, 180, 468, 469, 442, 181, 182, 183, 184, 0,
+	0, 185, 186, 462, 187, 0, 188, 189, 190, 191,
+	237, 496, 0, 192, 0, 0, 0, 0, 193, 194,
+	195, 196, 197, 420, 0, 0, 448, 436, 437, 438,
+	435, 424, 0, 0, 416, 417, 414, 0, 0, 0,
+	418, 0, 0, 425, 94, 95, 700, 96, 0, 0,
+	0, 0, 430, 0, 0, 0, 97, 98, 198, 477,
+	478, 99, 479, 480, 0, 100, 203, 101, 445, 463,
+	481, 482, 0, 473, 0, 456, 0, 102, 103, 104,
+	0, 105, 0, 106, 0, 336, 107, 108, 0, 457,
+	459, 0, 458, 460, 109, 110, 111, 112, 483, 113,
+	484, 485, 0, 0, 114, 0, 0, 0, 476, 116,
+	0, 0, 0, 0, 429, 117, 118, 464, 443, 0,
+	0, 119, 120, 486, 121, 0, 0, 0, 337, 0,
+	122, 474, 0, 214, 0, 123, 470, 472, 0, 0,
+	0, 124, 338, 125, 487, 488, 489, 126, 0, 455,
+	0, 339, 127, 340, 128, 129, 130, 0, 0, 475,
+	341, 131, 342, 0, 132, 0, 0, 0, 133, 134,
+	135, 136, 137, 343, 138, 139, 419, 140, 444, 471,
+	141, 490, 142, 143, 0, 0, 0, 0, 0, 144,
+	224, 344, 145, 345, 465, 146, 147, 148, 149, 0,
+	466, 150, 227, 0, 151, 152, 491, 153, 154, 0,
+	155, 156, 157, 158, 159, 0, 160, 346, 161, 162,
+	163, 433, 164, 0, 165, 166, 167, 0, 168, 169,
+	461, 170, 171, 172, 347, 173, 492, 174, 0, 175,
+	177, 231, 176, 467, 0, 0, 178, 179, 0, 493,
+	494, 495, 0, 0, 180, 468, 469, 442, 181, 182,
+	183, 184, 0, 0, 185, 186, 462, 187, 0, 188,
+	189, 190, 191, 237, 496, 0, 192, 0, 0, 0,
+	0, 193, 194, 195, 196, 197, 420, 0, 0, 448,
+	436, 437, 438, 435, 424, 0, 0, 416, 417, 0,
+	0, 0, 0, 418, 0, 0, 425, 94, 95, 0,
+	96, 0, 0, 0, 0, 430, 0, 0, 0, 97,
+	98, 198, 477, 478, 99, 479, 480, 0, 100, 203,
+	101, 445, 463, 481, 482, 0, 473, 0, 456, 0,
+	102, 103, 104, 0, 105, 0, 106, 0, 336, 107,
+	1712, 0, 457, 459, 0, 458, 460, 109, 110, 111,
+	112, 483, 113, 484, 485, 0, 0, 114, 0, 0,
+	0, 476, 116, 0, 0, 0, 0, 429, 117, 118,
+	464, 443, 0, 0, 119, 120, 486, 121, 0, 0,
+	0, 337, 0, 122, 474, 0, 214, 0, 123, 470,
+	472, 0, 0, 0, 124, 338, 125, 487, 488, 489,
+	126, 0, 455, 0, 339, 127, 340, 128, 129, 130,
+	0, 0, 475, 341, 131, 342, 0, 132, 0, 0,
+	0, 133, 134, 135, 136, 137, 343, 138, 139, 419,
+	140, 444, 471, 141, 490, 142, 143, 0, 0, 0,
+	0, 0, 144, 224, 344, 145, 345, 465, 146, 147,
+	148, 149, 0, 466, 150, 227, 0, 151, 152, 491,
+	153, 154, 0, 155, 156, 157, 158, 159, 0, 160,
+	346, 161, 162, 163, 433, 164, 0, 165, 166, 167,
+	0, 168, 169, 461, 170, 171, 172, 347, 173, 492,
+	174, 0, 175, 177, 231, 176, 467, 0, 0, 178,
+	179, 0, 493, 494, 495, 0, 0, 180, 468, 469,
+	442, 181, 182, 1711, 184, 0, 0, 185, 186, 462,
+	187, 0, 188, 189, 190, 191, 237, 496, 0, 192,
+	0, 0, 0, 0, 193, 194, 195, 196, 197, 420,
+	0, 0, 448, 436, 437, 438, 435, 424, 0, 0,
+	416, 417, 0, 0, 0, 0, 418, 0, 0, 425,
+	94, 95, 0, 96, 0, 0, 0, 0, 430, 0,
+	0, 0, 97, 98, 1710, 477, 478, 99, 479, 480,
+	0, 100, 203, 101, 445, 463, 481, 482, 0, 473,
+	0, 456, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 336, 107, 1712, 0, 457, 459, 0, 458, 460,
+	109, 110, 111, 112, 483, 113, 484, 485, 0, 0,
+	114, 0, 0, 0, 476, 116, 0, 0, 0, 0,
+	429, 117, 118, 464, 443, 0, 0, 119, 120, 486,
+	121, 0, 0, 0, 337, 0, 122, 474, 0, 214,
+	0, 123, 470, 472, 0, 0, 0, 124, 338, 125,
+	487, 488, 489, 126, 0, 455, 0, 339, 127, 340,
+	128, 129, 130, 0, 0, 475, 341, 131, 342, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 343,
+	138, 139, 419, 140, 444, 471, 141, 490, 142, 143,
+	0, 0, 0, 0, 0, 144, 224, 344, 145, 345,
+	465, 146, 147, 148, 149, 0, 466, 150, 227, 0,
+	151, 152, 491, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 346, 161, 162, 163, 433, 164, 0,
+	165, 166, 167, 0, 168, 169, 461, 170, 171, 172,
+	347, 173, 492, 174, 0, 175, 177, 231, 176, 467,
+	0, 0, 178, 179, 0, 493, 494, 495, 0, 0,
+	180, 468, 469, 442, 181, 182, 1711, 184, 0, 0,
+	185, 186, 462, 187, 0, 188, 189, 190, 191, 237,
+	496, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 420, 0, 0, 448, 436, 437, 438, 435,
+	424, 0, 0, 416, 417, 0, 0, 0, 0, 418,
+	0, 0, 425, 94, 95, 0, 96, 0, 0, 0,
+	0, 430, 0, 0, 0, 97, 98, 198, 477, 478,
+	99, 479, 480, 0, 100, 203, 101, 445, 463, 481,
+	482, 0, 473, 0, 456, 0, 102, 103, 104, 0,
+	105, 0, 106, 0, 336, 107, 108, 0, 457, 459,
+	0, 458, 460, 109, 110, 111, 112, 483, 113, 484,
+	485, 0, 0, 114, 0, 0, 0, 476, 116, 0,
+	0, 0, 0, 429, 117, 118, 464, 443, 0, 0,
+	119, 120, 486, 121, 0, 0, 0, 337, 0, 122,
+	474, 0, 214, 0, 123, 470, 472, 0, 0, 0,
+	124, 338, 125, 487, 488, 489, 126, 0, 455, 0,
+	339, 127, 340, 128, 129, 130, 0, 0, 475, 341,
+	131, 342, 0, 132, 0, 0, 0, 133, 134, 135,
+	136, 137, 343, 138, 139, 419, 140, 444, 471, 141,
+	490, 142, 143, 0, 0, 0, 0, 0, 144, 224,
+	344, 145, 345, 465, 146, 147, 148, 149, 0, 466,
+	150, 227, 0, 151, 152, 491, 153, 154, 0, 155,
+	156, 157, 158, 159, 0, 160, 346, 161, 162, 163,
+	433, 164, 0, 165, 166, 167, 0, 168, 169, 461,
+	170, 171, 172, 347, 173, 492, 174, 0, 175, 177,
+	231, 176, 467, 0, 0, 178, 179, 0, 493, 494,
+	495, 0, 0, 180, 468, 469, 442, 181, 182, 183,
+	184, 0, 0, 185, 186, 462, 187, 0, 188, 189,
+	190, 191, 237, 496, 0, 192, 0, 0, 0, 0,
+	193, 194, 195, 196, 197, 420, 0, 0, 448, 436,
+	437, 438, 435, 424, 0, 0, 416, 417, 0, 0,
+	0, 0, 418, 0, 0, 425, 94, 95, 0, 96,
+	0, 0, 0, 0, 430, 0, 0, 0, 97, 98,
+	198, 477, 478, 99, 479, 480, 0, 100, 203, 101,
+	445, 463, 481, 482, 0, 473, 0, 456, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 336, 107, 108,
+	0, 457, 459, 0, 458, 460, 109, 110, 111, 112,
+	483, 113, 484, 485, 0, 0, 114, 0, 0, 0,
+	476, 116, 0, 0, 0, 0, 429, 117, 118, 464,
+	443, 0, 0, 119, 120, 486, 121, 0, 0, 0,
+	337, 0, 122, 474, 0, 214, 0, 123, 470, 472,
+	0, 0, 0, 124, 338, 125, 487, 488, 489, 126,
+	0, 455, 0, 339, 127, 340, 128, 129, 130, 0,
+	0, 475, 341, 131, 342, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 343, 138, 139, 0, 140,
+	444, 471, 141, 490, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 344, 145, 345, 465, 146, 147, 148,
+	149, 0, 466, 150, 227, 0, 151, 152, 491, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 346,
+	161, 162, 163, 1065, 164, 0, 165, 166, 167, 0,
+	168, 169, 461, 170, 171, 172, 347, 173, 492, 174,
+	0, 175, 177, 231, 176, 467, 0, 0, 178, 179,
+	0, 493, 494, 495, 0, 0, 180, 468, 469, 442,
+	181, 182, 183, 184, 0, 0, 185, 186, 462, 187,
+	0, 188, 189, 190, 191, 237, 496, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 448, 436,
+	437, 438, 435, 424, 0, 0, 0, 0, 0, 1061,
+	1062, 0, 0, 0, 0, 1063, 94, 95, 1064, 96,
+	0, 0, 0, 0, 430, 0, 0, 0, 97, 98,
+	0, 477, 478, 99, 479, 480, 0, 100, 203, 101,
+	445, 463, 481, 482, 0, 473, 0, 456, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 336, 107, 1712,
+	0, 457, 459, 0, 458, 460, 109, 110, 111, 112,
+	483, 113, 484, 485, 0, 0, 114, 0, 0, 0,
+	476, 116, 0, 0, 0, 0, 429, 117, 118, 464,
+	443, 0, 0, 119, 120, 486, 121, 0, 0, 0,
+	337, 0, 122, 474, 0, 214, 0, 123, 470, 472,
+	0, 0, 0, 124, 338, 125, 487, 488, 489, 126,
+	0, 455, 0, 0, 127, 340, 128, 129, 130, 0,
+	0, 475, 341, 131, 0, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 343, 138, 139, 419, 140,
+	444, 471, 141, 490, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 344, 145, 345, 465, 146, 147, 148,
+	149, 0, 466, 150, 227, 0, 151, 152, 491, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 346,
+	161, 162, 163, 433, 164, 0, 165, 166, 167, 0,
+	168, 169, 461, 170, 171, 172, 0, 173, 492, 174,
+	0, 175, 177, 231, 176, 467, 0, 0, 178, 179,
+	0, 493, 494, 495, 0, 0, 180, 468, 469, 442,
+	181, 182, 1711, 184, 0, 0, 185, 186, 462, 187,
+	0, 188, 189, 190, 191, 237, 496, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 448, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 416,
+	417, 0, 0, 0, 0, 418, 94, 95, 425, 96,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 98,
+	198, 199, 200, 99, 201, 202, 0, 100, 203, 101,
+	0, 463, 204, 205, 0, 473, 0, 456, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 336, 107, 108,
+	0, 457, 459, 0, 458, 460, 109, 110, 111, 112,
+	207, 113, 208, 209, 0, 0, 114, 0, 0, 0,
+	115, 116, 0, 0, 0, 0, 210, 117, 118, 464,
+	0, 0, 0, 119, 120, 212, 121, 0, 0, 0,
+	337, 0, 122, 474, 0, 214, 0, 123, 470, 472,
+	0, 0, 0, 124, 338, 125, 217, 218, 219, 126,
+	0, 220, 0, 339, 127, 340, 128, 129, 130, 0,
+	0, 475, 341, 131, 342, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 343, 138, 139, 0, 140,
+	0, 471, 141, 223, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 344, 145, 345, 465, 146, 147, 148,
+	149, 0, 466, 150, 227, 0, 151, 152, 228, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 346,
+	161, 162, 163, 229, 164, 0, 165, 166, 167, 0,
+	168, 169, 461, 170, 171, 172, 347, 173, 230, 174,
+	0, 175, 177, 231, 176, 467, 0, 0, 178, 179,
+	0, 266, 233, 234, 0, 0, 180, 468, 469, 0,
+	181, 182, 183, 184, 0, 0, 185, 186, 462, 187,
+	448, 188, 189, 190, 191, 237, 238, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 94, 95,
+	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 198, 199, 200, 99, 201, 202, 1505, 100,
+	203, 101, 0, 0, 204, 205, 0, 206, 0, 335,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 336,
+	107, 108, 0, 0, 0, 0, 0, 0, 109, 110,
+	111, 112, 207, 113, 208, 209, 0, 0, 114, 0,
+	0, 0, 115, 116, 0, 0, 0, 0, 210, 117,
+	118, 211, 0, 0, 0, 119, 120, 212, 121, 0,
+	0, 0, 337, 0, 122, 213, 0, 214, 0, 123,
+	215, 216, 0, 0, 0, 124, 338, 125, 217, 218,
+	219, 126, 0, 220, 0, 339, 127, 340, 128, 129,
+	130, 0, 0, 221, 341, 131, 342, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 343, 138, 139,
+	0, 140, 0, 222, 141, 223, 142, 143, 0, 0,
+	301, 0, 0, 144, 224, 344, 145, 345, 225, 146,
+	147, 148, 149, 0, 226, 150, 227, 0, 151, 152,
+	228, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 346, 161, 162, 163, 229, 164, 0, 165, 166,
+	167, 50, 168, 169, 0, 170, 171, 172, 347, 173,
+	230, 174, 0, 175, 177, 231, 176, 232, 0, 52,
+	178, 179, 0, 266, 233, 234, 0, 0, 180, 235,
+	236, 0, 181, 182, 183, 184, 0, 0, 185, 186,
+	0, 187, 0, 188, 189, 190, 191, 334, 238, 0,
+	192, 330, 0, 0, 48, 193, 194, 195, 196, 197,
+	0, 49, 0, 0, 0, 0, 0, 0, 0, 94,
+	95, 0, 96, 0, 0, 0, 0, 0, 0, 0,
+	916, 97, 98, 198, 199, 200, 99, 201, 202, 0,
+	100, 203, 101, 0, 0, 204, 205, 0, 206, 0,
+	335, 0, 102, 103, 104, 0, 105, 0, 106, 0,
+	336, 107, 108, 0, 0, 0, 0, 0, 0, 109,
+	110, 111, 112, 207, 113, 208, 209, 0, 0, 114,
+	0, 0, 0, 115, 116, 0, 0, 0, 0, 210,
+	117, 118, 211, 0, 0, 0, 119, 120, 212, 121,
+	0, 0, 0, 337, 0, 122, 213, 0, 214, 0,
+	123, 215, 216, 0, 0, 0, 124, 338, 125, 217,
+	218, 219, 126, 0, 220, 0, 339, 127, 340, 128,
+	129, 130, 0, 0, 221, 341, 131, 342, 0, 132,
+	0, 0, 0, 133, 134, 135, 136, 137, 343, 138,
+	139, 0, 140, 0, 222, 141, 223, 142, 143, 0,
+	0, 0, 0, 0, 144, 224, 344, 145, 345, 225,
+	146, 147, 148, 149, 0, 226, 150, 227, 0, 151,
+	152, 228, 153, 154, 0, 155, 156, 157, 158, 159,
+	0, 160, 346, 161, 162, 163, 229, 164, 0, 165,
+	166, 167, 50, 168, 169, 0, 170, 171, 172, 347,
+	173, 230, 174, 0, 175, 177, 231, 176, 232, 0,
+	52, 178, 179, 0, 266, 233, 234, 0, 0, 180,
+	235, 236, 0, 181, 182, 183, 184, 0, 0, 185,
+	186, 0, 187, 0, 188, 189, 190, 191, 334, 238,
+	0, 192, 448, 0, 0, 48, 193, 194, 195, 196,
+	197, 0, 49, 0, 0, 0, 0, 0, 0, 0,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 47, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 335, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 336, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 0, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 0, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 337, 0, 122, 213, 0, 214,
+	0, 123, 215, 216, 0, 0, 0, 124, 338, 125,
+	217, 218, 219, 126, 0, 220, 0, 339, 127, 340,
+	128, 129, 130, 0, 0, 221, 341, 131, 342, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 343,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 0, 301, 0, 0, 144, 224, 344, 145, 345,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 346, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	347, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 0, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 0, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 330, 654, 658, 0, 659, 649, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	94, 95, 916, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 335, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 336, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 662, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 651, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 337, 0, 122, 213, 0, 214,
+	0, 123, 215, 216, 0, 0, 0, 124, 338, 125,
+	217, 218, 219, 126, 0, 220, 0, 339, 127, 340,
+	128, 129, 130, 0, 0, 221, 341, 131, 342, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 343,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 652, 0, 0, 0, 144, 224, 344, 145, 345,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 346, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	347, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 650, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 0, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 330, 654, 658, 0, 659, 649, 0, 0,
+	0, 0, 0, 660, 655, 0, 0, 0, 0, 0,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 335, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 336, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 645, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 651, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 337, 0, 122, 213, 0, 214,
+	0, 123, 215, 216, 0, 0, 0, 124, 338, 125,
+	217, 218, 219, 126, 0, 220, 0, 339, 127, 340,
+	128, 129, 130, 0, 0, 221, 341, 131, 342, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 343,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 652, 0, 0, 0, 144, 224, 344, 145, 345,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 346, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	347, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 650, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 0, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 330, 654, 658, 0, 659, 649, 0, 0,
+	0, 0, 0, 660, 655, 0, 0, 0, 0, 0,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 335, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 336, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 0, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 651, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 337, 0, 122, 213, 0, 214,
+	0, 123, 215, 216, 0, 0, 0, 124, 338, 125,
+	217, 218, 219, 126, 0, 220, 0, 339, 127, 340,
+	128, 129, 130, 0, 0, 221, 341, 131, 342, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 343,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 652, 0, 0, 0, 144, 224, 344, 145, 345,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 346, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	347, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 650, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 0, 188, 189, 190, 191, 237,
+	238, 91, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 0, 0, 0, 0, 0, 0, 0, 94,
+	95, 0, 96, 660, 655, 0, 0, 0, 0, 0,
+	0, 97, 98, 198, 199, 200, 99, 201, 202, 0,
+	100, 203, 101, 0, 0, 204, 205, 0, 206, 0,
+	0, 0, 102, 103, 104, 0, 105, 0, 106, 0,
+	0, 107, 108, 0, 0, 0, 0, 0, 0, 109,
+	110, 111, 112, 207, 113, 208, 209, 0, 0, 114,
+	0, 0, 0, 115, 116, 0, 0, 0, 0, 210,
+	117, 118, 211, 0, 0, 0, 119, 120, 212, 121,
+	0, 0, 0, 0, 0, 122, 213, 0, 214, 0,
+	123, 215, 216, 0, 0, 0, 124, 0, 125, 217,
+	218, 219, 126, 0, 220, 0, 0, 127, 0, 128,
+	129, 130, 0, 0, 221, 0, 131, 0, 0, 132,
+	0, 0, 0, 133, 134, 135, 136, 137, 0, 138,
+	139, 0, 140, 0, 222, 141, 223, 142, 143, 0,
+	0, 0, 0, 0, 144, 224, 0, 145, 0, 225,
+	146, 147, 148, 149, 0, 226, 150, 227, 0, 151,
+	152, 228, 153, 154, 0, 155, 156, 157, 158, 159,
+	0, 160, 0, 161, 162, 163, 229, 164, 0, 165,
+	166, 167, 50, 168, 169, 0, 170, 171, 172, 0,
+	173, 230, 174, 0, 175, 177, 231, 176, 232, 0,
+	52, 178, 179, 0, 266, 233, 234, 0, 0, 180,
+	235, 236, 0, 181, 182, 183, 184, 0, 0, 185,
+	186, 0, 187, 0, 188, 189, 190, 191, 334, 238,
+	0, 192, 91, 0, 0, 48, 193, 194, 195, 196,
+	197, 0, 49, 0, 0, 0, 0, 0, 0, 0,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 1173,
+	0, 47, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 0, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 0, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 0, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 0, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 0, 0, 122, 213, 0, 214,
+	0, 123, 215, 216, 0, 0, 0, 124, 0, 125,
+	217, 218, 219, 126, 0, 220, 0, 0, 127, 0,
+	128, 129, 130, 0, 0, 221, 0, 131, 0, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 0,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 0, 0, 0, 0, 144, 224, 0, 145, 0,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 0, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	0, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 0, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 0, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 91, 0, 0, 193, 194, 195,
+	196, 197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 94, 95, 0, 96, 0, 0, 0, 0,
+	0, 0, 0, 405, 97, 98, 198, 199, 200, 99,
+	201, 202, 0, 100, 203, 101, 0, 0, 204, 205,
+	0, 206, 0, 0, 0, 102, 103, 104, 0, 105,
+	0, 106, 0, 0, 107, 108, 0, 0, 0, 0,
+	0, 0, 109, 110, 111, 112, 207, 113, 208, 209,
+	0, 0, 114, 0, 0, 0, 115, 116, 0, 0,
+	0, 0, 210, 117, 118, 211, 0, 0, 0, 119,
+	120, 212, 121, 0, 0, 0, 0, 0, 122, 213,
+	0, 214, 0, 123, 215, 216, 0, 0, 0, 124,
+	0, 125, 217, 218, 219, 126, 0, 220, 0, 0,
+	127, 0, 128, 129, 130, 0, 0, 221, 0, 131,
+	0, 0, 132, 0, 0, 0, 133, 134, 135, 136,
+	137, 0, 138, 139, 0, 140, 0, 222, 141, 223,
+	142, 143, 0, 0, 0, 0, 0, 144, 224, 0,
+	145, 0, 225, 146, 147, 148, 149, 0, 226, 150,
+	227, 0, 151, 152, 228, 153, 154, 0, 155, 156,
+	157, 158, 159, 0, 160, 0, 161, 162, 163, 229,
+	164, 0, 165, 166, 167, 0, 168, 169, 0, 170,
+	171, 172, 0, 173, 230, 174, 0, 175, 177, 231,
+	176, 232, 0, 0, 178, 179, 0, 266, 233, 234,
+	0, 0, 180, 235, 236, 0, 181, 182, 183, 184,
+	0, 0, 185, 186, 0, 187, 91, 188, 189, 190,
+	191, 237, 238, 0, 192, 0, 0, 0, 0, 193,
+	194, 195, 196, 197, 94, 95, 0, 96, 0, 0,
+	0, 0, 0, 0, 0, 0, 97, 98, 198, 199,
+	200, 99, 201, 202, 856, 100, 203, 101, 0, 0,
+	204, 205, 0, 206, 0, 0, 0, 102, 103, 104,
+	0, 105, 0, 106, 0, 0, 107, 108, 0, 0,
+	0, 0, 0, 0, 109, 110, 111, 112, 207, 113,
+	208, 209, 0, 0, 114, 0, 0, 0, 115, 116,
+	0, 0, 0, 0, 210, 117, 118, 211, 0, 0,
+	0, 119, 120, 212, 121, 0, 0, 0, 0, 0,
+	122, 213, 0, 214, 0, 123, 215, 216, 0, 0,
+	0, 124, 0, 125, 217, 218, 219, 126, 0, 220,
+	0, 0, 127, 0, 128, 129, 130, 0, 0, 221,
+	0, 131, 0, 0, 132, 0, 0, 0, 133, 134,
+	135, 136, 137, 0, 138, 139, 0, 140, 0, 222,
+	141, 223, 142, 143, 0, 0, 0, 0, 0, 144,
+	224, 0, 145, 0, 225, 146, 147, 148, 149, 0,
+	226, 150, 227, 0, 151, 152, 228, 153, 154, 0,
+	155, 156, 157, 158, 159, 0, 160, 0, 161, 162,
+	163, 229, 164, 0, 165, 166, 167, 0, 168, 169,
+	0, 170, 171, 172, 0, 173, 230, 174, 0, 175,
+	177, 231, 176, 232, 0, 0, 178, 179, 0, 266,
+	233, 234, 0, 0, 180, 235, 236, 0, 181, 182,
+	183, 184, 0, 0, 185, 186, 0, 187, 91, 188,
+	189, 190, 191, 237, 238, 0, 192, 0, 0, 0,
+	0, 193, 194, 195, 196, 197, 94, 95, 0, 96,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 98,
+	198, 199, 200, 99, 201, 202, 1403, 100, 203, 101,
+	0, 0, 204, 205, 0, 206, 0, 0, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 0, 107, 108,
+	0, 0, 0, 0, 0, 0, 109, 110, 111, 112,
+	207, 113, 208, 209, 0, 0, 114, 0, 0, 0,
+	115, 116, 0, 0, 0, 0, 210, 117, 118, 211,
+	0, 0, 0, 119, 120, 212, 121, 0, 0, 0,
+	0, 0, 122, 213, 0, 214, 0, 123, 215, 216,
+	0, 0, 0, 124, 0, 125, 217, 218, 219, 126,
+	0, 220, 0, 0, 127, 0, 128, 129, 130, 0,
+	0, 221, 0, 131, 0, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 0, 138, 139, 0, 140,
+	0, 222, 141, 223, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 0, 145, 0, 225, 146, 147, 148,
+	149, 0, 226, 150, 227, 0, 151, 152, 228, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 0,
+	161, 162, 163, 229, 164, 0, 165, 166, 167, 0,
+	168, 169, 0, 170, 171, 172, 0, 173, 230, 174,
+	0, 175, 177, 231, 176, 232, 0, 0, 178, 179,
+	0, 266, 233, 234, 0, 0, 180, 235, 236, 0,
+	181, 182, 183, 184, 0, 0, 185, 186, 0, 187,
+	330, 188, 189, 190, 191, 237, 238, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 94, 95,
+	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 198, 199, 200, 99, 201, 202, 507, 100,
+	203, 101, 0, 0, 204, 205, 0, 206, 0, 335,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 336,
+	107, 108, 0, 0, 0, 0, 0, 0, 109, 110,
+	111, 112, 207, 113, 208, 209, 0, 0, 114, 0,
+	0, 0, 115, 116, 0, 0, 0, 0, 210, 117,
+	118, 211, 0, 0, 0, 119, 120, 212, 121, 0,
+	0, 0, 337, 0, 122, 213, 0, 214, 0, 123,
+	215, 216, 0, 0, 0, 124, 338, 125, 217, 218,
+	219, 126, 0, 220, 0, 339, 127, 340, 128, 129,
+	130, 0, 0, 221, 341, 131, 342, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 343, 138, 139,
+	0, 140, 0, 222, 141, 223, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 344, 145, 345, 225, 146,
+	147, 148, 149, 0, 226, 150, 227, 0, 151, 152,
+	228, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 346, 161, 162, 163, 229, 164, 0, 165, 166,
+	167, 0, 168, 169, 0, 170, 171, 172, 347, 173,
+	230, 174, 0, 175, 177, 231, 176, 232, 0, 0,
+	178, 179, 0, 266, 233, 234, 0, 0, 180, 235,
+	236, 0, 181, 182, 183, 184, 0, 0, 185, 186,
+	0, 187, 91, 188, 189, 190, 191, 237, 238, 0,
+	192, 0, 0, 0, 0, 193, 194, 195, 196, 197,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 829, 206,
+	0, 0, 0, 102, 103, 104, 0, 105, 827, 106,
+	0, 0, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 0, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 0, 894, 0, 119, 120, 212,
+	121, 0, 832, 0, 0, 0, 122, 213, 0, 214,
+	0, 123, 215, 216, 0, 892, 0, 124, 0, 125,
+	217, 218, 219, 126, 0, 220, 0, 0, 127, 0,
+	128, 129, 130, 0, 0, 221, 0, 131, 0, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 0,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 0, 0, 0, 0, 144, 224, 0, 145, 0,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 831,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 0, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	0, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 0, 181, 182, 183, 184, 0, 893,
+	185, 186, 0, 187, 91, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 94, 95, 0, 96, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 198, 199, 200, 99,
+	201, 202, 0, 100, 203, 101, 0, 0, 204, 205,
+	829, 206, 0, 0, 824, 102, 103, 104, 0, 105,
+	827, 106, 0, 0, 107, 108, 0, 0, 0, 0,
+	0, 0, 109, 110, 111, 112, 207, 113, 208, 209,
+	0, 0, 114, 0, 0, 0, 115, 116, 0, 0,
+	0, 0, 210, 117, 118, 211, 0, 0, 0, 119,
+	120, 212, 121, 0, 832, 0, 0, 0, 122, 213,
+	0, 214, 0, 123, 823, 216, 0, 0, 0, 124,
+	0, 125, 217, 218, 219, 126, 0, 220, 0, 0,
+	127, 0, 128, 129, 130, 0, 0, 221, 0, 131,
+	0, 0, 132, 0, 0, 0, 133, 134, 135, 136,
+	137, 0, 138, 139, 0, 140, 0, 222, 141, 223,
+	142, 143, 0, 0, 0, 0, 0, 144, 224, 0,
+	145, 0, 225, 146, 147, 148, 149, 0, 226, 150,
+	227, 831, 151, 152, 228, 153, 154, 0, 155, 156,
+	157, 158, 159, 0, 160, 0, 161, 162, 163, 229,
+	164, 0, 165, 166, 167, 0, 168, 169, 0, 170,
+	171, 172, 0, 173, 230, 174, 0, 175, 177, 231,
+	176, 232, 0, 0, 178, 179, 0, 266, 233, 234,
+	0, 0, 180, 235, 236, 0, 181, 182, 183, 184,
+	0, 830, 185, 186, 0, 187, 91, 188, 189, 190,
+	191, 237, 238, 0, 192, 0, 0, 0, 0, 193,
+	194, 195, 196, 197, 94, 95, 0, 96, 0, 0,
+	0, 0, 0, 1173, 0, 0, 97, 98, 198, 199,
+	200, 99, 201, 202, 0, 100, 203, 101, 0, 0,
+	204, 205, 0, 206, 0, 0, 0, 102, 103, 104,
+	0, 105, 0, 106, 0, 0, 107, 108, 0, 0,
+	0, 0, 0, 0, 109, 110, 111, 112, 207, 113,
+	208, 209, 0, 0, 114, 0, 0, 0, 115, 116,
+	0, 0, 0, 0, 210, 117, 118, 211, 0, 0,
+	0, 119, 120, 212, 121, 0, 0, 0, 0, 0,
+	122, 213, 0, 214, 0, 123, 215, 216, 0, 0,
+	0, 124, 0, 125, 217, 218, 219, 126, 0, 220,
+	0, 0, 127, 0, 128, 129, 130, 0, 0, 221,
+	0, 131, 0, 0, 132, 0, 0, 0, 133, 134,
+	135, 136, 137, 0, 138, 139, 0, 140, 0, 222,
+	141, 223, 142, 143, 0, 0, 0, 0, 0, 144,
+	224, 0, 145, 0, 225, 146, 147, 148, 149, 0,
+	226, 150, 227, 0, 151, 152, 228, 153, 154, 0,
+	155, 156, 157, 158, 159, 0, 160, 0, 161, 162,
+	163, 229, 164, 0, 165, 166, 167, 0, 168, 169,
+	0, 170, 171, 172, 0, 173, 230, 174, 0, 175,
+	177, 231, 176, 232, 0, 0, 178, 179, 0, 266,
+	233, 234, 0, 0, 180, 235, 236, 0, 181, 182,
+	183, 184, 0, 0, 185, 186, 0, 187, 91, 188,
+	189, 190, 191, 237, 238, 0, 192, 0, 0, 0,
+	0, 193, 194, 195, 196, 197, 94, 95, 0, 96,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 98,
+	198, 199, 200, 99, 201, 202, 0, 100, 203, 101,
+	0, 0, 204, 205, 0, 206, 0, 0, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 0, 107, 108,
+	0, 0, 0, 0, 0, 0, 109, 110, 111, 112,
+	207, 113, 208, 209, 0, 0, 114, 0, 0, 0,
+	115, 116, 0, 0, 0, 0, 210, 117, 118, 211,
+	0, 0, 0, 119, 120, 212, 121, 0, 0, 0,
+	0, 0, 122, 213, 0, 214, 0, 123, 215, 216,
+	0, 0, 0, 124, 0, 125, 217, 218, 219, 126,
+	0, 220, 0, 0, 127, 0, 128, 129, 130, 0,
+	0, 221, 0, 131, 0, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 0, 138, 139, 0, 140,
+	0, 222, 141, 223, 142, 143, 0, 0, 301, 0,
+	0, 144, 224, 0, 145, 0, 225, 146, 147, 148,
+	149, 0, 226, 150, 227, 0, 151, 152, 228, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 0,
+	161, 162, 163, 229, 164, 0, 165, 166, 167, 0,
+	168, 169, 0, 170, 171, 172, 0, 173, 230, 174,
+	0, 175, 177, 231, 176, 232, 0, 0, 178, 179,
+	0, 266, 233, 234, 0, 0, 180, 235, 236, 0,
+	181, 182, 183, 184, 0, 0, 185, 186, 0, 187,
+	91, 188, 189, 190, 191, 237, 238, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 94, 95,
+	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 198, 199, 200, 99, 201, 202, 0, 100,
+	203, 101, 0, 0, 204, 205, 0, 206, 0, 0,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 0,
+	107, 108, 0, 0, 0, 0, 0, 0, 109, 110,
+	550, 112, 207, 113, 208, 209, 0, 0, 114, 0,
+	0, 0, 115, 116, 0, 0, 0, 0, 210, 117,
+	118, 211, 0, 0, 0, 119, 120, 212, 121, 0,
+	0, 0, 0, 0, 122, 213, 0, 214, 0, 123,
+	215, 216, 0, 0, 0, 124, 0, 125, 217, 218,
+	219, 126, 0, 220, 0, 0, 127, 0, 128, 129,
+	130, 0, 0, 221, 0, 131, 0, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 0, 138, 139,
+	0, 140, 0, 222, 141, 223, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 0, 145, 0, 225, 146,
+	147, 148, 149, 0, 226, 150, 227, 0, 151, 152,
+	228, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 0, 161, 162, 163, 229, 164, 0, 165, 166,
+	167, 0, 168, 169, 0, 170, 171, 172, 0, 173,
+	230, 174, 0, 175, 177, 231, 176, 232, 0, 549,
+	178, 179, 0, 266, 233, 234, 0, 0, 180, 235,
+	236, 0, 181, 182, 183, 184, 0, 0, 185, 186,
+	0, 187, 91, 188, 189, 190, 191, 237, 238, 0,
+	192, 0, 0, 0, 0, 193, 194, 195, 196, 197,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 0, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 0, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 0, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 0, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 0, 0, 122, 213, 0, 214,
+	0, 123, 307, 216, 0, 0, 0, 124, 0, 125,
+	217, 218, 219, 126, 0, 220, 0, 0, 127, 0,
+	128, 129, 130, 0, 0, 221, 0, 131, 0, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 0,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 0, 301, 0, 0, 144, 224, 0, 145, 0,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 0, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	0, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 0, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 91, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 94, 95, 0, 96, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 198, 199, 200, 99,
+	201, 202, 0, 100, 203, 101, 0, 0, 204, 205,
+	0, 206, 0, 0, 0, 102, 103, 104, 0, 105,
+	0, 106, 0, 0, 107, 108, 0, 0, 0, 0,
+	0, 0, 109, 110, 111, 112, 207, 113, 208, 209,
+	0, 0, 114, 0, 0, 0, 115, 116, 0, 0,
+	0, 0, 210, 117, 118, 211, 0, 0, 0, 119,
+	120, 212, 121, 0, 0, 0, 0, 0, 122, 213,
+	0, 214, 0, 123, 215, 216, 0, 0, 0, 124,
+	0, 125, 217, 218, 219, 126, 0, 220, 0, 0,
+	127, 0, 128, 129, 130, 0, 0, 221, 0, 131,
+	0, 0, 132, 0, 0, 0, 133, 134, 135, 136,
+	137, 0, 138, 139, 0, 140, 0, 222, 141, 223,
+	142, 143, 0, 0, 0, 0, 0, 144, 224, 0,
+	145, 0, 225, 146, 147, 148, 149, 0, 226, 150,
+	227, 0, 151, 152, 228, 153, 154, 0, 155, 156,
+	157, 158, 159, 0, 160, 0, 161, 162, 163, 229,
+	164, 0, 165, 166, 167, 0, 168, 169, 0, 170,
+	171, 172, 0, 173, 230, 174, 0, 175, 177, 231,
+	176, 232, 0, 0, 178, 179, 0, 266, 233, 234,
+	0, 0, 180, 235, 236, 0, 181, 182, 183, 184,
+	0, 0, 185, 186, 0, 187, 91, 188, 189, 190,
+	191, 237, 238, 0, 192, 0, 0, 0, 0, 193,
+	194, 195, 196, 197, 94, 95, 0, 96, 0, 0,
+	0, 0, 0, 0, 0, 0, 97, 98, 198, 199,
+	200, 99, 201, 202, 0, 100, 203, 101, 0, 0,
+	204, 205, 0, 206, 0, 0, 0, 102, 103, 104,
+	0, 105, 0, 106, 0, 0, 107, 108, 0, 0,
+	0, 0, 0, 0, 109, 110, 111, 112, 207, 113,
+	208, 209, 0, 0, 114, 0, 0, 0, 115, 116,
+	0, 0, 0, 0, 210, 117, 118, 211, 0, 0,
+	0, 119, 120, 212, 121, 0, 0, 0, 0, 0,
+	122, 213, 0, 214, 0, 123, 1111, 216, 0, 0,
+	0, 124, 0, 125, 217, 218, 219, 126, 0, 220,
+	0, 0, 127, 0, 128, 129, 130, 0, 0, 221,
+	0, 131, 0, 0, 132, 0, 0, 0, 133, 134,
+	135, 136, 137, 0, 138, 139, 0, 140, 0, 222,
+	141, 223, 142, 143, 0, 0, 0, 0, 0, 144,
+	224, 0, 145, 0, 225, 146, 147, 148, 149, 0,
+	226, 150, 227, 0, 151, 152, 228, 153, 154, 0,
+	155, 156, 157, 158, 159, 0, 160, 0, 161, 162,
+	163, 229, 164, 0, 165, 166, 167, 0, 168, 169,
+	0, 170, 171, 172, 0, 173, 230, 174, 0, 175,
+	177, 231, 176, 232, 0, 0, 178, 179, 0, 266,
+	233, 234, 0, 0, 180, 235, 236, 0, 181, 182,
+	183, 184, 0, 0, 185, 186, 0, 187, 91, 188,
+	189, 190, 191, 237, 238, 0, 192, 0, 0, 0,
+	0, 193, 194, 195, 196, 197, 94, 95, 0, 96,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 98,
+	198, 199, 200, 99, 201, 202, 0, 100, 203, 101,
+	0, 0, 204, 205, 0, 206, 0, 0, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 0, 107, 108,
+	0, 0, 0, 0, 0, 0, 109, 110, 111, 112,
+	207, 113, 208, 209, 0, 0, 114, 0, 0, 0,
+	115, 116, 0, 0, 0, 0, 210, 117, 118, 211,
+	0, 0, 0, 119, 120, 212, 121, 0, 0, 0,
+	0, 0, 122, 213, 0, 214, 0, 123, 1109, 216,
+	0, 0, 0, 124, 0, 125, 217, 218, 219, 126,
+	0, 220, 0, 0, 127, 0, 128, 129, 130, 0,
+	0, 221, 0, 131, 0, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 0, 138, 139, 0, 140,
+	0, 222, 141, 223, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 0, 145, 0, 225, 146, 147, 148,
+	149, 0, 226, 150, 227, 0, 151, 152, 228, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 0,
+	161, 162, 163, 229, 164, 0, 165, 166, 167, 0,
+	168, 169, 0, 170, 171, 172, 0, 173, 230, 174,
+	0, 175, 177, 231, 176, 232, 0, 0, 178, 179,
+	0, 266, 233, 234, 0, 0, 180, 235, 236, 0,
+	181, 182, 183, 184, 0, 0, 185, 186, 0, 187,
+	91, 188, 189, 190, 191, 237, 238, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 94, 95,
+	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 198, 199, 200, 99, 201, 202, 0, 100,
+	203, 101, 0, 0, 204, 205, 0, 206, 0, 0,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 0,
+	107, 108, 0, 0, 0, 0, 0, 0, 109, 110,
+	111, 112, 207, 113, 208, 209, 0, 0, 114, 0,
+	0, 0, 115, 116, 0, 0, 0, 0, 210, 117,
+	118, 211, 0, 0, 0, 119, 120, 212, 121, 0,
+	0, 0, 0, 0, 122, 213, 0, 214, 0, 123,
+	1100, 216, 0, 0, 0, 124, 0, 125, 217, 218,
+	219, 126, 0, 220, 0, 0, 127, 0, 128, 129,
+	130, 0, 0, 221, 0, 131, 0, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 0, 138, 139,
+	0, 140, 0, 222, 141, 223, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 0, 145, 0, 225, 146,
+	147, 148, 149, 0, 226, 150, 227, 0, 151, 152,
+	228, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 0, 161, 162, 163, 229, 164, 0, 165, 166,
+	167, 0, 168, 169, 0, 170, 171, 172, 0, 173,
+	230, 174, 0, 175, 177, 231, 176, 232, 0, 0,
+	178, 179, 0, 266, 233, 234, 0, 0, 180, 235,
+	236, 0, 181, 182, 183, 184, 0, 0, 185, 186,
+	0, 187, 91, 188, 189, 190, 191, 237, 238, 0,
+	192, 0, 0, 0, 0, 193, 194, 195, 196, 197,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 0, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 0, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 0, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 0, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 0, 0, 122, 213, 0, 214,
+	0, 123, 686, 216, 0, 0, 0, 124, 0, 125,
+	217, 218, 219, 126, 0, 220, 0, 0, 127, 0,
+	128, 129, 130, 0, 0, 221, 0, 131, 0, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 0,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 0, 0, 0, 0, 144, 224, 0, 145, 0,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 0, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	0, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 0, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 91, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 94, 95, 0, 96, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 198, 199, 200, 99,
+	201, 202, 0, 100, 203, 101, 0, 0, 204, 205,
+	0, 206, 0, 0, 0, 102, 103, 104, 0, 105,
+	0, 106, 0, 0, 107, 108, 0, 0, 0, 0,
+	0, 0, 109, 110, 111, 112, 207, 113, 208, 209,
+	0, 0, 114, 0, 0, 0, 115, 116, 0, 0,
+	0, 0, 210, 117, 118, 211, 0, 0, 0, 119,
+	120, 212, 121, 0, 0, 0, 0, 0, 122, 213,
+	0, 214, 0, 123, 215, 216, 0, 0, 0, 124,
+	0, 125, 217, 218, 219, 126, 0, 220, 0, 0,
+	127, 0, 128, 129, 130, 0, 0, 221, 0, 131,
+	0, 0, 132, 0, 0, 0, 133, 134, 135, 136,
+	137, 0, 138, 139, 0, 140, 0, 222, 141, 223,
+	142, 143, 0, 0, 0, 0, 0, 144, 224, 0,
+	145, 0, 225, 146, 147, 148, 149, 0, 226, 150,
+	227, 0, 151, 152, 228, 153, 154, 0, 155, 156,
+	157, 158, 159, 0, 160, 0, 161, 162, 163, 229,
+	164, 0, 679, 166, 167, 0, 168, 169, 0, 170,
+	171, 172, 0, 173, 230, 174, 0, 175, 177, 231,
+	176, 232, 0, 0, 178, 179, 0, 266, 233, 234,
+	0, 0, 180, 235, 236, 0, 181, 182, 183, 184,
+	0, 0, 185, 186, 0, 187, 91, 188, 189, 190,
+	191, 237, 238, 0, 192, 0, 0, 0, 0, 193,
+	194, 195, 196, 197, 94, 95, 0, 96, 0, 0,
+	0, 0, 0, 535, 0, 0, 97, 98, 198, 199,
+	200, 99, 201, 202, 0, 100, 203, 101, 0, 0,
+	204, 205, 0, 206, 0, 0, 0, 102, 103, 104,
+	0, 105, 0, 106, 0, 0, 107, 108, 0, 0,
+	0, 0, 0, 0, 109, 110, 111, 112, 207, 113,
+	208, 209, 0, 0, 114, 0, 0, 0, 115, 116,
+	0, 0, 0, 0, 210, 117, 118, 211, 0, 0,
+	0, 119, 120, 212, 121, 0, 0, 0, 0, 0,
+	122, 213, 0, 214, 0, 123, 215, 216, 0, 0,
+	0, 124, 0, 125, 217, 218, 219, 126, 0, 220,
+	0, 0, 127, 0, 128, 129, 130, 0, 0, 221,
+	0, 131, 0, 0, 132, 0, 0, 0, 133, 134,
+	135, 136, 137, 0, 138, 139, 0, 140, 0, 222,
+	141, 223, 142, 143, 0, 0, 0, 0, 0, 144,
+	224, 0, 145, 0, 225, 146, 147, 148, 149, 0,
+	226, 150, 227, 0, 151, 152, 228, 153, 154, 0,
+	155, 156, 157, 158, 159, 0, 160, 0, 161, 162,
+	163, 229, 164, 0, 165, 166, 167, 0, 168, 169,
+	0, 0, 171, 172, 0, 173, 230, 174, 0, 175,
+	177, 231, 176, 232, 0, 0, 178, 179, 0, 266,
+	233, 234, 0, 0, 180, 235, 236, 0, 181, 182,
+	183, 184, 0, 0, 185, 186, 0, 187, 91, 188,
+	189, 190, 191, 237, 238, 0, 192, 0, 0, 0,
+	0, 193, 194, 195, 196, 197, 94, 95, 0, 96,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 98,
+	198, 199, 200, 99, 201, 202, 0, 100, 203, 101,
+	0, 0, 204, 205, 0, 206, 0, 0, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 0, 107, 108,
+	0, 0, 0, 0, 0, 0, 109, 110, 111, 112,
+	207, 113, 208, 209, 0, 0, 114, 0, 0, 0,
+	115, 116, 0, 0, 0, 0, 210, 117, 118, 211,
+	0, 0, 0, 119, 120, 212, 121, 0, 0, 0,
+	0, 0, 122, 213, 0, 214, 0, 123, 388, 216,
+	0, 0, 0, 124, 0, 125, 217, 218, 219, 126,
+	0, 220, 0, 0, 127, 0, 128, 129, 130, 0,
+	0, 221, 0, 131, 0, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 0, 138, 139, 0, 140,
+	0, 222, 141, 223, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 0, 145, 0, 225, 146, 147, 148,
+	149, 0, 226, 150, 227, 0, 151, 152, 228, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 0,
+	161, 162, 163, 229, 164, 0, 165, 166, 167, 0,
+	168, 169, 0, 170, 171, 172, 0, 173, 230, 174,
+	0, 175, 177, 231, 176, 232, 0, 0, 178, 179,
+	0, 266, 233, 234, 0, 0, 180, 235, 236, 0,
+	181, 182, 183, 184, 0, 0, 185, 186, 0, 187,
+	91, 188, 189, 190, 191, 237, 238, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 94, 95,
+	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 198, 199, 200, 99, 201, 202, 0, 100,
+	203, 101, 0, 0, 204, 205, 0, 206, 0, 0,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 0,
+	107, 108, 0, 0, 0, 0, 0, 0, 109, 110,
+	111, 112, 207, 113, 208, 209, 0, 0, 114, 0,
+	0, 0, 115, 116, 0, 0, 0, 0, 210, 117,
+	118, 211, 0, 0, 0, 119, 120, 212, 121, 0,
+	0, 0, 0, 0, 122, 213, 0, 214, 0, 123,
+	383, 216, 0, 0, 0, 124, 0, 125, 217, 218,
+	219, 126, 0, 220, 0, 0, 127, 0, 128, 129,
+	130, 0, 0, 221, 0, 131, 0, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 0, 138, 139,
+	0, 140, 0, 222, 141, 223, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 0, 145, 0, 225, 146,
+	147, 148, 149, 0, 226, 150, 227, 0, 151, 152,
+	228, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 0, 161, 162, 163, 229, 164, 0, 165, 166,
+	167, 0, 168, 169, 0, 170, 171, 172, 0, 173,
+	230, 174, 0, 175, 177, 231, 176, 232, 0, 0,
+	178, 179, 0, 266, 233, 234, 0, 0, 180, 235,
+	236, 0, 181, 182, 183, 184, 0, 0, 185, 186,
+	0, 187, 91, 188, 189, 190, 191, 237, 238, 0,
+	192, 0, 0, 0, 0, 193, 194, 195, 196, 197,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 0, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 0, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 0, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 0, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 0, 0, 122, 213, 0, 214,
+	0, 123, 215, 216, 0, 0, 0, 124, 0, 125,
+	217, 218, 219, 126, 0, 220, 0, 0, 127, 0,
+	128, 129, 130, 0, 0, 221, 0, 131, 0, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 249, 0,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 0, 0, 0, 0, 144, 224, 0, 145, 0,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 153, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 0, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	0, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 248, 233, 234, 0, 0,
+	244, 235, 236, 0, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 91, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 94, 95, 0, 96, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 198, 199, 200, 99,
+	201, 202, 0, 100, 203, 101, 0, 0, 204, 205,
+	0, 206, 0, 0, 0, 102, 103, 104, 0, 105,
+	0, 106, 0, 0, 107, 108, 0, 0, 0, 0,
+	0, 0, 109, 110, 111, 112, 207, 113, 208, 209,
+	0, 0, 114, 0, 0, 0, 115, 116, 0, 0,
+	0, 0, 210, 117, 118, 211, 0, 0, 0, 119,
+	120, 212, 121, 0, 0, 0, 0, 0, 122, 213,
+	0, 214, 0, 123, 322, 216, 0, 0, 0, 124,
+	0, 125, 217, 218, 219, 126, 0, 220, 0, 0,
+	127, 0, 128, 129, 130, 0, 0, 221, 0, 131,
+	0, 0, 132, 0, 0, 0, 133, 134, 135, 136,
+	137, 0, 138, 139, 0, 140, 0, 222, 141, 223,
+	142, 143, 0, 0, 0, 0, 0, 144, 224, 0,
+	145, 0, 225, 146, 147, 148, 149, 0, 226, 150,
+	227, 0, 151, 152, 228, 153, 154, 0, 155, 156,
+	157, 158, 159, 0, 160, 0, 161, 162, 163, 229,
+	164, 0, 165, 166, 167, 0, 168, 169, 0, 170,
+	171, 172, 0, 173, 230, 174, 0, 175, 177, 231,
+	176, 232, 0, 0, 178, 179, 0, 266, 233, 234,
+	0, 0, 180, 235, 236, 0, 181, 182, 183, 184,
+	0, 0, 185, 186, 0, 187, 91, 188, 189, 190,
+	191, 237, 238, 0, 192, 0, 0, 0, 0, 193,
+	194, 195, 196, 197, 94, 95, 0, 96, 0, 0,
+	0, 0, 0, 0, 0, 0, 97, 98, 198, 199,
+	200, 99, 201, 202, 0, 100, 203, 101, 0, 0,
+	204, 205, 0, 206, 0, 0, 0, 102, 103, 104,
+	0, 105, 0, 106, 0, 0, 107, 108, 0, 0,
+	0, 0, 0, 0, 109, 110, 111, 112, 207, 113,
+	208, 209, 0, 0, 114, 0, 0, 0, 115, 116,
+	0, 0, 0, 0, 210, 117, 118, 211, 0, 0,
+	0, 119, 120, 212, 121, 0, 0, 0, 0, 0,
+	122, 213, 0, 214, 0, 123, 319, 216, 0, 0,
+	0, 124, 0, 125, 217, 218, 219, 126, 0, 220,
+	0, 0, 127, 0, 128, 129, 130, 0, 0, 221,
+	0, 131, 0, 0, 132, 0, 0, 0, 133, 134,
+	135, 136, 137, 0, 138, 139, 0, 140, 0, 222,
+	141, 223, 142, 143, 0, 0, 0, 0, 0, 144,
+	224, 0, 145, 0, 225, 146, 147, 148, 149, 0,
+	226, 150, 227, 0, 151, 152, 228, 153, 154, 0,
+	155, 156, 157, 158, 159, 0, 160, 0, 161, 162,
+	163, 229, 164, 0, 165, 166, 167, 0, 168, 169,
+	0, 170, 171, 172, 0, 173, 230, 174, 0, 175,
+	177, 231, 176, 232, 0, 0, 178, 179, 0, 266,
+	233, 234, 0, 0, 180, 235, 236, 0, 181, 182,
+	183, 184, 0, 0, 185, 186, 0, 187, 91, 188,
+	189, 190, 191, 237, 238, 0, 192, 0, 0, 0,
+	0, 193, 194, 195, 196, 197, 94, 95, 0, 96,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 98,
+	198, 199, 200, 99, 201, 202, 0, 100, 203, 101,
+	0, 0, 204, 205, 0, 206, 0, 0, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 0, 107, 108,
+	0, 0, 0, 0, 0, 0, 109, 110, 111, 112,
+	207, 113, 208, 209, 0, 0, 114, 0, 0, 0,
+	115, 116, 0, 0, 0, 0, 210, 117, 118, 211,
+	0, 0, 0, 119, 120, 212, 121, 0, 0, 0,
+	0, 0, 122, 213, 0, 214, 0, 123, 317, 216,
+	0, 0, 0, 124, 0, 125, 217, 218, 219, 126,
+	0, 220, 0, 0, 127, 0, 128, 129, 130, 0,
+	0, 221, 0, 131, 0, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 0, 138, 139, 0, 140,
+	0, 222, 141, 223, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 0, 145, 0, 225, 146, 147, 148,
+	149, 0, 226, 150, 227, 0, 151, 152, 228, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 0,
+	161, 162, 163, 229, 164, 0, 165, 166, 167, 0,
+	168, 169, 0, 170, 171, 172, 0, 173, 230, 174,
+	0, 175, 177, 231, 176, 232, 0, 0, 178, 179,
+	0, 266, 233, 234, 0, 0, 180, 235, 236, 0,
+	181, 182, 183, 184, 0, 0, 185, 186, 0, 187,
+	91, 188, 189, 190, 191, 237, 238, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 94, 95,
+	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 198, 199, 200, 99, 201, 202, 0, 100,
+	203, 101, 0, 0, 204, 205, 0, 206, 0, 0,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 0,
+	107, 108, 0, 0, 0, 0, 0, 0, 109, 110,
+	111, 112, 207, 113, 208, 209, 0, 0, 114, 0,
+	0, 0, 115, 116, 0, 0, 0, 0, 210, 117,
+	118, 211, 0, 0, 0, 119, 120, 212, 121, 0,
+	0, 0, 0, 0, 122, 213, 0, 214, 0, 123,
+	311, 216, 0, 0, 0, 124, 0, 125, 217, 218,
+	219, 126, 0, 220, 0, 0, 127, 0, 128, 129,
+	130, 0, 0, 221, 0, 131, 0, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 0, 138, 139,
+	0, 140, 0, 222, 141, 223, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 0, 145, 0, 225, 146,
+	147, 148, 149, 0, 226, 150, 227, 0, 151, 152,
+	228, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 0, 161, 162, 163, 229, 164, 0, 165, 166,
+	167, 0, 168, 169, 0, 170, 171, 172, 0, 173,
+	230, 174, 0, 175, 177, 231, 176, 232, 0, 0,
+	178, 179, 0, 266, 233, 234, 0, 0, 180, 235,
+	236, 0, 181, 182, 183, 184, 0, 0, 185, 186,
+	0, 187, 91, 188, 189, 190, 191, 237, 238, 0,
+	192, 0, 0, 0, 0, 193, 194, 195, 196, 197,
+	94, 95, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 198, 199, 200, 99, 201, 202,
+	0, 100, 203, 101, 0, 0, 204, 205, 0, 206,
+	0, 0, 0, 102, 103, 104, 0, 105, 0, 106,
+	0, 0, 107, 108, 0, 0, 0, 0, 0, 0,
+	109, 110, 111, 112, 207, 113, 208, 209, 0, 0,
+	114, 0, 0, 0, 115, 116, 0, 0, 0, 0,
+	210, 117, 118, 211, 0, 0, 0, 119, 120, 212,
+	121, 0, 0, 0, 0, 0, 122, 213, 0, 214,
+	0, 123, 215, 216, 0, 0, 0, 124, 0, 125,
+	217, 218, 219, 126, 0, 220, 0, 0, 127, 0,
+	128, 129, 130, 0, 0, 221, 0, 131, 0, 0,
+	132, 0, 0, 0, 133, 134, 135, 136, 137, 0,
+	138, 139, 0, 140, 0, 222, 141, 223, 142, 143,
+	0, 0, 0, 0, 0, 144, 224, 0, 145, 0,
+	225, 146, 147, 148, 149, 0, 226, 150, 227, 0,
+	151, 152, 228, 290, 154, 0, 155, 156, 157, 158,
+	159, 0, 160, 0, 161, 162, 163, 229, 164, 0,
+	165, 166, 167, 0, 168, 169, 0, 170, 171, 172,
+	0, 173, 230, 174, 0, 175, 177, 231, 176, 232,
+	0, 0, 178, 179, 0, 266, 233, 234, 0, 0,
+	180, 235, 236, 0, 181, 182, 183, 184, 0, 0,
+	185, 186, 0, 187, 91, 188, 189, 190, 191, 237,
+	238, 0, 192, 0, 0, 0, 0, 193, 194, 195,
+	196, 197, 94, 95, 0, 96, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 198, 199, 200, 99,
+	201, 202, 0, 100, 203, 101, 0, 0, 204, 205,
+	0, 206, 0, 0, 0, 102, 103, 104, 0, 105,
+	0, 106, 0, 0, 107, 108, 0, 0, 0, 0,
+	0, 0, 109, 110, 111, 112, 207, 113, 208, 209,
+	0, 0, 114, 0, 0, 0, 115, 116, 0, 0,
+	0, 0, 210, 117, 118, 211, 0, 0, 0, 119,
+	120, 212, 121, 0, 0, 0, 0, 0, 122, 213,
+	0, 214, 0, 123, 215, 216, 0, 0, 0, 124,
+	0, 125, 217, 218, 219, 126, 0, 220, 0, 0,
+	127, 0, 128, 129, 130, 0, 0, 221, 0, 131,
+	0, 0, 132, 0, 0, 0, 133, 134, 135, 136,
+	137, 0, 138, 139, 0, 140, 0, 222, 141, 223,
+	142, 143, 0, 0, 0, 0, 0, 144, 224, 0,
+	145, 0, 225, 146, 147, 148, 149, 0, 226, 150,
+	227, 0, 151, 152, 228, 153, 154, 0, 155, 156,
+	157, 158, 159, 0, 160, 0, 161, 162, 163, 229,
+	164, 0, 267, 166, 167, 0, 168, 169, 0, 170,
+	171, 172, 0, 173, 230, 174, 0, 175, 177, 231,
+	176, 232, 0, 0, 178, 179, 0, 266, 233, 234,
+	0, 0, 180, 235, 236, 0, 181, 182, 183, 184,
+	0, 0, 185, 186, 0, 187, 91, 188, 189, 190,
+	191, 237, 238, 0, 192, 0, 0, 0, 0, 193,
+	194, 195, 196, 197, 94, 95, 0, 96, 0, 0,
+	0, 0, 0, 0, 0, 0, 97, 98, 198, 199,
+	200, 99, 201, 202, 0, 100, 203, 101, 0, 0,
+	204, 205, 0, 206, 0, 0, 0, 102, 103, 104,
+	0, 105, 0, 106, 0, 0, 107, 108, 0, 0,
+	0, 0, 0, 0, 109, 110, 111, 112, 207, 113,
+	208, 209, 0, 0, 114, 0, 0, 0, 115, 116,
+	0, 0, 0, 0, 210, 117, 118, 211, 0, 0,
+	0, 119, 120, 212, 121, 0, 0, 0, 0, 0,
+	122, 213, 0, 214, 0, 123, 215, 216, 0, 0,
+	0, 124, 0, 125, 217, 218, 219, 126, 0, 220,
+	0, 0, 127, 0, 128, 129, 130, 0, 0, 221,
+	0, 131, 0, 0, 242, 0, 0, 0, 133, 134,
+	135, 136, 249, 0, 138, 139, 0, 140, 0, 222,
+	141, 223, 142, 143, 0, 0, 0, 0, 0, 144,
+	224, 0, 145, 0, 225, 146, 147, 148, 149, 0,
+	226, 150, 227, 0, 151, 152, 228, 153, 154, 0,
+	155, 156, 157, 158, 159, 0, 160, 0, 161, 162,
+	163, 229, 164, 0, 165, 166, 167, 0, 168, 243,
+	0, 170, 171, 172, 0, 173, 230, 174, 0, 175,
+	177, 231, 176, 232, 0, 0, 178, 179, 0, 248,
+	233, 234, 0, 0, 244, 235, 236, 0, 181, 182,
+	183, 184, 0, 0, 185, 186, 0, 187, 91, 188,
+	189, 190, 191, 237, 238, 0, 192, 0, 0, 0,
+	0, 193, 194, 195, 196, 197, 94, 95, 0, 96,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 98,
+	198, 199, 200, 99, 201, 202, 0, 100, 203, 101,
+	0, 0, 204, 205, 0, 206, 0, 0, 0, 102,
+	103, 104, 0, 105, 0, 106, 0, 0, 107, 108,
+	0, 0, 0, 0, 0, 0, 109, 110, 111, 112,
+	207, 113, 208, 209, 0, 0, 114, 0, 0, 0,
+	115, 116, 0, 0, 0, 0, 210, 117, 118, 211,
+	0, 0, 0, 119, 120, 212, 121, 0, 0, 0,
+	0, 0, 122, 213, 0, 214, 0, 123, 215, 216,
+	0, 0, 0, 124, 0, 125, 217, 218, 219, 126,
+	0, 220, 0, 0, 127, 0, 128, 129, 130, 0,
+	0, 221, 0, 131, 0, 0, 132, 0, 0, 0,
+	133, 134, 135, 136, 137, 0, 138, 139, 0, 140,
+	0, 222, 141, 223, 142, 143, 0, 0, 0, 0,
+	0, 144, 224, 0, 145, 0, 225, 146, 147, 148,
+	149, 0, 226, 150, 227, 0, 151, 152, 228, 153,
+	154, 0, 155, 156, 157, 158, 159, 0, 160, 0,
+	161, 162, 163, 229, 164, 0, 165, 166, 167, 0,
+	168, 169, 0, 170, 171, 172, 0, 173, 230, 174,
+	0, 175, 177, 231, 176, 232, 0, 0, 178, 179,
+	0, 88, 233, 234, 0, 0, 180, 235, 236, 0,
+	181, 182, 183, 184, 0, 0, 185, 186, 0, 187,
+	91, 188, 189, 190, 191, 237, 238, 0, 192, 0,
+	0, 0, 0, 193, 194, 195, 196, 197, 94, 95,
+	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 198, 199, 200, 99, 201, 202, 0, 100,
+	203, 101, 0, 0, 204, 205, 0, 206, 0, 0,
+	0, 102, 103, 104, 0, 105, 0, 106, 0, 0,
+	107, 108, 0, 0, 0, 0, 0, 0, 109, 110,
+	111, 112, 207, 113, 208, 209, 0, 0, 114, 0,
+	0, 0, 115, 116, 0, 0, 0, 0, 210, 117,
+	118, 211, 0, 0, 0, 119, 120, 212, 121, 0,
+	0, 0, 0, 0, 122, 213, 0, 214, 0, 123,
+	215, 216, 0, 0, 0, 124, 0, 125, 217, 218,
+	219, 126, 0, 220, 0, 0, 127, 0, 128, 129,
+	130, 0, 0, 221, 0, 131, 0, 0, 132, 0,
+	0, 0, 133, 134, 135, 136, 137, 0, 138, 139,
+	0, 140, 0, 222, 141, 223, 142, 143, 0, 0,
+	0, 0, 0, 144, 224, 0, 145, 0, 225, 146,
+	147, 0, 149, 0, 226, 150, 227, 0, 0, 152,
+	228, 153, 154, 0, 155, 156, 157, 158, 159, 0,
+	160, 0, 161, 162, 163, 229, 0, 0, 165, 166,
+	167, 0, 168, 169, 0, 170, 171, 172, 0, 173,
+	230, 174, 0, 175, 177, 231, 176, 232, 0, 0,
+	178, 179, 0, 266, 233, 234, 0, 0, 180, 235,
+	236, 0, 181, 182, 183, 184, 0, 0, 185, 186,
+	0, 187, 0, 188, 189, 190, 191, 237, 238, 0,
+	192, 0, 0, 0, 0, 193, 194, 195, 196, 197,
+	715, 0, 731, 732, 733, 735, 736, 737, 740, 741,
+	742, 0, 0, 0, 0, 0, 0, 0, 743, 0,
+	0, 0, 0, 0, 717, 0, 749, 715, 0, 731,
+	732, 733, 735, 736, 737, 740, 741, 742, 0, 0,
+	0, 0, 716, 0, 0, 743, 0, 0, 730, 0,
+	0, 717, 0, 749, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 716,
+	0, 0, 0, 0, 0, 730, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 750, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 715, 748,
+	731, 732, 733, 735, 736, 737, 740, 741, 742, 0,
+	745, 0, 0, 750, 0, 738, 743, 0, 0, 0,
+	0, 0, 717, 0, 749, 0, 748, 0, 0, 0,
+	0, 0, 0, 0, 0, 744, 0, 745, 0, 0,
+	716, 0, 738, 0, 0, 0, 730, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 744, 0, 0, 0, 0, 0, 0, 0,
+	0, 739, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 747, 0, 715, 0, 731, 732, 733, 735,
+	736, 737, 740, 741, 742, 0, 0, 0, 739, 0,
+	0, 0, 743, 0, 750, 0, 0, 0, 717, 747,
+	749, 0, 0, 0, 0, 0, 0, 748, 0, 0,
+	0, 0, 0, 0, 0, 0, 716, 0, 745, 0,
+	0, 0, 730, 738, 746, 0, 727, 728, 729, 0,
+	734, 726, 723, 724, 725, 718, 719, 720, 721, 722,
+	0, 0, 0, 744, 0, 1654, 0, 0, 0, 0,
+	0, 746, 0, 727, 728, 729, 0, 734, 726, 723,
+	724, 725, 718, 719, 720, 721, 722, 0, 0, 0,
+	0, 0, 1629, 0, 0, 0, 0, 0, 0, 739,
+	750, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	747, 0, 715, 748, 731, 732, 733, 735, 736, 737,
+	740, 741, 742, 0, 745, 0, 0, 0, 0, 738,
+	743, 0, 0, 0, 0, 0, 717, 0, 749, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 744,
+	0, 0, 0, 0, 716, 0, 0, 0, 0, 0,
+	730, 0, 746, 0, 727, 728, 729, 0, 734, 726,
+	723, 724, 725, 718, 719, 720, 721, 722, 0, 0,
+	0, 0, 0, 1624, 0, 739, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 747, 0, 715, 0,
+	731, 732, 733, 735, 736, 737, 740, 741, 742, 0,
+	0, 0, 0, 0, 0, 0, 743, 0, 750, 0,
+	0, 0, 717, 0, 749, 0, 0, 0, 0, 0,
+	0, 748, 0, 0, 0, 0, 0, 0, 0, 0,
+	716, 0, 745, 0, 0, 0, 730, 738, 746, 0,
+	727, 728, 729, 0, 734, 726, 723, 724, 725, 718,
+	719, 720, 721, 722, 0, 0, 0, 744, 0, 1620,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 715, 0, 731, 732, 733, 735,
+	736, 737, 740, 741, 742, 0, 0, 0, 0, 0,
+	0, 0, 743, 739, 750, 0, 0, 0, 717, 0,
+	749, 0, 0, 0, 747, 0, 0, 748, 0, 0,
+	0, 0, 0, 0, 0, 0, 716, 0, 745, 0,
+	0, 0, 730, 738, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 744, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 746, 0, 727, 728,
+	729, 0, 734, 726, 723, 724, 725, 718, 719, 720,
+	721, 722, 0, 0, 0, 0, 0, 1561, 0, 739,
+	750, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	747, 0, 715, 748, 731, 732, 733, 735, 736, 737,
+	740, 741, 742, 0, 745, 0, 0, 0, 0, 738,
+	743, 0, 0, 0, 0, 0, 717, 0, 749, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 744,
+	0, 0, 0, 0, 716, 0, 0, 0, 0, 0,
+	730, 0, 746, 0, 727, 728, 729, 0, 734, 726,
+	723, 724, 725, 718, 719, 720, 721, 722, 0, 0,
+	0, 0, 0, 1560, 0, 739, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 747, 0, 715, 0,
+	731, 732, 733, 735, 736, 737, 740, 741, 742, 0,
+	0, 0, 0, 0, 0, 0, 743, 0, 750, 0,
+	0, 0, 717, 0, 749, 0, 0, 0, 0, 0,
+	0, 748, 0, 0, 0, 0, 0, 0, 0, 0,
+	716, 0, 745, 0, 0, 0, 730, 738, 746, 0,
+	727, 728, 729, 0, 734, 726, 723, 724, 725, 718,
+	719, 720, 721, 722, 0, 0, 0, 744, 0, 1528,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 715, 0, 731, 732, 733, 735,
+	736, 737, 740, 741, 742, 0, 0, 0, 0, 0,
+	0, 0, 743, 739, 750, 0, 0, 0, 717, 0,
+	749, 0, 0, 0, 747, 0, 0, 748, 0, 0,
+	0, 0, 0, 0, 0, 0, 716, 0, 745, 0,
+	0, 0, 730, 738, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 744, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 746, 0, 727, 728,
+	729, 0, 734, 726, 723, 724, 725, 718, 719, 720,
+	721, 722, 0, 0, 0, 0, 0, 1527, 0, 739,
+	750, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	747, 0, 715, 748, 731, 732, 733, 735, 736, 737,
+	740, 741, 742, 0, 745, 0, 0, 0, 0, 738,
+	743, 0, 0, 0, 0, 0, 717, 0, 749, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 744,
+	0, 0, 0, 0, 716, 0, 0, 0, 0, 0,
+	730, 0, 746, 0, 727, 728, 729, 0, 734, 726,
+	723, 724, 725, 718, 719, 720, 721, 722, 0, 0,
+	0, 0, 0, 1475, 0, 739, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 747, 0, 715, 0,
+	731, 732, 733, 735, 736, 737, 740, 741, 742, 0,
+	0, 0, 0, 0, 0, 0, 743, 0, 750, 0,
+	0, 0, 717, 0, 749, 0, 0, 0, 0, 0,
+	0, 748, 0, 0, 0, 0, 0, 0, 0, 0,
+	716, 0, 745, 0, 0, 0, 730, 738, 746, 0,
+	727, 728, 729, 0, 734, 726, 723, 724, 725, 718,
+	719, 720, 721, 722, 0, 0, 0, 744, 0, 1406,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1446,
+	0, 0, 0, 0, 715, 0, 731, 732, 733, 735,
+	736, 737, 740, 741, 742, 0, 0, 0, 0, 0,
+	0, 0, 743, 739, 750, 0, 0, 0, 717, 0,
+	749, 0, 0, 0, 747, 0, 0, 748, 0, 0,
+	0, 0, 0, 0, 0, 0, 716, 0, 745, 0,
+	0, 0, 730, 738, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 527, 0, 0, 0, 0, 0, 0, 0,
-	0, 827, 0, 0, 89, 90, 532, 91, 533, 534,
-	535, 536, 537, 538, 539, 540, 92, 93, 174, 175,
-	176, 94, 177, 178, 541, 95, 179, 96, 542, 543,
-	180, 181, 544, 182, 545, 293, 546, 97, 98, 99,
-	0, 100, 547, 101, 548, 294, 102, 103, 549, 550,
-	551, 552, 553, 554, 104, 105, 106, 107, 183, 108,
-	184, 185, 555, 556, 109, 557, 558, 559, 110, 111,
-	560, 561, 683, 562, 186, 112, 187, 563, 564, 113,
-	114, 188, 115, 565, 566, 567, 295, 568, 116, 189,
-	569, 190, 570, 117, 191, 192, 571, 572, 573, 296,
-	118, 193, 194, 195, 574, 196, 575, 297, 119, 298,
-	120, 576, 577, 197, 299, 121, 300, 578, 250, 579,
-	580, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	581, 128, 582, 198, 129, 199, 130, 131, 583, 584,
-	585, 586, 587, 132, 200, 302, 133, 303, 201, 134,
-	135, 588, 202, 136, 203, 589, 137, 138, 204, 139,
-	140, 590, 141, 142, 143, 591, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 592, 150, 252, 593, 151,
-	152, 305, 153, 206, 154, 594, 155, 157, 207, 156,
-	208, 595, 596, 158, 159, 597, 254, 209, 598, 599,
-	253, 210, 211, 600, 160, 161, 162, 163, 601, 602,
-	164, 165, 603, 604, 166, 167, 168, 212, 213, 605,
-	169, 606, 607, 608, 609, 170, 171, 172, 173, 0,
-	527, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 732, 89, 90, 532, 91, 533, 534, 535, 536,
-	537, 538, 539, 540, 92, 93, 174, 175, 176, 94,
-	177, 178, 541, 95, 179, 96, 542, 543, 180, 181,
-	544, 182, 545, 293, 546, 97, 98, 99, 0, 100,
-	547, 101, 548, 294, 102, 103, 549, 550, 551, 552,
-	553, 554, 104, 105, 106, 107, 183, 108, 184, 185,
-	555, 556, 109, 557, 558, 559, 110, 111, 560, 561,
-	0, 562, 186, 112, 187, 563, 564, 113, 114, 188,
-	115, 565, 566, 567, 295, 568, 116, 189, 569, 190,
-	570, 117, 191, 192, 571, 572, 573, 296, 118, 193,
-	194, 195, 574, 196, 575, 297, 119, 298, 120, 576,
-	577, 197, 299, 121, 300, 578, 250, 579, 580, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 581, 128,
-	582, 198, 129, 199, 130, 131, 583, 584, 585, 586,
-	587, 132, 200, 302, 133, 303, 201, 134, 135, 588,
-	202, 136, 203, 589, 137, 138, 204, 139, 140, 590,
-	141, 142, 143, 591, 144, 304, 145, 146, 205, 147,
-	0, 148, 149, 592, 150, 252, 593, 151, 152, 305,
-	153, 206, 154, 594, 155, 157, 207, 156, 208, 595,
-	596, 158, 159, 597, 254, 209, 598, 599, 253, 210,
-	211, 600, 160, 161, 162, 163, 601, 602, 164, 165,
-	603, 604, 166, 167, 168, 212, 213, 605, 169, 606,
-	607, 608, 609, 170, 171, 172, 173, 396, 384, 385,
-	386, 383, 372, 0, 0, 0, 0, 0, 0, 89,
-	90, 0, 91, 0, 0, 0, 0, 378, 0, 0,
-	0, 92, 93, 174, 425, 426, 94, 427, 428, 0,
-	95, 179, 96, 393, 411, 429, 430, 0, 421, 0,
-	404, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	294, 102, 103, 0, 405, 407, 0, 406, 408, 104,
-	105, 106, 107, 431, 108, 432, 433, 462, 0, 109,
-	0, 0, 0, 424, 111, 0, 0, 0, 0, 377,
-	112, 412, 391, 0, 113, 114, 434, 115, 0, 0,
-	0, 295, 0, 116, 422, 0, 190, 0, 117, 418,
-	420, 0, 0, 0, 296, 118, 435, 436, 437, 0,
-	403, 0, 297, 119, 298, 120, 0, 0, 423, 299,
-	121, 300, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 301, 126, 127, 367, 128, 392, 419, 129,
-	438, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	302, 133, 303, 413, 134, 135, 0, 414, 136, 203,
-	0, 137, 138, 439, 139, 140, 0, 141, 142, 143,
-	0, 144, 304, 145, 146, 381, 147, 0, 148, 149,
-	43, 150, 252, 409, 151, 152, 305, 153, 440, 154,
-	0, 155, 157, 207, 156, 415, 0, 45, 158, 159,
-	0, 254, 441, 0, 0, 253, 416, 417, 390, 160,
-	161, 162, 163, 0, 0, 164, 165, 410, 0, 166,
-	167, 168, 292, 442, 0, 169, 0, 0, 0, 41,
-	170, 171, 172, 173, 368, 42, 396, 384, 385, 386,
-	383, 372, 0, 0, 364, 365, 0, 0, 89, 90,
-	366, 91, 0, 373, 0, 0, 378, 0, 0, 0,
-	92, 93, 174, 425, 426, 94, 427, 428, 0, 95,
-	179, 96, 393, 411, 429, 430, 0, 421, 0, 404,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 294,
-	102, 103, 0, 405, 407, 0, 406, 408, 104, 105,
-	106, 107, 431, 108, 432, 433, 0, 0, 109, 0,
-	0, 0, 424, 111, 0, 0, 0, 0, 377, 112,
-	412, 391, 0, 113, 114, 434, 115, 0, 0, 0,
-	295, 0, 116, 422, 0, 190, 0, 117, 418, 420,
-	0, 0, 0, 296, 118, 435, 436, 437, 0, 403,
-	0, 297, 119, 298, 120, 0, 0, 423, 299, 121,
-	300, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 301, 126, 127, 367, 128, 392, 419, 129, 438,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 302,
-	133, 303, 413, 134, 135, 0, 414, 136, 203, 0,
-	137, 138, 439, 139, 140, 0, 141, 142, 143, 0,
-	144, 304, 145, 146, 381, 147, 0, 148, 149, 43,
-	150, 252, 409, 151, 152, 305, 153, 440, 154, 0,
-	155, 157, 207, 156, 415, 0, 45, 158, 159, 0,
-	254, 441, 0, 0, 253, 416, 417, 390, 160, 161,
-	162, 163, 0, 0, 164, 165, 410, 0, 166, 167,
-	168, 292, 442, 0, 169, 0, 0, 0, 41, 170,
-	171, 172, 173, 368, 42, 396, 384, 385, 386, 383,
-	372, 0, 0, 364, 365, 0, 0, 89, 90, 366,
-	91, 0, 373, 0, 0, 378, 0, 0, 0, 92,
-	93, 174, 425, 426, 94, 427, 428, 967, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 972, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	297, 119, 298, 120, 0, 968, 423, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 305, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 969, 253, 416, 417, 390, 160, 161, 162,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 368, 0, 396, 384, 385, 386, 383, 372,
-	0, 0, 364, 365, 0, 0, 89, 90, 366, 91,
-	0, 373, 0, 0, 378, 0, 0, 0, 92, 93,
-	174, 425, 426, 94, 427, 428, 0, 95, 179, 96,
-	393, 411, 429, 430, 0, 421, 0, 404, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 294, 102, 103,
-	0, 405, 407, 0, 406, 408, 104, 105, 106, 107,
-	431, 108, 432, 433, 0, 0, 109, 0, 0, 0,
-	424, 111, 0, 0, 0, 0, 377, 112, 412, 391,
-	0, 113, 114, 434, 115, 0, 0, 0, 295, 0,
-	116, 422, 0, 190, 0, 117, 418, 420, 0, 0,
-	0, 296, 118, 435, 436, 437, 0, 403, 0, 297,
-	119, 298, 120, 0, 0, 423, 299, 121, 300, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 301,
-	126, 127, 367, 128, 392, 419, 129, 438, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 302, 133, 303,
-	413, 134, 135, 0, 414, 136, 203, 0, 137, 138,
-	439, 139, 140, 0, 141, 142, 143, 0, 144, 304,
-	145, 146, 381, 147, 0, 148, 149, 0, 150, 252,
-	409, 151, 152, 305, 153, 440, 154, 0, 155, 157,
-	207, 156, 415, 0, 0, 158, 159, 0, 254, 441,
-	0, 0, 253, 416, 417, 390, 160, 161, 162, 163,
-	0, 0, 164, 165, 410, 0, 166, 167, 168, 212,
-	442, 0, 169, 0, 0, 0, 0, 170, 171, 172,
-	173, 368, 0, 396, 384, 385, 386, 383, 372, 0,
-	0, 364, 365, 0, 0, 89, 90, 366, 91, 0,
-	373, 1302, 0, 378, 0, 0, 0, 92, 93, 174,
-	425, 426, 94, 427, 428, 0, 95, 179, 96, 393,
-	411, 429, 430, 0, 421, 0, 404, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 294, 102, 103, 0,
-	405, 407, 0, 406, 408, 104, 105, 106, 107, 431,
-	108, 432, 433, 0, 0, 109, 0, 0, 0, 424,
-	111, 0, 0, 0, 0, 377, 112, 412, 391, 0,
-	113, 114, 434, 115, 0, 0, 0, 295, 0, 116,
-	422, 0, 190, 0, 117, 418, 420, 0, 0, 0,
-	296, 118, 435, 436, 437, 0, 403, 0, 297, 119,
-	298, 120, 0, 0, 423, 299, 121, 300, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 301, 126,
-	127, 367, 128, 392, 419, 129, 438, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 302, 133, 303, 413,
-	134, 135, 0, 414, 136, 203, 0, 137, 138, 439,
-	139, 140, 0, 141, 142, 143, 0, 144, 304, 145,
-	146, 381, 147, 0, 148, 149, 0, 150, 252, 409,
-	151, 152, 305, 153, 440, 154, 0, 155, 157, 207,
-	156, 415, 0, 0, 158, 159, 0, 254, 441, 0,
-	0, 253, 416, 417, 390, 160, 161, 162, 163, 0,
-	0, 164, 165, 410, 0, 166, 167, 168, 212, 442,
-	0, 169, 0, 0, 0, 0, 170, 171, 172, 173,
-	368, 0, 396, 384, 385, 386, 383, 372, 0, 0,
-	364, 365, 0, 0, 89, 90, 366, 91, 0, 373,
-	1245, 0, 378, 0, 0, 0, 92, 93, 174, 425,
-	426, 94, 427, 428, 0, 95, 179, 96, 393, 411,
-	429, 430, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 431, 108,
-	432, 433, 0, 0, 109, 0, 0, 0, 424, 111,
-	0, 0, 0, 0, 377, 112, 412, 391, 0, 113,
-	114, 434, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 435, 436, 437, 0, 403, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	367, 128, 392, 419, 129, 438, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 439, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	381, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 440, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 441, 0, 0,
-	253, 416, 417, 390, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 442, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 368,
-	0, 396, 384, 385, 386, 383, 372, 0, 0, 364,
-	365, 0, 0, 89, 90, 366, 91, 0, 373, 918,
-	0, 378, 0, 0, 0, 92, 93, 174, 425, 426,
-	94, 427, 428, 0, 95, 179, 96, 393, 411, 429,
-	430, 0, 421, 0, 404, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 294, 102, 103, 0, 405, 407,
-	0, 406, 408, 104, 105, 106, 107, 431, 108, 432,
-	433, 0, 0, 109, 0, 0, 0, 424, 111, 0,
-	0, 0, 0, 377, 112, 412, 391, 0, 113, 114,
-	434, 115, 0, 0, 0, 295, 0, 116, 422, 0,
-	190, 0, 117, 418, 420, 0, 0, 0, 296, 118,
-	435, 436, 437, 0, 403, 0, 297, 119, 298, 120,
-	0, 0, 423, 299, 121, 300, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 301, 126, 127, 367,
-	128, 392, 419, 129, 438, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 302, 133, 303, 413, 134, 135,
-	0, 414, 136, 203, 0, 137, 138, 439, 139, 140,
-	0, 141, 142, 143, 0, 144, 304, 145, 146, 381,
-	147, 0, 148, 149, 0, 150, 252, 409, 151, 152,
-	305, 153, 440, 154, 0, 155, 157, 207, 156, 415,
-	0, 0, 158, 159, 0, 254, 441, 0, 0, 253,
-	416, 417, 390, 160, 161, 162, 163, 0, 0, 164,
-	165, 410, 0, 166, 167, 168, 212, 442, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 364, 365,
-	0, 0, 0, 0, 366, 689, 914, 373, 396, 384,
-	385, 386, 383, 372, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 0, 0, 0, 378, 0,
-	0, 0, 92, 93, 174, 425, 426, 94, 427, 428,
-	0, 95, 179, 96, 393, 411, 429, 430, 0, 421,
-	0, 404, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 405, 407, 0, 406, 408,
-	104, 105, 106, 107, 431, 108, 432, 433, 0, 0,
-	109, 0, 0, 0, 424, 111, 0, 0, 0, 0,
-	377, 112, 412, 391, 0, 113, 114, 434, 115, 0,
-	0, 0, 295, 0, 116, 422, 0, 190, 0, 117,
-	418, 420, 0, 0, 0, 296, 118, 435, 436, 437,
-	0, 403, 0, 297, 119, 298, 120, 0, 0, 423,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 367, 128, 392, 419,
-	129, 438, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 413, 134, 135, 0, 414, 136,
-	203, 0, 137, 138, 439, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 381, 147, 0, 148,
-	149, 0, 150, 252, 409, 151, 152, 305, 153, 440,
-	154, 0, 155, 157, 207, 156, 415, 0, 0, 158,
-	159, 0, 254, 441, 0, 0, 253, 416, 417, 390,
-	160, 161, 162, 163, 0, 0, 164, 165, 410, 0,
-	166, 167, 168, 212, 442, 1251, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 368, 0, 396, 384, 385,
-	386, 383, 372, 0, 0, 364, 365, 0, 0, 89,
-	90, 366, 91, 0, 373, 0, 0, 378, 0, 0,
-	0, 92, 93, 174, 425, 426, 94, 427, 428, 0,
-	95, 179, 96, 393, 411, 429, 430, 0, 421, 0,
-	404, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	294, 102, 103, 0, 405, 407, 0, 406, 408, 104,
-	105, 106, 107, 431, 108, 432, 433, 462, 0, 109,
-	0, 0, 0, 424, 111, 0, 0, 0, 0, 377,
-	112, 412, 391, 0, 113, 114, 434, 115, 0, 0,
-	0, 295, 0, 116, 422, 0, 190, 0, 117, 418,
-	420, 0, 0, 0, 296, 118, 435, 436, 437, 0,
-	403, 0, 297, 119, 298, 120, 0, 0, 423, 299,
-	121, 300, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 301, 126, 127, 367, 128, 392, 419, 129,
-	438, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	302, 133, 303, 413, 134, 135, 0, 414, 136, 203,
-	0, 137, 138, 439, 139, 140, 0, 141, 142, 143,
-	0, 144, 304, 145, 146, 381, 147, 0, 148, 149,
-	0, 150, 252, 409, 151, 152, 305, 153, 440, 154,
-	0, 155, 157, 207, 156, 415, 0, 0, 158, 159,
-	0, 254, 441, 0, 0, 253, 416, 417, 390, 160,
-	161, 162, 163, 0, 0, 164, 165, 410, 0, 166,
-	167, 168, 212, 442, 0, 169, 0, 0, 0, 0,
-	170, 171, 172, 173, 368, 0, 396, 384, 385, 386,
-	383, 372, 0, 0, 364, 365, 0, 0, 89, 90,
-	366, 91, 0, 373, 0, 0, 378, 0, 0, 0,
-	92, 93, 174, 425, 426, 94, 427, 428, 0, 95,
-	179, 96, 393, 411, 429, 430, 0, 421, 0, 404,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 294,
-	102, 103, 0, 405, 407, 0, 406, 408, 104, 105,
-	106, 107, 431, 108, 432, 433, 0, 0, 109, 0,
-	0, 0, 424, 111, 0, 0, 0, 0, 377, 112,
-	412, 391, 0, 113, 114, 434, 115, 0, 0, 972,
-	295, 0, 116, 422, 0, 190, 0, 117, 418, 420,
-	0, 0, 0, 296, 118, 435, 436, 437, 0, 403,
-	0, 297, 119, 298, 120, 0, 0, 423, 299, 121,
-	300, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 301, 126, 127, 367, 128, 392, 419, 129, 438,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 302,
-	133, 303, 413, 134, 135, 0, 414, 136, 203, 0,
-	137, 138, 439, 139, 140, 0, 141, 142, 143, 0,
-	144, 304, 145, 146, 381, 147, 0, 148, 149, 0,
-	150, 252, 409, 151, 152, 305, 153, 440, 154, 0,
-	155, 157, 207, 156, 415, 0, 0, 158, 159, 0,
-	254, 441, 0, 0, 253, 416, 417, 390, 160, 161,
-	162, 163, 0, 0, 164, 165, 410, 0, 166, 167,
-	168, 212, 442, 0, 169, 0, 0, 0, 0, 170,
-	171, 172, 173, 368, 0, 396, 384, 385, 386, 383,
-	372, 0, 0, 364, 365, 0, 0, 89, 90, 366,
-	91, 0, 373, 0, 0, 378, 0, 0, 0, 92,
-	93, 174, 425, 426, 94, 427, 428, 0, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 0, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	297, 119, 298, 120, 0, 0, 423, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 305, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 0, 253, 416, 417, 390, 160, 161, 162,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 364, 365, 362, 0, 0, 0, 366, 0,
-	0, 373, 396, 384, 385, 386, 383, 372, 0, 0,
-	0, 0, 0, 0, 89, 90, 630, 91, 0, 0,
-	0, 0, 378, 0, 0, 0, 92, 93, 174, 425,
-	426, 94, 427, 428, 0, 95, 179, 96, 393, 411,
-	429, 430, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 431, 108,
-	432, 433, 0, 0, 109, 0, 0, 0, 424, 111,
-	0, 0, 0, 0, 377, 112, 412, 391, 0, 113,
-	114, 434, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 435, 436, 437, 0, 403, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	367, 128, 392, 419, 129, 438, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 439, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	381, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 440, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 441, 0, 0,
-	253, 416, 417, 390, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 442, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 368,
-	0, 396, 384, 385, 386, 383, 372, 0, 0, 364,
-	365, 0, 0, 89, 90, 366, 91, 0, 373, 0,
-	0, 378, 0, 0, 0, 92, 93, 174, 425, 426,
-	94, 427, 428, 0, 95, 179, 96, 393, 411, 429,
-	430, 0, 421, 0, 404, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 294, 102, 1564, 0, 405, 407,
-	0, 406, 408, 104, 105, 106, 107, 431, 108, 432,
-	433, 0, 0, 109, 0, 0, 0, 424, 111, 0,
-	0, 0, 0, 377, 112, 412, 391, 0, 113, 114,
-	434, 115, 0, 0, 0, 295, 0, 116, 422, 0,
-	190, 0, 117, 418, 420, 0, 0, 0, 296, 118,
-	435, 436, 437, 0, 403, 0, 297, 119, 298, 120,
-	0, 0, 423, 299, 121, 300, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 301, 126, 127, 367,
-	128, 392, 419, 129, 438, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 302, 133, 303, 413, 134, 135,
-	0, 414, 136, 203, 0, 137, 138, 439, 139, 140,
-	0, 141, 142, 143, 0, 144, 304, 145, 146, 381,
-	147, 0, 148, 149, 0, 150, 252, 409, 151, 152,
-	305, 153, 440, 154, 0, 155, 157, 207, 156, 415,
-	0, 0, 158, 159, 0, 254, 441, 0, 0, 253,
-	416, 417, 390, 160, 161, 1563, 163, 0, 0, 164,
-	165, 410, 0, 166, 167, 168, 212, 442, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 368, 0,
-	396, 384, 385, 386, 383, 372, 0, 0, 364, 365,
-	0, 0, 89, 90, 366, 91, 0, 373, 0, 0,
-	378, 0, 0, 0, 92, 93, 1562, 425, 426, 94,
-	427, 428, 0, 95, 179, 96, 393, 411, 429, 430,
-	0, 421, 0, 404, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 294, 102, 1564, 0, 405, 407, 0,
-	406, 408, 104, 105, 106, 107, 431, 108, 432, 433,
-	0, 0, 109, 0, 0, 0, 424, 111, 0, 0,
-	0, 0, 377, 112, 412, 391, 0, 113, 114, 434,
-	115, 0, 0, 0, 295, 0, 116, 422, 0, 190,
-	0, 117, 418, 420, 0, 0, 0, 296, 118, 435,
-	436, 437, 0, 403, 0, 297, 119, 298, 120, 0,
-	0, 423, 299, 121, 300, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 367, 128,
-	392, 419, 129, 438, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 302, 133, 303, 413, 134, 135, 0,
-	414, 136, 203, 0, 137, 138, 439, 139, 140, 0,
-	141, 142, 143, 0, 144, 304, 145, 146, 381, 147,
-	0, 148, 149, 0, 150, 252, 409, 151, 152, 305,
-	153, 440, 154, 0, 155, 157, 207, 156, 415, 0,
-	0, 158, 159, 0, 254, 441, 0, 0, 253, 416,
-	417, 390, 160, 161, 1563, 163, 0, 0, 164, 165,
-	410, 0, 166, 167, 168, 212, 442, 0, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 368, 0, 396,
-	384, 385, 386, 383, 372, 0, 0, 364, 365, 0,
-	0, 89, 90, 366, 91, 0, 373, 0, 0, 378,
-	0, 0, 0, 92, 93, 174, 425, 426, 94, 427,
-	428, 0, 95, 179, 96, 393, 411, 429, 430, 0,
-	421, 0, 404, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 294, 102, 103, 0, 405, 407, 0, 406,
-	408, 104, 105, 106, 107, 431, 108, 432, 433, 0,
-	0, 109, 0, 0, 0, 424, 111, 0, 0, 0,
-	0, 377, 112, 412, 391, 0, 113, 114, 434, 115,
-	0, 0, 0, 295, 0, 116, 422, 0, 190, 0,
-	117, 418, 420, 0, 0, 0, 296, 118, 435, 436,
-	437, 0, 403, 0, 297, 119, 298, 120, 0, 0,
-	423, 299, 121, 300, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 301, 126, 127, 367, 128, 392,
-	419, 129, 438, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 302, 133, 303, 413, 134, 135, 0, 414,
-	136, 203, 0, 137, 138, 439, 139, 140, 0, 141,
-	142, 143, 0, 144, 304, 145, 146, 381, 147, 0,
-	148, 149, 0, 150, 252, 409, 151, 152, 305, 153,
-	440, 154, 0, 155, 157, 207, 156, 415, 0, 0,
-	158, 159, 0, 254, 441, 0, 0, 253, 416, 417,
-	390, 160, 161, 162, 163, 0, 0, 164, 165, 410,
-	0, 166, 167, 168, 212, 442, 0, 169, 0, 0,
-	0, 0, 170, 171, 172, 173, 368, 0, 396, 384,
-	385, 386, 383, 372, 0, 0, 364, 365, 0, 0,
-	89, 90, 366, 91, 0, 373, 0, 0, 378, 0,
-	0, 0, 92, 93, 174, 425, 426, 94, 427, 428,
-	0, 95, 179, 96, 393, 411, 429, 430, 0, 421,
-	0, 404, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 405, 407, 0, 406, 408,
-	104, 105, 106, 107, 431, 108, 432, 433, 0, 0,
-	109, 0, 0, 0, 424, 111, 0, 0, 0, 0,
-	377, 112, 412, 391, 0, 113, 114, 434, 115, 0,
-	0, 0, 295, 0, 116, 422, 0, 190, 0, 117,
-	418, 420, 0, 0, 0, 296, 118, 435, 436, 437,
-	0, 403, 0, 297, 119, 298, 120, 0, 0, 423,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 392, 419,
-	129, 438, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 413, 134, 135, 0, 414, 136,
-	203, 0, 137, 138, 439, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 962, 147, 0, 148,
-	149, 0, 150, 252, 409, 151, 152, 305, 153, 440,
-	154, 0, 155, 157, 207, 156, 415, 0, 0, 158,
-	159, 0, 254, 441, 0, 0, 253, 416, 417, 390,
-	160, 161, 162, 163, 0, 0, 164, 165, 410, 0,
-	166, 167, 168, 212, 442, 0, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 396, 384, 385, 386, 383,
-	372, 0, 0, 0, 0, 958, 959, 89, 90, 0,
-	91, 960, 0, 0, 961, 378, 0, 0, 0, 92,
-	93, 0, 425, 426, 94, 427, 428, 0, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	1564, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 0, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	0, 119, 298, 120, 0, 0, 423, 299, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 0, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 0, 253, 416, 417, 390, 160, 161, 1563,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 396, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 364, 365, 89, 90, 0, 91, 366, 0,
-	0, 373, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 411,
-	180, 181, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 412, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 193, 194, 195, 0, 196, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	0, 128, 0, 419, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 206, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 416, 417, 0, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 213, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 288,
+	0, 0, 0, 744, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 746, 0, 727, 728,
+	729, 0, 734, 726, 723, 724, 725, 718, 719, 720,
+	721, 722, 0, 0, 0, 0, 0, 1381, 0, 739,
+	750, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	747, 0, 0, 748, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 745, 0, 0, 0, 0, 738,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 1362, 0,
-	0, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 293, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 294, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 295, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 296, 118, 193, 194,
-	195, 0, 196, 0, 297, 119, 298, 120, 0, 0,
-	197, 299, 121, 300, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 301, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 302, 133, 303, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 304, 145, 146, 205, 147, 0,
-	148, 149, 43, 150, 252, 0, 151, 152, 305, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 45,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 0, 164, 165, 0,
-	0, 166, 167, 168, 292, 213, 0, 169, 0, 0,
-	0, 41, 170, 171, 172, 173, 0, 42, 288, 507,
-	511, 0, 512, 502, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 40, 0, 0, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 293, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 515, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 504, 0, 113, 114, 188, 115, 0,
-	0, 0, 295, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 296, 118, 193, 194, 195,
-	0, 196, 0, 297, 119, 298, 120, 0, 0, 197,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 505, 0, 0, 0, 132,
-	200, 302, 133, 303, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 305, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 503,
-	160, 161, 162, 163, 0, 0, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 288, 507, 511, 0, 512,
-	502, 0, 0, 0, 0, 513, 508, 89, 90, 0,
-	91, 0, 0, 0, 0, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 293, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 498, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	504, 0, 113, 114, 188, 115, 0, 0, 0, 295,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	0, 0, 296, 118, 193, 194, 195, 0, 196, 0,
-	297, 119, 298, 120, 0, 0, 197, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 505, 0, 0, 0, 132, 200, 302, 133,
-	303, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 305, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 503, 160, 161, 162,
-	163, 0, 0, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 288, 507, 511, 0, 512, 502, 0, 0,
-	0, 0, 513, 508, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 293, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 504, 0, 113,
-	114, 188, 115, 0, 0, 0, 295, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 296,
-	118, 193, 194, 195, 0, 196, 0, 297, 119, 298,
-	120, 0, 0, 197, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 505,
-	0, 0, 0, 132, 200, 302, 133, 303, 201, 134,
-	135, 0, 202, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 305, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 503, 160, 161, 162, 163, 0, 0,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 86,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 513,
-	508, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 263, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 43, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 45,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 0, 164, 165, 0,
-	0, 166, 167, 168, 292, 213, 0, 169, 0, 0,
-	0, 41, 170, 171, 172, 173, 86, 42, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 89, 90,
-	0, 91, 0, 0, 0, 829, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 191, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 43,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 45, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 0, 164, 165, 0, 0, 166, 167,
-	168, 292, 213, 0, 169, 0, 0, 0, 41, 170,
-	171, 172, 173, 86, 42, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 89, 90, 0, 91, 0,
-	0, 0, 40, 0, 1061, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 0, 158, 159, 0, 254, 209, 0,
-	0, 253, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	353, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 191, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 263, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 0, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 86, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 89,
-	90, 0, 91, 0, 0, 0, 829, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 191,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 0, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 0, 0, 0, 0,
-	170, 171, 172, 173, 86, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 89, 90, 0, 91,
-	0, 0, 0, 774, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 191, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 0, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 0, 0, 0, 0, 170, 171, 172,
-	173, 86, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 89, 90, 0, 91, 0, 0, 0,
-	1269, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 191, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 0, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 288, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 744,
+	0, 1244, 0, 1258, 1259, 1260, 1262, 1263, 1264, 1267,
+	1268, 1269, 746, 0, 727, 728, 729, 0, 734, 726,
+	723, 724, 725, 718, 719, 720, 721, 722, 0, 0,
+	0, 0, 0, 1013, 715, 739, 731, 732, 733, 735,
+	736, 737, 740, 741, 742, 0, 747, 0, 0, 1257,
+	0, 0, 743, 0, 0, 0, 0, 0, 717, 0,
+	749, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 716, 0, 0, 0,
+	0, 0, 730, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 746, 0,
+	727, 728, 729, 0, 734, 726, 723, 724, 725, 718,
+	719, 720, 721, 722, 0, 0, 0, 1459, 0, 0,
+	0, 0, 0, 0, 0, 715, 1730, 731, 732, 733,
+	735, 736, 737, 740, 741, 742, 1265, 0, 0, 0,
+	750, 0, 0, 743, 0, 0, 0, 0, 0, 717,
+	0, 749, 0, 748, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 745, 0, 0, 716, 0, 738,
+	0, 0, 0, 730, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 744,
+	0, 0, 1266, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1729, 715, 0, 731, 732, 733, 735,
+	736, 737, 740, 741, 742, 0, 0, 0, 1281, 0,
+	1280, 0, 743, 0, 0, 739, 905, 0, 717, 0,
+	749, 750, 0, 0, 0, 0, 747, 0, 0, 0,
+	0, 0, 0, 0, 748, 0, 716, 0, 0, 0,
+	0, 0, 730, 0, 0, 745, 0, 1254, 1255, 1256,
+	738, 1261, 1253, 1250, 1251, 1252, 1245, 1246, 1247, 1248,
+	1249, 0, 0, 0, 0, 0, 0, 906, 0, 0,
+	744, 0, 0, 0, 0, 0, 0, 0, 746, 0,
+	727, 728, 729, 0, 734, 726, 723, 724, 725, 718,
+	719, 720, 721, 722, 0, 0, 0, 0, 0, 0,
+	750, 0, 0, 0, 0, 0, 739, 0, 0, 0,
+	0, 0, 0, 748, 0, 0, 0, 747, 0, 0,
+	0, 0, 0, 0, 745, 0, 0, 0, 0, 738,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 0, 0, 453, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 293, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 295, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 296, 118, 193, 194, 195,
-	0, 196, 0, 297, 119, 298, 120, 0, 0, 197,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 305, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 749, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 747, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 752, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	807, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 751, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 0, 160, 161, 162,
-	163, 0, 808, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 86, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 0, 0, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 749, 182, 0, 0, 744, 97, 98, 99,
-	0, 100, 747, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 752, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 743, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	135, 0, 202, 136, 203, 751, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 750,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 86,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 0,
-	1061, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 0, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 0,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 86, 164, 165, 0,
-	0, 166, 167, 168, 212, 213, 0, 169, 89, 90,
-	0, 91, 170, 171, 172, 173, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 191, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 263, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 0,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 0, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 86, 164, 165, 0, 0, 166, 167,
-	168, 212, 213, 0, 169, 89, 90, 0, 91, 170,
-	171, 172, 173, 0, 0, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 493, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 492, 158, 159, 0, 254, 209, 0,
-	0, 253, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	0, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 269, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 263, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 86, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 89,
-	90, 0, 91, 170, 171, 172, 173, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 191,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 86, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 89, 90, 0, 91,
-	170, 171, 172, 173, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 1006, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 86, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 89, 90, 0, 91, 170, 171, 172,
-	173, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 1004, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 86, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	89, 90, 0, 91, 170, 171, 172, 173, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 0, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 0, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 0, 0, 116, 189, 0, 190, 0, 117,
-	995, 192, 0, 0, 0, 0, 118, 193, 194, 195,
-	0, 196, 0, 0, 119, 0, 120, 0, 0, 197,
-	0, 121, 0, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 0, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 0, 133, 0, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 0, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 0, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 0, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 622, 192, 0,
-	0, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 0, 160, 161, 162,
-	163, 0, 86, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 89, 90, 0, 91, 170, 171,
-	172, 173, 0, 479, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 0, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	135, 0, 202, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 0,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 86,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 0,
-	169, 89, 90, 0, 91, 170, 171, 172, 173, 0,
-	0, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 338, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 0, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 0,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 86, 164, 165, 0,
-	0, 166, 167, 168, 212, 213, 0, 169, 89, 90,
-	0, 91, 170, 171, 172, 173, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 335, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 0,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 0, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 86, 164, 165, 0, 0, 166, 167,
-	168, 212, 213, 0, 169, 89, 90, 0, 91, 170,
-	171, 172, 173, 0, 0, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 83, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 0, 158, 159, 0, 82, 209, 0,
-	0, 78, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	0, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 283, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 86, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 89,
-	90, 0, 91, 170, 171, 172, 173, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 280,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 86, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 89, 90, 0, 91,
-	170, 171, 172, 173, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 278, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 86, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 89, 90, 0, 91, 170, 171, 172,
-	173, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 272, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 86, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	89, 90, 0, 91, 170, 171, 172, 173, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 0, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 0, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 0, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 0, 118, 193, 194, 195,
-	0, 196, 0, 0, 119, 0, 120, 0, 0, 197,
-	0, 121, 0, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 0, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 0, 133, 0, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 247, 140, 0, 141, 142,
-	143, 0, 144, 0, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 0, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 0, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	0, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 76, 0, 0, 0, 122, 123, 124, 125, 83,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	77, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 82,
-	209, 0, 0, 78, 210, 211, 0, 160, 161, 162,
-	163, 0, 86, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 89, 90, 0, 91, 170, 171,
-	172, 173, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 0, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	0, 0, 202, 136, 203, 0, 0, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 0, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 0,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 646,
-	169, 664, 665, 666, 0, 170, 171, 172, 173, 0,
-	0, 667, 0, 0, 0, 0, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 646, 0, 664, 665, 666,
-	0, 0, 0, 0, 0, 647, 0, 667, 0, 0,
-	0, 661, 0, 648, 0, 673, 0, 0, 0, 0,
-	0, 0, 646, 0, 664, 665, 666, 0, 0, 0,
-	0, 647, 0, 0, 667, 0, 0, 661, 0, 0,
-	648, 646, 673, 664, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 0, 647, 648,
-	0, 673, 0, 0, 661, 0, 0, 674, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 672, 0,
-	0, 0, 0, 661, 0, 0, 0, 669, 0, 0,
-	0, 0, 662, 674, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 672, 0, 0, 0, 0, 0,
-	0, 0, 668, 669, 0, 0, 0, 0, 662, 0,
-	674, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 672, 0, 0, 0, 0, 0, 0, 668, 674,
-	669, 0, 0, 663, 0, 662, 0, 0, 0, 0,
-	672, 0, 671, 0, 0, 0, 0, 0, 0, 669,
-	0, 0, 0, 0, 662, 668, 0, 0, 0, 663,
-	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
-	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 663, 0, 0, 0,
-	670, 0, 658, 659, 660, 671, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 663, 0, 0, 0, 0,
-	0, 0, 0, 1165, 671, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 0, 0, 0, 0, 0, 0, 0, 0, 1164,
-	0, 0, 0, 670, 0, 658, 659, 660, 0, 657,
-	654, 655, 656, 649, 650, 651, 652, 653, 0, 0,
-	0, 0, 670, 1521, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 646, 0, 664,
-	665, 666, 1520, 0, 0, 0, 0, 0, 0, 667,
-	0, 0, 0, 0, 0, 648, 646, 673, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	0, 0, 0, 647, 648, 0, 673, 0, 0, 661,
-	0, 0, 0, 646, 0, 664, 665, 666, 0, 0,
-	0, 0, 647, 0, 0, 667, 0, 0, 661, 0,
-	0, 648, 0, 673, 0, 0, 0, 0, 0, 646,
-	0, 664, 665, 666, 0, 0, 0, 0, 0, 647,
-	0, 667, 0, 0, 0, 661, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 674, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 647, 672, 0, 0, 0,
-	0, 661, 0, 0, 674, 669, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	668, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 672, 0, 0, 0, 0, 0, 0, 668,
-	0, 669, 0, 0, 0, 0, 662, 674, 0, 0,
-	0, 663, 0, 0, 0, 0, 0, 0, 672, 0,
-	671, 0, 0, 0, 0, 0, 668, 669, 0, 0,
-	663, 0, 662, 0, 0, 0, 0, 0, 0, 671,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 744,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 668, 0, 0, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 663, 0, 0, 0, 670, 1507, 658,
-	659, 660, 671, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 0, 0, 1484, 0, 0,
-	0, 0, 0, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 0,
-	0, 0, 0, 0, 1479, 0, 0, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 646, 0, 664, 665, 666,
-	1475, 0, 0, 0, 0, 0, 0, 667, 0, 0,
-	0, 0, 0, 648, 646, 673, 664, 665, 666, 0,
-	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
-	0, 647, 648, 0, 673, 0, 0, 661, 0, 0,
-	0, 646, 0, 664, 665, 666, 0, 0, 0, 0,
-	647, 0, 0, 667, 0, 0, 661, 0, 0, 648,
-	0, 673, 0, 0, 0, 0, 0, 646, 0, 664,
-	665, 666, 0, 0, 0, 0, 0, 647, 0, 667,
-	0, 0, 0, 661, 0, 648, 0, 673, 0, 0,
-	0, 0, 0, 674, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 647, 672, 0, 0, 0, 0, 661,
-	0, 0, 674, 669, 0, 0, 0, 0, 662, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 0, 669, 0, 0, 0, 0, 662, 668, 674,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 746,
+	0, 727, 728, 729, 0, 734, 726, 723, 724, 725,
+	718, 719, 720, 721, 722, 739, 0, 0, 0, 752,
+	0, 0, 0, 0, 0, 715, 747, 731, 732, 733,
+	735, 736, 737, 740, 741, 742, 0, 0, 0, 0,
+	0, 0, 0, 743, 0, 0, 751, 0, 0, 717,
+	0, 749, 0, 715, 0, 731, 732, 733, 735, 736,
+	737, 740, 741, 742, 0, 0, 0, 716, 0, 0,
+	0, 743, 0, 730, 0, 0, 0, 717, 746, 749,
+	727, 728, 729, 0, 734, 726, 723, 724, 725, 718,
+	719, 720, 721, 722, 0, 716, 0, 0, 0, 0,
+	0, 730, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 0, 668, 0, 669,
-	0, 0, 0, 0, 662, 674, 0, 0, 0, 663,
-	0, 0, 0, 0, 0, 0, 672, 0, 671, 0,
-	0, 0, 0, 0, 668, 669, 0, 0, 663, 0,
-	662, 0, 0, 0, 0, 0, 0, 671, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	668, 0, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 663, 0, 0, 0, 670, 1417, 658, 659, 660,
-	671, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	0, 0, 0, 0, 0, 1416, 0, 0, 0, 0,
-	0, 0, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 0, 1334, 0, 0, 0, 0, 0, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 646, 0, 664, 665, 666, 1272, 0,
-	0, 0, 0, 0, 0, 667, 0, 0, 0, 0,
-	0, 648, 646, 673, 664, 665, 666, 0, 0, 0,
-	0, 0, 0, 0, 667, 0, 0, 0, 0, 647,
-	648, 0, 673, 0, 0, 661, 0, 0, 0, 646,
-	0, 664, 665, 666, 0, 0, 0, 0, 647, 0,
-	0, 667, 0, 0, 661, 0, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 646, 0, 664, 665, 666,
-	0, 0, 0, 0, 0, 647, 0, 667, 0, 0,
-	0, 661, 0, 648, 0, 673, 0, 0, 0, 0,
-	0, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 647, 672, 0, 0, 0, 0, 661, 0, 0,
-	674, 669, 0, 0, 0, 0, 662, 0, 0, 0,
-	0, 672, 0, 0, 0, 0, 0, 0, 0, 0,
-	669, 0, 0, 0, 0, 662, 668, 674, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 672, 1581,
-	0, 0, 0, 0, 0, 668, 0, 669, 0, 0,
-	0, 0, 662, 674, 0, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 672, 0, 671, 0, 0, 0,
-	0, 0, 668, 669, 0, 0, 663, 0, 662, 0,
-	0, 0, 0, 0, 0, 671, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 668, 0,
-	0, 0, 0, 663, 0, 0, 0, 0, 0, 0,
-	1580, 0, 671, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 663,
-	0, 0, 0, 670, 1247, 658, 659, 660, 671, 657,
-	654, 655, 656, 649, 650, 651, 652, 653, 0, 0,
-	0, 0, 0, 910, 0, 0, 0, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 0, 0, 0, 1318, 0,
-	0, 0, 0, 0, 0, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 646, 0, 664, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 0, 0, 648,
-	646, 673, 664, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 667, 0, 0, 0, 818, 647, 648, 0,
-	673, 0, 676, 661, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 647, 0, 0, 0,
-	667, 0, 661, 675, 0, 0, 648, 0, 673, 0,
+	0, 750, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 715, 748, 731, 732, 733, 735, 736,
+	737, 740, 741, 742, 0, 745, 0, 0, 0, 750,
+	738, 743, 0, 0, 0, 0, 0, 717, 0, 749,
+	0, 0, 748, 0, 0, 0, 0, 0, 0, 0,
+	744, 0, 0, 745, 0, 716, 0, 0, 738, 0,
+	0, 730, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 744, 285,
+	0, 0, 0, 0, 0, 0, 739, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 747, 0, 0,
+	0, 0, 715, 0, 731, 732, 733, 735, 736, 737,
+	740, 741, 742, 0, 739, 0, 0, 0, 0, 750,
+	743, 0, 0, 0, 0, 747, 717, 0, 749, 0,
+	0, 0, 748, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 745, 716, 0, 0, 0, 738, 746,
+	730, 727, 728, 729, 0, 734, 726, 723, 724, 725,
+	718, 719, 720, 721, 722, 0, 0, 0, 744, 0,
+	0, 0, 0, 0, 0, 0, 0, 746, 0, 727,
+	728, 729, 0, 734, 726, 723, 724, 725, 718, 719,
+	720, 721, 722, 0, 0, 0, 0, 1287, 0, 0,
+	0, 0, 0, 0, 739, 0, 0, 0, 750, 0,
+	0, 0, 0, 0, 0, 747, 0, 0, 0, 0,
+	0, 748, 0, 0, 0, 0, 0, 0, 0, 1400,
+	0, 0, 745, 0, 0, 0, 0, 738, 0, 0,
+	0, 0, 715, 0, 731, 732, 733, 735, 736, 737,
+	740, 741, 742, 0, 0, 0, 0, 744, 0, 0,
+	743, 0, 0, 1282, 0, 0, 717, 746, 749, 727,
+	728, 729, 0, 734, 726, 723, 724, 725, 718, 719,
+	720, 721, 722, 0, 716, 0, 0, 0, 0, 0,
+	730, 0, 715, 739, 731, 732, 733, 735, 736, 737,
+	740, 741, 742, 0, 747, 0, 0, 0, 0, 0,
+	743, 0, 0, 0, 0, 0, 717, 0, 749, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 647, 0, 1156, 819, 1155, 0,
-	661, 0, 0, 0, 0, 0, 0, 0, 0, 674,
+	0, 0, 0, 0, 716, 0, 0, 0, 0, 0,
+	730, 0, 0, 0, 0, 0, 0, 0, 750, 0,
+	0, 0, 0, 0, 0, 0, 746, 0, 727, 728,
+	729, 748, 734, 726, 723, 724, 725, 718, 719, 720,
+	721, 722, 745, 0, 0, 0, 0, 738, 0, 0,
+	0, 0, 715, 0, 731, 732, 733, 735, 736, 737,
+	740, 741, 742, 0, 0, 0, 0, 744, 750, 0,
+	743, 0, 0, 1237, 0, 0, 717, 0, 749, 0,
+	715, 748, 731, 732, 733, 735, 736, 737, 740, 741,
+	742, 0, 745, 0, 716, 0, 0, 738, 743, 0,
+	730, 0, 0, 739, 717, 0, 749, 0, 0, 0,
+	0, 0, 0, 0, 747, 0, 0, 744, 0, 0,
+	0, 0, 716, 0, 0, 0, 0, 0, 730, 1242,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 0, 0, 674, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 0, 0, 0, 0, 669, 0,
-	0, 0, 0, 662, 668, 0, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 672, 0, 0,
-	0, 0, 0, 668, 0, 0, 669, 0, 0, 0,
-	0, 662, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 0, 0, 0, 0,
-	0, 668, 0, 0, 663, 0, 0, 0, 0, 0,
-	0, 0, 0, 671, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 670, 0, 658, 659, 660, 0, 657, 654, 655,
-	656, 649, 650, 651, 652, 653, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 670,
-	0, 658, 659, 660, 0, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 646, 0, 664, 665, 666, 0,
-	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
-	0, 0, 648, 646, 673, 664, 665, 666, 0, 0,
-	0, 0, 0, 0, 0, 667, 0, 0, 0, 0,
-	647, 648, 0, 673, 0, 0, 661, 0, 0, 0,
-	0, 646, 0, 664, 665, 666, 0, 0, 0, 647,
-	0, 0, 0, 667, 0, 661, 0, 0, 0, 648,
-	0, 673, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 0, 0,
-	0, 0, 0, 661, 0, 0, 0, 0, 0, 0,
-	0, 0, 674, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 674, 669, 0, 0, 0, 0, 662, 0, 0,
-	0, 0, 672, 0, 0, 0, 0, 0, 1162, 0,
-	0, 669, 0, 0, 0, 0, 662, 668, 242, 674,
+	0, 0, 0, 739, 0, 0, 0, 0, 750, 0,
+	0, 0, 0, 0, 747, 0, 746, 0, 727, 728,
+	729, 748, 734, 726, 723, 724, 725, 718, 719, 720,
+	721, 722, 745, 0, 0, 0, 750, 738, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 748,
+	0, 0, 0, 0, 0, 0, 0, 744, 0, 0,
+	745, 0, 0, 0, 0, 738, 746, 0, 727, 728,
+	729, 0, 734, 726, 723, 724, 725, 718, 719, 720,
+	721, 722, 0, 0, 0, 744, 0, 0, 0, 0,
+	0, 0, 0, 739, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 747, 715, 0, 731, 732, 733,
+	735, 736, 737, 740, 741, 742, 0, 0, 0, 0,
+	0, 739, 0, 743, 0, 0, 0, 0, 0, 717,
+	0, 749, 747, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 716, 0, 0,
+	0, 0, 0, 730, 0, 0, 746, 0, 727, 728,
+	729, 0, 734, 726, 723, 724, 725, 718, 719, 720,
+	721, 722, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 746, 0, 727, 728, 729, 0,
+	734, 726, 723, 724, 725, 718, 719, 720, 721, 722,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 668, 0, 0, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 0, 0, 0, 671, 0, 0,
-	0, 0, 0, 0, 668, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
+	715, 750, 731, 732, 733, 735, 736, 737, 740, 741,
+	742, 0, 0, 0, 748, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 717, 745, 749, 0, 0, 0,
+	738, 1244, 0, 1258, 1259, 1260, 1262, 1263, 1264, 1267,
+	1268, 1269, 716, 0, 0, 0, 0, 0, 730, 1530,
+	0, 1244, 0, 1258, 1259, 1260, 1262, 1263, 1264, 1267,
+	1268, 1269, 0, 0, 0, 0, 0, 0, 715, 1529,
+	731, 732, 733, 735, 736, 737, 740, 741, 742, 1257,
+	0, 0, 0, 0, 0, 0, 739, 0, 0, 0,
+	0, 0, 717, 0, 749, 0, 0, 747, 0, 1257,
+	0, 0, 0, 0, 0, 0, 750, 0, 0, 0,
+	716, 0, 0, 0, 0, 0, 730, 0, 0, 748,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1266, 0, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 670, 0, 658, 659, 660,
-	0, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	0, 0, 0, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 1126,
-	0, 1142, 1143, 1144, 0, 0, 0, 0, 0, 0,
-	0, 1385, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 646, 0, 664,
-	665, 666, 0, 0, 0, 0, 0, 0, 0, 667,
-	0, 1139, 1157, 0, 0, 648, 646, 673, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	0, 0, 0, 647, 648, 0, 673, 0, 0, 661,
-	0, 0, 0, 0, 646, 0, 664, 665, 666, 0,
-	0, 0, 647, 0, 0, 0, 667, 0, 661, 1119,
-	0, 0, 648, 0, 673, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1145, 0,
-	647, 0, 0, 0, 0, 0, 661, 0, 0, 0,
-	0, 0, 1140, 0, 0, 674, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 672, 0, 0, 0,
-	0, 0, 0, 0, 674, 669, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	668, 0, 674, 1141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 668,
-	0, 0, 669, 0, 0, 0, 0, 662, 0, 1124,
-	0, 663, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 0, 0, 0, 668, 0, 0,
-	663, 0, 0, 0, 0, 0, 0, 0, 0, 671,
-	0, 0, 1136, 1137, 1138, 0, 1135, 1132, 1133, 1134,
-	1127, 1128, 1129, 1130, 1131, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 0, 0, 0, 671, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 0, 0, 0, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 1126, 0, 1142, 1143, 1144, 0, 0, 0,
-	0, 0, 0, 0, 1242, 670, 0, 658, 659, 660,
-	0, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	646, 0, 664, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 667, 0, 1139, 0, 0, 0, 648, 646,
-	673, 664, 665, 666, 0, 0, 0, 0, 0, 0,
-	0, 667, 0, 0, 0, 0, 647, 648, 0, 673,
-	0, 0, 661, 0, 0, 0, 646, 0, 664, 665,
-	666, 0, 0, 0, 0, 647, 0, 0, 0, 0,
-	0, 661, 0, 0, 648, 0, 673, 0, 0, 0,
-	0, 0, 1126, 0, 1142, 1143, 1144, 0, 0, 0,
-	0, 1145, 647, 0, 1241, 0, 0, 0, 661, 0,
-	0, 0, 0, 0, 0, 1140, 0, 0, 674, 1126,
-	0, 1142, 1143, 1144, 0, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 1139, 0, 0, 674, 669, 0,
-	0, 0, 0, 662, 0, 0, 0, 0, 672, 0,
-	0, 0, 0, 0, 0, 0, 0, 669, 0, 0,
-	0, 1139, 662, 668, 674, 1126, 1141, 1142, 1143, 1144,
-	0, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	0, 0, 0, 0, 663, 0, 0, 0, 0, 0,
-	0, 1145, 0, 671, 0, 0, 0, 1139, 0, 0,
-	0, 0, 0, 663, 0, 1140, 0, 0, 0, 0,
-	0, 0, 671, 0, 0, 1136, 1137, 1138, 1145, 1135,
-	1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 0, 0,
-	663, 0, 1140, 0, 0, 0, 0, 0, 0, 671,
-	0, 670, 0, 658, 659, 660, 0, 657, 654, 655,
-	656, 649, 650, 651, 652, 653, 1141, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 0, 0, 0, 1140, 0,
-	0, 0, 0, 1141, 0, 0, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1136, 1137, 1138, 0, 1135,
-	1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 0, 1141,
+	745, 0, 0, 0, 0, 738, 1244, 0, 1258, 1259,
+	1260, 1262, 1263, 1264, 1267, 1268, 1269, 0, 0, 746,
+	1270, 727, 728, 729, 1376, 734, 726, 723, 724, 725,
+	718, 719, 720, 721, 722, 0, 1265, 0, 0, 0,
+	1270, 0, 0, 0, 750, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1257, 0, 1265, 0, 0, 0,
+	0, 739, 0, 0, 0, 0, 0, 0, 745, 0,
+	0, 0, 747, 738, 1244, 0, 1258, 1259, 1260, 1262,
+	1263, 1264, 1267, 1268, 1269, 0, 0, 0, 0, 0,
+	0, 0, 1266, 0, 0, 1244, 0, 1258, 1259, 1260,
+	1262, 1263, 1264, 1267, 1268, 1269, 0, 0, 0, 0,
+	0, 0, 1266, 1375, 0, 0, 0, 0, 0, 0,
+	0, 0, 1257, 0, 746, 1270, 727, 728, 729, 739,
+	734, 726, 723, 724, 725, 718, 719, 720, 721, 722,
+	747, 1265, 0, 1257, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1254, 1255, 1256,
+	0, 1261, 1253, 1250, 1251, 1252, 1245, 1246, 1247, 1248,
+	1249, 0, 0, 0, 0, 0, 0, 1254, 1255, 1256,
+	1271, 1261, 1253, 1250, 1251, 1252, 1245, 1246, 1247, 1248,
+	1249, 0, 746, 1270, 727, 728, 729, 1266, 734, 726,
+	723, 724, 725, 718, 719, 720, 721, 722, 0, 1265,
+	0, 0, 0, 0, 1270, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1136, 1137, 1138, 0, 1135, 1132, 1133, 1134,
-	1127, 1128, 1129, 1130, 1131, 0, 0, 0, 0, 0,
-	0, 0, 846, 861, 838, 854, 853, 0, 0, 839,
-	0, 0, 0, 863, 862, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1136, 1137,
-	1138, 0, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130,
-	1131, 859, 0, 851, 850, 0, 0, 0, 0, 0,
-	0, 849, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 848, 0, 0, 0, 0, 0,
+	1265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 842, 843, 844, 0, 524, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1254, 1255, 1256, 1266, 1261, 1253, 1250, 1251,
+	1252, 1245, 1246, 1247, 1248, 1249, 715, 0, 731, 732,
+	733, 735, 736, 737, 0, 0, 1266, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 852, 0,
+	717, 0, 749, 0, 0, 938, 955, 926, 946, 945,
+	0, 0, 927, 0, 0, 0, 957, 956, 716, 0,
+	0, 0, 0, 0, 730, 0, 0, 0, 0, 0,
+	1254, 1255, 1256, 0, 1261, 1253, 1250, 1251, 1252, 1245,
+	1246, 1247, 1248, 1249, 951, 0, 943, 942, 0, 0,
+	0, 1254, 1255, 1256, 941, 1261, 1253, 1250, 1251, 1252,
+	1245, 1246, 1247, 1248, 1249, 0, 0, 0, 0, 940,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 750, 0, 0, 0, 0, 933, 0, 0,
+	934, 935, 936, 0, 0, 671, 0, 0, 0, 0,
+	930, 931, 0, 0, 0, 0, 745, 0, 0, 0,
+	0, 738, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 944, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 847, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 845, 0, 0, 0,
-	0, 841, 0, 0, 0, 0, 0, 840, 0, 0,
-	860, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 939, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 739, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 747, 0,
+	0, 0, 937, 0, 0, 0, 0, 929, 0, 0,
+	0, 0, 0, 928, 0, 954, 952, 953, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 864,
+	0, 0, 0, 0, 0, 932, 0, 0, 0, 0,
+	958, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	746, 0, 0, 0, 0, 0, 734, 726, 723, 724,
+	725, 718, 719, 720, 721, 722,
 }
 var sqlPact = [...]int{
 
-	1951, -1000, -12, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 709,
-	-1000, -1000, -1000, 535, 635, 90, 864, 864, -1000, -1000,
-	15081, 1760, 306, 306, 306, 396, 480, 99, -1000, 755,
-	27, 14864, 12043, 1060, -14, 11392, 212, 1951, 11826, 12043,
-	14647, 931, 862, 11392, 14430, 14213, 13996, -1000, 7925, -1000,
-	-1000, -1000, -1000, 682, -1000, -15, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 676, -1000, 13779, 13779, 853, -1000,
-	-1000, 472, 258, 1086, -1000, -7, -1000, -1000, -1000, -1000,
+	112, -1000, -16, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 679, -1000, -1000, -1000, -1000, -1000, 747, 702, 249,
+	1137, 17784, 1137, -1000, -1000, 17542, 1002, 356, 356, 356,
+	13670, 17300, 478, 615, 71, -1000, 988, 28, 17058, 13670,
+	1166, -19, 12944, 231, 112, 13428, 13670, 13670, 16816, 1032,
+	928, 12944, 16574, 16332, 16090, 1337, 13670, -1000, 9467, -1000,
+	-1000, -1000, -1000, 805, -14, -1000, -20, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 278, -1000,
+	-8, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2747,338 +3124,370 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 930, -1000, 675, 928,
-	913, 252, 859, -1000, 853, -1000, -1000, -1000, 11392, -1000,
-	13562, 875, 13345, -1000, 755, -1000, -1000, -1000, 805, 1059,
-	1059, 1059, 1075, 79, 77, 99, -16, 12043, -1000, 216,
-	-1000, -1000, -1000, -1000, -1000, -16, 6001, 6001, -1000, -1000,
-	212, -1000, 232, 10267, -139, -1000, 5523, -1000, 602, 987,
-	511, 506, 983, 11392, 12043, 434, 13128, -1000, 980, 78,
-	979, -1000, -32, 977, -1000, -23, -1000, -1000, -1000, -1000,
-	-1000, -1000, 212, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 11609, 910, 11609, -1000,
-	-1000, -1000, 806, 8401, 8164, 1021, 1599, -1000, -1000, -1000,
-	-10, 3356, 12043, 942, 11609, 12043, -1000, 12043, -1000, 800,
-	-1000, -1000, 85, -1000, 210, 765, 12911, -1000, 761, -1000,
-	805, -1000, 631, 788, 6258, 6975, 99, -1000, -1000, 99,
-	99, 6975, -1000, -1000, 12043, -16, 1095, 12043, 911, -18,
-	-1000, 16838, -1000, -1000, 6975, 6975, 6975, 6975, 6975, 578,
-	-1000, -1000, -1000, 3832, -1000, -1000, -139, 202, 222, -1000,
-	-1000, 200, -139, -1000, -1000, -1000, -1000, 197, 1192, 330,
-	-1000, -1000, -1000, 6975, 263, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 939, 196, 194, -1000, -1000, -1000,
-	-1000, 191, 187, 182, 180, 178, 166, 160, 159, 154,
-	152, 150, 146, 145, 569, -1000, 274, -1000, -1000, 274,
-	274, -1000, 131, 131, 132, -1000, -1000, -1000, 131, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 144, 52,
-	-1000, -1000, -1000, 12043, -139, -1000, 3118, 3356, 6975, -24,
-	-1000, 17670, -1000, -71, 562, -1000, 10948, 1036, 1034, 1047,
-	11392, 382, 377, 12043, 268, 55, 1090, 9793, -1000, 12043,
-	12043, -1000, 12043, -1000, -1000, 12043, 12043, 12043, 27, 10504,
-	375, -41, 12043, 12043, -1000, 909, 650, -19, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1172, -1000,
-	-1000, -1000, -1000, 1183, -19, -1000, -1000, -1000, -1000, -1000,
-	1190, -1000, -1000, -1000, -1000, 3356, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 797,
+	53, -1000, 15848, 15848, 917, -1000, -1000, 574, 277, 1192,
+	-1000, -1000, 1023, -1000, 794, 1022, 1020, -1000, 276, 921,
+	-1000, 917, -1000, -1000, 463, -1000, -1000, 13670, -1000, 12944,
+	-1000, 15606, 952, 1337, 13670, 15364, -1000, 988, -1000, -1000,
+	-1000, 860, 1162, 1162, 1162, 1214, 74, 73, 71, -22,
+	13670, -1000, 234, -22, 7139, 7139, -1000, -1000, 231, -1000,
+	246, 11734, -162, -1000, 6613, -1000, 839, 1087, 294, 635,
+	613, 1084, 12944, 13670, 500, 15122, -1000, 1083, 78, 1081,
+	-1000, -40, 1078, -1000, -21, -67, -1000, -27, -1000, -1000,
+	-1000, -1000, -1000, -1000, 231, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13186, 13670,
+	1337, 790, -1000, -9, 3719, 13186, 13670, -1000, -1000, -1000,
+	881, 10238, 9978, 1135, 565, -1000, -1000, -1000, 13670, 1037,
+	13186, 13670, -1000, 13670, -1000, 878, -1000, -1000, 14880, -1000,
+	83, -1000, 224, 858, 14638, -1000, 293, -1000, 854, -1000,
+	804, 1036, 804, 748, 873, 358, 7402, 8191, 71, -1000,
+	-1000, 71, 71, 8191, -1000, -1000, 13670, -22, 1262, 13670,
+	1007, -23, -1000, 19915, -1000, -1000, 8191, 8191, 8191, 8191,
+	8191, 703, -1000, -1000, -1000, 4506, -1000, -1000, -162, 221,
+	238, -1000, -1000, 208, -162, -1000, -1000, -1000, -1000, 207,
+	1334, 332, -1000, -1000, -1000, 8191, 288, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1035, 204, 202, -1000,
+	-1000, -1000, -1000, 201, 200, 199, 197, 187, 185, 184,
+	182, 178, 177, 176, 173, 168, 657, -1000, 307, -1000,
+	-1000, 307, 307, -1000, 126, 126, 129, -1000, -1000, -1000,
+	126, -1000, -1000, 270, 270, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 165, 43, -1000, -1000, -1000, 13670, -162, -1000,
+	3459, 3719, 8191, -31, -1000, 20430, -1000, -101, 628, -1000,
+	12460, 1185, 1152, 1160, 499, 12944, 691, 460, 455, 13670,
+	302, 50, 1257, 11250, -1000, 13670, 13670, -1000, 13670, -1000,
+	-1000, 13670, 13670, 13670, 13670, 28, 11976, 454, -66, 13670,
+	13670, -102, -1000, -1000, -1000, 3719, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 12043, -1000, -1000, -1000, -1000, -1000, 11392, 10721,
-	976, 673, 756, -1000, 971, -1000, -1000, -1000, -1000, 17670,
-	-1000, 17670, 448, 867, -1000, 867, -20, -1000, 16810, -1000,
-	143, -25, -1000, 268, 9556, 6001, 18001, 12043, 385, 6975,
-	6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975,
-	6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975,
-	657, 370, 896, 618, 130, 3356, -1000, 1136, 1136, 1136,
-	17716, 17716, 153, -140, 16492, -21, -139, -1000, -1000, 5027,
-	4788, -139, 2706, -1000, 634, 1181, 271, 17670, 948, 895,
-	142, 72, 70, 6975, 725, 6975, 7214, 6975, 6975, 4071,
-	6975, 6975, 6975, 6975, 6975, 6975, -1000, 138, -1000, -1000,
-	-1000, -1000, 1180, -1000, -1000, 1176, -1000, 1175, 268, 64,
-	-1000, -1000, -1000, -1000, 1826, 5523, -1000, 605, 12043, 12043,
-	12043, -1000, -1000, 742, 12694, -1000, 18001, 12043, -1000, 136,
-	135, 829, 821, 12043, 12043, 12477, 12260, 12043, 528, 12043,
-	12043, 474, -1000, 6975, 659, -1000, 9102, 279, 12043, 50,
-	-1000, -1000, -1000, 243, 12043, -1000, -1000, -1000, 78, -1000,
-	-32, -1000, -1000, 12043, -41, -52, 12043, -1000, 470, 546,
-	-1000, -1000, 8638, -1000, -1000, -1000, 634, -1000, -84, -1000,
-	-1000, 59, -53, -1000, -1000, -1000, -1000, 12043, 209, 12043,
-	12043, 968, 12043, -1000, -1000, -1000, 6975, -1000, -1000, -1000,
-	27, 12043, -1000, 888, -70, 921, 11175, 11175, -1000, 8865,
-	-1000, -1000, 1105, -1000, -1000, -1000, -1000, 61, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 132, 569,
-	131, 131, 131, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 274, 274, 274, -1000, -1000, 249, 383, 383, 1096,
-	1096, 1096, 495, 495, 422, 763, 1985, 1985, 1985, 445,
-	706, 706, 1985, 1985, 1985, 17716, 17689, 2341, 6975, 354,
-	580, 130, 6975, -1000, 975, -1000, -1000, -1000, 908, 129,
-	7214, 7214, -1000, -1000, -1000, 3832, -1000, -1000, 128, 6975,
-	-1000, 6975, -29, -30, -1000, 17670, -1000, -36, -1000, -1000,
-	-38, 6975, 6975, 6975, 51, -1000, 350, -1000, 347, 342,
-	336, -1000, 127, 49, 438, -1000, 6975, 596, 124, 121,
-	6975, -1000, -1000, 17424, 48, 907, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 47, 17396, 46, 2004, -1000, 7214, 7214,
-	7214, 3832, 120, 45, 16791, -116, 17377, 5762, 5762, 5762,
-	44, 17131, 6975, -116, 15545, 15519, 2318, -37, -42, -43,
-	1174, -44, 43, 41, 888, -1000, -1000, 6975, -1000, -1000,
-	-1000, 329, 318, 966, -1000, 734, -1000, 670, 6975, 12043,
-	119, 118, 527, -1000, 964, 694, 962, 694, -1000, -71,
-	551, -1000, -1000, 317, 17670, -1000, 1049, -48, -1000, -1000,
-	268, 9793, 5523, -54, -1000, -84, -84, -1000, -1000, -1000,
-	-1000, -1000, 12043, -1000, 10721, 117, 12043, 116, 115, 12043,
-	-1000, -1000, 40, -1000, -1000, -1000, -1000, -1000, 883, 1073,
-	9556, 851, 844, 9556, 856, 606, 606, 606, -1000, -1000,
-	-1000, 12043, 113, -1000, 9339, 38, 921, 225, 223, -1000,
-	1173, 6975, 2341, 6975, 7214, 7214, -1000, 2341, -1000, -1000,
-	-1000, -1000, 905, 111, 6975, 18001, 17742, 17642, -55, 4549,
-	-95, 16473, 6975, -1000, -1000, 222, -1000, 37, 5284, -1000,
-	17084, -11, -11, -1000, 778, 746, 492, 440, 1161, 1187,
-	978, -1000, 6975, 17103, -1000, 10030, 269, 620, 16227, 18001,
-	-1000, 6975, -1000, 904, 6975, -1000, 18001, 7214, 7214, 7214,
-	7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214,
-	7214, 7214, 7214, 7214, 7214, 802, 7214, 1130, 1130, 1130,
-	-103, 4310, -1000, 938, 904, 6975, 6975, 18001, 35, 34,
-	31, -1000, 6975, -116, 6975, 6975, 6975, -1000, -1000, -1000,
-	29, -1000, 1160, -1000, -1000, 883, 16519, 12043, 12043, 12043,
-	955, 1566, -1000, 16201, -56, 12043, 12043, -1000, 768, 882,
-	299, 12043, -1000, 12043, -1000, 12043, 12043, 12043, 12043, 137,
-	27, -1000, -1000, -1000, 241, -1000, -1000, 12043, 103, 10721,
-	7688, 653, -1000, 266, 6975, 6975, 921, 9556, 9556, 1606,
-	831, 9556, -1000, -1000, -1000, -1000, 101, 12043, 11175, 356,
-	1139, 24, 1093, 2341, 17349, 1810, 6975, 18001, 2448, -60,
-	-1000, 6975, 6975, -1000, -61, -1000, 6975, -1000, 17670, -1000,
-	1186, 6975, 22, 21, 20, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15, -1000, -1000, 17670, 6975, -1000, -1000, 15298,
-	6975, 13, -1000, 11, 17670, 938, 17670, -1000, 553, 553,
-	1130, 1130, 1130, 548, 548, 741, 283, 2414, 2414, 2414,
-	1151, 389, 389, 2414, 2414, 2414, 903, 757, 98, 17769,
-	6975, -62, -1000, -1000, -1000, 17670, 17670, 10, -1000, -1000,
-	-1000, -116, 2161, 16174, 16155, -1000, 9, 266, -1000, -1000,
-	-1000, -1000, 12043, -1000, 12043, -1000, 12043, 697, -1000, -1000,
-	817, 97, 7214, 12043, -1000, 577, -66, -72, 692, -1000,
-	642, 6975, -1000, 18001, 694, 694, -1000, 316, 315, -1000,
-	996, 7688, 1044, -1000, 95, -73, 12043, 5, -74, -1000,
-	65, 1058, 6975, -1000, -1000, 94, 12043, -1000, 12043, 17670,
-	-116, -1000, 1606, -1000, 93, 6975, 9556, -1000, 12043, -80,
-	-1000, -1000, 220, 218, -1000, 6975, 6975, 2448, -82, -1000,
-	18001, 2341, 2341, -1000, 15909, -1000, 17084, -1000, -1000, -1000,
-	-1000, 17670, 557, -1000, 15883, -1000, -1000, -1000, 7214, 901,
-	91, 18001, 15856, -1000, -1000, 6975, -1000, -1000, -1000, -1000,
-	-1000, 1503, -1000, -1000, -1000, 6975, 17769, 62, -1000, 87,
-	-1000, -1000, -1000, 515, -1000, -1000, 17670, 1061, -1000, -1000,
-	12043, 12043, 374, -86, 12043, -1000, -1000, 3593, 577, -87,
-	-1000, 577, 7688, 1057, -139, 12043, 1057, 15837, 2706, 84,
-	-109, -1000, 1089, -1000, 12043, 17670, -1000, -91, -1000, -1000,
-	-1000, 2341, 2341, -1000, -1000, -1000, 4, 620, 1072, -1000,
-	17815, 7214, 18001, -93, -1000, 15591, -1000, 15572, 781, 12043,
-	12043, 12043, 285, 12043, -1000, -1000, 413, -1000, 268, -1000,
-	-1000, 577, -1000, -1000, -1000, -1000, -1000, 1058, -38, 7688,
-	12043, 83, -108, -1000, -1000, 555, 6975, 17815, -120, -1000,
-	-1000, -1000, 624, 684, -126, -128, 62, -1000, 6975, -1000,
-	9793, -1000, -1000, 1057, 3, -133, -1000, -1000, -1000, 1,
-	6736, 6736, -116, -1000, -1000, 643, 641, 497, -1000, -1000,
-	-1000, -1000, -1000, 781, 17670, -104, -1000, -1000, 577, -1000,
-	-1000, -1000, 7451, 630, 453, 16545, -1000, -1000, 1003, -1000,
-	292, 652, 652, 624, -1000, -1000, 1110, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1119, -1000, -1000, 798, -1000, -1000, 6497,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1003, -102, 716, -26, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1305, -1000, -1000, -1000, -1000,
+	1326, -26, -1000, -1000, -1000, -1000, -1000, 1333, -1000, -1000,
+	-1000, -1000, -1000, -1000, 13670, -1000, -1000, -1000, -1000, 13670,
+	-1000, -1000, 12944, 12218, 1076, 770, 849, -1000, -1000, 681,
+	1074, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 20430,
+	-1000, 20430, 599, 936, -1000, 936, -28, -1000, 19714, -1000,
+	163, -35, 302, 9718, 7139, 21118, 13670, 470, 8191, 8191,
+	8191, 8191, 8191, 8191, 8191, 8191, 8191, 8191, 8191, 6087,
+	8191, 8191, 8191, 8191, 8191, 8191, 8191, 8191, 8191, 8191,
+	8191, 8191, 8191, 8191, 8191, 8191, 674, 453, 1273, 666,
+	122, 3719, -1000, 1292, 1292, 1292, 20710, 20710, 151, -164,
+	19268, -32, -162, -1000, -1000, 5824, 5560, -162, 3979, -1000,
+	735, 1325, 305, 20430, 1043, 974, 148, 72, 68, 8191,
+	916, 8191, 8454, 8191, 8191, 4769, 8191, 8191, 8191, 8191,
+	8191, 8191, -1000, 146, -1000, -1000, -1000, -1000, 1318, -1000,
+	-1000, 1317, -1000, -1000, 467, 392, -1000, 1311, 302, 67,
+	-1000, -1000, -1000, -1000, 2021, 6613, -1000, 1140, 13670, 13670,
+	13670, -1000, -1000, 848, 14396, -1000, 21118, 13670, -1000, 145,
+	140, 905, 898, 13670, 13670, 14154, 13912, 13670, 678, 792,
+	1297, 13670, 13670, 611, -1000, 8191, 764, -1000, 10747, 314,
+	13670, 41, -1000, -1000, -1000, 260, 13670, -1000, -1000, -1000,
+	78, -1000, -40, -1000, -1000, -1000, 13670, -66, -67, 13670,
+	-1000, 13670, -1000, 534, 645, -1000, -1000, 10498, -1000, -1000,
+	-1000, 735, -102, -1000, -1000, 64, -73, -1000, -1000, -1000,
+	-1000, -1000, 13670, 196, 13670, 13670, 13670, 1072, 1297, 13670,
+	-1000, -1000, -1000, 8191, -1000, -1000, -1000, 28, -1000, 971,
+	-85, 1696, 12702, 12702, 12702, -1000, 9216, 138, -1000, -1000,
+	326, -1000, -1000, -1000, -1000, 42, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	129, 657, 126, 126, 126, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 270, -1000, 270, 307, 307, 307, -1000, -1000,
+	273, 757, 757, 1222, 1222, 1222, 308, 308, 485, 1547,
+	21116, 21116, 21116, 124, 123, 399, 399, 399, 399, 399,
+	1533, 1533, 1533, 493, 493, 21116, 21116, 21116, 20710, 20605,
+	2046, 8191, 450, 665, 122, 8191, -1000, 618, -1000, -1000,
+	-1000, 998, 121, 8454, 8454, -1000, -1000, -1000, 4506, -1000,
+	-1000, 118, 8191, -162, 8191, -117, -131, -1000, 20430, -1000,
+	-36, -1000, -1000, -25, 8191, 8191, 8191, 61, -1000, 397,
+	-1000, 388, 383, 380, -1000, 117, 59, 504, -1000, 8191,
+	706, 116, 111, 8191, -1000, -1000, 20402, 58, 997, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 55, 20312, 54, 20914,
+	-1000, 8454, 8454, 8454, 4506, 110, 51, 19635, -155, 20262,
+	6876, 6876, 6876, 48, 20122, 8191, -155, 2873, 2572, 2545,
+	-41, -43, -45, 1309, -47, 47, 268, 266, 40, 971,
+	-1000, -1000, 8191, -1000, -1000, -1000, 377, 372, 1070, -1000,
+	840, -1000, 662, 8191, 13670, 109, 108, 617, -1000, 1066,
+	732, 1063, 732, -1000, -1000, 281, -1000, -101, 549, -1000,
+	-1000, -1000, -1000, -1000, 369, 20430, -1000, 1164, -49, -1000,
+	-1000, 302, 11250, 6613, -51, -1000, -102, -1000, -102, -1000,
+	-1000, -1000, -1000, -1000, 941, 12218, 107, 13670, 106, 103,
+	102, 13670, -1000, -1000, -1000, 39, -1000, -1000, -1000, -1000,
+	968, 1212, 9718, 924, 922, 9718, 1054, 709, 709, 709,
+	-1000, -1000, -1000, 13670, 101, -1000, -1000, 10998, 35, 1696,
+	3979, -1000, 240, 376, -1000, 1308, 8191, 8191, 8191, 2046,
+	8191, 8454, 8454, -1000, 2046, -1000, -1000, -1000, -1000, 996,
+	98, 8191, 21118, 20935, 20846, -53, 5296, -111, -162, 19182,
+	8191, -1000, -1000, 238, -1000, 34, 6350, -1000, 19943, -24,
+	-24, -1000, 857, 758, 571, 509, 1307, 1332, 1091, -1000,
+	8191, 20033, -1000, 11492, 303, 742, 19064, 21118, -1000, 8191,
+	-1000, 987, 8191, -1000, 21118, 8454, 8454, 8454, 8454, 8454,
+	8454, 8454, 8454, 8454, 8454, 8454, 8454, 8454, 8454, 8454,
+	8454, 8454, 8454, 8454, 8454, 8454, 8454, 8454, 8454, 8454,
+	888, 8454, 1290, 1290, 1290, -115, 5032, -1000, 1004, 987,
+	8191, 8191, 21118, 31, 25, 22, -1000, 8191, -155, 8191,
+	8191, 8191, -1000, -1000, -1000, 14, -1000, 1303, -1000, -1000,
+	-1000, -1000, 968, 19354, 13670, 13670, 13670, 1051, 1117, -1000,
+	18978, -57, 13670, 13670, -1000, 920, 957, 339, 13670, -1000,
+	13670, -1000, -1000, 13670, 13670, 13670, 13670, 139, 28, -1000,
+	-1000, -1000, 257, -1000, 954, -1000, 13670, 96, 13670, 12218,
+	8974, 756, -1000, 297, 8191, 8191, 1696, 9718, 9718, 1726,
+	914, 9718, -1000, -1000, -1000, -1000, 95, 13670, 12702, -25,
+	1301, -1000, 167, 13, 1215, 18892, 18774, 2046, 20761, 20741,
+	8191, 21118, 20778, -58, -1000, 8191, 8191, -1000, -59, -1000,
+	8191, -1000, 20430, -1000, 1330, 8191, 12, 7, 5, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 4, -1000, -1000, 20430,
+	8191, -1000, -1000, 18026, 8191, 3, -1000, 2, 20430, 1004,
+	20430, -1000, 516, 516, 1290, 1290, 1290, 389, 389, 820,
+	379, 166, 166, 166, 874, 874, 874, 874, 874, 1655,
+	1655, 1655, 472, 472, 166, 166, 166, 984, 886, 94,
+	3116, 8191, -75, -1000, -1000, -1000, 20430, 20430, 0, -1000,
+	-1000, -1000, -155, 2250, 18688, 18602, -1000, -1, 297, -1000,
+	-1000, -1000, -1000, 13670, -1000, 13670, -1000, 13670, 826, -1000,
+	-1000, 897, 92, 8454, 13670, -1000, 545, -76, -77, 818,
+	-1000, 811, 8191, -1000, 21118, 732, 732, -1000, 368, 367,
+	-1000, 1099, 8974, 1144, -1000, 91, 700, -81, 13670, -83,
+	-2, -87, -1000, 65, 1178, 8191, -1000, -1000, 13670, -1000,
+	13670, 20430, -155, -1000, 1726, -1000, 90, 8191, 9718, -1000,
+	13670, -88, -1000, -3, 79, -1000, -1000, -1000, -1000, 8191,
+	8191, 20778, -92, -1000, 21118, 2046, 2046, -1000, 18484, -1000,
+	19943, -1000, -1000, -1000, -1000, 20430, 694, -1000, 18398, -1000,
+	-1000, -1000, 8454, 980, 89, 21118, 18307, -1000, -1000, 8191,
+	-1000, -1000, -1000, -1000, -1000, 1133, -1000, -1000, -1000, 8191,
+	3116, 77, -1000, 88, -1000, -1000, -1000, 640, -1000, -1000,
+	20430, 1183, -1000, -1000, 13670, 13670, 438, -93, 13670, -1000,
+	-1000, 4243, 13670, 545, -94, -1000, 941, 545, 8974, 1169,
+	-162, 13670, 1169, 18280, 85, -123, -1000, 1244, -1000, 13670,
+	20430, -1000, -100, -1000, -1000, -1000, 2046, 2046, -1000, -1000,
+	-1000, -4, 742, 1194, -1000, 19511, 8454, 21118, -113, -1000,
+	3153, -1000, 3063, 867, 13670, 13670, 13670, 320, 13670, -1000,
+	-1000, 497, -1000, 302, -1000, 81, -1000, 545, -1000, 941,
+	-1000, -1000, -1000, -1000, 1178, 8974, 13670, 80, -118, -1000,
+	-1000, 636, 8191, 19511, -129, -1000, -1000, -1000, 749, 803,
+	-135, -145, 77, -1000, 8191, -1000, 11250, -1000, 13670, -1000,
+	302, 1169, -150, -1000, -1000, -1000, -5, 7928, 7928, -155,
+	-1000, -1000, 753, 750, 514, -1000, -1000, -1000, -1000, -1000,
+	867, 20430, -125, -156, -1000, -1000, 545, -1000, -1000, -1000,
+	8714, 819, 517, 19544, -1000, -1000, 1113, -1000, 329, 851,
+	851, 749, -1000, -1000, 941, 1267, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1275, -1000, -1000, 903, -1000, -1000, 302, 7665,
+	-1000, -1000, -1000, -1000, -1000,
 }
 var sqlPgo = [...]int{
 
-	0, 1450, 1449, 1105, 1447, 1445, 1441, 1436, 1434, 83,
-	1432, 1430, 85, 1429, 79, 1428, 1425, 1424, 64, 1423,
-	1421, 1418, 1417, 78, 33, 1754, 114, 102, 1415, 1413,
-	1412, 10, 76, 72, 1409, 37, 1408, 542, 1163, 49,
-	20, 15, 4, 1407, 1397, 1393, 29, 1391, 1390, 1389,
-	11, 40, 25, 1388, 14, 89, 1384, 1379, 74, 1373,
-	127, 42, 96, 97, 1367, 1341, 484, 1340, 12, 46,
-	1339, 24, 1333, 19, 51, 103, 1332, 499, 38, 22,
-	36, 1331, 1329, 1328, 61, 70, 43, 1325, 30, 32,
-	1324, 45, 1322, 95, 98, 1321, 1315, 1314, 1312, 1311,
-	1310, 1094, 1309, 7, 52, 41, 28, 39, 0, 215,
-	551, 1299, 56, 27, 35, 31, 1298, 77, 1293, 1292,
-	1289, 1285, 1283, 54, 1282, 44, 107, 26, 63, 69,
-	23, 101, 57, 88, 112, 84, 1281, 91, 1279, 75,
-	1278, 1277, 633, 73, 1274, 1273, 1272, 617, 614, 474,
-	176, 1270, 1268, 334, 324, 1266, 1263, 65, 1259, 1257,
-	106, 1256, 120, 92, 1254, 87, 1253, 66, 1251, 108,
-	113, 86, 1250, 94, 48, 1248, 1246, 1245, 17, 1,
-	6, 5, 8, 3, 18, 16, 1244, 1238, 82, 67,
-	1236, 511, 1235, 1230, 47, 1228, 1225, 21, 1216, 13,
-	1211, 9, 2, 1210, 100, 1208, 71, 1207, 1136, 1204,
-	110, 1201, 1199, 1144, 62,
+	0, 1564, 1562, 1253, 1559, 1558, 1556, 1555, 1554, 1553,
+	1551, 1550, 80, 1547, 1546, 88, 1545, 79, 1544, 1541,
+	1540, 1539, 35, 1534, 1529, 1526, 1525, 76, 32, 1957,
+	99, 94, 1519, 1517, 1516, 11, 82, 1512, 78, 1507,
+	46, 1505, 543, 1597, 61, 44, 17, 161, 1503, 1502,
+	1501, 26, 1500, 1499, 1498, 14, 50, 59, 102, 1496,
+	21, 111, 1495, 1491, 58, 85, 1490, 81, 19, 89,
+	41, 1488, 1487, 116, 1484, 13, 49, 1482, 24, 1481,
+	31, 54, 96, 1480, 272, 45, 23, 51, 1478, 1477,
+	1473, 60, 73, 40, 1471, 43, 28, 1470, 52, 1468,
+	95, 112, 1467, 1466, 1465, 1464, 1463, 1461, 577, 1460,
+	9, 1, 25, 48, 4, 29, 0, 1082, 516, 1459,
+	57, 34, 37, 16, 1458, 75, 1456, 1455, 1454, 1453,
+	1452, 56, 1445, 47, 105, 36, 63, 69, 20, 30,
+	68, 98, 115, 86, 1444, 103, 1443, 90, 1440, 1437,
+	614, 64, 1435, 1434, 1432, 345, 259, 164, 38, 1431,
+	1426, 67, 39, 1423, 1420, 66, 1418, 1412, 114, 1408,
+	104, 83, 1406, 100, 1403, 74, 1397, 1384, 70, 579,
+	149, 93, 1375, 91, 53, 1372, 1370, 1368, 1365, 22,
+	6, 10, 5, 7, 3, 42, 18, 1363, 1362, 92,
+	71, 1360, 134, 1356, 1355, 27, 1354, 1353, 12, 1352,
+	15, 1351, 8, 2, 1349, 108, 1348, 77, 1347, 1203,
+	107, 1268, 1346, 113, 1344, 1343, 1221, 55,
 }
 var sqlR1 = [...]int{
 
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 4, 33, 33, 32, 32, 32, 32, 32, 32,
-	32, 32, 32, 32, 32, 32, 32, 32, 32, 29,
-	29, 35, 35, 35, 34, 34, 30, 30, 5, 5,
-	5, 9, 10, 10, 10, 10, 10, 10, 62, 62,
-	61, 61, 65, 65, 11, 11, 12, 12, 12, 12,
-	138, 138, 137, 13, 17, 204, 204, 204, 208, 208,
-	209, 209, 210, 210, 210, 210, 210, 210, 210, 206,
-	206, 19, 19, 19, 101, 101, 100, 100, 100, 100,
-	102, 102, 102, 102, 162, 160, 160, 167, 167, 167,
-	44, 44, 44, 44, 44, 159, 159, 159, 159, 168,
-	168, 168, 168, 168, 168, 45, 45, 45, 166, 166,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	161, 161, 205, 205, 207, 207, 8, 8, 46, 46,
-	47, 47, 105, 105, 105, 104, 176, 176, 177, 177,
-	177, 178, 178, 178, 178, 178, 178, 178, 175, 175,
-	173, 173, 174, 174, 174, 174, 211, 211, 103, 103,
-	50, 50, 181, 181, 181, 181, 179, 179, 179, 179,
-	179, 182, 180, 183, 183, 183, 183, 183, 126, 126,
-	126, 22, 7, 7, 90, 90, 54, 54, 130, 130,
-	130, 41, 41, 31, 31, 31, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 91, 91, 92, 92, 21,
-	21, 21, 213, 213, 36, 36, 37, 6, 6, 14,
-	43, 43, 97, 97, 97, 99, 99, 99, 98, 98,
-	98, 23, 68, 68, 69, 69, 136, 70, 70, 18,
-	18, 25, 25, 24, 24, 24, 24, 24, 24, 26,
-	26, 27, 27, 27, 27, 27, 27, 27, 189, 189,
-	189, 191, 191, 188, 15, 15, 15, 15, 190, 190,
-	212, 212, 77, 77, 77, 49, 48, 48, 52, 52,
-	51, 53, 53, 129, 75, 75, 75, 75, 93, 94,
-	94, 95, 95, 96, 96, 74, 74, 113, 113, 28,
-	28, 58, 58, 59, 59, 131, 131, 131, 131, 132,
-	132, 132, 132, 132, 132, 127, 127, 127, 127, 128,
-	128, 80, 80, 80, 80, 78, 78, 79, 79, 133,
-	133, 133, 133, 76, 76, 134, 134, 134, 106, 106,
-	139, 139, 139, 57, 57, 57, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 141, 141, 141, 141,
-	143, 143, 143, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 144, 144, 151, 151,
-	152, 152, 153, 154, 145, 145, 146, 146, 147, 148,
-	155, 155, 155, 157, 157, 149, 149, 150, 85, 85,
-	85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
-	85, 85, 86, 86, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 109, 110, 110, 110, 110, 110, 110, 110,
-	110, 110, 110, 110, 110, 184, 184, 184, 184, 184,
-	184, 184, 186, 186, 187, 187, 185, 185, 185, 185,
-	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
-	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
-	185, 192, 192, 193, 193, 194, 194, 195, 195, 197,
-	198, 198, 198, 199, 203, 203, 196, 196, 200, 200,
-	200, 201, 201, 202, 202, 202, 202, 202, 117, 117,
-	117, 118, 118, 119, 63, 63, 115, 115, 114, 114,
-	114, 116, 116, 64, 156, 156, 156, 156, 156, 156,
-	156, 81, 81, 87, 82, 82, 83, 83, 83, 83,
-	83, 83, 88, 89, 84, 84, 84, 112, 112, 120,
-	124, 124, 123, 122, 122, 121, 121, 107, 107, 107,
-	107, 107, 71, 71, 214, 214, 125, 125, 72, 72,
-	73, 67, 67, 66, 66, 135, 135, 135, 135, 60,
-	60, 42, 42, 55, 55, 56, 56, 40, 40, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	158, 158, 158, 38, 38, 38, 39, 39, 164, 164,
-	164, 165, 165, 165, 165, 163, 163, 163, 163, 163,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
-	170, 170, 170, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172,
+	3, 3, 4, 4, 5, 38, 38, 36, 36, 36,
+	36, 36, 36, 36, 36, 36, 36, 36, 36, 36,
+	36, 36, 36, 37, 37, 33, 33, 40, 40, 40,
+	39, 39, 34, 34, 6, 6, 6, 6, 6, 12,
+	13, 13, 13, 13, 13, 13, 13, 13, 69, 69,
+	68, 68, 72, 72, 14, 14, 15, 15, 15, 15,
+	146, 146, 145, 16, 16, 21, 21, 215, 215, 215,
+	221, 221, 222, 222, 223, 223, 223, 223, 223, 223,
+	223, 217, 217, 220, 219, 219, 23, 23, 23, 20,
+	20, 108, 108, 107, 107, 107, 107, 109, 109, 109,
+	109, 170, 168, 168, 175, 175, 175, 49, 49, 49,
+	49, 49, 167, 167, 167, 167, 176, 176, 176, 176,
+	176, 176, 50, 50, 50, 174, 174, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 169, 169,
+	216, 216, 218, 218, 10, 10, 111, 111, 51, 51,
+	52, 52, 113, 113, 113, 113, 112, 187, 187, 188,
+	188, 188, 189, 189, 189, 189, 189, 189, 189, 185,
+	185, 186, 183, 183, 184, 184, 184, 184, 224, 224,
+	110, 110, 55, 55, 192, 192, 192, 192, 190, 190,
+	190, 190, 190, 193, 191, 194, 194, 194, 194, 194,
+	134, 134, 134, 26, 8, 8, 97, 97, 60, 60,
+	138, 138, 138, 46, 46, 35, 35, 35, 19, 19,
+	19, 19, 19, 19, 19, 19, 19, 98, 98, 99,
+	99, 25, 25, 25, 25, 25, 25, 25, 25, 226,
+	226, 41, 41, 42, 9, 11, 177, 177, 178, 178,
+	7, 7, 17, 48, 48, 104, 104, 104, 106, 106,
+	106, 105, 105, 105, 27, 75, 75, 76, 76, 144,
+	77, 77, 22, 22, 29, 29, 28, 28, 28, 28,
+	28, 28, 28, 28, 28, 30, 30, 31, 31, 31,
+	31, 31, 31, 31, 200, 200, 200, 202, 202, 199,
+	18, 18, 18, 18, 201, 201, 225, 225, 84, 84,
+	84, 54, 53, 53, 58, 58, 57, 57, 56, 59,
+	59, 137, 82, 82, 82, 82, 100, 101, 101, 102,
+	102, 103, 103, 81, 81, 121, 121, 32, 32, 65,
+	65, 66, 66, 139, 139, 139, 139, 139, 140, 140,
+	140, 140, 140, 140, 135, 135, 135, 135, 136, 136,
+	87, 87, 87, 87, 85, 85, 86, 86, 141, 141,
+	141, 141, 83, 83, 142, 142, 142, 114, 114, 147,
+	147, 147, 63, 63, 63, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 149,
+	149, 149, 149, 151, 151, 151, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 152,
+	152, 159, 159, 160, 160, 161, 162, 153, 153, 154,
+	154, 155, 156, 163, 163, 163, 165, 165, 157, 157,
+	157, 157, 64, 64, 64, 158, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	93, 93, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	195, 195, 195, 195, 195, 195, 195, 197, 197, 198,
+	198, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 203, 203, 204, 204,
+	205, 205, 206, 206, 208, 209, 209, 209, 210, 214,
+	214, 207, 207, 211, 211, 211, 212, 212, 213, 213,
+	213, 213, 213, 125, 125, 125, 126, 126, 127, 70,
+	70, 123, 123, 122, 122, 122, 124, 124, 71, 164,
+	164, 164, 164, 164, 164, 164, 88, 88, 94, 89,
+	89, 90, 90, 90, 90, 90, 90, 95, 96, 91,
+	91, 91, 120, 120, 128, 132, 132, 131, 130, 130,
+	129, 129, 115, 115, 115, 115, 115, 78, 78, 227,
+	227, 133, 133, 79, 79, 80, 74, 74, 73, 73,
+	143, 143, 143, 143, 67, 67, 47, 47, 61, 61,
+	62, 62, 45, 45, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 166, 166, 166, 43, 43,
+	43, 44, 44, 172, 172, 172, 173, 173, 173, 173,
+	171, 171, 171, 171, 171, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 180, 180, 180, 180, 180, 180, 180, 180, 180,
+	180, 180, 180, 180, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182,
 }
 var sqlR2 = [...]int{
 
 	0, 1, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	4, 6, 1, 3, 2, 5, 3, 6, 4, 6,
-	6, 6, 4, 8, 2, 3, 3, 6, 4, 3,
-	2, 1, 1, 0, 2, 0, 2, 0, 1, 1,
-	1, 5, 3, 5, 4, 6, 3, 5, 1, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 4, 6, 6, 1, 3, 2, 5, 3,
+	6, 4, 6, 6, 6, 4, 8, 2, 3, 3,
+	6, 4, 3, 2, 1, 3, 2, 1, 1, 0,
+	2, 0, 2, 0, 1, 1, 1, 1, 1, 5,
+	3, 5, 4, 6, 3, 5, 3, 3, 1, 3,
 	1, 2, 2, 3, 2, 5, 1, 1, 1, 1,
-	1, 3, 1, 6, 6, 1, 2, 2, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 2, 3, 3, 2, 1, 3, 3, 3, 3,
-	1, 3, 3, 2, 1, 1, 3, 1, 1, 1,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 0, 1, 1,
-	2, 2, 4, 2, 4, 4, 3, 3, 4, 2,
-	2, 0, 2, 0, 2, 0, 6, 9, 1, 0,
-	1, 3, 1, 1, 1, 3, 2, 0, 3, 1,
-	2, 2, 1, 1, 2, 4, 2, 5, 6, 7,
-	3, 1, 4, 5, 5, 10, 1, 1, 4, 0,
-	3, 0, 2, 2, 2, 0, 1, 1, 2, 2,
-	0, 3, 3, 2, 1, 1, 2, 2, 1, 2,
-	1, 4, 10, 13, 1, 0, 1, 3, 3, 3,
-	5, 2, 0, 1, 1, 0, 6, 6, 8, 6,
-	8, 8, 10, 8, 10, 1, 0, 2, 0, 3,
-	2, 2, 1, 0, 1, 0, 3, 3, 6, 6,
-	1, 3, 1, 4, 2, 8, 5, 0, 4, 3,
-	0, 7, 1, 3, 1, 1, 3, 5, 5, 1,
-	1, 3, 3, 1, 2, 3, 2, 3, 4, 1,
-	1, 8, 8, 1, 2, 4, 4, 4, 2, 2,
-	3, 1, 3, 6, 1, 1, 1, 1, 1, 0,
-	1, 0, 1, 1, 0, 1, 1, 0, 1, 0,
-	3, 1, 3, 2, 2, 2, 1, 1, 2, 2,
-	3, 1, 1, 1, 1, 3, 0, 2, 0, 2,
-	3, 2, 0, 1, 3, 2, 2, 1, 4, 3,
-	4, 5, 4, 5, 4, 5, 2, 4, 1, 1,
-	0, 2, 2, 2, 1, 1, 0, 4, 2, 1,
-	2, 2, 4, 1, 3, 1, 2, 3, 2, 0,
-	2, 5, 2, 3, 4, 0, 1, 1, 1, 1,
-	2, 4, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 5, 0, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 1, 3, 0, 1, 1,
-	1, 1, 5, 2, 1, 1, 1, 1, 4, 1,
-	2, 2, 1, 1, 0, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 0, 1, 4, 1, 3, 3, 5, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	1, 3, 1, 6, 4, 6, 4, 1, 2, 2,
+	1, 1, 1, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 1, 3, 2, 3, 3, 2,
+	3, 2, 1, 3, 3, 3, 3, 1, 3, 3,
+	2, 1, 1, 3, 1, 1, 1, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 1, 1, 0, 1, 1, 2, 2, 4,
+	2, 4, 4, 3, 2, 3, 4, 2, 2, 0,
+	2, 0, 2, 0, 7, 10, 7, 0, 1, 0,
+	1, 3, 1, 1, 1, 1, 3, 2, 0, 3,
+	1, 2, 2, 1, 1, 2, 4, 2, 5, 6,
+	7, 5, 3, 1, 4, 5, 5, 10, 1, 1,
+	4, 0, 3, 0, 2, 2, 2, 0, 1, 1,
+	2, 2, 0, 3, 3, 2, 1, 1, 2, 2,
+	1, 2, 1, 4, 12, 15, 1, 0, 1, 3,
+	3, 3, 5, 2, 0, 1, 1, 0, 6, 6,
+	8, 6, 8, 8, 10, 8, 10, 1, 0, 2,
+	0, 3, 2, 2, 2, 3, 2, 5, 4, 1,
+	0, 1, 0, 3, 3, 4, 3, 0, 1, 1,
+	3, 6, 6, 1, 3, 1, 4, 2, 8, 5,
+	0, 4, 3, 0, 7, 1, 3, 1, 1, 3,
+	5, 5, 1, 1, 3, 3, 1, 2, 3, 3,
+	4, 4, 2, 3, 4, 1, 1, 8, 8, 1,
+	2, 4, 4, 4, 2, 2, 3, 1, 3, 6,
+	1, 1, 1, 1, 1, 0, 1, 0, 1, 1,
+	0, 1, 1, 0, 2, 2, 1, 0, 3, 1,
+	3, 2, 2, 2, 1, 1, 2, 2, 3, 1,
+	1, 1, 1, 3, 0, 2, 0, 2, 3, 2,
+	0, 1, 3, 2, 2, 2, 1, 4, 3, 4,
+	5, 4, 5, 4, 5, 2, 4, 1, 1, 0,
+	2, 2, 2, 1, 1, 0, 4, 2, 1, 2,
+	2, 4, 1, 3, 1, 2, 3, 2, 0, 2,
+	5, 2, 2, 3, 0, 1, 1, 1, 1, 2,
+	4, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 5, 0, 1, 1, 1, 1,
+	1, 1, 2, 2, 2, 2, 2, 1, 1, 3,
+	0, 1, 1, 1, 1, 5, 2, 1, 1, 1,
+	1, 4, 1, 2, 2, 1, 1, 0, 1, 2,
+	1, 2, 3, 3, 0, 1, 1, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 0,
+	1, 4, 1, 3, 3, 5, 2, 2, 2, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 3, 4, 4, 5, 3, 4, 3,
-	3, 4, 3, 4, 3, 4, 5, 6, 6, 7,
-	6, 7, 6, 7, 3, 4, 1, 3, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 5,
-	6, 6, 7, 1, 1, 1, 3, 1, 1, 1,
-	2, 2, 2, 1, 1, 3, 5, 6, 8, 6,
-	6, 4, 4, 1, 1, 1, 5, 1, 3, 1,
-	3, 1, 1, 1, 1, 6, 4, 4, 4, 4,
-	6, 5, 5, 5, 4, 8, 6, 6, 4, 4,
-	4, 5, 0, 5, 0, 2, 0, 1, 3, 3,
-	2, 2, 0, 6, 1, 0, 3, 0, 2, 2,
-	0, 1, 4, 2, 2, 2, 2, 2, 4, 3,
-	5, 4, 3, 5, 1, 3, 1, 3, 3, 3,
-	2, 1, 3, 3, 1, 1, 1, 1, 1, 1,
-	1, 4, 3, 2, 3, 0, 3, 3, 2, 2,
-	1, 0, 2, 2, 3, 2, 1, 1, 3, 5,
-	1, 2, 4, 2, 0, 1, 0, 2, 2, 2,
-	3, 5, 1, 2, 1, 0, 1, 1, 1, 3,
-	3, 1, 0, 1, 3, 3, 2, 1, 1, 1,
-	3, 1, 2, 1, 3, 3, 0, 1, 2, 1,
-	1, 1, 1, 6, 2, 3, 5, 1, 1, 1,
-	1, 2, 2, 1, 1, 1, 1, 0, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 3, 4,
+	4, 5, 3, 4, 3, 3, 4, 3, 4, 3,
+	4, 5, 6, 6, 7, 6, 7, 6, 7, 3,
+	4, 6, 6, 1, 3, 2, 2, 2, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 5, 6, 6, 7, 1, 1, 1,
+	3, 4, 1, 1, 1, 2, 2, 2, 1, 1,
+	3, 5, 6, 8, 6, 6, 4, 4, 1, 1,
+	1, 5, 1, 3, 1, 3, 1, 1, 1, 1,
+	6, 4, 4, 4, 4, 6, 5, 5, 5, 4,
+	8, 6, 6, 4, 4, 4, 5, 0, 5, 0,
+	2, 0, 1, 3, 3, 2, 2, 0, 6, 1,
+	0, 3, 0, 2, 2, 0, 1, 4, 2, 2,
+	2, 2, 2, 4, 3, 5, 4, 3, 5, 1,
+	3, 1, 3, 3, 3, 2, 1, 3, 3, 1,
+	1, 1, 1, 1, 1, 1, 4, 3, 2, 3,
+	0, 3, 3, 2, 2, 1, 0, 2, 2, 3,
+	2, 1, 1, 3, 5, 1, 2, 4, 2, 0,
+	1, 0, 2, 2, 2, 3, 5, 1, 2, 1,
+	0, 1, 1, 1, 3, 3, 1, 0, 1, 3,
+	3, 2, 1, 1, 1, 3, 1, 2, 1, 3,
+	3, 0, 1, 2, 1, 1, 1, 1, 6, 2,
+	3, 5, 1, 1, 1, 1, 2, 2, 1, 1,
+	1, 1, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3102,349 +3511,379 @@ var sqlR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1,
+	1, 1, 1,
 }
 var sqlChk = [...]int{
 
-	-1000, -1, -2, -3, -4, -5, -9, -10, -11, -13,
-	-14, -16, -17, -18, -19, -20, -21, -22, -23, 19,
-	-6, -7, -8, -190, 81, 87, 99, 178, -24, -25,
-	191, 192, 29, 50, 180, 216, 56, -189, -27, -26,
-	260, 236, 242, 187, -28, 204, 229, 263, 204, 68,
-	109, 76, 112, 223, 68, 109, 204, -12, 260, -18,
-	-14, -23, -9, -208, 18, -209, -210, 56, 81, 99,
-	187, 112, 76, 223, -208, -101, 130, 189, 212, -102,
-	-100, -162, 208, 138, -61, -38, 4, -169, -171, 16,
-	17, 19, 28, 29, 33, 37, 39, 49, 50, 51,
-	53, 55, 58, 59, 66, 67, 68, 69, 71, 76,
-	80, 81, 87, 91, 92, 94, 100, 105, 112, 120,
-	122, 127, 134, 135, 136, 137, 140, 141, 143, 146,
-	148, 149, 155, 158, 161, 162, 165, 168, 169, 171,
-	172, 174, 175, 176, 178, 180, 181, 183, 185, 186,
-	188, 191, 192, 194, 196, 198, 201, 199, 205, 206,
-	216, 217, 218, 219, 222, 223, 226, 227, 228, 232,
-	237, 238, 239, 240, 30, 31, 32, 34, 35, 38,
-	42, 43, 45, 70, 72, 73, 86, 88, 93, 101,
-	103, 106, 107, 113, 114, 115, 117, 125, 145, 147,
-	156, 160, 164, 166, 170, 182, 195, 200, 202, 209,
-	213, 214, 229, 230, 4, 68, 49, 69, 100, 109,
-	205, 208, 212, 18, -213, 212, -213, -213, -212, 204,
-	204, -90, 68, 221, -26, -27, -25, -51, -52, 220,
-	116, 85, 154, -24, -25, -189, -191, 171, -188, -38,
-	130, 138, 189, 212, 208, -191, -48, -49, 18, 78,
-	264, -133, -42, 152, -38, -73, 260, -3, -133, 106,
-	-38, -42, 106, 97, 118, -134, -133, -38, 106, -60,
-	106, -42, -62, 106, -61, -138, -137, -165, 4, -169,
-	-171, -170, 229, 47, 57, 98, 111, 119, 121, 126,
-	128, 139, 157, 159, 179, 193, 151, 264, 151, -101,
-	-101, -37, 120, 210, 245, 97, 240, -45, 6, 74,
-	-65, 262, 97, -205, 151, 97, -161, 97, 240, 120,
-	-36, -37, -76, -133, -61, 106, 109, -38, 106, -51,
-	-52, -75, -93, -94, 129, 150, -77, 18, 78, -77,
-	-77, 37, 261, 261, 264, -191, -56, 260, -67, -66,
-	-135, -108, 253, -110, 251, 252, 257, 142, 241, -117,
-	-42, -111, 9, 260, -120, -186, -25, 86, 24, -118,
-	-119, 182, -38, 8, 5, 6, 7, -40, -141, -150,
-	215, 89, 144, 40, -184, -185, 4, -169, -164, -142,
-	-152, -146, -149, 117, 47, 61, 64, 62, 65, 190,
-	224, 41, 88, 160, 164, 202, 213, 214, 106, 145,
-	107, 45, 101, 125, 80, 31, 32, 34, 35, 42,
-	43, 70, 72, 73, 93, 113, 114, 115, 147, 170,
-	195, 209, 230, -170, -153, -154, -147, -148, -155, -66,
-	-73, 253, -42, 260, -71, -107, 262, 265, 258, -72,
-	-125, -108, 74, -33, 174, -32, 17, 19, 81, 227,
-	86, 174, 174, 86, -134, -43, -42, 191, -38, 25,
-	86, -35, 264, 39, 176, 86, 264, 86, 261, 264,
-	-204, -60, 204, 68, -210, -204, 127, -160, 74, -167,
-	-159, -126, 9, 215, 89, 151, -166, 5, 252, -158,
-	-165, 6, 8, 251, -160, 74, 59, -168, 6, 4,
-	-150, -126, 74, 130, 117, 262, -163, 4, -169, -171,
-	-170, -172, 18, 20, 21, 22, 23, 24, 25, 26,
-	27, 36, 40, 41, 44, 46, 48, 54, 56, 60,
-	61, 62, 63, 64, 65, 74, 75, 77, 78, 79,
-	82, 83, 85, 89, 90, 95, 96, 97, 99, 102,
-	104, 108, 109, 110, 116, 118, 123, 124, 129, 131,
-	132, 142, 144, 150, 151, 152, 153, 154, 163, 167,
-	173, 177, 187, 190, 197, 203, 204, 207, 210, 211,
-	215, 220, 221, 224, 225, 231, 233, 234, 235, 236,
-	-162, -207, 95, -204, -162, -162, 127, -35, 264, 260,
-	142, -39, 106, -38, 142, -75, -94, -93, -95, -108,
-	18, -108, -110, -26, -26, -26, -53, -129, -108, -188,
-	25, -55, -38, -58, 97, 264, 10, 46, 28, 251,
-	252, 253, 254, 255, 248, 249, 250, 247, 243, 244,
-	245, 52, 133, 184, 12, 13, 14, 22, 153, 128,
-	241, 193, 119, 30, 108, 25, 4, -108, -108, -108,
-	-108, -108, 159, -25, -108, -63, -71, -25, -114, 258,
-	260, -71, 260, 6, 6, 260, -121, -108, -192, 237,
-	95, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 166, -157, 232, -157,
-	-157, -143, 260, -143, -144, 260, -143, 260, -58, -42,
-	-107, -163, 253, -163, -108, 264, 261, 264, 210, -91,
-	54, 48, -104, 106, 48, -173, -38, 54, -174, 44,
-	221, 167, 96, -91, 54, -91, 54, 54, -133, 210,
-	210, -42, -106, 234, -97, -18, 260, 74, 25, -68,
-	-69, -136, -70, -42, 260, -38, -38, -42, -60, -61,
-	-62, -12, -137, 210, -60, -55, 97, -44, 169, 175,
-	196, 188, 264, 5, 8, 8, 6, -163, -206, -38,
-	-133, -46, -47, -105, -104, -175, -173, 109, 221, 86,
-	151, 142, 86, -96, 182, 183, 264, -31, 26, 77,
-	260, 264, 261, -106, -59, -131, -133, -25, -132, 260,
-	-135, -139, -140, -142, -151, -145, -149, -150, 33, 38,
-	206, 200, 113, 114, 115, 195, 31, 170, 93, 80,
-	73, 72, 147, 35, 34, -153, -154, -147, -148, 70,
-	209, 32, 43, 42, 230, -61, 208, -108, -108, -108,
-	-108, -108, -108, -108, -108, -108, -108, -108, -108, -108,
-	-108, -108, -108, -108, -108, -108, -108, -108, 128, 193,
-	30, 108, 210, 144, 142, 215, 89, 222, 78, 148,
-	-214, 203, 27, -112, -25, 260, -163, -117, 182, 260,
-	261, 264, -63, -116, 259, -108, -114, -63, 261, 261,
-	-63, 231, 18, 78, 253, -85, 239, 136, 71, 105,
-	135, -86, 186, 8, -124, -123, 233, -193, 91, 102,
-	260, 261, 261, -108, -64, -156, 4, 239, 136, 71,
-	105, 135, 186, -81, -108, -82, -109, -110, 251, 252,
-	257, 260, 182, -83, -108, -63, -108, 36, 124, 211,
-	-84, -108, 97, -63, -108, -108, -108, -63, -63, -63,
-	260, 8, 8, 8, -106, 261, 259, 266, -125, -32,
-	-42, -38, -38, 142, -104, 106, -139, -38, 260, 260,
-	122, 122, -38, -38, 106, -38, 106, -38, -38, -33,
-	174, -38, -38, 174, -108, -99, 151, -60, 229, -38,
-	-58, 264, 245, -60, -35, -206, -206, 219, 51, 169,
-	-167, -85, 264, 261, 264, -39, 109, -61, -42, 86,
-	-38, -129, -15, -18, -14, -23, -9, -38, -74, 102,
-	264, 57, -80, 121, 139, 98, 126, 179, 111, -128,
-	-127, 25, -38, -128, -25, -132, -131, -57, 24, -85,
-	260, 240, -108, 210, -214, 203, -112, -108, 144, 215,
-	89, 222, 78, 148, 97, 260, -109, -109, -63, 260,
-	-63, -108, 264, 259, 259, 264, 261, -52, 264, -51,
-	-108, -63, -63, 261, 210, 210, 210, 210, 260, 261,
-	-122, -123, 82, -108, -198, 158, 260, 260, -108, 25,
-	261, 97, 261, -87, 163, 261, 10, 251, 252, 253,
-	254, 255, 248, 249, 250, 247, 243, 244, 245, 52,
-	133, 184, 12, 13, 14, 119, 108, -109, -109, -109,
-	-63, 260, 261, -88, -89, 97, 95, 25, -84, -84,
-	-84, 261, 97, -63, 264, 264, 264, 261, 261, 261,
-	8, 261, 264, 261, 261, -74, -108, 210, 210, 86,
-	142, -176, -174, -108, -55, 260, 260, -29, 81, 191,
-	-92, 86, -35, 86, -35, 210, -91, 54, 210, 53,
-	261, -106, -69, -125, 261, -38, -105, 260, -39, 260,
-	260, -38, 261, -113, 104, 37, -131, 121, 121, -131,
-	-80, 121, -78, 157, -78, -78, -38, 260, 261, 258,
-	258, 8, -108, -108, -109, -109, 97, 260, -108, -115,
-	-139, 22, 22, 261, -63, 261, 264, 261, -108, -114,
-	261, 231, -52, -52, -52, 136, 105, 135, -86, 135,
-	-86, -86, 8, 6, 83, -108, 207, -199, -38, 260,
-	234, -51, 261, -139, -108, -88, -108, -139, -109, -109,
-	-109, -109, -109, -109, -109, -109, -109, -109, -109, -109,
-	-109, -109, -109, -109, -109, -109, 78, 142, 148, -109,
-	264, -63, 261, -89, -88, -108, -108, -139, 261, 261,
-	261, -63, -108, -108, -108, 261, 8, -113, 259, -38,
-	-38, -104, 86, -177, 54, -178, 46, 142, 144, 221,
-	167, 44, 74, 173, 261, 261, -55, -55, 142, 74,
-	142, 74, 67, 217, -38, -38, -42, -38, -38, -38,
-	-98, 260, 151, -18, 245, -55, 260, -46, -54, -130,
-	-38, -187, 260, -184, -185, -40, 151, -194, 235, -108,
-	-63, -131, -131, -79, 225, 151, 121, -131, 260, -55,
-	-127, 259, 8, 8, 261, 22, 22, -108, -115, 261,
-	264, -108, -108, 261, -108, 6, -108, 261, 261, 261,
-	261, -108, -203, -38, -108, 261, 261, -89, 97, 78,
-	148, 260, -108, 261, 261, 264, 261, 261, 261, -194,
-	-104, -38, -61, 144, 122, 260, -109, -42, -103, -211,
-	55, 201, 261, 261, 144, 144, -108, -139, -35, -35,
-	210, 210, 79, -54, 54, -73, -25, 260, 261, -55,
-	261, 261, 264, -41, -71, 46, -41, -108, 260, -42,
-	-195, -197, -38, -79, 260, -108, -131, -55, 261, 259,
-	259, -108, -108, 261, -139, 261, -52, -196, 162, 261,
-	-109, 97, 260, -115, 261, -108, -178, -108, -50, 260,
-	260, 173, -34, 46, -38, -38, 223, 143, 261, -38,
-	-103, 261, -103, -130, -31, -61, -31, 261, -63, 260,
-	264, 25, -55, 261, 261, -52, 37, -109, -115, 261,
-	261, 261, -181, 134, -55, -55, -42, -30, 225, -61,
-	191, -106, -103, -41, -52, -54, -197, -199, 261, -200,
-	168, 183, -63, 261, -179, -182, -180, 151, 98, 161,
-	194, 261, 261, -50, -108, -68, -31, 261, 261, 261,
-	-201, -202, 30, 218, 59, -108, -201, -180, 151, -182,
-	151, 223, 76, -181, -106, -103, -202, 165, 94, 182,
-	165, 94, -183, 141, 176, 39, 191, -183, -179, 22,
-	16, 144, 74, -202,
+	-1000, -1, -2, -3, -4, -5, -6, -12, -13, -14,
+	-16, -17, -19, -20, -21, -22, -23, -24, -25, -26,
+	-27, 25, -7, -8, -9, -10, -11, -201, 87, 94,
+	107, 191, 194, -28, -29, 209, 211, 35, 56, 197,
+	202, 188, 236, 62, -200, -31, -30, 284, 258, 265,
+	205, -32, 223, 251, 287, 223, 244, 74, 117, 82,
+	121, 243, 74, 117, 223, 196, 244, -15, 284, -22,
+	-17, -27, -12, -221, -219, 24, -222, -220, -223, 4,
+	62, 87, 107, 205, 121, 82, 243, -170, 227, -68,
+	-43, 4, -179, -181, 22, 23, 25, 34, 35, 39,
+	43, 45, 55, 56, 57, 59, 61, 64, 65, 72,
+	73, 74, 75, 77, 82, 86, 87, 93, 94, 99,
+	100, 102, 108, 113, 119, 121, 125, 130, 132, 133,
+	134, 139, 142, 146, 147, 148, 149, 150, 152, 153,
+	155, 158, 160, 161, 167, 170, 173, 174, 175, 176,
+	179, 182, 183, 185, 186, 188, 189, 190, 191, 192,
+	194, 196, 197, 198, 200, 202, 203, 204, 206, 207,
+	209, 210, 211, 213, 215, 217, 220, 218, 224, 225,
+	232, 236, 237, 238, 239, 242, 243, 245, 247, 248,
+	249, 250, 254, 259, 260, 261, 262, 263, 36, 37,
+	38, 40, 41, 44, 48, 49, 51, 76, 78, 79,
+	92, 95, 101, 109, 111, 114, 115, 122, 123, 124,
+	127, 137, 157, 159, 168, 172, 178, 180, 184, 199,
+	214, 219, 221, 228, 229, 233, 234, 251, 252, -221,
+	-219, -108, 142, 207, 232, -109, -107, -170, 227, 150,
+	4, 74, 55, 75, 108, 117, 224, 245, 227, 232,
+	24, -226, 232, -226, -226, -43, 227, 202, -43, -225,
+	223, 223, -97, 196, 244, 74, 241, -30, -31, -29,
+	-56, -57, 240, 126, 91, 166, -28, -29, -200, -202,
+	185, -199, -43, -202, -53, -54, 24, 84, 288, -141,
+	-47, 164, -43, -80, 284, -3, -141, 114, -43, -43,
+	-47, 114, 105, 128, -142, -141, -43, 114, -67, 114,
+	-47, -69, 114, -68, -219, -61, -43, -146, -145, -173,
+	4, -179, -181, -180, 251, 53, 63, 106, 120, 129,
+	131, 138, 140, 151, 169, 171, 195, 212, 163, 230,
+	288, 288, 263, -72, 286, 163, 105, -108, -108, -42,
+	130, 230, 268, 105, 263, -50, 6, 80, 105, -216,
+	163, 105, -169, 105, 263, 130, -41, -42, 230, -43,
+	-83, -141, -68, 114, 117, -220, -43, -43, 114, -56,
+	-57, -82, -58, -100, -101, 103, 141, 162, -84, 24,
+	84, -84, -84, 43, 285, 285, 288, -202, -62, 284,
+	-74, -73, -143, -116, 277, -118, 275, 276, 281, 154,
+	264, -125, -47, -119, 9, 284, -128, -197, -29, 92,
+	30, -126, -127, 199, -43, 8, 5, 6, 7, -45,
+	-149, -158, 235, 96, 156, 46, -195, -196, 4, -179,
+	-172, -150, -160, -154, -157, 127, 53, 67, 70, 68,
+	71, 208, 244, 47, 95, 172, 178, 221, 233, 234,
+	114, 157, 115, 51, 109, 137, 86, 37, 38, 40,
+	41, 48, 49, 76, 78, 79, 101, 122, 123, 124,
+	159, 184, 214, 227, 228, 229, 252, -180, -161, -162,
+	-155, -156, -163, -73, -80, 277, -47, 284, -78, -115,
+	286, 289, 282, -79, -133, -116, 80, -38, 189, -36,
+	23, 25, 87, 249, 93, 92, 258, 189, 189, 92,
+	-142, -48, -47, 209, -43, 31, 92, -40, 288, 45,
+	192, 92, 288, 92, 288, 285, 288, -215, -67, 223,
+	74, -217, -43, -220, -223, 286, -171, 4, -179, -181,
+	-180, -182, 24, 26, 27, 28, 29, 30, 31, 32,
+	33, 42, 46, 47, 50, 52, 54, 60, 62, 66,
+	67, 68, 69, 70, 71, 80, 81, 83, 84, 85,
+	88, 89, 91, 96, 97, 98, 103, 104, 105, 107,
+	110, 112, 116, 117, 118, 126, 128, 135, 136, 141,
+	143, 144, 154, 156, 162, 163, 164, 165, 166, 177,
+	181, 187, 193, 205, 208, 216, 222, 223, 226, 230,
+	231, 235, 240, 241, 244, 246, 253, 255, 256, 257,
+	258, -215, -217, 139, -168, 80, -175, -167, -134, 9,
+	235, 96, 163, -174, 5, 276, -166, -173, 6, 8,
+	275, -168, 80, 65, -176, 6, 4, -158, -134, 80,
+	142, 127, -170, -218, 103, -215, -170, -170, 139, 202,
+	-43, -40, 288, 284, 154, -44, 114, -43, -177, 258,
+	154, -82, -58, -82, -101, -100, 243, 210, -102, -116,
+	24, -116, -118, -30, -30, -30, -59, -137, -116, -199,
+	31, -61, -65, 105, 288, 10, 52, 34, 275, 276,
+	277, 278, 279, 272, 273, 274, 271, 266, 267, 268,
+	58, 12, 13, 14, 270, 15, 16, 17, 145, 201,
+	18, 19, 20, 28, 165, 140, 264, 212, 129, 36,
+	116, 31, 4, -116, -116, -116, -116, -116, 171, -29,
+	-116, -70, -78, -29, -122, 282, 284, -78, 284, 6,
+	6, 284, -129, -116, -203, 259, 103, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 284, 180, -165, 254, -165, -165, -151, 284, -151,
+	-152, 284, -151, -64, 265, 260, -64, 284, -65, -47,
+	-115, -171, 277, -171, -116, 288, 285, 288, 230, -98,
+	60, 54, -112, 114, 54, -183, -43, 60, -184, 50,
+	241, 181, 104, -98, 60, -98, 60, 60, 209, -141,
+	176, 230, 230, -47, -114, 256, -104, -22, 284, 80,
+	31, -75, -76, -144, -77, -47, 284, -43, -43, -47,
+	-67, -68, -69, -43, -15, -145, 230, -67, -61, 288,
+	-171, 105, -49, 183, 190, 215, 206, 288, 5, 8,
+	8, 6, -217, -43, -141, -51, -52, -113, -112, -185,
+	-186, -183, 117, 241, 97, 92, 163, 154, 176, 92,
+	-103, 199, 200, 288, -35, 32, 83, 284, 285, -114,
+	-66, -139, -141, -29, -195, -140, 284, -45, -143, -147,
+	-148, -150, -159, -153, -157, -158, 39, 44, 225, 219,
+	132, 133, 247, 119, 122, 123, 124, 214, 37, 184,
+	101, 86, 79, 78, 159, 41, 40, -161, -162, -155,
+	-156, 76, 228, 229, 227, 38, 49, 48, 252, -43,
+	227, -116, -116, -116, -116, -116, -116, -116, -116, -116,
+	-116, -116, -116, 29, 216, -116, -116, -116, -116, -116,
+	-116, -116, -116, -116, -116, -116, -116, -116, -116, -116,
+	-116, 140, 212, 36, 116, 230, 156, 154, 235, 96,
+	242, 84, 160, -227, 222, 33, -120, -29, 284, -171,
+	-125, 199, 284, 285, 288, -70, -124, 283, -116, -122,
+	-70, 285, 285, -70, 253, 24, 84, 277, -92, 262,
+	148, 77, 113, 147, -93, 204, 8, -132, -131, 255,
+	-204, 99, 110, 284, 285, 285, -116, -71, -164, 4,
+	262, 148, 77, 113, 147, 204, -88, -116, -89, -117,
+	-118, 275, 276, 281, 284, 199, -90, -116, -70, -116,
+	42, 136, 231, -91, -116, 105, -70, -116, -116, -116,
+	-70, -70, -70, 284, 8, 8, 227, 227, 8, -114,
+	285, 283, 290, -133, -36, -47, -43, -43, 154, -112,
+	114, -147, -43, 284, 284, 134, 134, -43, -43, 114,
+	-43, 114, -43, -43, -37, 183, 161, -38, 189, -178,
+	6, 9, -43, -43, 189, -116, -106, 163, -67, 251,
+	-43, -65, 288, 268, -67, -40, -217, -43, -217, 239,
+	57, 183, -175, -92, 285, 288, -44, 117, -44, -68,
+	-47, 92, -178, -43, -137, -18, -22, -17, -27, -12,
+	-81, 110, 288, 63, -87, 131, 151, 106, 138, 195,
+	120, -136, -135, 31, -43, -136, -136, -29, -140, -139,
+	284, -63, 30, 282, -92, 284, 263, 284, 284, -116,
+	230, -227, 222, -120, -116, 156, 235, 96, 242, 84,
+	160, 105, 284, -117, -117, -70, 284, -70, -78, -116,
+	288, 283, 283, 288, 285, -57, 288, -56, -116, -70,
+	-70, 285, 230, 230, 230, 230, 284, 285, -130, -131,
+	88, -116, -209, 170, 284, 284, -116, 31, 285, 105,
+	285, -94, 177, 285, 10, 275, 276, 277, 278, 279,
+	272, 273, 274, 271, 266, 267, 268, 58, 12, 13,
+	14, 270, 15, 16, 17, 145, 201, 18, 19, 20,
+	129, 116, -117, -117, -117, -70, 284, 285, -95, -96,
+	105, 103, 31, -91, -91, -91, 285, 105, -70, 288,
+	288, 288, 285, 285, 285, 8, 285, 288, 285, 263,
+	263, 285, -81, -116, 230, 230, 92, 154, -187, -184,
+	-116, -61, 284, 284, -33, 87, 209, -99, 92, -40,
+	92, -40, 261, 230, -98, 60, 230, 59, 285, -114,
+	-76, -133, 285, -111, 125, -113, 284, -44, 284, 284,
+	284, -43, 285, -121, 112, 43, -139, 131, 131, -139,
+	-87, 131, -85, 169, -85, -85, -43, 284, 285, -70,
+	282, 283, 8, 8, -116, -116, -116, -116, -117, -117,
+	105, 284, -116, -123, -147, 28, 28, 285, -70, 285,
+	288, 285, -116, -122, 285, 253, -57, -57, -57, 148,
+	113, 147, -93, 147, -93, -93, 8, 6, 89, -116,
+	226, -210, -43, 284, 256, -56, 285, -147, -116, -95,
+	-116, -147, -117, -117, -117, -117, -117, -117, -117, -117,
+	-117, -117, -117, -117, -117, -117, -117, -117, -117, -117,
+	-117, -117, -117, -117, -117, -117, -117, 84, 154, 160,
+	-117, 288, -70, 285, -96, -95, -116, -116, -147, 285,
+	285, 285, -70, -116, -116, -116, 285, 8, -121, 283,
+	-43, -43, -112, 92, -188, 60, -189, 52, 154, 156,
+	241, 181, 50, 80, 187, 285, 285, -61, -61, 154,
+	80, 154, 80, 73, 237, -43, -43, -47, -43, -43,
+	-43, -105, 284, 163, -22, 268, 116, -61, 284, -61,
+	-51, -60, -138, -43, -198, 284, -195, -196, 163, -205,
+	257, -116, -70, -139, -139, -86, 246, 163, 131, -139,
+	284, -61, -135, -57, 8, 283, 285, 285, 285, 28,
+	28, -116, -123, 285, 288, -116, -116, 285, -116, 6,
+	-116, 285, 285, 285, 285, -116, -214, -43, -116, 285,
+	285, -96, 105, 84, 160, 284, -116, 285, 285, 288,
+	285, 285, 285, -205, -112, -43, -43, 156, 134, 284,
+	-117, -47, -110, -224, 61, 220, 285, 285, 156, 156,
+	-116, -147, -40, -40, 230, 230, 85, -60, 60, -80,
+	-29, 284, 173, 285, -61, 285, 285, 285, 288, -46,
+	-78, 52, -46, -116, -47, -206, -208, -43, -86, 284,
+	-116, -139, -61, 285, 285, 283, -116, -116, 285, -147,
+	285, -57, -207, 175, 285, -117, 105, 284, -123, 285,
+	-116, -189, -116, -55, 284, 284, 187, -39, 52, -43,
+	-43, 243, 155, 285, -43, -47, -110, 285, -111, -110,
+	-138, -35, -68, -35, 285, 284, 288, 31, -61, 285,
+	285, -57, 43, -117, -123, 285, 285, 285, -192, 146,
+	-61, -61, -47, -34, 246, -68, 209, -114, 284, -110,
+	-111, -46, -60, -208, -210, 285, -211, 182, 200, -70,
+	285, -190, -193, -191, 163, 106, 174, 213, 285, 285,
+	-55, -116, -75, -61, -114, -35, 285, 285, -212, -213,
+	36, 238, 65, -116, -212, -191, 163, -193, 163, 243,
+	82, -192, -114, 285, -110, -213, 179, 102, 199, 179,
+	102, -194, 153, 192, 45, 209, -194, -190, -111, 28,
+	22, 156, 80, -114, -213,
 }
 var sqlDef = [...]int{
 
 	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
-	10, 11, 12, 13, 14, 15, 16, 17, 18, 0,
-	48, 49, 50, 0, 0, 289, 0, 0, 259, -2,
-	0, 0, 233, 233, 233, 291, 205, 288, -2, 299,
-	0, 0, 0, 297, 273, 0, 0, -2, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 66,
-	67, 68, 69, 0, 78, 79, 80, 82, 83, 84,
-	85, 86, 87, 88, 0, 91, 733, 764, 774, 95,
-	100, 0, 824, -2, 104, 60, 683, 684, 685, 700,
-	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
-	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
-	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 734, 735, 736, 737, 739, 740, 741, 742,
-	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
-	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
-	763, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	775, 776, 777, 778, 779, 780, 781, 782, 783, 784,
-	785, 786, 787, 788, 789, 790, 791, 792, 793, 794,
-	795, 796, 797, 798, 799, 800, 801, 802, 803, 804,
-	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
-	815, 816, 817, 818, 819, 820, 821, 822, 823, 825,
-	826, 827, 828, 829, 130, 131, 0, 133, 143, 0,
-	141, 0, 0, 139, 235, 232, 230, 231, 0, 290,
-	0, 0, 0, 204, -2, 269, 270, -2, 0, 294,
-	294, 294, 0, 0, 270, 0, 278, 752, 281, 666,
-	733, 738, 764, 774, 824, 279, 652, 0, 296, 295,
-	0, 274, 349, 0, 661, 319, 0, 2, 0, 806,
-	0, 0, 806, 0, 0, 0, 355, 52, 806, 43,
-	806, 659, 56, 806, 58, 0, 70, 72, 691, 692,
-	693, 694, 828, 830, 831, 832, 833, 834, 835, 836,
-	837, 838, 839, 840, 841, 842, 0, 0, 0, 92,
-	93, 94, 0, 0, 0, 0, 0, 103, 125, 126,
-	61, 0, 0, 145, 0, 0, 136, 0, 137, 0,
-	229, 234, 43, 353, 0, 806, 687, 237, 806, -2,
-	0, 265, 306, 307, 0, 0, 0, 292, 293, 0,
-	0, 0, 261, 262, 0, 280, 0, 0, 322, 651,
-	653, 657, 658, 434, 0, 0, 0, 0, 0, 0,
-	513, 514, 515, 0, 517, 518, 519, 801, 0, 523,
-	524, 820, 661, 669, 670, 671, 672, 0, 0, 0,
-	677, 678, 679, 636, 562, 533, -2, -2, 667, 376,
-	377, 378, 379, -2, 830, 537, 539, 541, 542, 543,
-	544, 0, 802, 816, 817, 823, 826, 827, 806, 813,
-	807, 797, 804, 812, 721, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, 690, 400, 401, 406, 407, 409, 322,
-	320, 350, 351, 0, 662, 642, 0, 0, 0, 0,
-	648, 646, 647, 20, 226, 22, 0, 226, 226, 0,
-	0, 0, 0, 0, 359, 0, 240, 0, 356, 0,
-	0, 54, 0, 41, 42, 0, 0, 0, 289, 0,
-	0, 75, 0, 717, 81, 0, 0, 96, 98, 105,
-	107, 108, 109, 115, 116, 117, 118, 198, 0, 200,
-	128, 129, 680, 0, 97, 99, 101, 102, 119, 120,
-	0, 122, 123, 124, 417, 0, 62, 695, 696, 697,
-	698, 699, 843, 844, 845, 846, 847, 848, 849, 850,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 0, 54, 55, 56, 57, 58, 0, 0, 325,
+	0, 0, 0, 292, -2, 0, 0, 260, 260, 260,
+	0, 0, 327, 227, 324, -2, 337, 0, 0, 0,
+	333, 309, 0, 0, -2, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 74, 0, 76,
+	77, 78, 79, 0, 0, 90, 91, 104, 92, 103,
+	94, 95, 96, 97, 98, 99, 100, 109, 904, 121,
+	70, 748, 749, 750, 765, 766, 767, 768, 769, 770,
+	771, 772, 773, 774, 775, 776, 777, 778, 779, 780,
+	781, 782, 783, 784, 785, 786, 787, 788, 789, 790,
+	791, 792, 793, 794, 795, 796, 797, 798, 799, 800,
+	801, 802, 803, 804, 805, 806, 807, 808, 809, 810,
+	811, 812, 813, 814, 815, 816, 817, 818, 819, 820,
+	821, 822, 823, 824, 825, 826, 827, 828, 829, 830,
+	831, 832, 833, 834, 835, 836, 837, 838, 839, 840,
+	841, 842, 843, 844, 845, 846, 847, 848, 849, 850,
 	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
 	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
 	871, 872, 873, 874, 875, 876, 877, 878, 879, 880,
 	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
 	891, 892, 893, 894, 895, 896, 897, 898, 899, 900,
-	901, 902, 903, 904, 905, 906, 907, 908, 909, 910,
-	911, 912, 913, 914, 915, 916, 917, 918, 919, 920,
-	132, 134, 0, 142, 135, 140, 138, 201, 0, 149,
-	0, 0, 806, 686, 0, 268, 304, 305, 308, 311,
-	312, 309, 434, 275, 276, 277, 300, 301, 215, 282,
-	0, 0, 663, 359, 0, 0, 0, 0, 0, 0,
+	901, 902, 903, 905, 906, 907, 908, 909, 910, 0,
+	0, 106, 803, 840, 851, 112, 117, 0, 904, -2,
+	147, 148, 0, 150, 161, 0, 159, 154, 0, 0,
+	157, 262, 259, 252, 253, 254, 904, 836, 256, 0,
+	326, 0, 0, 0, 0, 0, 226, -2, 305, 306,
+	-2, 0, 330, 330, 330, 0, 0, 306, 0, 314,
+	824, 317, 731, 315, 717, 0, 332, 331, 0, 310,
+	388, 0, 726, 357, 0, 2, 0, 886, 0, 0,
+	0, 886, 0, 0, 0, 394, 60, 886, 49, 886,
+	724, 64, 886, 68, 66, 67, 728, 0, 80, 82,
+	756, 757, 758, 759, 909, 911, 912, 913, 914, 915,
+	916, 917, 918, 919, 920, 921, 922, 923, 0, 0,
+	0, 0, 110, 71, 0, 0, 0, 107, 108, 111,
+	0, 0, 0, 0, 0, 120, 142, 143, 0, 163,
+	0, 0, 153, 0, 155, 0, 251, 261, 0, 255,
+	49, 392, 0, 886, 752, 264, 267, 270, 886, -2,
+	0, 298, 299, 344, 345, 0, 0, 0, 0, 328,
+	329, 0, 0, 0, 294, 295, 0, 316, 0, 0,
+	360, 716, 718, 722, 723, 482, 0, 0, 0, 0,
+	0, 0, 577, 578, 579, 0, 582, 583, 584, 881,
+	0, 588, 589, 900, 726, 734, 735, 736, 737, 0,
+	0, 0, 742, 743, 744, 701, 627, 598, -2, -2,
+	732, 419, 420, 421, 422, -2, 911, 602, 604, 606,
+	607, 608, 609, 0, 882, 896, 897, 903, 907, 908,
+	886, 893, 887, 877, 884, 892, 786, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, 755, 443, 444,
+	449, 450, 452, 360, 358, 389, 390, 0, 727, 707,
+	0, 0, 0, 0, 713, 711, 712, 22, 248, 25,
+	0, 248, 248, 0, 0, 0, 0, 0, 0, 0,
+	398, 0, 273, 0, 395, 0, 0, 62, 0, 47,
+	48, 0, 0, 0, 0, 325, 0, 0, 87, 0,
+	782, 84, 101, 105, 93, 0, 72, 760, 761, 762,
+	763, 764, 924, 925, 926, 927, 928, 929, 930, 931,
+	932, 933, 934, 935, 936, 937, 938, 939, 940, 941,
+	942, 943, 944, 945, 946, 947, 948, 949, 950, 951,
+	952, 953, 954, 955, 956, 957, 958, 959, 960, 961,
+	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
+	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
+	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
+	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
+	1002, 0, 86, 0, 113, 115, 122, 124, 125, 126,
+	132, 133, 134, 135, 220, 0, 222, 145, 146, 745,
+	0, 114, 116, 118, 119, 136, 137, 0, 139, 140,
+	141, 465, 149, 151, 0, 160, 152, 158, 156, 836,
+	258, 223, 0, 169, 0, 0, 886, 751, 265, 0,
+	0, 304, 301, 300, 342, 343, 334, 335, 346, 349,
+	350, 347, 482, 311, 312, 313, 338, 339, 237, 318,
+	0, 0, 398, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 645, 0, 0, 656, 438, 439, 440,
-	461, 462, 0, -2, 594, 0, 520, 521, 522, 0,
-	0, -2, 0, 674, 431, 0, 0, 635, 564, 0,
-	0, 0, 0, 0, 0, 0, 615, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 390, 403, 413, 411,
-	410, 392, 0, 391, 389, 0, 393, 0, 359, 0,
-	643, 637, 638, 639, 0, 0, 650, 0, 0, 0,
-	0, 225, 24, 806, 0, 34, 0, 0, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 710,
+	0, 0, 721, 486, 487, 488, 516, 517, 0, -2,
+	659, 0, 585, 586, 587, 0, 0, -2, 0, 739,
+	479, 0, 0, 700, 629, 0, 0, 0, 0, 0,
+	0, 0, 680, 686, 0, 0, 0, 0, 0, 0,
+	0, 0, 433, 446, 456, 454, 453, 435, 0, 434,
+	432, 0, 436, 461, 0, 0, 459, 0, 398, 0,
+	708, 702, 703, 704, 0, 0, 715, 0, 0, 0,
+	0, 247, 27, 886, 0, 37, 0, 0, 193, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 51, 0, 247, 242, 0, 0, 0, 322,
-	252, 254, 255, 0, 0, 357, 53, 660, 43, 59,
-	57, 65, 71, 0, 76, 77, 0, 236, 0, 0,
-	113, 114, 0, 199, 682, 681, 431, 63, 144, 89,
-	354, 0, 148, 150, 152, 153, 154, 687, 0, 0,
-	0, 0, 0, 310, 313, 314, 0, 303, 213, 214,
-	289, 0, 665, 316, 321, 323, 340, 340, 327, 0,
-	654, 435, 365, 366, 367, 368, 369, 431, 372, 373,
-	374, 375, 383, 384, 385, 386, 387, 388, 397, 0,
-	382, 382, 382, 394, 395, 398, 399, 404, 405, 415,
-	416, 414, 414, 414, 412, 436, 0, 441, 442, 443,
-	444, 445, 446, 447, 448, 449, -2, -2, -2, 453,
-	454, 455, -2, -2, -2, 459, 460, -2, 0, 0,
-	645, 0, 0, 467, 0, 470, 472, 474, 0, 0,
-	0, 0, 644, 484, 627, 0, 655, 469, 0, 0,
-	516, 0, 0, 0, 600, 594, 601, 0, -2, 525,
-	299, 0, 0, 0, 0, 675, 418, 419, 420, 421,
-	422, 423, 432, 0, 634, 630, 0, 572, 0, 0,
-	0, 538, 540, 0, 0, 0, 604, 605, 606, 607,
-	608, 609, 610, 0, 0, 0, 0, 486, 0, 0,
-	0, 0, 820, 0, 594, 620, 0, 0, 0, 0,
-	0, 594, 0, 626, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 316, 352, 640, 0, 649, 23,
-	217, 0, 0, 0, 26, 806, 157, 0, 0, 0,
-	0, 0, 228, 35, 806, 43, 806, 43, 36, 21,
-	226, 216, 219, 0, 358, 239, 0, 0, 244, 241,
-	359, 0, 0, 0, 55, 73, 74, 110, 111, 112,
-	106, 121, 0, 146, 0, 0, 687, 0, 0, 0,
-	238, 302, 0, 284, 285, 286, 287, 664, 318, 0,
-	0, 0, 0, 0, 0, 346, 346, 346, 344, 325,
-	339, 0, 338, 326, -2, 327, 0, 360, 362, 370,
-	0, 0, -2, 0, 0, 0, 485, -2, 468, 471,
-	473, 475, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 595, 0, 598, 599, 0, -2, 0, 0, 298,
-	299, 299, 299, 531, 0, 0, 0, 0, 0, 0,
-	0, 631, 0, 0, 532, 0, 0, 0, 0, 0,
-	546, 0, 547, 0, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 59, 0, 280, 275, 0, 0,
+	0, 360, 285, 287, 288, 0, 0, 396, 61, 725,
+	49, 69, 65, 729, 75, 81, 0, 88, 89, 0,
+	73, 0, 263, 0, 0, 130, 131, 0, 221, 747,
+	746, 479, 162, 257, 393, 0, 168, 170, 172, 173,
+	174, 175, 752, 0, 752, 0, 0, 0, 0, 0,
+	348, 351, 352, 0, 341, 235, 236, 325, 730, 354,
+	359, 361, 379, 379, 379, 366, 0, 0, 719, 483,
+	404, 405, 406, 407, 408, 479, 411, 412, 413, 414,
+	415, 416, 417, 418, 426, 427, 428, 429, 430, 431,
+	440, 0, 425, 425, 425, 437, 438, 441, 442, 447,
+	448, 458, 464, 460, 464, 457, 457, 457, 455, 484,
+	0, 489, 490, 491, 492, 493, 494, 495, 496, 497,
+	-2, -2, -2, 0, 0, 501, 502, 503, 504, 505,
+	506, 507, 508, 509, 510, -2, -2, -2, 514, 515,
+	-2, 0, 0, 710, 0, 0, 522, 0, 525, 527,
+	529, 0, 0, 0, 0, 709, 539, 692, 0, 720,
+	524, 0, 0, 580, 0, 0, 0, 665, 659, 666,
+	0, -2, 590, 337, 0, 0, 0, 0, 740, 466,
+	467, 468, 469, 470, 471, 480, 0, 699, 695, 0,
+	637, 0, 0, 0, 603, 605, 0, 0, 0, 669,
+	670, 671, 672, 673, 674, 675, 0, 0, 0, 0,
+	543, 0, 0, 0, 0, 900, 0, 659, 685, 0,
+	0, 0, 0, 0, 659, 0, 691, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 354,
+	391, 705, 0, 714, 26, 239, 0, 0, 0, 29,
+	886, 178, 0, 0, 0, 0, 0, 250, 38, 886,
+	49, 886, 49, 39, 42, 0, 44, 23, 248, 24,
+	268, 269, 238, 241, 0, 397, 272, 0, 0, 277,
+	274, 398, 0, 0, 0, 63, 83, 102, 85, 127,
+	128, 129, 123, 138, 167, 0, 0, 752, 0, 0,
+	0, 0, 266, 271, 340, 0, 320, 321, 322, 323,
+	356, 0, 0, 0, 0, 0, 0, 385, 385, 385,
+	383, 363, 378, 0, 377, 364, 365, -2, 366, 0,
+	0, 399, 401, 0, 409, 0, 0, 0, 0, -2,
+	0, 0, 0, 540, -2, 523, 526, 528, 530, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 581, 660,
+	0, 663, 664, 0, -2, 0, 0, 336, 337, 337,
+	337, 596, 0, 0, 0, 0, 0, 0, 0, 696,
+	0, 0, 597, 0, 0, 0, 0, 0, 611, 0,
+	612, 0, 0, 613, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 488, 489, 490,
-	0, 0, 549, 618, 619, 0, 0, 0, 0, 0,
-	0, 554, 0, 625, 0, 0, 0, 558, 559, 560,
-	0, 380, 0, 396, 408, 318, 0, 0, 0, 0,
-	0, 155, 170, 0, 0, 0, 0, 28, 0, 0,
-	0, 0, 32, 0, 38, 0, 0, 0, 0, 250,
-	0, 251, 253, 256, 0, 90, 151, 0, 0, 149,
-	0, 0, 283, 566, 0, 0, 324, 0, 0, 0,
-	0, 0, 341, 345, 342, 343, 336, 0, 329, 0,
-	0, 0, 437, -2, 0, 0, 0, 0, -2, 0,
-	596, 0, 0, 628, 0, 589, 0, -2, 595, 602,
-	526, 0, 0, 0, 0, 424, 425, 426, 427, 428,
-	429, 430, 0, 676, 629, 633, 0, 570, 571, 575,
-	0, 0, 536, 0, 603, 612, 613, 487, 491, 492,
-	493, 494, 495, 496, 497, 498, 499, -2, -2, -2,
-	503, 504, 505, -2, -2, -2, 0, 0, 0, 614,
-	0, 0, 592, 616, 617, 622, 623, 0, 551, 552,
-	553, 624, 0, 0, 0, 402, 0, 566, 641, 221,
-	223, 25, 0, 156, 0, 159, 0, 0, 162, 163,
-	0, 0, 0, 0, 172, 179, 0, 0, 0, 40,
-	0, 0, 227, 0, 43, 43, 218, 0, 0, 220,
-	0, 0, 0, 243, 0, 0, 0, 0, 0, 206,
-	212, 212, 0, 534, 535, 0, 0, 271, 0, 317,
-	315, 330, 0, 332, 0, 0, 0, 334, 0, 0,
-	328, 363, 0, 0, 371, 0, 0, -2, 0, 478,
-	0, -2, -2, 588, 595, 673, 299, 527, 529, 530,
-	433, 632, 577, 574, 0, 561, 545, 611, 0, 0,
-	0, 0, 595, 591, 550, 0, 556, 557, 381, 272,
-	27, 0, 160, 161, 164, 0, 166, 181, 173, 0,
-	176, 177, 174, 0, 29, 30, 39, 45, 31, 37,
-	0, 0, 0, 0, 0, 257, 258, 0, 179, 0,
-	147, 179, 0, 215, 668, 0, 215, 0, 0, 0,
-	565, 567, 0, 331, 0, 348, 333, 0, 337, 364,
-	361, -2, -2, 479, 597, 590, 0, 299, 0, 563,
-	-2, 0, 0, 0, 593, 0, 158, 0, 185, 0,
-	0, 0, 47, 0, 222, 224, 0, 246, 359, 249,
-	168, 179, 202, 207, 208, 211, 209, 212, 299, 0,
-	0, 0, 0, 335, 528, 580, 0, -2, 0, 511,
-	555, 165, 190, 0, 0, 0, 181, 33, 0, 44,
-	0, 248, 169, 215, 0, 0, 568, 569, 347, 0,
-	0, 0, 576, 512, 167, 186, 187, 0, 182, 183,
-	184, 180, 178, 185, 46, 359, 210, 526, 179, 573,
-	578, 581, -2, 777, 714, 0, 579, 188, 0, 189,
-	0, 0, 0, 190, 245, 203, 0, 583, 584, 585,
-	586, 587, 191, 0, 194, 195, 0, 192, 175, 0,
-	193, 196, 197, 582,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 545, 546, 547, 0, 0, 614, 683, 684,
+	0, 0, 0, 0, 0, 0, 619, 0, 690, 0,
+	0, 0, 623, 624, 625, 0, 423, 0, 439, 462,
+	463, 451, 356, 0, 0, 0, 0, 0, 176, 192,
+	0, 0, 0, 0, 31, 0, 0, 0, 0, 35,
+	0, 41, 43, 0, 0, 0, 0, 283, 0, 284,
+	286, 289, 0, 164, 0, 171, 0, 0, 0, 169,
+	0, 0, 319, 631, 0, 0, 362, 0, 0, 0,
+	0, 0, 380, 384, 381, 382, 375, 0, 368, 337,
+	0, 402, 0, 0, 485, 0, 0, -2, 0, 0,
+	0, 0, -2, 0, 661, 0, 0, 693, 0, 654,
+	0, -2, 660, 667, 591, 0, 0, 0, 0, 472,
+	473, 474, 475, 476, 477, 478, 0, 741, 694, 698,
+	0, 635, 636, 640, 0, 0, 601, 0, 668, 677,
+	678, 544, 548, 549, 550, 551, 552, 553, 554, 555,
+	556, -2, -2, -2, 560, 561, 562, 563, 564, 565,
+	566, 567, 568, 569, -2, -2, -2, 0, 0, 0,
+	679, 0, 0, 657, 681, 682, 687, 688, 0, 616,
+	617, 618, 689, 0, 0, 0, 445, 0, 631, 706,
+	243, 245, 28, 0, 177, 0, 180, 0, 0, 183,
+	184, 0, 0, 0, 0, 194, 201, 0, 0, 0,
+	46, 0, 0, 249, 0, 49, 49, 240, 0, 0,
+	242, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 228, 234, 234, 0, 599, 600, 0, 307,
+	0, 355, 353, 369, 0, 371, 0, 0, 0, 373,
+	0, 0, 367, 0, 0, 403, 410, 541, 542, 0,
+	0, -2, 0, 533, 0, -2, -2, 653, 660, 738,
+	337, 592, 594, 595, 481, 697, 642, 639, 0, 626,
+	610, 676, 0, 0, 0, 0, 660, 656, 615, 0,
+	621, 622, 424, 308, 30, 0, 181, 182, 185, 0,
+	187, 203, 195, 0, 198, 199, 196, 0, 32, 33,
+	45, 51, 34, 40, 0, 0, 0, 0, 0, 290,
+	291, 0, 0, 201, 0, 191, 167, 201, 0, 237,
+	733, 0, 237, 0, 0, 630, 632, 0, 370, 0,
+	387, 372, 0, 376, 591, 400, -2, -2, 534, 662,
+	655, 0, 337, 0, 628, -2, 0, 0, 0, 658,
+	0, 179, 0, 207, 0, 0, 0, 53, 0, 244,
+	246, 0, 279, 398, 282, 0, 189, 201, 165, 167,
+	229, 230, 233, 231, 234, 0, 0, 0, 0, 374,
+	593, 645, 0, -2, 0, 575, 620, 186, 212, 0,
+	0, 0, 203, 36, 0, 50, 0, 281, 0, 190,
+	398, 237, 0, 633, 634, 386, 0, 0, 0, 641,
+	576, 188, 208, 209, 0, 204, 205, 206, 202, 200,
+	207, 52, 398, 0, 224, 232, 201, 638, 643, 646,
+	-2, 854, 779, 0, 644, 210, 0, 211, 0, 0,
+	0, 212, 278, 166, 167, 0, 648, 649, 650, 651,
+	652, 213, 0, 216, 217, 0, 214, 197, 398, 0,
+	215, 218, 219, 225, 647,
 }
 var sqlTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 249, 3, 255, 250, 3,
-	260, 261, 253, 251, 264, 252, 262, 254, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 266, 263,
-	243, 245, 244, 3, 265, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 273, 3, 279, 274, 3,
+	284, 285, 277, 275, 288, 276, 286, 278, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 290, 287,
+	266, 268, 267, 270, 289, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 258, 3, 259, 248, 3, 3, 3, 3, 3,
+	3, 282, 3, 283, 272, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 247, 3, 257,
+	3, 3, 3, 3, 271, 3, 281,
 }
 var sqlTok2 = [...]int{
 
@@ -3472,9 +3911,12 @@ var sqlTok2 = [...]int{
 	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
 	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
 	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
-	242, 246, 256,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+	252, 253, 254, 255, 256, 257,
 }
 var sqlTok3 = [...]int{
+	57600, 258, 57601, 259, 57602, 260, 57603, 261, 57604, 262,
+	57605, 263, 57606, 264, 57607, 265, 57608, 269, 57609, 280,
 	0,
 }
 
@@ -3820,13 +4262,13 @@ sqldefault:
 
 	case 1:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:439
+		//line sql.y:458
 		{
 			sqllex.(*scanner).stmts = sqlDollar[1].stmts
 		}
 	case 2:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:445
+		//line sql.y:464
 		{
 			if sqlDollar[3].stmt != nil {
 				sqlVAL.stmts = append(sqlDollar[1].stmts, sqlDollar[3].stmt)
@@ -3834,7 +4276,7 @@ sqldefault:
 		}
 	case 3:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:451
+		//line sql.y:470
 		{
 			if sqlDollar[1].stmt != nil {
 				sqlVAL.stmts = []Statement{sqlDollar[1].stmt}
@@ -3842,549 +4284,621 @@ sqldefault:
 				sqlVAL.stmts = nil
 			}
 		}
-	case 13:
+	case 15:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:470
+		//line sql.y:491
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 19:
+	case 21:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:479
+		//line sql.y:500
 		{
 			sqlVAL.stmt = nil
 		}
-	case 20:
+	case 22:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:485
+		//line sql.y:506
 		{
 			sqlVAL.stmt = &AlterTable{Table: sqlDollar[3].qname, IfExists: false, Cmds: sqlDollar[4].alterTableCmds}
 		}
-	case 21:
+	case 23:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:489
+		//line sql.y:510
 		{
 			sqlVAL.stmt = &AlterTable{Table: sqlDollar[5].qname, IfExists: true, Cmds: sqlDollar[6].alterTableCmds}
 		}
-	case 22:
+	case 24:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:517
+		{
+			sqlVAL.stmt = &AlterUser{Name: Name(sqlDollar[3].str), Password: sqlDollar[6].expr}
+		}
+	case 25:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:495
+		//line sql.y:523
 		{
 			sqlVAL.alterTableCmds = AlterTableCmds{sqlDollar[1].alterTableCmd}
 		}
-	case 23:
+	case 26:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:499
+		//line sql.y:527
 		{
 			sqlVAL.alterTableCmds = append(sqlDollar[1].alterTableCmds, sqlDollar[3].alterTableCmd)
 		}
-	case 24:
+	case 27:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:506
+		//line sql.y:534
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, IfNotExists: false, ColumnDef: sqlDollar[2].colDef}
 		}
-	case 25:
+	case 28:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:511
+		//line sql.y:539
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, IfNotExists: true, ColumnDef: sqlDollar[5].colDef}
 		}
-	case 26:
+	case 29:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:516
+		//line sql.y:544
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, IfNotExists: false, ColumnDef: sqlDollar[3].colDef}
 		}
-	case 27:
+	case 30:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:521
+		//line sql.y:549
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, IfNotExists: true, ColumnDef: sqlDollar[6].colDef}
 		}
-	case 28:
+	case 31:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:525
+		//line sql.y:553
 		{
 			unimplemented()
 		}
-	case 29:
+	case 32:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:527
+		//line sql.y:555
 		{
 			unimplemented()
 		}
-	case 30:
+	case 33:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:529
+		//line sql.y:557
 		{
 			unimplemented()
 		}
-	case 31:
+	case 34:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:532
+		//line sql.y:560
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: true, Column: sqlDollar[5].str}
 		}
-	case 32:
+	case 35:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:537
+		//line sql.y:565
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: false, Column: sqlDollar[3].str}
 		}
-	case 33:
+	case 36:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:542
+		//line sql.y:570
 		{
 		}
-	case 34:
+	case 37:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:545
+		//line sql.y:573
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddConstraint{ConstraintDef: sqlDollar[2].constraintDef}
 		}
-	case 35:
+	case 38:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:549
+		//line sql.y:577
 		{
 			unimplemented()
 		}
-	case 36:
+	case 39:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:551
+		//line sql.y:579
 		{
 			unimplemented()
 		}
-	case 37:
+	case 40:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:554
+		//line sql.y:582
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropConstraint{IfExists: true, Constraint: sqlDollar[5].str}
 		}
-	case 38:
+	case 41:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:559
+		//line sql.y:587
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropConstraint{IfExists: false, Constraint: sqlDollar[3].str}
 		}
-	case 39:
+	case 42:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:592
+		{
+			sqlVAL.alterTableCmd = &AlterTableSetAudit{Mode: sqlDollar[3].auditMode}
+		}
+	case 43:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:598
+		{
+			sqlVAL.auditMode = AuditModeReadWrite
+		}
+	case 44:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:602
+		{
+			sqlVAL.auditMode = AuditModeDisable
+		}
+	case 45:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:564
+		//line sql.y:607
 		{
 			unimplemented()
 		}
-	case 40:
+	case 46:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:565
+		//line sql.y:608
 		{
 			unimplemented()
 		}
-	case 41:
+	case 47:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:568
+		//line sql.y:611
 		{
 			unimplemented()
 		}
-	case 42:
+	case 48:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:569
+		//line sql.y:612
 		{
 			unimplemented()
 		}
-	case 43:
+	case 49:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:570
+		//line sql.y:613
 		{
 		}
-	case 44:
+	case 50:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:573
+		//line sql.y:616
 		{
 			unimplemented()
 		}
-	case 45:
+	case 51:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:574
+		//line sql.y:617
 		{
 		}
-	case 46:
+	case 52:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:577
+		//line sql.y:620
 		{
 			unimplemented()
 		}
-	case 47:
+	case 53:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:578
+		//line sql.y:621
 		{
 		}
-	case 51:
+	case 59:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:589
+		//line sql.y:634
 		{
 			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr)}
 		}
-	case 52:
+	case 60:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:596
+		//line sql.y:641
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[3].str), IfExists: false}
 		}
-	case 53:
+	case 61:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:600
+		//line sql.y:645
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[5].str), IfExists: true}
 		}
-	case 54:
+	case 62:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:604
+		//line sql.y:649
 		{
 			sqlVAL.stmt = &DropIndex{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 55:
+	case 63:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:608
+		//line sql.y:653
 		{
 			sqlVAL.stmt = &DropIndex{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 56:
+	case 64:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:612
+		//line sql.y:657
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 57:
+	case 65:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:616
+		//line sql.y:661
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 58:
+	case 66:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:665
+		{
+			sqlVAL.stmt = &DropRole{Names: NameList(sqlDollar[3].strs)}
+		}
+	case 67:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:669
+		{
+			sqlVAL.stmt = &DropUser{Names: NameList(sqlDollar[3].strs)}
+		}
+	case 68:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:622
+		//line sql.y:675
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 59:
+	case 69:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:626
+		//line sql.y:679
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 60:
+	case 70:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:632
+		//line sql.y:685
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 61:
+	case 71:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:636
+		//line sql.y:689
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 62:
+	case 72:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:642
+		//line sql.y:695
 		{
 			sqlVAL.indirect = Indirection{NameIndirection(sqlDollar[2].str)}
 		}
-	case 63:
+	case 73:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:646
+		//line sql.y:699
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, NameIndirection(sqlDollar[3].str))
 		}
-	case 64:
+	case 74:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:653
+		//line sql.y:706
 		{
 			sqlVAL.stmt = &Explain{Statement: sqlDollar[2].stmt}
 		}
-	case 65:
+	case 75:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:657
+		//line sql.y:710
 		{
 			sqlVAL.stmt = &Explain{Options: sqlDollar[3].strs, Statement: sqlDollar[5].stmt}
 		}
-	case 66:
+	case 76:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:663
+		//line sql.y:716
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 70:
+	case 80:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:672
+		//line sql.y:725
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 71:
+	case 81:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:676
+		//line sql.y:729
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 73:
+	case 83:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:686
+		//line sql.y:740
 		{
 			sqlVAL.stmt = &Grant{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 74:
+	case 84:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:744
+		{
+			sqlVAL.stmt = &GrantRole{Roles: NameList(sqlDollar[2].strs), Members: NameList(sqlDollar[4].strs)}
+		}
+	case 85:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:693
+		//line sql.y:752
 		{
 			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 75:
+	case 86:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:756
+		{
+			sqlVAL.stmt = &RevokeRole{Roles: NameList(sqlDollar[2].strs), Members: NameList(sqlDollar[4].strs)}
+		}
+	case 87:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:700
+		//line sql.y:763
 		{
 			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
 		}
-	case 76:
+	case 88:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:704
+		//line sql.y:767
 		{
 			// TODO(marc): this is postgres' grammar, but do we really need
 			// both "x" and "TABLE X"?
 			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
 		}
-	case 77:
+	case 89:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:710
+		//line sql.y:773
 		{
 			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
 		}
-	case 78:
+	case 90:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:717
+		//line sql.y:780
 		{
 			sqlVAL.privilegeList = privilege.List{privilege.ALL}
 		}
-	case 79:
+	case 91:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:720
+		//line sql.y:783
 		{
 		}
-	case 80:
+	case 92:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:724
+		//line sql.y:787
 		{
 			sqlVAL.privilegeList = privilege.List{sqlDollar[1].privilegeType}
 		}
-	case 81:
+	case 93:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:728
+		//line sql.y:791
 		{
 			sqlVAL.privilegeList = append(sqlDollar[1].privilegeList, sqlDollar[3].privilegeType)
 		}
-	case 82:
+	case 94:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:735
+		//line sql.y:798
 		{
 			sqlVAL.privilegeType = privilege.CREATE
 		}
-	case 83:
+	case 95:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:739
+		//line sql.y:802
 		{
 			sqlVAL.privilegeType = privilege.DROP
 		}
-	case 84:
+	case 96:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:743
+		//line sql.y:806
 		{
 			sqlVAL.privilegeType = privilege.GRANT
 		}
-	case 85:
+	case 97:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:747
+		//line sql.y:810
 		{
 			sqlVAL.privilegeType = privilege.SELECT
 		}
-	case 86:
+	case 98:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:751
+		//line sql.y:814
 		{
 			sqlVAL.privilegeType = privilege.INSERT
 		}
-	case 87:
+	case 99:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:755
+		//line sql.y:818
 		{
 			sqlVAL.privilegeType = privilege.DELETE
 		}
-	case 88:
+	case 100:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:759
+		//line sql.y:822
 		{
 			sqlVAL.privilegeType = privilege.UPDATE
 		}
-	case 89:
+	case 101:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:767
+		//line sql.y:830
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 90:
+	case 102:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:771
+		//line sql.y:834
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 91:
+	case 104:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:845
+		{
+			sqlVAL.strs = []string{sqlDollar[1].str}
+		}
+	case 105:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:849
+		{
+			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
+		}
+	case 106:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:779
+		//line sql.y:857
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 92:
+	case 107:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:783
+		//line sql.y:861
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 93:
+	case 108:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:787
+		//line sql.y:865
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 94:
+	case 109:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:873
+		{
+			sqlVAL.stmt = &Set{Name: sqlDollar[2].qname}
+		}
+	case 110:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:877
+		{
+			sqlVAL.stmt = &SetTimeZone{Value: DString("DEFAULT")}
+		}
+	case 111:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:793
+		//line sql.y:883
 		{
 			sqlVAL.stmt = &SetTransaction{Isolation: sqlDollar[2].isoLevel}
 		}
-	case 96:
+	case 113:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:800
+		//line sql.y:890
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 97:
+	case 114:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:804
+		//line sql.y:894
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 98:
+	case 115:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:808
+		//line sql.y:898
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 99:
+	case 116:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:812
+		//line sql.y:902
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 101:
+	case 118:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:819
+		//line sql.y:909
 		{
 			unimplemented()
 		}
-	case 102:
+	case 119:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:822
+		//line sql.y:912
 		{
 			sqlVAL.stmt = &SetTimeZone{Value: sqlDollar[3].expr}
 		}
-	case 103:
+	case 120:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:825
+		//line sql.y:915
 		{
 			unimplemented()
 		}
-	case 105:
+	case 122:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:832
+		//line sql.y:922
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 106:
+	case 123:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:836
+		//line sql.y:926
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 109:
+	case 126:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:844
+		//line sql.y:934
 		{
 			sqlVAL.expr = ValArg(sqlDollar[1].str)
 		}
-	case 110:
+	case 127:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:850
+		//line sql.y:940
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 111:
+	case 128:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:855
+		//line sql.y:945
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 112:
+	case 129:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:860
+		//line sql.y:950
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 113:
+	case 130:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:865
+		//line sql.y:955
 		{
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 114:
+	case 131:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:869
+		//line sql.y:959
 		{
 			sqlVAL.isoLevel = SerializableIsolation
 		}
-	case 115:
+	case 132:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:875
+		//line sql.y:965
 		{
 			sqlVAL.expr = DBool(true)
 		}
-	case 116:
+	case 133:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:879
+		//line sql.y:969
 		{
 			sqlVAL.expr = DBool(false)
 		}
-	case 117:
+	case 134:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:883
+		//line sql.y:973
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 119:
+	case 136:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:898
+		//line sql.y:988
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 120:
+	case 137:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:902
+		//line sql.y:992
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 121:
+	case 138:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:906
+		//line sql.y:996
 		{
 			// TODO(pmattis): support opt_interval?
 			expr := &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
@@ -4400,250 +4914,273 @@ sqldefault:
 			}
 			sqlVAL.expr = d
 		}
-	case 123:
+	case 140:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:923
+		//line sql.y:1013
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 124:
+	case 141:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:927
+		//line sql.y:1017
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 125:
+	case 142:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:932
+		//line sql.y:1022
 		{
 			unimplemented()
 		}
-	case 126:
+	case 143:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:933
+		//line sql.y:1023
 		{
 			unimplemented()
 		}
-	case 127:
+	case 144:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:934
+		//line sql.y:1024
 		{
 		}
-	case 128:
+	case 145:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:938
+		//line sql.y:1028
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 129:
+	case 146:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:942
+		//line sql.y:1032
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 130:
+	case 147:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:948
+		//line sql.y:1038
 		{
 			sqlVAL.stmt = &Show{Name: sqlDollar[2].str}
 		}
-	case 131:
+	case 148:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:952
+		//line sql.y:1042
 		{
 			sqlVAL.stmt = &Show{Name: sqlDollar[2].str}
 		}
-	case 132:
+	case 149:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:956
+		//line sql.y:1046
 		{
 			sqlVAL.stmt = &ShowColumns{Table: sqlDollar[4].qname}
 		}
-	case 133:
+	case 150:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:960
+		//line sql.y:1050
 		{
 			sqlVAL.stmt = &ShowDatabases{}
 		}
-	case 134:
+	case 151:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:964
+		//line sql.y:1054
 		{
 			sqlVAL.stmt = &ShowGrants{Targets: sqlDollar[3].targetListPtr, Grantees: sqlDollar[4].strs}
 		}
-	case 135:
+	case 152:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:968
+		//line sql.y:1058
 		{
 			sqlVAL.stmt = &ShowIndex{Table: sqlDollar[4].qname}
 		}
-	case 136:
+	case 153:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:972
+		//line sql.y:1062
 		{
 			sqlVAL.stmt = &ShowTables{Name: sqlDollar[3].qname}
 		}
-	case 137:
+	case 154:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1066
+		{
+			sqlVAL.stmt = &ShowUsers{}
+		}
+	case 155:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:976
+		//line sql.y:1070
 		{
 			sqlVAL.stmt = &Show{Name: "TIME ZONE"}
 		}
-	case 138:
+	case 156:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:980
+		//line sql.y:1074
 		{
 			sqlVAL.stmt = &Show{Name: "TRANSACTION ISOLATION LEVEL"}
 		}
-	case 139:
+	case 157:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:984
+		//line sql.y:1078
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &Show{Name: "ALL"}
 		}
-	case 140:
+	case 158:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:990
+		//line sql.y:1084
 		{
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 141:
+	case 159:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:994
+		//line sql.y:1088
 		{
 			sqlVAL.qname = nil
 		}
-	case 142:
+	case 160:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1000
+		//line sql.y:1094
 		{
 			tmp := sqlDollar[2].targetList
 			sqlVAL.targetListPtr = &tmp
 		}
-	case 143:
+	case 161:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1005
+		//line sql.y:1099
 		{
 			sqlVAL.targetListPtr = nil
 		}
-	case 144:
+	case 162:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1011
+		//line sql.y:1105
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 145:
+	case 163:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1015
+		//line sql.y:1109
 		{
 			sqlVAL.strs = nil
 		}
-	case 146:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1022
+	case 164:
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+		//line sql.y:1116
 		{
-			sqlVAL.stmt = &CreateTable{Table: sqlDollar[3].qname, IfNotExists: false, Defs: sqlDollar[5].tblDefs}
+			sqlVAL.stmt = &CreateTable{Table: sqlDollar[3].qname, IfNotExists: false, Defs: sqlDollar[5].tblDefs, Interleave: sqlDollar[7].interleave}
 		}
-	case 147:
-		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:1026
+	case 165:
+		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
+		//line sql.y:1120
 		{
-			sqlVAL.stmt = &CreateTable{Table: sqlDollar[6].qname, IfNotExists: true, Defs: sqlDollar[8].tblDefs}
+			sqlVAL.stmt = &CreateTable{Table: sqlDollar[6].qname, IfNotExists: true, Defs: sqlDollar[8].tblDefs, Interleave: sqlDollar[10].interleave}
 		}
-	case 149:
+	case 166:
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+		//line sql.y:1126
+		{
+			sqlVAL.interleave = &InterleaveDef{Parent: sqlDollar[4].qname, Fields: NameList(sqlDollar[6].strs)}
+		}
+	case 167:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1130
+		{
+			sqlVAL.interleave = nil
+		}
+	case 169:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1033
+		//line sql.y:1137
 		{
 			sqlVAL.tblDefs = nil
 		}
-	case 150:
+	case 170:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1039
+		//line sql.y:1143
 		{
 			sqlVAL.tblDefs = TableDefs{sqlDollar[1].tblDef}
 		}
-	case 151:
+	case 171:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1043
+		//line sql.y:1147
 		{
 			sqlVAL.tblDefs = append(sqlDollar[1].tblDefs, sqlDollar[3].tblDef)
 		}
-	case 152:
+	case 172:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1049
+		//line sql.y:1153
 		{
 			sqlVAL.tblDef = sqlDollar[1].colDef
 		}
-	case 154:
+	case 175:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1054
+		//line sql.y:1159
 		{
 			sqlVAL.tblDef = sqlDollar[1].constraintDef
 		}
-	case 155:
+	case 176:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1060
+		//line sql.y:1165
 		{
-			sqlVAL.colDef = newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].colType, sqlDollar[3].colQuals)
+			tableDef, err := newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].colType, sqlDollar[3].colQuals)
+			if err != nil {
+				sqllex.Error(err.Error())
+				return 1
+			}
+			sqlVAL.colDef = tableDef
 		}
-	case 156:
+	case 177:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1066
+		//line sql.y:1176
 		{
 			sqlVAL.colQuals = append(sqlDollar[1].colQuals, sqlDollar[2].colQual)
 		}
-	case 157:
+	case 178:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1070
+		//line sql.y:1180
 		{
 			sqlVAL.colQuals = nil
 		}
-	case 158:
+	case 179:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1076
+		//line sql.y:1186
 		{
 			// TODO(pmattis): Handle constraint name.
 			sqlVAL.colQual = sqlDollar[3].colQual
 		}
-	case 160:
+	case 181:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1081
+		//line sql.y:1192
 		{
-			unimplemented()
+			sqlVAL.colQual = ColumnCollation(sqlDollar[2].str)
 		}
-	case 161:
+	case 182:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1097
+		//line sql.y:1210
 		{
 			sqlVAL.colQual = NotNullConstraint{}
 		}
-	case 162:
+	case 183:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1101
+		//line sql.y:1214
 		{
 			sqlVAL.colQual = NullConstraint{}
 		}
-	case 163:
+	case 184:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1105
+		//line sql.y:1218
 		{
 			sqlVAL.colQual = UniqueConstraint{}
 		}
-	case 164:
+	case 185:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1109
+		//line sql.y:1222
 		{
 			sqlVAL.colQual = PrimaryKeyConstraint{}
 		}
-	case 165:
+	case 186:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1112
+		//line sql.y:1225
 		{
 			unimplemented()
 		}
-	case 166:
+	case 187:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1114
+		//line sql.y:1227
 		{
 			if ContainsVars(sqlDollar[2].expr) {
 				sqllex.Error("default expression contains a variable")
@@ -4655,15 +5192,15 @@ sqldefault:
 			}
 			sqlVAL.colQual = &ColumnDefault{Expr: sqlDollar[2].expr}
 		}
-	case 167:
+	case 188:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1125
+		//line sql.y:1238
 		{
 			unimplemented()
 		}
-	case 168:
+	case 189:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1129
+		//line sql.y:1242
 		{
 			sqlVAL.tblDef = &IndexTableDef{
 				Name:    Name(sqlDollar[2].str),
@@ -4671,9 +5208,9 @@ sqldefault:
 				Storing: sqlDollar[6].strs,
 			}
 		}
-	case 169:
+	case 190:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1137
+		//line sql.y:1250
 		{
 			sqlVAL.tblDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4683,28 +5220,37 @@ sqldefault:
 				},
 			}
 		}
-	case 170:
+	case 191:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1262
+		{
+			sqlVAL.tblDef = &FamilyTableDef{
+				Name:    Name(sqlDollar[2].str),
+				Columns: NameList(sqlDollar[4].strs),
+			}
+		}
+	case 192:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1152
+		//line sql.y:1274
 		{
 			sqlVAL.constraintDef = sqlDollar[3].constraintDef
 			sqlVAL.constraintDef.setName(Name(sqlDollar[2].str))
 		}
-	case 171:
+	case 193:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1157
+		//line sql.y:1279
 		{
 			sqlVAL.constraintDef = sqlDollar[1].constraintDef
 		}
-	case 172:
+	case 194:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1162
+		//line sql.y:1284
 		{
 			unimplemented()
 		}
-	case 173:
+	case 195:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1164
+		//line sql.y:1286
 		{
 			sqlVAL.constraintDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4713,9 +5259,9 @@ sqldefault:
 				},
 			}
 		}
-	case 174:
+	case 196:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1173
+		//line sql.y:1295
 		{
 			sqlVAL.constraintDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -4724,498 +5270,568 @@ sqldefault:
 				PrimaryKey: true,
 			}
 		}
-	case 175:
+	case 197:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1182
+		//line sql.y:1304
 		{
 			unimplemented()
 		}
-	case 178:
+	case 200:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1199
+		//line sql.y:1321
 		{
 			sqlVAL.strs = sqlDollar[3].strs
 		}
-	case 179:
+	case 201:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1203
+		//line sql.y:1325
 		{
 			sqlVAL.strs = nil
 		}
-	case 180:
+	case 202:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1209
+		//line sql.y:1331
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 181:
+	case 203:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1213
+		//line sql.y:1335
 		{
 			sqlVAL.strs = nil
 		}
-	case 182:
+	case 204:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1218
+		//line sql.y:1340
 		{
 			unimplemented()
 		}
-	case 183:
+	case 205:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1219
+		//line sql.y:1341
 		{
 			unimplemented()
 		}
-	case 184:
+	case 206:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1220
+		//line sql.y:1342
 		{
 			unimplemented()
 		}
-	case 185:
+	case 207:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1221
+		//line sql.y:1343
 		{
 		}
-	case 186:
+	case 208:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1228
+		//line sql.y:1350
 		{
 			unimplemented()
 		}
-	case 187:
+	case 209:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1229
+		//line sql.y:1351
 		{
 			unimplemented()
 		}
-	case 188:
+	case 210:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1230
+		//line sql.y:1352
 		{
 			unimplemented()
 		}
-	case 189:
+	case 211:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1231
+		//line sql.y:1353
 		{
 			unimplemented()
 		}
-	case 190:
+	case 212:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1232
+		//line sql.y:1354
 		{
 		}
-	case 191:
+	case 213:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1235
+		//line sql.y:1357
 		{
 			unimplemented()
 		}
-	case 192:
+	case 214:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1238
+		//line sql.y:1360
 		{
 			unimplemented()
 		}
-	case 193:
+	case 215:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1241
+		//line sql.y:1363
 		{
 			unimplemented()
 		}
-	case 194:
+	case 216:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1242
+		//line sql.y:1364
 		{
 			unimplemented()
 		}
-	case 195:
+	case 217:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1243
+		//line sql.y:1365
 		{
 			unimplemented()
 		}
-	case 196:
+	case 218:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1244
+		//line sql.y:1366
 		{
 			unimplemented()
 		}
-	case 197:
+	case 219:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1245
+		//line sql.y:1367
 		{
 			unimplemented()
 		}
-	case 198:
+	case 220:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1249
+		//line sql.y:1371
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 199:
+	case 221:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1253
+		//line sql.y:1375
 		{
 			sqlVAL.expr = NumVal("-" + sqlDollar[2].str)
 		}
-	case 200:
+	case 222:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1257
+		//line sql.y:1379
 		{
 			sqlVAL.expr = DInt(sqlDollar[1].ival)
 		}
-	case 201:
+	case 223:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1264
+		//line sql.y:1386
 		{
 			sqlVAL.stmt = &Truncate{Tables: sqlDollar[3].qnames}
 		}
-	case 202:
-		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1271
+	case 224:
+		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
+		//line sql.y:1393
 		{
 			sqlVAL.stmt = &CreateIndex{
-				Name:    Name(sqlDollar[4].str),
-				Table:   sqlDollar[6].qname,
-				Unique:  sqlDollar[2].boolVal,
-				Columns: sqlDollar[8].strs,
-				Storing: sqlDollar[10].strs,
+				Name:       Name(sqlDollar[4].str),
+				Table:      sqlDollar[6].qname,
+				Unique:     sqlDollar[2].boolVal,
+				Columns:    sqlDollar[8].idxElems,
+				Storing:    sqlDollar[10].strs,
+				Interleave: sqlDollar[11].interleave,
+				Where:      newWhere(astWhere, sqlDollar[12].expr),
 			}
 		}
-	case 203:
-		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:1281
+	case 225:
+		sqlDollar = sqlS[sqlpt-15 : sqlpt+1]
+		//line sql.y:1405
 		{
 			sqlVAL.stmt = &CreateIndex{
 				Name:        Name(sqlDollar[7].str),
 				Table:       sqlDollar[9].qname,
 				Unique:      sqlDollar[2].boolVal,
 				IfNotExists: true,
-				Columns:     sqlDollar[11].strs,
+				Columns:     sqlDollar[11].idxElems,
 				Storing:     sqlDollar[13].strs,
+				Interleave:  sqlDollar[14].interleave,
+				Where:       newWhere(astWhere, sqlDollar[15].expr),
 			}
 		}
-	case 204:
+	case 226:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1294
+		//line sql.y:1420
 		{
 			sqlVAL.boolVal = true
 		}
-	case 205:
+	case 227:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1298
+		//line sql.y:1424
 		{
 			sqlVAL.boolVal = false
 		}
-	case 206:
+	case 228:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1304
+		//line sql.y:1430
 		{
-			sqlVAL.strs = []string{sqlDollar[1].str}
+			sqlVAL.idxElems = IndexElemList{sqlDollar[1].idxElem}
 		}
-	case 207:
+	case 229:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1308
+		//line sql.y:1434
 		{
-			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
+			sqlVAL.idxElems = append(sqlDollar[1].idxElems, sqlDollar[3].idxElem)
 		}
-	case 208:
+	case 230:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1317
+		//line sql.y:1443
 		{
 			// TODO(pmattis): Support opt_asc_desc.
-			sqlVAL.str = sqlDollar[1].str
+			sqlVAL.idxElem = IndexElem{Column: Name(sqlDollar[1].str)}
 		}
-	case 209:
+	case 231:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1321
+		//line sql.y:1448
 		{
-			unimplemented()
+			sqlVAL.idxElem = IndexElem{Expr: sqlDollar[1].expr}
 		}
-	case 210:
+	case 232:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1322
+		//line sql.y:1452
 		{
-			unimplemented()
+			sqlVAL.idxElem = IndexElem{Expr: sqlDollar[2].expr}
 		}
-	case 211:
+	case 233:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1325
+		//line sql.y:1457
 		{
 			unimplemented()
 		}
-	case 212:
+	case 234:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1326
+		//line sql.y:1458
 		{
 		}
-	case 213:
+	case 235:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1330
+		//line sql.y:1462
 		{
 			sqlVAL.dir = Ascending
 		}
-	case 214:
+	case 236:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1334
+		//line sql.y:1466
 		{
 			sqlVAL.dir = Descending
 		}
-	case 215:
+	case 237:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1338
+		//line sql.y:1470
 		{
 			sqlVAL.dir = DefaultDirection
 		}
-	case 216:
+	case 238:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1345
+		//line sql.y:1477
 		{
 			sqlVAL.stmt = &RenameDatabase{Name: Name(sqlDollar[3].str), NewName: Name(sqlDollar[6].str)}
 		}
-	case 217:
+	case 239:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1349
+		//line sql.y:1481
 		{
 			sqlVAL.stmt = &RenameTable{Name: sqlDollar[3].qname, NewName: sqlDollar[6].qname, IfExists: false}
 		}
-	case 218:
+	case 240:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1353
+		//line sql.y:1485
 		{
 			sqlVAL.stmt = &RenameTable{Name: sqlDollar[5].qname, NewName: sqlDollar[8].qname, IfExists: true}
 		}
-	case 219:
+	case 241:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1357
+		//line sql.y:1489
 		{
 			sqlVAL.stmt = &RenameIndex{Name: sqlDollar[3].qname, NewName: Name(sqlDollar[6].str), IfExists: false}
 		}
-	case 220:
+	case 242:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1361
+		//line sql.y:1493
 		{
 			sqlVAL.stmt = &RenameIndex{Name: sqlDollar[5].qname, NewName: Name(sqlDollar[8].str), IfExists: true}
 		}
-	case 221:
+	case 243:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1365
+		//line sql.y:1497
 		{
 			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[3].qname, Name: Name(sqlDollar[6].str), NewName: Name(sqlDollar[8].str), IfExists: false}
 		}
-	case 222:
+	case 244:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1369
+		//line sql.y:1501
 		{
 			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[5].qname, Name: Name(sqlDollar[8].str), NewName: Name(sqlDollar[10].str), IfExists: true}
 		}
-	case 223:
+	case 245:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1373
+		//line sql.y:1505
 		{
 			sqlVAL.stmt = nil
 		}
-	case 224:
+	case 246:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1377
+		//line sql.y:1509
 		{
 			sqlVAL.stmt = nil
 		}
-	case 225:
+	case 247:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1383
+		//line sql.y:1515
 		{
 			sqlVAL.boolVal = true
 		}
-	case 226:
+	case 248:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1387
+		//line sql.y:1519
 		{
 			sqlVAL.boolVal = false
 		}
-	case 227:
+	case 249:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1392
+		//line sql.y:1524
 		{
 		}
-	case 228:
+	case 250:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1393
+		//line sql.y:1525
 		{
 		}
-	case 229:
+	case 251:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1398
+		//line sql.y:1530
 		{
 			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
 		}
-	case 230:
+	case 252:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1402
+		//line sql.y:1534
 		{
 			sqlVAL.stmt = &CommitTransaction{}
 		}
-	case 231:
+	case 253:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1406
+		//line sql.y:1538
 		{
 			sqlVAL.stmt = &RollbackTransaction{}
 		}
-	case 232:
+	case 254:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1542
+		{
+			sqlVAL.stmt = &Savepoint{Name: Name(sqlDollar[2].str)}
+		}
+	case 255:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1546
+		{
+			sqlVAL.stmt = &ReleaseSavepoint{Name: Name(sqlDollar[3].str)}
+		}
+	case 256:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1550
+		{
+			sqlVAL.stmt = &ReleaseSavepoint{Name: Name(sqlDollar[2].str)}
+		}
+	case 257:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1554
+		{
+			sqlVAL.stmt = &RollbackToSavepoint{Name: Name(sqlDollar[5].str)}
+		}
+	case 258:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1558
+		{
+			sqlVAL.stmt = &RollbackToSavepoint{Name: Name(sqlDollar[4].str)}
+		}
+	case 259:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1411
+		//line sql.y:1563
 		{
 		}
-	case 233:
+	case 260:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1412
+		//line sql.y:1564
 		{
 		}
-	case 235:
+	case 262:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1417
+		//line sql.y:1569
 		{
 			sqlVAL.isoLevel = UnspecifiedIsolation
 		}
-	case 236:
+	case 263:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1423
+		//line sql.y:1575
 		{
 			sqlVAL.isoLevel = sqlDollar[3].isoLevel
 		}
-	case 237:
+	case 264:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1582
+		{
+			sqlVAL.stmt = &CreateRole{Name: Name(sqlDollar[3].str)}
+		}
+	case 265:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1589
+		{
+			sqlVAL.stmt = &CreateUser{Name: Name(sqlDollar[3].str), Password: sqlDollar[4].expr}
+		}
+	case 266:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1595
+		{
+			sqlVAL.expr = sqlDollar[3].expr
+		}
+	case 267:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1599
+		{
+			sqlVAL.expr = nil
+		}
+	case 268:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1607
+		{
+			sqlVAL.expr = DString(sqlDollar[1].str)
+		}
+	case 269:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1611
+		{
+			sqlVAL.expr = ValArg(sqlDollar[1].str)
+		}
+	case 270:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1429
+		//line sql.y:1617
 		{
 			sqlVAL.stmt = &CreateDatabase{Name: Name(sqlDollar[3].str)}
 		}
-	case 238:
+	case 271:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1433
+		//line sql.y:1621
 		{
 			sqlVAL.stmt = &CreateDatabase{IfNotExists: true, Name: Name(sqlDollar[6].str)}
 		}
-	case 239:
+	case 272:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1439
+		//line sql.y:1627
 		{
 			sqlVAL.stmt = sqlDollar[5].stmt
 			sqlVAL.stmt.(*Insert).Table = sqlDollar[4].qname
 		}
-	case 242:
+	case 275:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1455
+		//line sql.y:1643
 		{
 			sqlVAL.stmt = &Insert{Rows: sqlDollar[1].selectStmt}
 		}
-	case 243:
+	case 276:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1459
+		//line sql.y:1647
 		{
 			sqlVAL.stmt = &Insert{Columns: sqlDollar[2].qnames, Rows: sqlDollar[4].selectStmt}
 		}
-	case 244:
+	case 277:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1463
+		//line sql.y:1651
 		{
 			sqlVAL.stmt = &Insert{}
 		}
-	case 245:
+	case 278:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1468
+		//line sql.y:1656
 		{
 			unimplemented()
 		}
-	case 246:
+	case 279:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1469
+		//line sql.y:1657
 		{
 			unimplemented()
 		}
-	case 247:
+	case 280:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1470
+		//line sql.y:1658
 		{
 		}
-	case 248:
+	case 281:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1473
+		//line sql.y:1661
 		{
 			unimplemented()
 		}
-	case 249:
+	case 282:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1474
+		//line sql.y:1662
 		{
 			unimplemented()
 		}
-	case 250:
+	case 283:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1475
+		//line sql.y:1663
 		{
 		}
-	case 251:
+	case 284:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1480
+		//line sql.y:1668
 		{
 			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr)}
 		}
-	case 252:
+	case 285:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1486
+		//line sql.y:1674
 		{
 			sqlVAL.updateExprs = UpdateExprs{sqlDollar[1].updateExpr}
 		}
-	case 253:
+	case 286:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1490
+		//line sql.y:1678
 		{
 			sqlVAL.updateExprs = append(sqlDollar[1].updateExprs, sqlDollar[3].updateExpr)
 		}
-	case 256:
+	case 289:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1500
+		//line sql.y:1688
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Names: QualifiedNames{sqlDollar[1].qname}, Expr: sqlDollar[3].expr}
 		}
-	case 257:
+	case 290:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1512
+		//line sql.y:1700
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Tuple: true, Names: sqlDollar[2].qnames, Expr: Tuple(sqlDollar[5].exprs)}
 		}
-	case 258:
+	case 291:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1516
+		//line sql.y:1704
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Tuple: true, Names: sqlDollar[2].qnames, Expr: &Subquery{Select: sqlDollar[5].selectStmt}}
 		}
-	case 261:
+	case 294:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1563
+		//line sql.y:1751
 		{
 			sqlVAL.selectStmt = &ParenSelect{Select: sqlDollar[2].selectStmt}
 		}
-	case 262:
+	case 295:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1567
+		//line sql.y:1755
 		{
 			sqlVAL.selectStmt = &ParenSelect{Select: sqlDollar[2].selectStmt}
 		}
-	case 264:
+	case 297:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1583
+		//line sql.y:1771
 		{
 			sqlVAL.selectStmt = sqlDollar[1].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[2].orderBy
 			}
 		}
-	case 265:
+	case 298:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1590
+		//line sql.y:1778
 		{
 			sqlVAL.selectStmt = sqlDollar[1].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
@@ -5223,24 +5839,65 @@ sqldefault:
 				s.Limit = sqlDollar[3].limit
 			}
 		}
-	case 266:
+	case 299:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1786
+		{
+			s, ok := sqlDollar[1].selectStmt.(*Select)
+			if !ok {
+				sqllex.Error("locking clause is not allowed with UNION/INTERSECT/EXCEPT")
+				return 1
+			}
+			sqlVAL.selectStmt = s
+			s.OrderBy = sqlDollar[2].orderBy
+			s.Lock = sqlDollar[3].lock
+		}
+	case 300:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1797
+		{
+			s, ok := sqlDollar[1].selectStmt.(*Select)
+			if !ok {
+				sqllex.Error("locking clause is not allowed with UNION/INTERSECT/EXCEPT")
+				return 1
+			}
+			sqlVAL.selectStmt = s
+			s.OrderBy = sqlDollar[2].orderBy
+			s.Lock = sqlDollar[3].lock
+			s.Limit = sqlDollar[4].limit
+		}
+	case 301:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1809
+		{
+			s, ok := sqlDollar[1].selectStmt.(*Select)
+			if !ok {
+				sqllex.Error("locking clause is not allowed with UNION/INTERSECT/EXCEPT")
+				return 1
+			}
+			sqlVAL.selectStmt = s
+			s.OrderBy = sqlDollar[2].orderBy
+			s.Limit = sqlDollar[3].limit
+			s.Lock = sqlDollar[4].lock
+		}
+	case 302:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1598
+		//line sql.y:1821
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 		}
-	case 267:
+	case 303:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1602
+		//line sql.y:1825
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[3].orderBy
 			}
 		}
-	case 268:
+	case 304:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1609
+		//line sql.y:1832
 		{
 			sqlVAL.selectStmt = sqlDollar[2].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
@@ -5248,9 +5905,9 @@ sqldefault:
 				s.Limit = sqlDollar[4].limit
 			}
 		}
-	case 271:
+	case 307:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1647
+		//line sql.y:1870
 		{
 			sqlVAL.selectStmt = &Select{
 				Exprs:   sqlDollar[3].selExprs,
@@ -5260,9 +5917,9 @@ sqldefault:
 				Having:  newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 272:
+	case 308:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1659
+		//line sql.y:1882
 		{
 			sqlVAL.selectStmt = &Select{
 				Distinct: sqlDollar[2].boolVal,
@@ -5273,9 +5930,9 @@ sqldefault:
 				Having:   newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 274:
+	case 310:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1671
+		//line sql.y:1894
 		{
 			sqlVAL.selectStmt = &Select{
 				Exprs:       SelectExprs{StarSelectExpr()},
@@ -5283,9 +5940,9 @@ sqldefault:
 				tableSelect: true,
 			}
 		}
-	case 275:
+	case 311:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1679
+		//line sql.y:1902
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astUnion,
@@ -5294,9 +5951,9 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 276:
+	case 312:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1688
+		//line sql.y:1911
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astIntersect,
@@ -5305,9 +5962,9 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 277:
+	case 313:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1697
+		//line sql.y:1920
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astExcept,
@@ -5316,142 +5973,154 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 278:
+	case 314:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1715
+		//line sql.y:1938
 		{
 			unimplemented()
 		}
-	case 279:
+	case 315:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1716
+		//line sql.y:1939
 		{
 			unimplemented()
 		}
-	case 280:
+	case 316:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1717
+		//line sql.y:1940
 		{
 			unimplemented()
 		}
-	case 281:
+	case 317:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1720
+		//line sql.y:1943
 		{
 			unimplemented()
 		}
-	case 282:
+	case 318:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1721
+		//line sql.y:1944
 		{
 			unimplemented()
 		}
-	case 283:
+	case 319:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1724
+		//line sql.y:1947
 		{
 			unimplemented()
 		}
-	case 284:
+	case 320:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1728
+		//line sql.y:1951
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 288:
+	case 324:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1736
+		//line sql.y:1959
 		{
 			unimplemented()
 		}
-	case 289:
+	case 325:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1737
+		//line sql.y:1960
 		{
 		}
-	case 290:
+	case 326:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1740
+		//line sql.y:1963
 		{
 		}
-	case 291:
+	case 327:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1741
+		//line sql.y:1964
 		{
 		}
-	case 292:
+	case 328:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1745
+		//line sql.y:1968
 		{
 			sqlVAL.boolVal = true
 		}
-	case 293:
+	case 329:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1749
+		//line sql.y:1972
 		{
 			sqlVAL.boolVal = false
 		}
-	case 294:
+	case 330:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1753
+		//line sql.y:1976
 		{
 			sqlVAL.boolVal = false
 		}
-	case 295:
+	case 331:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1759
+		//line sql.y:1982
 		{
 			sqlVAL.boolVal = true
 		}
-	case 296:
+	case 332:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1764
+		//line sql.y:1987
 		{
 		}
-	case 297:
+	case 333:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1765
+		//line sql.y:1988
 		{
 		}
-	case 298:
+	case 334:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1993
+		{
+			sqlVAL.lock = LockForUpdate
+		}
+	case 335:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1997
+		{
+			sqlVAL.lock = LockForShare
+		}
+	case 336:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1769
+		//line sql.y:2003
 		{
 			sqlVAL.orderBy = sqlDollar[1].orderBy
 		}
-	case 299:
+	case 337:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1773
+		//line sql.y:2007
 		{
 			sqlVAL.orderBy = nil
 		}
-	case 300:
+	case 338:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1779
+		//line sql.y:2013
 		{
 			sqlVAL.orderBy = OrderBy(sqlDollar[3].orders)
 		}
-	case 301:
+	case 339:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1785
+		//line sql.y:2019
 		{
 			sqlVAL.orders = []*Order{sqlDollar[1].order}
 		}
-	case 302:
+	case 340:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1789
+		//line sql.y:2023
 		{
 			sqlVAL.orders = append(sqlDollar[1].orders, sqlDollar[3].order)
 		}
-	case 303:
+	case 341:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1795
+		//line sql.y:2029
 		{
 			sqlVAL.order = &Order{Expr: sqlDollar[1].expr, Direction: sqlDollar[2].dir}
 		}
-	case 304:
+	case 342:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1803
+		//line sql.y:2037
 		{
 			if sqlDollar[1].limit == nil {
 				sqlVAL.limit = sqlDollar[2].limit
@@ -5460,18 +6129,18 @@ sqldefault:
 				sqlVAL.limit.Offset = sqlDollar[2].limit.Offset
 			}
 		}
-	case 305:
+	case 343:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1812
+		//line sql.y:2046
 		{
 			sqlVAL.limit = sqlDollar[1].limit
 			if sqlDollar[2].limit != nil {
 				sqlVAL.limit.Count = sqlDollar[2].limit.Count
 			}
 		}
-	case 308:
+	case 346:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1823
+		//line sql.y:2057
 		{
 			if sqlDollar[2].expr == nil {
 				sqlVAL.limit = nil
@@ -5479,2030 +6148,2211 @@ sqldefault:
 				sqlVAL.limit = &Limit{Count: sqlDollar[2].expr}
 			}
 		}
-	case 309:
+	case 347:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1836
+		//line sql.y:2070
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 310:
+	case 348:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1843
+		//line sql.y:2077
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 312:
+	case 350:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1850
+		//line sql.y:2084
 		{
 			sqlVAL.expr = nil
 		}
-	case 313:
+	case 351:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1864
+		//line sql.y:2098
 		{
 		}
-	case 314:
+	case 352:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1865
+		//line sql.y:2099
 		{
 		}
-	case 315:
+	case 353:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1891
+		//line sql.y:2125
 		{
 			sqlVAL.groupBy = GroupBy(sqlDollar[3].exprs)
 		}
-	case 316:
+	case 354:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1895
+		//line sql.y:2129
 		{
 			sqlVAL.groupBy = nil
 		}
-	case 317:
+	case 355:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1901
+		//line sql.y:2135
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 318:
+	case 356:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1905
+		//line sql.y:2139
 		{
 			sqlVAL.expr = nil
 		}
-	case 319:
+	case 357:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1911
+		//line sql.y:2145
 		{
 			sqlVAL.selectStmt = Values{Tuple(sqlDollar[2].exprs)}
 		}
-	case 320:
+	case 358:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1915
+		//line sql.y:2149
 		{
 			sqlVAL.selectStmt = append(sqlDollar[1].selectStmt.(Values), Tuple(sqlDollar[3].exprs))
 		}
-	case 321:
+	case 359:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1925
+		//line sql.y:2159
 		{
 			sqlVAL.tblExprs = sqlDollar[2].tblExprs
 		}
-	case 322:
+	case 360:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1929
+		//line sql.y:2163
 		{
 			sqlVAL.tblExprs = nil
 		}
-	case 323:
+	case 361:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1935
+		//line sql.y:2169
 		{
 			sqlVAL.tblExprs = TableExprs{sqlDollar[1].tblExpr}
 		}
-	case 324:
+	case 362:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1939
+		//line sql.y:2173
 		{
 			sqlVAL.tblExprs = append(sqlDollar[1].tblExprs, sqlDollar[3].tblExpr)
 		}
-	case 325:
+	case 363:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1946
+		//line sql.y:2180
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 326:
+	case 364:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1950
+		//line sql.y:2184
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: &Subquery{Select: sqlDollar[1].selectStmt}, As: Name(sqlDollar[2].str)}
 		}
-	case 328:
+	case 365:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:2188
+		{
+			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].expr.(*FuncExpr), As: Name(sqlDollar[2].str)}
+		}
+	case 367:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1954
+		//line sql.y:2192
 		{
 			unimplemented()
 		}
-	case 329:
+	case 368:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1972
+		//line sql.y:2210
 		{
 			sqlVAL.tblExpr = &ParenTableExpr{Expr: sqlDollar[2].tblExpr}
 		}
-	case 330:
+	case 369:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1976
+		//line sql.y:2214
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astCrossJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 331:
+	case 370:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1980
+		//line sql.y:2218
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: sqlDollar[2].str, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr, Cond: sqlDollar[5].joinCond}
 		}
-	case 332:
+	case 371:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1984
+		//line sql.y:2222
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[3].tblExpr, Cond: sqlDollar[4].joinCond}
 		}
-	case 333:
+	case 372:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1988
+		//line sql.y:2226
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[5].tblExpr}
 		}
-	case 334:
+	case 373:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1992
+		//line sql.y:2230
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 335:
+	case 374:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1997
+		//line sql.y:2235
 		{
 			unimplemented()
 		}
-	case 336:
+	case 375:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1999
+		//line sql.y:2237
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 337:
+	case 376:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2002
+		//line sql.y:2240
 		{
 			unimplemented()
 		}
-	case 338:
+	case 377:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2004
+		//line sql.y:2242
 		{
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 340:
+	case 379:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2011
+		//line sql.y:2249
 		{
 			sqlVAL.str = ""
 		}
-	case 341:
+	case 380:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2017
+		//line sql.y:2255
 		{
 			sqlVAL.str = astFullJoin
 		}
-	case 342:
+	case 381:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2021
+		//line sql.y:2259
 		{
 			sqlVAL.str = astLeftJoin
 		}
-	case 343:
+	case 382:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2025
+		//line sql.y:2263
 		{
 			sqlVAL.str = astRightJoin
 		}
-	case 344:
+	case 383:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2029
+		//line sql.y:2267
 		{
 			sqlVAL.str = astInnerJoin
 		}
-	case 345:
+	case 384:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2035
+		//line sql.y:2273
 		{
 		}
-	case 346:
+	case 385:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2036
+		//line sql.y:2274
 		{
 		}
-	case 347:
+	case 386:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2047
+		//line sql.y:2285
 		{
 			sqlVAL.joinCond = &UsingJoinCond{Cols: NameList(sqlDollar[3].strs)}
 		}
-	case 348:
+	case 387:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2051
+		//line sql.y:2289
 		{
 			sqlVAL.joinCond = &OnJoinCond{Expr: sqlDollar[2].expr}
 		}
-	case 349:
+	case 388:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2057
+		//line sql.y:2295
 		{
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 350:
+	case 389:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2061
+		//line sql.y:2299
 		{
 			// TODO(pmattis): Handle the "*".
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 351:
+	case 390:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2066
+		//line sql.y:2304
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 352:
+	case 391:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2071
+		//line sql.y:2309
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[3].qname
 		}
-	case 353:
+	case 392:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2078
+		//line sql.y:2316
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 354:
+	case 393:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2082
+		//line sql.y:2320
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 355:
+	case 394:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2095
+		//line sql.y:2333
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname}
 		}
-	case 356:
+	case 395:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2099
+		//line sql.y:2337
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 357:
+	case 396:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2103
+		//line sql.y:2341
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[3].str)}
 		}
-	case 358:
+	case 397:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2109
+		//line sql.y:2347
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 359:
+	case 398:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2113
+		//line sql.y:2351
 		{
 			sqlVAL.expr = nil
 		}
-	case 360:
+	case 399:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2125
+		//line sql.y:2363
 		{
-			sqlVAL.colType = sqlDollar[1].colType
+			if sqlDollar[2].boolVal {
+				sqlVAL.colType = &ArrayType{ParamType: sqlDollar[1].colType}
+			} else {
+				sqlVAL.colType = sqlDollar[1].colType
+			}
 		}
-	case 361:
+	case 400:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2129
+		//line sql.y:2372
 		{
-			unimplemented()
+			sqlVAL.colType = &ArrayType{ParamType: sqlDollar[1].colType}
 		}
-	case 362:
+	case 401:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2130
+		//line sql.y:2376
 		{
-			unimplemented()
+			sqlVAL.colType = &ArrayType{ParamType: sqlDollar[1].colType}
 		}
-	case 363:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2133
+	case 402:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:2383
 		{
-			unimplemented()
+			sqlVAL.boolVal = true
 		}
-	case 364:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2134
+	case 403:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2387
 		{
-			unimplemented()
+			sqlVAL.boolVal = true
 		}
-	case 365:
+	case 404:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2135
+		//line sql.y:2391
 		{
+			sqlVAL.boolVal = false
 		}
-	case 371:
+	case 410:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2143
+		//line sql.y:2401
 		{
 			unimplemented()
 		}
-	case 372:
+	case 411:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2145
+		//line sql.y:2403
 		{
 			sqlVAL.colType = &BytesType{Name: "BLOB"}
 		}
-	case 373:
+	case 412:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2149
+		//line sql.y:2407
 		{
 			sqlVAL.colType = &BytesType{Name: "BYTES"}
 		}
-	case 374:
+	case 413:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2153
+		//line sql.y:2411
 		{
 			sqlVAL.colType = &StringType{Name: "TEXT"}
 		}
-	case 375:
+	case 414:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2157
+		//line sql.y:2415
 		{
 			sqlVAL.colType = &StringType{Name: "STRING"}
 		}
-	case 380:
+	case 415:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2419
+		{
+			sqlVAL.colType = &JSONType{Name: "JSON"}
+		}
+	case 416:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2423
+		{
+			sqlVAL.colType = &JSONType{Name: "JSONB"}
+		}
+	case 417:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2427
+		{
+			sqlVAL.colType = &UUIDType{}
+		}
+	case 418:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2431
+		{
+			sqlVAL.colType = &INetType{}
+		}
+	case 423:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2178
+		//line sql.y:2452
 		{
 			sqlVAL.colType = &DecimalType{Prec: int(sqlDollar[2].ival)}
 		}
-	case 381:
+	case 424:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2182
+		//line sql.y:2456
 		{
 			sqlVAL.colType = &DecimalType{Prec: int(sqlDollar[2].ival), Scale: int(sqlDollar[4].ival)}
 		}
-	case 382:
+	case 425:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2186
+		//line sql.y:2460
 		{
 			sqlVAL.colType = &DecimalType{}
 		}
-	case 383:
+	case 426:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2193
+		//line sql.y:2467
 		{
 			sqlVAL.colType = &IntType{Name: "INT"}
 		}
-	case 384:
+	case 427:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2197
+		//line sql.y:2471
 		{
 			sqlVAL.colType = &IntType{Name: "INT64"}
 		}
-	case 385:
+	case 428:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2201
+		//line sql.y:2475
 		{
 			sqlVAL.colType = &IntType{Name: "INTEGER"}
 		}
-	case 386:
+	case 429:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2205
+		//line sql.y:2479
 		{
 			sqlVAL.colType = &IntType{Name: "SMALLINT"}
 		}
-	case 387:
+	case 430:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2209
+		//line sql.y:2483
 		{
 			sqlVAL.colType = &IntType{Name: "BIGINT"}
 		}
-	case 388:
+	case 431:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2213
+		//line sql.y:2487
 		{
 			sqlVAL.colType = &FloatType{Name: "REAL"}
 		}
-	case 389:
+	case 432:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2217
+		//line sql.y:2491
 		{
 			sqlVAL.colType = &FloatType{Name: "FLOAT", Prec: int(sqlDollar[2].ival)}
 		}
-	case 390:
+	case 433:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2221
+		//line sql.y:2495
 		{
 			sqlVAL.colType = &FloatType{Name: "DOUBLE PRECISION"}
 		}
-	case 391:
+	case 434:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2225
+		//line sql.y:2499
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "DECIMAL"
 		}
-	case 392:
+	case 435:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2230
+		//line sql.y:2504
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "DEC"
 		}
-	case 393:
+	case 436:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2235
+		//line sql.y:2509
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "NUMERIC"
 		}
-	case 394:
+	case 437:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2240
+		//line sql.y:2514
 		{
 			sqlVAL.colType = &BoolType{Name: "BOOLEAN"}
 		}
-	case 395:
+	case 438:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2244
+		//line sql.y:2518
 		{
 			sqlVAL.colType = &BoolType{Name: "BOOL"}
 		}
-	case 396:
+	case 439:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2250
+		//line sql.y:2524
 		{
 			sqlVAL.ival = sqlDollar[2].ival
 		}
-	case 397:
+	case 440:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2254
+		//line sql.y:2528
 		{
 			sqlVAL.ival = 0
 		}
-	case 402:
+	case 445:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2272
+		//line sql.y:2546
 		{
 			sqlVAL.colType = &IntType{Name: "BIT", N: int(sqlDollar[4].ival)}
 		}
-	case 403:
+	case 446:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2278
+		//line sql.y:2552
 		{
 			sqlVAL.colType = &IntType{Name: "BIT"}
 		}
-	case 408:
+	case 451:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2294
+		//line sql.y:2568
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 			sqlVAL.colType.(*StringType).N = int(sqlDollar[3].ival)
 		}
-	case 409:
+	case 452:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2301
+		//line sql.y:2575
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 410:
+	case 453:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2307
+		//line sql.y:2581
 		{
 			sqlVAL.colType = &StringType{Name: "CHAR"}
 		}
-	case 411:
+	case 454:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2311
+		//line sql.y:2585
 		{
 			sqlVAL.colType = &StringType{Name: "CHAR"}
 		}
-	case 412:
+	case 455:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2315
+		//line sql.y:2589
 		{
 			sqlVAL.colType = &StringType{Name: "VARCHAR"}
 		}
-	case 413:
+	case 456:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2320
+		//line sql.y:2594
 		{
 		}
-	case 414:
+	case 457:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2321
+		//line sql.y:2595
 		{
 		}
-	case 415:
+	case 458:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2326
+		//line sql.y:2600
 		{
 			sqlVAL.colType = &DateType{}
 		}
-	case 416:
+	case 459:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:2604
+		{
+			if sqlDollar[2].boolVal {
+				sqlVAL.colType = &TimestampTZType{}
+			} else {
+				sqlVAL.colType = &TimestampType{}
+			}
+		}
+	case 460:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2330
+		//line sql.y:2612
 		{
-			sqlVAL.colType = &TimestampType{}
+			sqlVAL.colType = &TimestampTZType{}
 		}
-	case 417:
+	case 461:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:2616
+		{
+			if sqlDollar[2].boolVal {
+				sqllex.Error("TIME WITH TIME ZONE is not supported")
+				return 1
+			}
+			sqlVAL.colType = &TimeType{}
+		}
+	case 462:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2626
+		{
+			sqlVAL.boolVal = true
+		}
+	case 463:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2630
+		{
+			sqlVAL.boolVal = false
+		}
+	case 464:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:2634
+		{
+			sqlVAL.boolVal = false
+		}
+	case 465:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2335
+		//line sql.y:2639
 		{
 			sqlVAL.colType = &IntervalType{}
 		}
-	case 418:
+	case 466:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2340
+		//line sql.y:2644
 		{
 			unimplemented()
 		}
-	case 419:
+	case 467:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2341
+		//line sql.y:2645
 		{
 			unimplemented()
 		}
-	case 420:
+	case 468:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2342
+		//line sql.y:2646
 		{
 			unimplemented()
 		}
-	case 421:
+	case 469:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2343
+		//line sql.y:2647
 		{
 			unimplemented()
 		}
-	case 422:
+	case 470:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2344
+		//line sql.y:2648
 		{
 			unimplemented()
 		}
-	case 423:
+	case 471:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2345
+		//line sql.y:2649
 		{
 			unimplemented()
 		}
-	case 424:
+	case 472:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2346
+		//line sql.y:2650
 		{
 			unimplemented()
 		}
-	case 425:
+	case 473:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2347
+		//line sql.y:2651
 		{
 			unimplemented()
 		}
-	case 426:
+	case 474:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2348
+		//line sql.y:2652
 		{
 			unimplemented()
 		}
-	case 427:
+	case 475:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2349
+		//line sql.y:2653
 		{
 			unimplemented()
 		}
-	case 428:
+	case 476:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2350
+		//line sql.y:2654
 		{
 			unimplemented()
 		}
-	case 429:
+	case 477:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2351
+		//line sql.y:2655
 		{
 			unimplemented()
 		}
-	case 430:
+	case 478:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2352
+		//line sql.y:2656
 		{
 			unimplemented()
 		}
-	case 431:
+	case 479:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2353
+		//line sql.y:2657
 		{
 		}
-	case 432:
+	case 480:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2356
+		//line sql.y:2660
 		{
 			unimplemented()
 		}
-	case 433:
+	case 481:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2357
+		//line sql.y:2661
 		{
 			unimplemented()
 		}
-	case 435:
+	case 483:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2381
+		//line sql.y:2685
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 436:
+	case 484:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2384
+		//line sql.y:2689
 		{
-			unimplemented()
+			sqlVAL.expr = &CollateExpr{Expr: sqlDollar[1].expr, Locale: sqlDollar[3].str}
 		}
-	case 437:
+	case 485:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2385
+		//line sql.y:2693
 		{
-			unimplemented()
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: "timezone"}, Exprs: Exprs{sqlDollar[5].expr, sqlDollar[1].expr}}
 		}
-	case 438:
+	case 486:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2394
+		//line sql.y:2704
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 439:
+	case 487:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2398
+		//line sql.y:2708
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 440:
+	case 488:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2402
+		//line sql.y:2712
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 441:
+	case 489:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2406
+		//line sql.y:2716
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 442:
+	case 490:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2410
+		//line sql.y:2720
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 443:
+	case 491:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2414
+		//line sql.y:2724
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 444:
+	case 492:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2418
+		//line sql.y:2728
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 445:
+	case 493:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2422
+		//line sql.y:2732
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 446:
+	case 494:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2426
+		//line sql.y:2736
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 447:
+	case 495:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2430
+		//line sql.y:2740
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 448:
+	case 496:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2434
+		//line sql.y:2744
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 449:
+	case 497:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2438
+		//line sql.y:2748
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 450:
+	case 498:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2442
+		//line sql.y:2752
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 451:
+	case 499:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2446
+		//line sql.y:2756
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 452:
+	case 500:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2450
+		//line sql.y:2760
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 453:
+	case 501:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2454
+		//line sql.y:2764
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 454:
+	case 502:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2768
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: FetchVal, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 503:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2458
+		//line sql.y:2772
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: FetchText, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 504:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2776
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: Contains, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 505:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2780
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: HasKey, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 506:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2784
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: ContainedByOrEquals, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 507:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2788
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: ContainsOrEquals, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 508:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2792
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: Overlaps, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 509:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2796
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 455:
+	case 510:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2462
+		//line sql.y:2800
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 456:
+	case 511:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2466
+		//line sql.y:2804
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 457:
+	case 512:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2470
+		//line sql.y:2808
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 458:
+	case 513:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2474
+		//line sql.y:2812
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 459:
+	case 514:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2478
+		//line sql.y:2816
 		{
 			sqlVAL.expr = &AndExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 460:
+	case 515:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2482
+		//line sql.y:2820
 		{
 			sqlVAL.expr = &OrExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 461:
+	case 516:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2486
+		//line sql.y:2824
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 462:
+	case 517:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2490
+		//line sql.y:2828
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 463:
+	case 518:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2494
+		//line sql.y:2832
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 464:
+	case 519:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2498
+		//line sql.y:2836
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 465:
+	case 520:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2502
+		//line sql.y:2840
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: SimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 466:
+	case 521:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2506
+		//line sql.y:2844
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotSimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 467:
+	case 522:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2510
+		//line sql.y:2848
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 468:
+	case 523:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2514
+		//line sql.y:2852
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 469:
+	case 524:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2517
+		//line sql.y:2855
 		{
 			unimplemented()
 		}
-	case 470:
+	case 525:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2519
+		//line sql.y:2857
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DBool(true)}
 		}
-	case 471:
+	case 526:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2523
+		//line sql.y:2861
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DBool(true)}
 		}
-	case 472:
+	case 527:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2527
+		//line sql.y:2865
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DBool(false)}
 		}
-	case 473:
+	case 528:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2531
+		//line sql.y:2869
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DBool(false)}
 		}
-	case 474:
+	case 529:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2535
+		//line sql.y:2873
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 475:
+	case 530:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2539
+		//line sql.y:2877
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 476:
+	case 531:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2543
+		//line sql.y:2881
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 477:
+	case 532:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2547
+		//line sql.y:2885
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[6].expr}
 		}
-	case 478:
+	case 533:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2551
+		//line sql.y:2889
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Expr: sqlDollar[1].expr, Types: sqlDollar[5].colTypes}
 		}
-	case 479:
+	case 534:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2555
+		//line sql.y:2893
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].expr, Types: sqlDollar[6].colTypes}
 		}
-	case 480:
+	case 535:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2559
+		//line sql.y:2897
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 481:
+	case 536:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2563
+		//line sql.y:2901
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 482:
+	case 537:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2567
+		//line sql.y:2905
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 483:
+	case 538:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2571
+		//line sql.y:2909
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 484:
+	case 539:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2575
+		//line sql.y:2913
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: In, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 485:
+	case 540:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2579
+		//line sql.y:2917
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotIn, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 487:
+	case 541:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:2921
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: Any, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
+		}
+	case 542:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:2925
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: Any, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
+		}
+	case 544:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2596
+		//line sql.y:2942
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 488:
+	case 545:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2600
+		//line sql.y:2946
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 489:
+	case 546:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2604
+		//line sql.y:2950
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 490:
+	case 547:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2608
+		//line sql.y:2954
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 491:
+	case 548:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2612
+		//line sql.y:2958
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 492:
+	case 549:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2616
+		//line sql.y:2962
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 493:
+	case 550:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2620
+		//line sql.y:2966
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 494:
+	case 551:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2624
+		//line sql.y:2970
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 495:
+	case 552:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2628
+		//line sql.y:2974
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 496:
+	case 553:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2632
+		//line sql.y:2978
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 497:
+	case 554:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2636
+		//line sql.y:2982
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 498:
+	case 555:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2640
+		//line sql.y:2986
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 499:
+	case 556:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2644
+		//line sql.y:2990
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 500:
+	case 557:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2648
+		//line sql.y:2994
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 501:
+	case 558:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2652
+		//line sql.y:2998
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 502:
+	case 559:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2656
+		//line sql.y:3002
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 503:
+	case 560:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2660
+		//line sql.y:3006
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 504:
+	case 561:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2664
+		//line sql.y:3010
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: FetchVal, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 562:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3014
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: FetchText, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 563:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3018
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: Contains, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 564:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3022
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: HasKey, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 565:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3026
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: ContainedByOrEquals, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 566:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3030
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: ContainsOrEquals, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 567:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3034
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: Overlaps, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 568:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3038
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 505:
+	case 569:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2668
+		//line sql.y:3042
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 506:
+	case 570:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2672
+		//line sql.y:3046
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 507:
+	case 571:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2676
+		//line sql.y:3050
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 508:
+	case 572:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2680
+		//line sql.y:3054
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 509:
+	case 573:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2684
+		//line sql.y:3058
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 510:
+	case 574:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2688
+		//line sql.y:3062
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[6].expr}
 		}
-	case 511:
+	case 575:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2692
+		//line sql.y:3066
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Expr: sqlDollar[1].expr, Types: sqlDollar[5].colTypes}
 		}
-	case 512:
+	case 576:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2696
+		//line sql.y:3070
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].expr, Types: sqlDollar[6].colTypes}
 		}
-	case 513:
+	case 577:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2708
+		//line sql.y:3082
 		{
 			sqlVAL.expr = sqlDollar[1].qname
 		}
-	case 515:
+	case 579:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2713
+		//line sql.y:3087
 		{
 			sqlVAL.expr = ValArg(sqlDollar[1].str)
 		}
-	case 516:
+	case 580:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2717
+		//line sql.y:3091
 		{
 			sqlVAL.expr = &ParenExpr{Expr: sqlDollar[2].expr}
 		}
-	case 519:
+	case 581:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3095
+		{
+			for _, elem := range sqlDollar[4].indirect {
+				if _, ok := elem.(*ArrayIndirection); !ok {
+					sqllex.Error("only array subscripts are supported on expressions")
+					return 1
+				}
+			}
+			sqlVAL.expr = &IndirectionExpr{Expr: sqlDollar[2].expr, Indirection: sqlDollar[4].indirect}
+		}
+	case 584:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2723
+		//line sql.y:3107
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 520:
+	case 585:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2727
+		//line sql.y:3111
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 521:
+	case 586:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2731
+		//line sql.y:3115
 		{
 			sqlVAL.expr = &ExistsExpr{Subquery: &Subquery{Select: sqlDollar[2].selectStmt}}
 		}
-	case 522:
+	case 587:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2737
+		//line sql.y:3121
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 523:
+	case 588:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2741
+		//line sql.y:3125
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 524:
+	case 589:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2745
+		//line sql.y:3129
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 525:
+	case 590:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2753
+		//line sql.y:3137
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname}
 		}
-	case 526:
+	case 591:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2757
+		//line sql.y:3141
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: sqlDollar[3].exprs}
 		}
-	case 527:
+	case 592:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2761
+		//line sql.y:3145
 		{
 			unimplemented()
 		}
-	case 528:
+	case 593:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2762
+		//line sql.y:3146
 		{
 			unimplemented()
 		}
-	case 529:
+	case 594:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2763
+		//line sql.y:3147
 		{
 			unimplemented()
 		}
-	case 530:
+	case 595:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2765
+		//line sql.y:3149
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Distinct: true, Exprs: sqlDollar[4].exprs}
 		}
-	case 531:
+	case 596:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2770
+		//line sql.y:3154
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: Exprs{StarExpr()}}
 		}
-	case 532:
+	case 597:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2783
+		//line sql.y:3167
 		{
 			// TODO(pmattis): Support within_group_clause, filter_clause and
 			// over_clause?
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 533:
+	case 598:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2789
+		//line sql.y:3173
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 534:
+	case 599:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2798
+		//line sql.y:3183
 		{
-			unimplemented()
+			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 535:
+	case 600:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2799
+		//line sql.y:3187
 		{
-			unimplemented()
+			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 536:
+	case 601:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2803
+		//line sql.y:3193
 		{
 			unimplemented()
 		}
-	case 537:
+	case 602:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2805
+		//line sql.y:3195
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 538:
+	case 603:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2809
+		//line sql.y:3199
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 539:
+	case 604:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2813
+		//line sql.y:3203
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 540:
+	case 605:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2817
+		//line sql.y:3207
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 541:
+	case 606:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2820
+		//line sql.y:3210
 		{
 			unimplemented()
 		}
-	case 542:
+	case 607:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2821
+		//line sql.y:3211
 		{
 			unimplemented()
 		}
-	case 543:
+	case 608:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2822
+		//line sql.y:3212
 		{
 			unimplemented()
 		}
-	case 544:
+	case 609:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2823
+		//line sql.y:3213
 		{
 			unimplemented()
 		}
-	case 545:
+	case 610:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2825
+		//line sql.y:3215
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[3].expr, Type: sqlDollar[5].colType}
 		}
-	case 546:
+	case 611:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2829
+		//line sql.y:3219
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 547:
+	case 612:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2832
+		//line sql.y:3222
 		{
 			unimplemented()
 		}
-	case 548:
+	case 613:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2833
+		//line sql.y:3223
 		{
 			unimplemented()
 		}
-	case 549:
+	case 614:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2834
+		//line sql.y:3224
 		{
 			unimplemented()
 		}
-	case 550:
+	case 615:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2835
+		//line sql.y:3225
 		{
 			unimplemented()
 		}
-	case 551:
+	case 616:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2836
+		//line sql.y:3226
 		{
 			unimplemented()
 		}
-	case 552:
+	case 617:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2837
+		//line sql.y:3227
 		{
 			unimplemented()
 		}
-	case 553:
+	case 618:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2838
+		//line sql.y:3228
 		{
 			unimplemented()
 		}
-	case 554:
+	case 619:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2839
+		//line sql.y:3229
 		{
 			unimplemented()
 		}
-	case 555:
+	case 620:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2841
+		//line sql.y:3231
 		{
 			sqlVAL.expr = &IfExpr{Cond: sqlDollar[3].expr, True: sqlDollar[5].expr, Else: sqlDollar[7].expr}
 		}
-	case 556:
+	case 621:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2845
+		//line sql.y:3235
 		{
 			sqlVAL.expr = &NullIfExpr{Expr1: sqlDollar[3].expr, Expr2: sqlDollar[5].expr}
 		}
-	case 557:
+	case 622:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2849
+		//line sql.y:3239
 		{
 			sqlVAL.expr = &CoalesceExpr{Name: "IFNULL", Exprs: Exprs{sqlDollar[3].expr, sqlDollar[5].expr}}
 		}
-	case 558:
+	case 623:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2853
+		//line sql.y:3243
 		{
 			sqlVAL.expr = &CoalesceExpr{Name: "COALESCE", Exprs: sqlDollar[3].exprs}
 		}
-	case 559:
+	case 624:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2856
+		//line sql.y:3246
 		{
 			unimplemented()
 		}
-	case 560:
+	case 625:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2857
+		//line sql.y:3247
 		{
 			unimplemented()
 		}
-	case 561:
+	case 626:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2861
+		//line sql.y:3251
 		{
 			unimplemented()
 		}
-	case 562:
+	case 627:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2862
+		//line sql.y:3252
 		{
 		}
-	case 563:
+	case 628:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2865
+		//line sql.y:3255
 		{
 			unimplemented()
 		}
-	case 564:
+	case 629:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2866
+		//line sql.y:3256
 		{
 		}
-	case 565:
+	case 630:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2870
+		//line sql.y:3260
 		{
 			unimplemented()
 		}
-	case 566:
+	case 631:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2871
+		//line sql.y:3261
 		{
 		}
-	case 567:
+	case 632:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2874
+		//line sql.y:3264
 		{
 			unimplemented()
 		}
-	case 568:
+	case 633:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2875
+		//line sql.y:3265
 		{
 			unimplemented()
 		}
-	case 569:
+	case 634:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2878
+		//line sql.y:3268
 		{
 			unimplemented()
 		}
-	case 570:
+	case 635:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2881
+		//line sql.y:3271
 		{
 			unimplemented()
 		}
-	case 571:
+	case 636:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2882
+		//line sql.y:3272
 		{
 			unimplemented()
 		}
-	case 572:
+	case 637:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2883
+		//line sql.y:3273
 		{
 		}
-	case 573:
+	case 638:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2887
+		//line sql.y:3277
 		{
 			unimplemented()
 		}
-	case 574:
+	case 639:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2898
+		//line sql.y:3288
 		{
 			unimplemented()
 		}
-	case 575:
+	case 640:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2899
+		//line sql.y:3289
 		{
 		}
-	case 576:
+	case 641:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2902
+		//line sql.y:3292
 		{
 			unimplemented()
 		}
-	case 577:
+	case 642:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2903
+		//line sql.y:3293
 		{
 		}
-	case 578:
+	case 643:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2911
+		//line sql.y:3301
 		{
 			unimplemented()
 		}
-	case 579:
+	case 644:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2912
+		//line sql.y:3302
 		{
 			unimplemented()
 		}
-	case 580:
+	case 645:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2913
+		//line sql.y:3303
 		{
 		}
-	case 581:
+	case 646:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2916
+		//line sql.y:3306
 		{
 			unimplemented()
 		}
-	case 582:
+	case 647:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2917
+		//line sql.y:3307
 		{
 			unimplemented()
 		}
-	case 583:
+	case 648:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2923
+		//line sql.y:3313
 		{
 			unimplemented()
 		}
-	case 584:
+	case 649:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2924
+		//line sql.y:3314
 		{
 			unimplemented()
 		}
-	case 585:
+	case 650:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2925
+		//line sql.y:3315
 		{
 			unimplemented()
 		}
-	case 586:
+	case 651:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2926
+		//line sql.y:3316
 		{
 			unimplemented()
 		}
-	case 587:
+	case 652:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2927
+		//line sql.y:3317
 		{
 			unimplemented()
 		}
-	case 588:
+	case 653:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2938
+		//line sql.y:3328
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 589:
+	case 654:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2942
+		//line sql.y:3332
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 590:
+	case 655:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2946
+		//line sql.y:3336
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 591:
+	case 656:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2952
+		//line sql.y:3342
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 592:
+	case 657:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2956
+		//line sql.y:3346
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 593:
+	case 658:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2962
+		//line sql.y:3352
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 594:
+	case 659:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3003
+		//line sql.y:3393
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 595:
+	case 660:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3007
+		//line sql.y:3397
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 596:
+	case 661:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3013
+		//line sql.y:3403
 		{
 			sqlVAL.colTypes = []ColumnType{sqlDollar[1].colType}
 		}
-	case 597:
+	case 662:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3017
+		//line sql.y:3407
 		{
 			sqlVAL.colTypes = append(sqlDollar[1].colTypes, sqlDollar[3].colType)
 		}
-	case 598:
+	case 663:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3023
+		//line sql.y:3413
 		{
 			sqlVAL.expr = Array(sqlDollar[2].exprs)
 		}
-	case 599:
+	case 664:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3027
+		//line sql.y:3417
 		{
 			sqlVAL.expr = Array(sqlDollar[2].exprs)
 		}
-	case 600:
+	case 665:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3031
+		//line sql.y:3421
 		{
 			sqlVAL.expr = Array(nil)
 		}
-	case 601:
+	case 666:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3037
+		//line sql.y:3427
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 602:
+	case 667:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3041
+		//line sql.y:3431
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 603:
+	case 668:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3047
+		//line sql.y:3437
 		{
 			sqlVAL.exprs = Exprs{DString(sqlDollar[1].str), sqlDollar[3].expr}
 		}
-	case 611:
+	case 676:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3068
+		//line sql.y:3458
 		{
 			unimplemented()
 		}
-	case 612:
+	case 677:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3069
+		//line sql.y:3459
 		{
 			unimplemented()
 		}
-	case 613:
+	case 678:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3072
+		//line sql.y:3462
 		{
 			unimplemented()
 		}
-	case 614:
+	case 679:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3076
+		//line sql.y:3466
 		{
 			unimplemented()
 		}
-	case 615:
+	case 680:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3077
+		//line sql.y:3467
 		{
 		}
-	case 616:
+	case 681:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3091
+		//line sql.y:3481
 		{
 			unimplemented()
 		}
-	case 617:
+	case 682:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3092
+		//line sql.y:3482
 		{
 			unimplemented()
 		}
-	case 618:
+	case 683:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3093
+		//line sql.y:3483
 		{
 			unimplemented()
 		}
-	case 619:
+	case 684:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3094
+		//line sql.y:3484
 		{
 			unimplemented()
 		}
-	case 620:
+	case 685:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3095
+		//line sql.y:3485
 		{
 			unimplemented()
 		}
-	case 621:
+	case 686:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3096
+		//line sql.y:3486
 		{
 		}
-	case 622:
+	case 687:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3099
+		//line sql.y:3489
 		{
 			unimplemented()
 		}
-	case 623:
+	case 688:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3102
+		//line sql.y:3492
 		{
 			unimplemented()
 		}
-	case 624:
+	case 689:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3105
+		//line sql.y:3495
 		{
 			unimplemented()
 		}
-	case 625:
+	case 690:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3106
+		//line sql.y:3496
 		{
 			unimplemented()
 		}
-	case 626:
+	case 691:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3107
+		//line sql.y:3497
 		{
 			unimplemented()
 		}
-	case 627:
+	case 692:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3111
+		//line sql.y:3501
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 628:
+	case 693:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3115
+		//line sql.y:3505
 		{
 			sqlVAL.expr = Tuple(sqlDollar[2].exprs)
 		}
-	case 629:
+	case 694:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3126
+		//line sql.y:3516
 		{
 			sqlVAL.expr = &CaseExpr{Expr: sqlDollar[2].expr, Whens: sqlDollar[3].whens, Else: sqlDollar[4].expr}
 		}
-	case 630:
+	case 695:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3133
+		//line sql.y:3523
 		{
 			sqlVAL.whens = []*When{sqlDollar[1].when}
 		}
-	case 631:
+	case 696:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3137
+		//line sql.y:3527
 		{
 			sqlVAL.whens = append(sqlDollar[1].whens, sqlDollar[2].when)
 		}
-	case 632:
+	case 697:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3143
+		//line sql.y:3533
 		{
 			sqlVAL.when = &When{Cond: sqlDollar[2].expr, Val: sqlDollar[4].expr}
 		}
-	case 633:
+	case 698:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3149
+		//line sql.y:3539
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 634:
+	case 699:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3153
+		//line sql.y:3543
 		{
 			sqlVAL.expr = nil
 		}
-	case 636:
+	case 701:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3160
+		//line sql.y:3550
 		{
 			sqlVAL.expr = nil
 		}
-	case 637:
+	case 702:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3166
+		//line sql.y:3556
 		{
 			sqlVAL.indirectElem = NameIndirection(sqlDollar[2].str)
 		}
-	case 638:
+	case 703:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3170
+		//line sql.y:3560
 		{
 			sqlVAL.indirectElem = qualifiedStar
 		}
-	case 639:
+	case 704:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3174
+		//line sql.y:3564
 		{
 			sqlVAL.indirectElem = IndexIndirection(sqlDollar[2].str)
 		}
-	case 640:
+	case 705:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3178
+		//line sql.y:3568
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr}
 		}
-	case 641:
+	case 706:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3182
+		//line sql.y:3572
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr, End: sqlDollar[4].expr}
 		}
-	case 642:
+	case 707:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3188
+		//line sql.y:3578
 		{
 			sqlVAL.indirect = Indirection{sqlDollar[1].indirectElem}
 		}
-	case 643:
+	case 708:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3192
+		//line sql.y:3582
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, sqlDollar[2].indirectElem)
 		}
-	case 644:
+	case 709:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3197
+		//line sql.y:3587
 		{
 		}
-	case 645:
+	case 710:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3198
+		//line sql.y:3588
 		{
 		}
-	case 647:
+	case 712:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3207
+		//line sql.y:3597
 		{
 			sqlVAL.expr = DefaultVal{}
 		}
-	case 648:
+	case 713:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3213
+		//line sql.y:3603
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 649:
+	case 714:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3217
+		//line sql.y:3607
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 650:
+	case 715:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3226
+		//line sql.y:3616
 		{
 			sqlVAL.exprs = sqlDollar[2].exprs
 		}
-	case 652:
+	case 717:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3234
+		//line sql.y:3624
 		{
 			sqlVAL.selExprs = nil
 		}
-	case 653:
+	case 718:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3240
+		//line sql.y:3630
 		{
 			sqlVAL.selExprs = SelectExprs{sqlDollar[1].selExpr}
 		}
-	case 654:
+	case 719:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3244
+		//line sql.y:3634
 		{
 			sqlVAL.selExprs = append(sqlDollar[1].selExprs, sqlDollar[3].selExpr)
 		}
-	case 655:
+	case 720:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3250
+		//line sql.y:3640
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[3].str)}
 		}
-	case 656:
+	case 721:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3259
+		//line sql.y:3649
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[2].str)}
 		}
-	case 657:
+	case 722:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3263
+		//line sql.y:3653
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr}
 		}
-	case 658:
+	case 723:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3267
+		//line sql.y:3657
 		{
 			sqlVAL.selExpr = StarSelectExpr()
 		}
-	case 659:
+	case 724:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3275
+		//line sql.y:3665
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 660:
+	case 725:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3279
+		//line sql.y:3669
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 661:
+	case 726:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3290
+		//line sql.y:3680
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 662:
+	case 727:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3294
+		//line sql.y:3684
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 663:
+	case 728:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3300
+		//line sql.y:3690
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 664:
+	case 729:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3304
+		//line sql.y:3694
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 665:
+	case 730:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3310
+		//line sql.y:3700
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 666:
+	case 731:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3313
+		//line sql.y:3703
 		{
 		}
-	case 667:
+	case 732:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3323
+		//line sql.y:3713
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 668:
+	case 733:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3327
+		//line sql.y:3717
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 669:
+	case 734:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3334
+		//line sql.y:3724
 		{
 			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
-	case 670:
+	case 735:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3338
+		//line sql.y:3728
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 671:
+	case 736:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3342
+		//line sql.y:3732
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 672:
+	case 737:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3346
+		//line sql.y:3736
 		{
 			sqlVAL.expr = DBytes(sqlDollar[1].str)
 		}
-	case 673:
+	case 738:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3349
+		//line sql.y:3739
 		{
 			unimplemented()
 		}
-	case 674:
+	case 739:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3351
+		//line sql.y:3741
 		{
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 675:
+	case 740:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3355
+		//line sql.y:3745
 		{
 			// TODO(pmattis): support opt_interval?
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 676:
+	case 741:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3360
+		//line sql.y:3750
 		{
 			// TODO(pmattis): Support the precision specification?
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[5].str), Type: sqlDollar[1].colType}
 		}
-	case 677:
+	case 742:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3365
+		//line sql.y:3755
 		{
 			sqlVAL.expr = DBool(true)
 		}
-	case 678:
+	case 743:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3369
+		//line sql.y:3759
 		{
 			sqlVAL.expr = DBool(false)
 		}
-	case 679:
+	case 744:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3373
+		//line sql.y:3763
 		{
 			sqlVAL.expr = DNull
 		}
-	case 681:
+	case 746:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3380
+		//line sql.y:3770
 		{
 			sqlVAL.ival = +sqlDollar[2].ival
 		}
-	case 682:
+	case 747:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3384
+		//line sql.y:3774
 		{
 			sqlVAL.ival = -sqlDollar[2].ival
 		}
-	case 687:
+	case 752:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3406
+		//line sql.y:3796
 		{
 			sqlVAL.str = ""
 		}
@@ -73,7 +73,12 @@ func unimplemented() {
   dir            Direction
   alterTableCmd  AlterTableCmd
   alterTableCmds AlterTableCmds
+  auditMode      AuditMode
   isoLevel       IsolationLevel
+  lock           LockingStrength
+  interleave     *InterleaveDef
+  idxElem        IndexElem
+  idxElems       IndexElemList
 }
 
 %type <stmts> stmt_block
@@ -81,10 +86,13 @@ func unimplemented() {
 %type <stmt> stmt
 
 %type <stmt> alter_table_stmt
+%type <stmt> alter_user_stmt
 %type <stmt> create_stmt
 %type <stmt> create_database_stmt
 %type <stmt> create_index_stmt
+%type <stmt> create_role_stmt
 %type <stmt> create_table_stmt
+%type <stmt> create_user_stmt
 %type <stmt> delete_stmt
 %type <stmt> drop_stmt
 %type <stmt> explain_stmt
@@ -93,6 +101,7 @@ func unimplemented() {
 %type <stmt> insert_stmt
 %type <stmt> preparable_stmt
 %type <stmt> rename_stmt
+%type <stmt> reset_stmt
 %type <stmt> revoke_stmt
 %type <selectStmt> select_stmt
 %type <stmt> set_stmt
@@ -108,6 +117,7 @@ func unimplemented() {
 %type <dir> opt_asc_desc
 
 %type <alterTableCmd> alter_table_cmd
+%type <auditMode> audit_mode
 %type <alterTableCmds> alter_table_cmds
 
 %type <empty> opt_collate_clause
@@ -135,10 +145,12 @@ func unimplemented() {
 %type <boolVal> distinct_clause
 %type <strs> opt_column_list
 %type <orderBy> sort_clause opt_sort_clause
+%type <lock> for_locking_clause
 %type <orders> sortby_list
-%type <strs> index_params
+%type <idxElems> index_params
 %type <strs> name_list opt_name_list
-%type <empty> opt_array_bounds
+%type <boolVal> opt_array_bounds
+%type <boolVal> opt_timezone
 %type <tblExprs> from_clause from_list
 %type <qnames> qualified_name_list
 %type <qname> any_name
@@ -181,6 +193,7 @@ func unimplemented() {
 %type <stmt>  generic_set set_rest set_rest_more
 
 %type <strs> opt_storing
+%type <interleave> opt_interleave
 %type <colDef> column_def
 %type <tblDef> table_elem
 %type <expr>  where_clause
@@ -200,7 +213,7 @@ func unimplemented() {
 %type <expr> numeric_only
 %type <str> alias_clause opt_alias_clause
 %type <order> sortby
-%type <str> index_elem
+%type <idxElem> index_elem
 %type <tblExpr> table_ref
 %type <tblExpr> joined_table
 %type <qname> relation_expr
@@ -231,12 +244,14 @@ func unimplemented() {
 %type <expr>  non_reserved_word_or_sconst
 %type <expr>  var_value
 %type <expr>  zone_value
+%type <expr>  opt_password password
 
 %type <str>   unreserved_keyword type_func_name_keyword
 %type <str>   col_name_keyword reserved_keyword
 
 %type <constraintDef> table_constraint constraint_elem
 %type <tblDef> index_def
+%type <tblDef> family_def
 %type <colQuals> col_qual_list
 %type <colQual> col_qualification col_qualification_elem
 %type <empty> key_actions key_delete key_match key_update key_action
@@ -256,7 +271,8 @@ func unimplemented() {
 
 %type <targetList>    privilege_target
 %type <targetListPtr> on_privilege_target_clause
-%type <strs>          grantee_list for_grantee_clause
+%type <strs>          grantee_list for_grantee_clause role_list
+%type <str>           role_name
 %type <privilegeList> privileges privilege_list
 %type <privilegeType> privilege
 
@@ -271,6 +287,8 @@ func unimplemented() {
 %token <ival>  ICONST
 %token <str>   PARAM
 %token <str>   TYPECAST DOT_DOT
+%token <str>   FETCHVAL FETCHTEXT CONTAINS
+%token <str>   INET_CONTAINED_BY_OR_EQUALS INET_CONTAINS_OR_EQUALS AND_AND
 %token <str>   LESS_EQUALS GREATER_EQUALS NOT_EQUALS
 %token <str>   ERROR
 
@@ -301,9 +319,9 @@ func unimplemented() {
 %token <str>   DISTINCT DO DOUBLE DROP
 
 %token <str>   ELSE END ESCAPE EXCEPT
-%token <str>   EXISTS EXPLAIN EXTRACT
+%token <str>   EXISTS EXPERIMENTAL_AUDIT EXPLAIN EXTRACT
 
-%token <str>   FALSE FETCH FILTER FIRST FLOAT FOLLOWING FOR
+%token <str>   FALSE FAMILY FETCH FILTER FIRST FLOAT FOLLOWING FOR
 %token <str>   FOREIGN FROM FULL
 
 %token <str>   GRANT GRANTS GREATEST GROUP GROUPING
@@ -312,10 +330,10 @@ func unimplemented() {
 
 %token <str>   IF IFNULL IN
 %token <str>   INDEX INITIALLY
-%token <str>   INNER INSERT INT INT64 INTEGER
-%token <str>   INTERSECT INTERVAL INTO IS ISOLATION
+%token <str>   INET INNER INSERT INT INT64 INTEGER
+%token <str>   INTERLEAVE INTERSECT INTERVAL INTO IS ISOLATION
 
-%token <str>   JOIN
+%token <str>   JOIN JSON JSONB
 
 %token <str>   KEY
 
@@ -332,30 +350,30 @@ func unimplemented() {
 %token <str>   OF OFF OFFSET ON ONLY OR
 %token <str>   ORDER ORDINALITY OUT OUTER OVER OVERLAPS OVERLAY
 
-%token <str>   PARTIAL PARTITION PLACING POSITION
+%token <str>   PARENT PARTIAL PARTITION PASSWORD PLACING POSITION
 %token <str>   PRECEDING PRECISION PRIMARY
 
 %token <str>   RANGE READ REAL RECURSIVE REF REFERENCES
-%token <str>   RENAME REPEATABLE
-%token <str>   RESTRICT RETURNING REVOKE RIGHT ROLLBACK ROLLUP
+%token <str>   RELEASE RENAME REPEATABLE RESET
+%token <str>   RESTRICT RETURNING REVOKE RIGHT ROLE ROLLBACK ROLLUP
 %token <str>   ROW ROWS RSHIFT
 
-%token <str>   SEARCH SECOND SELECT
-%token <str>   SERIALIZABLE SESSION SESSION_USER SET SHOW
+%token <str>   SAVEPOINT SEARCH SECOND SELECT
+%token <str>   SERIALIZABLE SESSION SESSION_USER SET SHARE SHOW
 %token <str>   SIMILAR SIMPLE SMALLINT SNAPSHOT SOME SQL
 %token <str>   STRICT STRING STORING SUBSTRING
 %token <str>   SYMMETRIC
 
 %token <str>   TABLE TABLES TEXT THEN
-%token <str>   TIME TIMESTAMP TO TRAILING TRANSACTION TREAT TRIM TRUE
+%token <str>   TIME TIMESTAMP TIMESTAMPTZ TO TRAILING TRANSACTION TREAT TRIM TRUE
 %token <str>   TRUNCATE TYPE
 
 %token <str>   UNBOUNDED UNCOMMITTED UNION UNIQUE UNKNOWN
-%token <str>   UPDATE USER USING
+%token <str>   UPDATE USER USERS USING UUID
 
 %token <str>   VALID VALIDATE VALUE VALUES VARCHAR VARIADIC VARYING
 
-%token <str>   WHEN WHERE WINDOW WITH WITHIN WITHOUT
+%token <str>   WHEN WHERE WINDOW WITH WITHIN WITHOUT WRITE
 
 %token <str>   YEAR
 
@@ -409,7 +427,8 @@ func unimplemented() {
 // funny behavior of UNBOUNDED on the SQL standard, though.
 %nonassoc  UNBOUNDED         // ideally should have same precedence as IDENT
 %nonassoc  IDENT NULL PARTITION RANGE ROWS PRECEDING FOLLOWING CUBE ROLLUP
-%left      CONCAT       // multi-character ops
+%left      CONCAT FETCHVAL FETCHTEXT CONTAINS '?' // multi-character ops
+%left      INET_CONTAINED_BY_OR_EQUALS INET_CONTAINS_OR_EQUALS AND_AND
 %left      '|'
 %left      '^' '#'
 %left      '&'
@@ -458,6 +477,7 @@ stmt_list:
 
 stmt:
   alter_table_stmt
+| alter_user_stmt
 | create_stmt
 | delete_stmt
 | drop_stmt
@@ -465,6 +485,7 @@ stmt:
 | grant_stmt
 | insert_stmt
 | rename_stmt
+| reset_stmt
 | revoke_stmt
 | select_stmt
   {
@@ -490,6 +511,13 @@ alter_table_stmt:
     $$ = &AlterTable{Table: $5, IfExists: true, Cmds: $6}
   }
 
+// ALTER USER name WITH PASSWORD password
+alter_user_stmt:
+  ALTER USER name WITH PASSWORD password
+  {
+    $$ = &AlterUser{Name: Name($3), Password: $6}
+  }
+
 alter_table_cmds:
   alter_table_cmd
   {
@@ -559,6 +587,21 @@ alter_table_cmd:
   {
     $$ = &AlterTableDropConstraint{IfExists: false, Constraint: $3}
   }
+  // ALTER TABLE <name> EXPERIMENTAL_AUDIT SET {READ WRITE|OFF}
+| EXPERIMENTAL_AUDIT SET audit_mode
+  {
+    $$ = &AlterTableSetAudit{Mode: $3}
+  }
+
+audit_mode:
+  READ WRITE
+  {
+    $$ = AuditModeReadWrite
+  }
+| OFF
+  {
+    $$ = AuditModeDisable
+  }
 
 alter_column_default:
   SET DEFAULT a_expr { unimplemented() }
@@ -577,11 +620,13 @@ alter_using:
   USING a_expr { unimplemented() }
 | /* EMPTY */ {}
 
-// CREATE [DATABASE|INDEX|TABLE|TABLE AS]
+// CREATE [DATABASE|INDEX|ROLE|TABLE|TABLE AS|USER]
 create_stmt:
   create_database_stmt
 | create_index_stmt
+| create_role_stmt
 | create_table_stmt
+| create_user_stmt
 
 // DELETE FROM query
 delete_stmt:
@@ -616,6 +661,14 @@ drop_stmt:
   {
     $$ = &DropTable{Names: $5, IfExists: true}
   }
+| DROP ROLE role_list
+  {
+    $$ = &DropRole{Names: NameList($3)}
+  }
+| DROP USER name_list
+  {
+    $$ = &DropUser{Names: NameList($3)}
+  }
 
 any_name_list:
   any_name
@@ -681,18 +734,28 @@ explain_option_name:
   non_reserved_word
 
 // GRANT privileges ON privilege_target TO grantee_list
+// GRANT role_list TO grantee_list
 grant_stmt:
   GRANT privileges ON privilege_target TO grantee_list
   {
     $$ = &Grant{Privileges: $2, Grantees: NameList($6), Targets: $4}
   }
+| GRANT role_list TO grantee_list
+  {
+    $$ = &GrantRole{Roles: NameList($2), Members: NameList($4)}
+  }
 
 // REVOKE privileges ON privilege_target FROM grantee_list
+// REVOKE role_list FROM grantee_list
 revoke_stmt:
   REVOKE privileges ON privilege_target FROM grantee_list
   {
     $$ = &Revoke{Privileges: $2, Grantees: NameList($6), Targets: $4}
   }
+| REVOKE role_list FROM grantee_list
+  {
+    $$ = &RevokeRole{Roles: NameList($2), Members: NameList($4)}
+  }
 
 
 privilege_target:
@@ -772,6 +835,21 @@ grantee_list:
     $$ = append($1, $3)
   }
 
+// Role names are plain identifiers so that a list of roles can be told apart
+// from a list of privileges.
+role_name:
+  IDENT
+
+role_list:
+  role_name
+  {
+    $$ = []string{$1}
+  }
+| role_list ',' role_name
+  {
+    $$ = append($1, $3)
+  }
+
 // SET name TO 'var_value'
 // SET TIME ZONE 'var_value'
 set_stmt:
@@ -788,6 +866,18 @@ set_stmt:
     $$ = $3
   }
 
+// RESET name
+// RESET TIME ZONE
+reset_stmt:
+  RESET var_name
+  {
+    $$ = &Set{Name: $2}
+  }
+| RESET TIME ZONE
+  {
+    $$ = &SetTimeZone{Value: DString("DEFAULT")}
+  }
+
 set_rest:
   TRANSACTION transaction_iso_level
   {
@@ -972,6 +1062,10 @@ show_stmt:
   {
     $$ = &ShowTables{Name: $3}
   }
+| SHOW USERS
+  {
+    $$ = &ShowUsers{}
+  }
 | SHOW TIME ZONE
   {
     $$ = &Show{Name: "TIME ZONE"}
@@ -982,7 +1076,7 @@ show_stmt:
   }
 | SHOW ALL
   {
-    $$ = nil
+    $$ = &Show{Name: "ALL"}
   }
 
 opt_from_var_name_clause:
@@ -1018,13 +1112,23 @@ for_grantee_clause:
 
 // CREATE TABLE relname
 create_table_stmt:
-  CREATE TABLE any_name '(' opt_table_elem_list ')'
+  CREATE TABLE any_name '(' opt_table_elem_list ')' opt_interleave
+  {
+    $$ = &CreateTable{Table: $3, IfNotExists: false, Defs: $5, Interleave: $7}
+  }
+| CREATE TABLE IF NOT EXISTS any_name '(' opt_table_elem_list ')' opt_interleave
+  {
+    $$ = &CreateTable{Table: $6, IfNotExists: true, Defs: $8, Interleave: $10}
+  }
+
+opt_interleave:
+  INTERLEAVE IN PARENT qualified_name '(' name_list ')'
   {
-    $$ = &CreateTable{Table: $3, IfNotExists: false, Defs: $5}
+    $$ = &InterleaveDef{Parent: $4, Fields: NameList($6)}
   }
-| CREATE TABLE IF NOT EXISTS any_name '(' opt_table_elem_list ')'
+| /* EMPTY */
   {
-    $$ = &CreateTable{Table: $6, IfNotExists: true, Defs: $8}
+    $$ = nil
   }
 
 opt_table_elem_list:
@@ -1050,6 +1154,7 @@ table_elem:
     $$ = $1
   }
 | index_def
+| family_def
 | table_constraint
   {
     $$ = $1
@@ -1058,7 +1163,12 @@ table_elem:
 column_def:
   name typename col_qual_list
   {
-    $$ = newColumnTableDef(Name($1), $2, $3)
+    tableDef, err := newColumnTableDef(Name($1), $2, $3)
+    if err != nil {
+      sqllex.Error(err.Error())
+      return 1
+    }
+    $$ = tableDef
   }
 
 col_qual_list:
@@ -1078,7 +1188,10 @@ col_qualification:
     $$ = $3
   }
 | col_qualification_elem
-| COLLATE any_name { unimplemented() }
+| COLLATE name
+  {
+    $$ = ColumnCollation($2)
+  }
 
 // DEFAULT NULL is already the default for Postgres. But define it here and
 // carry it forward into the system to make it explicit.
@@ -1144,6 +1257,15 @@ index_def:
     }
   }
 
+family_def:
+  FAMILY opt_name '(' name_list ')'
+  {
+    $$ = &FamilyTableDef{
+      Name:    Name($2),
+      Columns: NameList($4),
+    }
+  }
+
 // constraint_elem specifies constraint syntax which is not embedded into a
 // column definition. col_qualification_elem specifies the embedded form.
 // - thomas 1997-12-03
@@ -1267,17 +1389,19 @@ truncate_stmt:
 
 // CREATE INDEX
 create_index_stmt:
-  CREATE opt_unique INDEX opt_name ON qualified_name '(' index_params ')' opt_storing
+  CREATE opt_unique INDEX opt_name ON qualified_name '(' index_params ')' opt_storing opt_interleave where_clause
   {
     $$ = &CreateIndex{
-      Name:    Name($4),
-      Table:   $6,
-      Unique:  $2,
-      Columns: $8,
-      Storing: $10,
+      Name:       Name($4),
+      Table:      $6,
+      Unique:     $2,
+      Columns:    $8,
+      Storing:    $10,
+      Interleave: $11,
+      Where:      newWhere(astWhere, $12),
     }
   }
-| CREATE opt_unique INDEX IF NOT EXISTS name ON qualified_name '(' index_params ')' opt_storing
+| CREATE opt_unique INDEX IF NOT EXISTS name ON qualified_name '(' index_params ')' opt_storing opt_interleave where_clause
   {
     $$ = &CreateIndex{
       Name:        Name($7),
@@ -1286,6 +1410,8 @@ create_index_stmt:
       IfNotExists: true,
       Columns:     $11,
       Storing:     $13,
+      Interleave:  $14,
+      Where:       newWhere(astWhere, $15),
     }
   }
 
@@ -1302,7 +1428,7 @@ opt_unique:
 index_params:
   index_elem
   {
-    $$ = []string{$1}
+    $$ = IndexElemList{$1}
   }
 | index_params ',' index_elem
   {
@@ -1316,10 +1442,16 @@ index_elem:
   name opt_collate opt_asc_desc
   {
     // TODO(pmattis): Support opt_asc_desc.
-    $$ = $1
+    $$ = IndexElem{Column: Name($1)}
+  }
+| func_expr_windowless opt_collate opt_asc_desc
+  {
+    $$ = IndexElem{Expr: $1}
+  }
+| '(' a_expr ')' opt_collate opt_asc_desc
+  {
+    $$ = IndexElem{Expr: $2}
   }
-| func_expr_windowless opt_collate opt_asc_desc { unimplemented() }
-| '(' a_expr ')' opt_collate opt_asc_desc { unimplemented() }
 
 opt_collate:
   COLLATE any_name { unimplemented() }
@@ -1406,6 +1538,26 @@ transaction_stmt:
   {
     $$ = &RollbackTransaction{}
   }
+| SAVEPOINT name
+  {
+    $$ = &Savepoint{Name: Name($2)}
+  }
+| RELEASE SAVEPOINT name
+  {
+    $$ = &ReleaseSavepoint{Name: Name($3)}
+  }
+| RELEASE name
+  {
+    $$ = &ReleaseSavepoint{Name: Name($2)}
+  }
+| ROLLBACK opt_transaction TO SAVEPOINT name
+  {
+    $$ = &RollbackToSavepoint{Name: Name($5)}
+  }
+| ROLLBACK opt_transaction TO name
+  {
+    $$ = &RollbackToSavepoint{Name: Name($4)}
+  }
 
 opt_transaction:
   TRANSACTION {}
@@ -1424,6 +1576,42 @@ transaction_iso_level:
     $$ = $3
   }
 
+// CREATE ROLE name
+create_role_stmt:
+  CREATE ROLE role_name
+  {
+    $$ = &CreateRole{Name: Name($3)}
+  }
+
+// CREATE USER name [WITH PASSWORD password]
+create_user_stmt:
+  CREATE USER name opt_password
+  {
+    $$ = &CreateUser{Name: Name($3), Password: $4}
+  }
+
+opt_password:
+  WITH PASSWORD password
+  {
+    $$ = $3
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
+// Passwords can be given as placeholders, which keeps them out of the
+// statement text.
+password:
+  SCONST
+  {
+    $$ = DString($1)
+  }
+| PARAM
+  {
+    $$ = ValArg($1)
+  }
+
 create_database_stmt:
   CREATE DATABASE name
   {
@@ -1594,6 +1782,41 @@ select_no_parens:
       s.Limit = $3
     }
   }
+| select_clause opt_sort_clause for_locking_clause
+  {
+    s, ok := $1.(*Select)
+    if !ok {
+      sqllex.Error("locking clause is not allowed with UNION/INTERSECT/EXCEPT")
+      return 1
+    }
+    $$ = s
+    s.OrderBy = $2
+    s.Lock = $3
+  }
+| select_clause opt_sort_clause for_locking_clause select_limit
+  {
+    s, ok := $1.(*Select)
+    if !ok {
+      sqllex.Error("locking clause is not allowed with UNION/INTERSECT/EXCEPT")
+      return 1
+    }
+    $$ = s
+    s.OrderBy = $2
+    s.Lock = $3
+    s.Limit = $4
+  }
+| select_clause opt_sort_clause select_limit for_locking_clause
+  {
+    s, ok := $1.(*Select)
+    if !ok {
+      sqllex.Error("locking clause is not allowed with UNION/INTERSECT/EXCEPT")
+      return 1
+    }
+    $$ = s
+    s.OrderBy = $2
+    s.Limit = $3
+    s.Lock = $4
+  }
 | with_clause select_clause
   {
     $$ = $2
@@ -1764,6 +1987,17 @@ opt_all_clause:
   ALL {}
 | /* EMPTY */ {}
 
+// NO KEY UPDATE, KEY SHARE, OF table_list and NOWAIT are not supported.
+for_locking_clause:
+  FOR UPDATE
+  {
+    $$ = LockForUpdate
+  }
+| FOR SHARE
+  {
+    $$ = LockForShare
+  }
+
 opt_sort_clause:
   sort_clause
   {
@@ -1950,6 +2184,10 @@ table_ref:
   {
     $$ = &AliasedTableExpr{Expr: &Subquery{Select: $1}, As: Name($2)}
   }
+| func_application opt_alias_clause
+  {
+    $$ = &AliasedTableExpr{Expr: $1.(*FuncExpr), As: Name($2)}
+  }
 | joined_table
 | '(' joined_table ')' alias_clause { unimplemented() }
 
@@ -2123,16 +2361,36 @@ where_clause:
 typename:
   simple_typename opt_array_bounds
   {
-    $$ = $1
+    if $2 {
+      $$ = &ArrayType{ParamType: $1}
+    } else {
+      $$ = $1
+    }
   }
   // SQL standard syntax, currently only one-dimensional
-| simple_typename ARRAY '[' ICONST ']' { unimplemented() }
-| simple_typename ARRAY { unimplemented() }
+| simple_typename ARRAY '[' ICONST ']'
+  {
+    $$ = &ArrayType{ParamType: $1}
+  }
+| simple_typename ARRAY
+  {
+    $$ = &ArrayType{ParamType: $1}
+  }
 
+// Only one-dimensional arrays are supported and the bounds are ignored.
 opt_array_bounds:
-  opt_array_bounds '[' ']' { unimplemented() }
-| opt_array_bounds '[' ICONST ']' { unimplemented() }
-| /* EMPTY */ {}
+  '[' ']'
+  {
+    $$ = true
+  }
+| '[' ICONST ']'
+  {
+    $$ = true
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 simple_typename:
   numeric
@@ -2157,6 +2415,22 @@ simple_typename:
   {
     $$ = &StringType{Name: "STRING"}
   }
+| JSON
+  {
+    $$ = &JSONType{Name: "JSON"}
+  }
+| JSONB
+  {
+    $$ = &JSONType{Name: "JSONB"}
+  }
+| UUID
+  {
+    $$ = &UUIDType{}
+  }
+| INET
+  {
+    $$ = &INetType{}
+  }
 
 // We have a separate const_typename to allow defaulting fixed-length types
 // such as CHAR() and BIT() to an unspecified length. SQL9x requires that these
@@ -2326,9 +2600,39 @@ const_datetime:
   {
     $$ = &DateType{}
   }
-| TIMESTAMP
+| TIMESTAMP opt_timezone
+  {
+    if $2 {
+      $$ = &TimestampTZType{}
+    } else {
+      $$ = &TimestampType{}
+    }
+  }
+| TIMESTAMPTZ
+  {
+    $$ = &TimestampTZType{}
+  }
+| TIME opt_timezone
+  {
+    if $2 {
+      sqllex.Error("TIME WITH TIME ZONE is not supported")
+      return 1
+    }
+    $$ = &TimeType{}
+  }
+
+opt_timezone:
+  WITH_LA TIME ZONE
+  {
+    $$ = true
+  }
+| WITHOUT TIME ZONE
   {
-    $$ = &TimestampType{}
+    $$ = false
+  }
+| /* EMPTY */
+  {
+    $$ = false
   }
 
 const_interval:
@@ -2381,8 +2685,14 @@ a_expr:
   {
     $$ = &CastExpr{Expr: $1, Type: $3}
   }
-| a_expr COLLATE any_name { unimplemented() }
-| a_expr AT TIME ZONE a_expr %prec AT { unimplemented() }
+| a_expr COLLATE name
+  {
+    $$ = &CollateExpr{Expr: $1, Locale: $3}
+  }
+| a_expr AT TIME ZONE a_expr %prec AT
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: "timezone"}, Exprs: Exprs{$5, $1}}
+  }
   // These operators must be called out explicitly in order to make use of
   // bison's automatic operator-precedence handling. All other operator names
   // are handled by the generic productions using "OP", below; and all those
@@ -2454,6 +2764,34 @@ a_expr:
   {
     $$ = &BinaryExpr{Operator: Concat, Left: $1, Right: $3}
   }
+| a_expr FETCHVAL a_expr
+  {
+    $$ = &BinaryExpr{Operator: FetchVal, Left: $1, Right: $3}
+  }
+| a_expr FETCHTEXT a_expr
+  {
+    $$ = &BinaryExpr{Operator: FetchText, Left: $1, Right: $3}
+  }
+| a_expr CONTAINS a_expr
+  {
+    $$ = &ComparisonExpr{Operator: Contains, Left: $1, Right: $3}
+  }
+| a_expr '?' a_expr
+  {
+    $$ = &ComparisonExpr{Operator: HasKey, Left: $1, Right: $3}
+  }
+| a_expr INET_CONTAINED_BY_OR_EQUALS a_expr
+  {
+    $$ = &ComparisonExpr{Operator: ContainedByOrEquals, Left: $1, Right: $3}
+  }
+| a_expr INET_CONTAINS_OR_EQUALS a_expr
+  {
+    $$ = &ComparisonExpr{Operator: ContainsOrEquals, Left: $1, Right: $3}
+  }
+| a_expr AND_AND a_expr
+  {
+    $$ = &ComparisonExpr{Operator: Overlaps, Left: $1, Right: $3}
+  }
 | a_expr LSHIFT a_expr
   {
     $$ = &BinaryExpr{Operator: LShift, Left: $1, Right: $3}
@@ -2579,6 +2917,14 @@ a_expr:
   {
     $$ = &ComparisonExpr{Operator: NotIn, Left: $1, Right: $4}
   }
+| a_expr '=' ANY '(' a_expr ')' %prec CONCAT
+  {
+    $$ = &ComparisonExpr{Operator: Any, Left: $1, Right: $5}
+  }
+| a_expr '=' SOME '(' a_expr ')' %prec CONCAT
+  {
+    $$ = &ComparisonExpr{Operator: Any, Left: $1, Right: $5}
+  }
 // | a_expr subquery_op sub_type select_with_parens %prec CONCAT { unimplemented() }
 // | a_expr subquery_op sub_type '(' a_expr ')' %prec CONCAT { unimplemented() }
 // | UNIQUE select_with_parens { unimplemented() }
@@ -2660,6 +3006,34 @@ b_expr:
   {
     $$ = &BinaryExpr{Operator: Concat, Left: $1, Right: $3}
   }
+| b_expr FETCHVAL b_expr
+  {
+    $$ = &BinaryExpr{Operator: FetchVal, Left: $1, Right: $3}
+  }
+| b_expr FETCHTEXT b_expr
+  {
+    $$ = &BinaryExpr{Operator: FetchText, Left: $1, Right: $3}
+  }
+| b_expr CONTAINS b_expr
+  {
+    $$ = &ComparisonExpr{Operator: Contains, Left: $1, Right: $3}
+  }
+| b_expr '?' b_expr
+  {
+    $$ = &ComparisonExpr{Operator: HasKey, Left: $1, Right: $3}
+  }
+| b_expr INET_CONTAINED_BY_OR_EQUALS b_expr
+  {
+    $$ = &ComparisonExpr{Operator: ContainedByOrEquals, Left: $1, Right: $3}
+  }
+| b_expr INET_CONTAINS_OR_EQUALS b_expr
+  {
+    $$ = &ComparisonExpr{Operator: ContainsOrEquals, Left: $1, Right: $3}
+  }
+| b_expr AND_AND b_expr
+  {
+    $$ = &ComparisonExpr{Operator: Overlaps, Left: $1, Right: $3}
+  }
 | b_expr LSHIFT b_expr
   {
     $$ = &BinaryExpr{Operator: LShift, Left: $1, Right: $3}
@@ -2717,6 +3091,16 @@ c_expr:
   {
     $$ = &ParenExpr{Expr: $2}
   }
+| '(' a_expr ')' indirection
+  {
+    for _, elem := range $4 {
+      if _, ok := elem.(*ArrayIndirection); !ok {
+        sqllex.Error("only array subscripts are supported on expressions")
+        return 1
+      }
+    }
+    $$ = &IndirectionExpr{Expr: $2, Indirection: $4}
+  }
 | case_expr
 | func_expr
 | select_with_parens %prec UMINUS
@@ -2795,8 +3179,14 @@ func_expr:
 // expressions are not allowed, where needed to disambiguate the grammar
 // (e.g. in CREATE INDEX).
 func_expr_windowless:
-  func_application { unimplemented() }
-| func_expr_common_subexpr { unimplemented() }
+  func_application
+  {
+    $$ = $1
+  }
+| func_expr_common_subexpr
+  {
+    $$ = $1
+  }
 
 // Special expressions that are considered to be functions.
 func_expr_common_subexpr:
@@ -3465,14 +3855,19 @@ unreserved_keyword:
 | DELETE
 | DOUBLE
 | DROP
+| EXPERIMENTAL_AUDIT
 | EXPLAIN
 | FILTER
 | FIRST
 | FOLLOWING
 | GRANTS
 | HOUR
+| INET
 | INSERT
+| INTERLEAVE
 | ISOLATION
+| JSON
+| JSONB
 | KEY
 | LEVEL
 | LOCAL
@@ -3489,25 +3884,32 @@ unreserved_keyword:
 | OFF
 | ORDINALITY
 | OVER
+| PARENT
 | PARTIAL
 | PARTITION
+| PASSWORD
 | PRECEDING
 | RANGE
 | READ
 | RECURSIVE
 | REF
+| RELEASE
 | RENAME
 | REPEATABLE
+| RESET
 | RESTRICT
 | REVOKE
+| ROLE
 | ROLLBACK
 | ROLLUP
 | ROWS
+| SAVEPOINT
 | SEARCH
 | SECOND
 | SERIALIZABLE
 | SESSION
 | SET
+| SHARE
 | SHOW
 | SIMPLE
 | SNAPSHOT
@@ -3523,12 +3925,15 @@ unreserved_keyword:
 | UNCOMMITTED
 | UNKNOWN
 | UPDATE
+| USERS
+| UUID
 | VALID
 | VALIDATE
 | VALUE
 | VARYING
 | WITHIN
 | WITHOUT
+| WRITE
 | YEAR
 | ZONE
 
@@ -3579,6 +3984,7 @@ col_name_keyword:
 | SUBSTRING
 | TIME
 | TIMESTAMP
+| TIMESTAMPTZ
 | TREAT
 | TRIM
 | VALUES
@@ -3645,6 +4051,7 @@ reserved_keyword:
 | END
 | EXCEPT
 | FALSE
+| FAMILY
 | FETCH
 | FOR
 | FOREIGN
@@ -61,6 +61,9 @@ type Statement interface {
 // StatementType implements the Statement interface.
 func (*AlterTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*AlterUser) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*BeginTransaction) StatementType() StatementType { return Ack }
 
@@ -73,9 +76,15 @@ func (*CreateDatabase) StatementType() StatementType { return DDL }
 // StatementType implements the Statement interface.
 func (*CreateIndex) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*CreateRole) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*CreateTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*CreateUser) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Delete) StatementType() StatementType { return RowsAffected }
 
@@ -85,21 +94,33 @@ func (*DropDatabase) StatementType() StatementType { return DDL }
 // StatementType implements the Statement interface.
 func (*DropIndex) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*DropRole) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*DropTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*DropUser) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Explain) StatementType() StatementType { return Rows }
 
 // StatementType implements the Statement interface.
 func (*Grant) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*GrantRole) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Insert) StatementType() StatementType { return RowsAffected }
 
 // StatementType implements the Statement interface.
 func (*ParenSelect) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ReleaseSavepoint) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*RenameColumn) StatementType() StatementType { return DDL }
 
@@ -115,9 +136,18 @@ func (*RenameTable) StatementType() StatementType { return DDL }
 // StatementType implements the Statement interface.
 func (*Revoke) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*RevokeRole) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*RollbackTransaction) StatementType() StatementType { return Ack }
 
+// StatementType implements the Statement interface.
+func (*RollbackToSavepoint) StatementType() StatementType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*Savepoint) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*Select) StatementType() StatementType { return Rows }
 
@@ -148,6 +178,9 @@ func (*ShowIndex) StatementType() StatementType { return Rows }
 // StatementType implements the Statement interface.
 func (*ShowTables) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ShowUsers) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (*Truncate) StatementType() StatementType { return Ack }
 
@@ -72,3 +72,30 @@ type RollbackTransaction struct{}
 func (node *RollbackTransaction) String() string {
 	return "ROLLBACK TRANSACTION"
 }
+
+// Savepoint represents a SAVEPOINT statement.
+type Savepoint struct {
+	Name Name
+}
+
+func (node *Savepoint) String() string {
+	return fmt.Sprintf("SAVEPOINT %s", node.Name)
+}
+
+// ReleaseSavepoint represents a RELEASE SAVEPOINT statement.
+type ReleaseSavepoint struct {
+	Name Name
+}
+
+func (node *ReleaseSavepoint) String() string {
+	return fmt.Sprintf("RELEASE SAVEPOINT %s", node.Name)
+}
+
+// RollbackToSavepoint represents a ROLLBACK TO SAVEPOINT statement.
+type RollbackToSavepoint struct {
+	Name Name
+}
+
+func (node *RollbackToSavepoint) String() string {
+	return fmt.Sprintf("ROLLBACK TRANSACTION TO SAVEPOINT %s", node.Name)
+}
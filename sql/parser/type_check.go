@@ -117,9 +117,30 @@ func TypeCheckExpr(expr Expr) (Datum, error) {
 		}
 		return tuple, nil
 
+	case Array:
+		return typeCheckArray(t)
+
+	case *IndirectionExpr:
+		return typeCheckIndirectionExpr(t)
+
 	case DReference:
 		return t.Datum(), nil
 
+	case *DArray:
+		return DummyArrayOf(t.ParamType), nil
+
+	case *DJSON:
+		return DummyJSON, nil
+
+	case *DCollatedString:
+		return DummyCollatedString(t.Locale), nil
+
+	case DUUID:
+		return DummyUUID, nil
+
+	case DInet:
+		return DummyInet, nil
+
 	case Datum:
 		return t, nil
 
@@ -143,6 +164,9 @@ func TypeCheckExpr(expr Expr) (Datum, error) {
 	case *CastExpr:
 		return typeCheckCastExpr(t)
 
+	case *CollateExpr:
+		return typeCheckCollateExpr(t)
+
 	default:
 		return nil, util.Errorf("typeCheck: unsupported expression: %T", expr)
 	}
@@ -207,6 +231,15 @@ func typeCheckComparisonOp(op ComparisonOp, dummyLeft, dummyRight Datum) (Datum,
 			if err := typeCheckTupleIN(dummyLeft, dummyRight); err != nil {
 				return nil, cmpOp{}, err
 			}
+		} else if op == Any {
+			if err := typeCheckArrayAny(dummyLeft, dummyRight); err != nil {
+				return nil, cmpOp{}, err
+			}
+		} else if lType == collatedStringType &&
+			dummyLeft.(*DCollatedString).Locale != dummyRight.(*DCollatedString).Locale {
+			// Strings can only be compared in the same collation.
+			return nil, cmpOp{}, fmt.Errorf("unsupported comparison operator: <%s> %s <%s>",
+				dummyLeft.Type(), op, dummyRight.Type())
 		}
 
 		return cmpOpResultType, cmp, nil
@@ -453,6 +486,101 @@ func typeCheckTupleIN(arg, values Datum) error {
 	return nil
 }
 
+func typeCheckArrayAny(arg, values Datum) error {
+	paramType := values.(*DArray).ParamType
+	if paramType == DNull {
+		return nil
+	}
+	_, _, err := typeCheckComparisonOp(EQ, arg, paramType)
+	return err
+}
+
+func typeCheckArray(expr Array) (Datum, error) {
+	paramType := Datum(DNull)
+	for _, e := range expr {
+		dummyElem, err := TypeCheckExpr(e)
+		if err != nil {
+			return nil, err
+		}
+		if dummyElem == DNull {
+			continue
+		}
+		dummyParam, ok := arrayParamTypes[reflect.TypeOf(dummyElem)]
+		if !ok {
+			return nil, fmt.Errorf("arrays of %s are not supported", dummyElem.Type())
+		}
+		if paramType == DNull {
+			paramType = dummyParam
+		} else if paramType != dummyParam {
+			return nil, fmt.Errorf("incompatible ARRAY element types %s, %s", paramType.Type(), dummyElem.Type())
+		}
+	}
+	return DummyArrayOf(paramType), nil
+}
+
+// arraySubscript returns the subscript of an indirection expression, which
+// must be a single array subscript or slice.
+func arraySubscript(expr *IndirectionExpr) (*ArrayIndirection, error) {
+	if len(expr.Indirection) == 1 {
+		if subscript, ok := expr.Indirection[0].(*ArrayIndirection); ok {
+			return subscript, nil
+		}
+	}
+	return nil, fmt.Errorf("only one-dimensional arrays are supported: %s", expr)
+}
+
+func typeCheckIndirectionExpr(expr *IndirectionExpr) (Datum, error) {
+	subscript, err := arraySubscript(expr)
+	if err != nil {
+		return nil, err
+	}
+	dummyExpr, err := TypeCheckExpr(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range []Expr{subscript.Begin, subscript.End} {
+		if e == nil {
+			continue
+		}
+		dummySubscript, err := TypeCheckExpr(e)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := dummySubscript.(DInt); !ok && dummySubscript != DNull {
+			return nil, fmt.Errorf("array subscript must be type int: %s", dummySubscript.Type())
+		}
+	}
+	if dummyExpr == DNull {
+		return DNull, nil
+	}
+	array, ok := dummyExpr.(*DArray)
+	if !ok {
+		return nil, fmt.Errorf("cannot subscript type %s because it is not an array", dummyExpr.Type())
+	}
+	if subscript.End == nil {
+		return array.ParamType, nil
+	}
+	return DummyArrayOf(array.ParamType), nil
+}
+
+func typeCheckCollateExpr(expr *CollateExpr) (Datum, error) {
+	dummyExpr, err := TypeCheckExpr(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	if dummyExpr == DNull {
+		return DNull, nil
+	}
+	if _, ok := dummyExpr.(*DCollatedString); !ok && dummyExpr != DummyString {
+		return nil, fmt.Errorf("incompatible COLLATE argument type %s", dummyExpr.Type())
+	}
+	locale, err := CanonicalLocale(expr.Locale)
+	if err != nil {
+		return nil, err
+	}
+	return DummyCollatedString(locale), nil
+}
+
 func typeCheckCastExpr(expr *CastExpr) (Datum, error) {
 	dummyExpr, err := TypeCheckExpr(expr.Expr)
 	if err != nil {
@@ -479,35 +607,69 @@ func typeCheckCastExpr(expr *CastExpr) (Datum, error) {
 		}
 
 	case *StringType:
+		if _, ok := dummyExpr.(*DCollatedString); ok {
+			return DummyString, nil
+		}
 		switch dummyExpr {
-		case DummyBool, DummyInt, DummyFloat, DNull, DummyString, DummyBytes:
+		case DummyBool, DummyInt, DummyFloat, DNull, DummyString, DummyBytes, DummyJSON,
+			DummyTimestampTZ, DummyTime, DummyUUID, DummyInet:
 			return DummyString, nil
 		}
 
 	case *BytesType:
 		switch dummyExpr {
-		case DummyBytes, DummyString:
+		case DummyBytes, DummyString, DummyUUID:
 			return DummyBytes, nil
 		}
 
 	case *DateType:
 		switch dummyExpr {
-		case DummyString, DummyTimestamp:
+		case DummyString, DummyTimestamp, DummyTimestampTZ:
 			return DummyDate, nil
 		}
 
 	case *TimestampType:
 		switch dummyExpr {
-		case DummyString, DummyDate:
+		case DummyString, DummyDate, DummyTimestampTZ:
 			return DummyTimestamp, nil
 		}
 
+	case *TimestampTZType:
+		switch dummyExpr {
+		case DummyString, DummyDate, DummyTimestamp, DummyTimestampTZ:
+			return DummyTimestampTZ, nil
+		}
+
+	case *TimeType:
+		switch dummyExpr {
+		case DummyString, DummyTimestamp, DummyTimestampTZ, DummyTime:
+			return DummyTime, nil
+		}
+
 	case *IntervalType:
 		switch dummyExpr {
-		case DummyString, DummyInt:
+		case DummyString, DummyInt, DummyTime:
 			return DummyInterval, nil
 		}
 
+	case *JSONType:
+		switch dummyExpr {
+		case DummyString, DummyJSON:
+			return DummyJSON, nil
+		}
+
+	case *UUIDType:
+		switch dummyExpr {
+		case DummyString, DummyBytes, DummyUUID:
+			return DummyUUID, nil
+		}
+
+	case *INetType:
+		switch dummyExpr {
+		case DummyString, DummyInet:
+			return DummyInet, nil
+		}
+
 	// TODO(pmattis): unimplemented.
 	case *DecimalType:
 	}
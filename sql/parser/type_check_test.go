@@ -49,6 +49,13 @@ func TestTypeCheckExpr(t *testing.T) {
 		`true IS NOT TRUE`,
 		`true IS FALSE`,
 		`true IS NOT FALSE`,
+		`ARRAY[1, NULL, 3]`,
+		`(ARRAY['a'])[1] = 'a'`,
+		`1 = ANY (ARRAY[1, 2])`,
+		`array_length(ARRAY[], 1)`,
+		`'{}'::jsonb -> 'a' = '1'::jsonb`,
+		`'{}'::jsonb ->> 0 = 'a'`,
+		`'{}'::jsonb @> '{}'::jsonb AND '{}'::jsonb ? 'a'`,
 	}
 	for _, d := range testData {
 		q, err := ParseTraditional("SELECT " + d)
@@ -84,6 +91,17 @@ func TestTypeCheckExprError(t *testing.T) {
 		{`1::decimal`, `invalid cast: int -> DECIMAL`},
 		{`1::date`, `invalid cast: int -> DATE`},
 		{`1::timestamp`, `invalid cast: int -> TIMESTAMP`},
+		{`1::timestamptz`, `invalid cast: int -> TIMESTAMP WITH TIME ZONE`},
+		{`1 COLLATE en`, `incompatible COLLATE argument type int`},
+		{`1::uuid`, `invalid cast: int -> UUID`},
+		{`b'a'::inet`, `invalid cast: bytes -> INET`},
+		{`'10.0.0.1'::inet::bytes`, `invalid cast: inet -> BYTES`},
+		{`'10.0.0.1'::inet << 1`, `unsupported binary operator: <inet> << <int>`},
+		{`'10.0.0.1'::inet && '10.0.0.1'`, `unsupported comparison operator: <inet> && <string>`},
+		{`'a' COLLATE xx`, `invalid locale "xx"`},
+		{`'a' COLLATE en = 'a'`, `unsupported comparison operator: <collatedstring{en}> = <string>`},
+		{`'a' COLLATE en = 'a' COLLATE de`, `unsupported comparison operator: <collatedstring{en}> = <collatedstring{de}>`},
+		{`'1h'::interval::time`, `invalid cast: interval -> TIME`},
 		{`CASE 'one' WHEN 1 THEN 1 WHEN 'two' THEN 2 END`, `incompatible condition type`},
 		{`CASE 1 WHEN 1 THEN 'one' WHEN 2 THEN 2 END`, `incompatible value type`},
 		{`CASE 1 WHEN 1 THEN 'one' ELSE 2 END`, `incompatible value type`},
@@ -97,6 +115,15 @@ func TestTypeCheckExprError(t *testing.T) {
 		{`NULLIF(1, 2.0)`, `incompatible NULLIF expressions int, float`},
 		{`COALESCE(1, 2.0)`, `incompatible COALESCE expressions int, float`},
 		{`COALESCE(1, 2, 3, 4, '5')`, `incompatible COALESCE expressions int, string`},
+		{`ARRAY[1, 'a']`, `incompatible ARRAY element types int, string`},
+		{`ARRAY[ARRAY[1]]`, `arrays of int[] are not supported`},
+		{`(ARRAY[1])[1] = 'a'`, `unsupported comparison operator: <int> = <string>`},
+		{`(ARRAY[1])[1][2]`, `only one-dimensional arrays are supported`},
+		{`'a' = ANY (ARRAY[1, 2])`, `unsupported comparison operator: <string> = <int>`},
+		{`1 = ANY (1)`, `unsupported comparison operator: <int> = ANY <int>`},
+		{`'{}'::jsonb -> 1.5`, `unsupported binary operator: <jsonb> -> <float>`},
+		{`'{}'::jsonb @> '{}'`, `unsupported comparison operator: <jsonb> @> <string>`},
+		{`1::jsonb`, `invalid cast: int -> JSONB`},
 	}
 	for _, d := range testData {
 		q, err := ParseTraditional("SELECT " + d.expr)
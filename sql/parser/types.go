@@ -28,15 +28,22 @@ type ColumnType interface {
 	columnType()
 }
 
-func (*BoolType) columnType()      {}
-func (*IntType) columnType()       {}
-func (*FloatType) columnType()     {}
-func (*DecimalType) columnType()   {}
-func (*DateType) columnType()      {}
-func (*TimestampType) columnType() {}
-func (*IntervalType) columnType()  {}
-func (*StringType) columnType()    {}
-func (*BytesType) columnType()     {}
+func (*BoolType) columnType()           {}
+func (*IntType) columnType()            {}
+func (*FloatType) columnType()          {}
+func (*DecimalType) columnType()        {}
+func (*DateType) columnType()           {}
+func (*TimestampType) columnType()      {}
+func (*TimestampTZType) columnType()    {}
+func (*TimeType) columnType()           {}
+func (*IntervalType) columnType()       {}
+func (*StringType) columnType()         {}
+func (*CollatedStringType) columnType() {}
+func (*BytesType) columnType()          {}
+func (*ArrayType) columnType()          {}
+func (*JSONType) columnType()           {}
+func (*UUIDType) columnType()           {}
+func (*INetType) columnType()           {}
 
 // BoolType represents a BOOLEAN type.
 type BoolType struct {
@@ -113,6 +120,22 @@ func (node *TimestampType) String() string {
 	return "TIMESTAMP"
 }
 
+// TimestampTZType represents a TIMESTAMP WITH TIME ZONE or TIMESTAMPTZ type.
+type TimestampTZType struct {
+}
+
+func (node *TimestampTZType) String() string {
+	return "TIMESTAMP WITH TIME ZONE"
+}
+
+// TimeType represents a TIME (without time zone) type.
+type TimeType struct {
+}
+
+func (node *TimeType) String() string {
+	return "TIME"
+}
+
 // IntervalType represents a INTERVAL type
 type IntervalType struct {
 }
@@ -136,6 +159,24 @@ func (node *StringType) String() string {
 	return buf.String()
 }
 
+// CollatedStringType represents a STRING, CHAR or VARCHAR type with a
+// collation.
+type CollatedStringType struct {
+	Name   string
+	N      int
+	Locale string
+}
+
+func (node *CollatedStringType) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(node.Name)
+	if node.N > 0 {
+		fmt.Fprintf(&buf, "(%d)", node.N)
+	}
+	fmt.Fprintf(&buf, " COLLATE %s", Name(node.Locale))
+	return buf.String()
+}
+
 // BytesType represents a BYTES or BLOB type.
 type BytesType struct {
 	Name string
@@ -144,3 +185,39 @@ type BytesType struct {
 func (node *BytesType) String() string {
 	return node.Name
 }
+
+// ArrayType represents an ARRAY type. Only one-dimensional arrays are
+// supported.
+type ArrayType struct {
+	ParamType ColumnType
+}
+
+func (node *ArrayType) String() string {
+	return fmt.Sprintf("%s[]", node.ParamType)
+}
+
+// JSONType represents a JSON or JSONB type. Both are stored in the parsed,
+// canonical JSONB representation.
+type JSONType struct {
+	Name string
+}
+
+func (node *JSONType) String() string {
+	return node.Name
+}
+
+// UUIDType represents a UUID type.
+type UUIDType struct {
+}
+
+func (node *UUIDType) String() string {
+	return "UUID"
+}
+
+// INetType represents an INET type.
+type INetType struct {
+}
+
+func (node *INetType) String() string {
+	return "INET"
+}
@@ -48,14 +48,22 @@ func TestParseColumnType(t *testing.T) {
 		{"NUMERIC(9,10)", &DecimalType{Name: "NUMERIC", Prec: 9, Scale: 10}},
 		{"DATE", &DateType{}},
 		{"TIMESTAMP", &TimestampType{}},
+		{"TIMESTAMP WITH TIME ZONE", &TimestampTZType{}},
+		{"TIME", &TimeType{}},
 		{"INTERVAL", &IntervalType{}},
 		{"STRING", &StringType{Name: "STRING"}},
 		{"CHAR", &StringType{Name: "CHAR"}},
 		{"VARCHAR", &StringType{Name: "VARCHAR"}},
 		{"CHAR(11)", &StringType{Name: "CHAR", N: 11}},
 		{"TEXT", &StringType{Name: "TEXT"}},
+		{"STRING COLLATE de", &CollatedStringType{Name: "STRING", Locale: "de"}},
+		{`CHAR(3) COLLATE "de-DE"`, &CollatedStringType{Name: "CHAR", N: 3, Locale: "de-DE"}},
 		{"BLOB", &BytesType{Name: "BLOB"}},
 		{"BYTES", &BytesType{Name: "BYTES"}},
+		{"JSON", &JSONType{Name: "JSON"}},
+		{"JSONB", &JSONType{Name: "JSONB"}},
+		{"UUID", &UUIDType{}},
+		{"INET", &INetType{}},
 	}
 	for i, d := range testData {
 		sql := fmt.Sprintf("CREATE TABLE a (b %s)", d.str)
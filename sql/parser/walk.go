@@ -149,6 +149,20 @@ func WalkExpr(v Visitor, expr Expr) Expr {
 	case *CastExpr:
 		t.Expr = WalkExpr(v, t.Expr)
 
+	case *CollateExpr:
+		t.Expr = WalkExpr(v, t.Expr)
+
+	case *IndirectionExpr:
+		t.Expr = WalkExpr(v, t.Expr)
+		for _, elem := range t.Indirection {
+			if a, ok := elem.(*ArrayIndirection); ok {
+				a.Begin = WalkExpr(v, a.Begin)
+				if a.End != nil {
+					a.End = WalkExpr(v, a.End)
+				}
+			}
+		}
+
 	default:
 		panic(fmt.Sprintf("walk: unsupported expression type: %T", expr))
 	}
@@ -199,6 +213,12 @@ func FillArgs(stmt Statement, args Args) error {
 // by WalkExpr.
 func WalkStmt(v Visitor, stmt Statement) {
 	switch stmt := stmt.(type) {
+	case *AlterUser:
+		stmt.Password = WalkExpr(v, stmt.Password)
+	case *CreateUser:
+		if stmt.Password != nil {
+			stmt.Password = WalkExpr(v, stmt.Password)
+		}
 	case *Delete:
 		if stmt.Where != nil {
 			stmt.Where.Expr = WalkExpr(v, stmt.Where.Expr)
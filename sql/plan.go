@@ -34,6 +34,26 @@ type planner struct {
 	user         string
 	evalCtx      parser.EvalContext
 	systemConfig *config.SystemConfig
+	// savepoints holds the savepoints established in txn, in the order they
+	// were created.
+	savepoints []Session_Savepoint
+	// txnFailed is set when a statement fails in a transaction containing
+	// savepoints. Only a rollback, either to a savepoint or of the entire
+	// transaction, is then permitted.
+	txnFailed bool
+	// auditAccesses holds the accesses of the current statement to audited
+	// tables.
+	auditAccesses []auditAccess
+	// deadline is the time by which the current statement must complete. It
+	// is zero if the statement has no timeout.
+	deadline time.Time
+	// locks holds the row locks taken by the current statement, which are
+	// written in a single batch once all of its rows have been retrieved.
+	locks *client.Batch
+	// roleMemberships holds the role memberships read by the current
+	// statement, so that system.role_members is scanned at most once per
+	// statement (see getRoleMemberships).
+	roleMemberships RoleMemberships
 }
 
 func (p *planner) setTxn(txn *client.Txn, timestamp time.Time) {
@@ -43,6 +63,27 @@ func (p *planner) setTxn(txn *client.Txn, timestamp time.Time) {
 
 func (p *planner) resetTxn() {
 	p.setTxn(nil, time.Time{})
+	p.savepoints = nil
+	p.txnFailed = false
+}
+
+// checkDeadline returns an error if the deadline of the current statement
+// has passed.
+func (p *planner) checkDeadline() error {
+	if !p.deadline.IsZero() && time.Now().After(p.deadline) {
+		return errStatementTimeout
+	}
+	return nil
+}
+
+// flushLocks writes the row locks taken by the current statement.
+func (p *planner) flushLocks() error {
+	if p.locks == nil {
+		return nil
+	}
+	b := p.locks
+	p.locks = nil
+	return p.txn.Run(b)
 }
 
 // makePlan creates the query plan for a single SQL statement. The returned
@@ -61,6 +102,8 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 	switch n := stmt.(type) {
 	case *parser.AlterTable:
 		return p.AlterTable(n)
+	case *parser.AlterUser:
+		return p.AlterUser(n)
 	case *parser.BeginTransaction:
 		return p.BeginTransaction(n)
 	case *parser.CommitTransaction:
@@ -69,20 +112,30 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.CreateDatabase(n)
 	case *parser.CreateIndex:
 		return p.CreateIndex(n)
+	case *parser.CreateRole:
+		return p.CreateRole(n)
 	case *parser.CreateTable:
 		return p.CreateTable(n)
+	case *parser.CreateUser:
+		return p.CreateUser(n)
 	case *parser.Delete:
 		return p.Delete(n)
 	case *parser.DropDatabase:
 		return p.DropDatabase(n)
 	case *parser.DropIndex:
 		return p.DropIndex(n)
+	case *parser.DropRole:
+		return p.DropRole(n)
 	case *parser.DropTable:
 		return p.DropTable(n)
+	case *parser.DropUser:
+		return p.DropUser(n)
 	case *parser.Explain:
 		return p.Explain(n)
 	case *parser.Grant:
 		return p.Grant(n)
+	case *parser.GrantRole:
+		return p.GrantRole(n)
 	case *parser.Insert:
 		return p.Insert(n)
 	case *parser.ParenSelect:
@@ -97,8 +150,16 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.RenameTable(n)
 	case *parser.Revoke:
 		return p.Revoke(n)
+	case *parser.RevokeRole:
+		return p.RevokeRole(n)
+	case *parser.ReleaseSavepoint:
+		return p.ReleaseSavepoint(n)
+	case *parser.RollbackToSavepoint:
+		return p.RollbackToSavepoint(n)
 	case *parser.RollbackTransaction:
 		return p.RollbackTransaction(n)
+	case *parser.Savepoint:
+		return p.Savepoint(n)
 	case *parser.Select:
 		return p.Select(n)
 	case *parser.Set:
@@ -119,6 +180,8 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.ShowIndex(n)
 	case *parser.ShowTables:
 		return p.ShowTables(n)
+	case *parser.ShowUsers:
+		return p.ShowUsers(n)
 	case *parser.Truncate:
 		return p.Truncate(n)
 	case *parser.Update:
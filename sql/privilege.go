@@ -239,15 +239,87 @@ func (p *PrivilegeDescriptor) Show() ([]UserPrivilegeString, error) {
 	return ret, nil
 }
 
-// CheckPrivilege returns true if 'user' has 'privilege' on this descriptor.
-func (p *PrivilegeDescriptor) CheckPrivilege(user string, priv privilege.Kind) bool {
-	userPriv, ok := p.findUser(user)
-	if !ok {
-		return false
+// RoleMemberships maps each user or role to the roles it is a direct member
+// of. A member inherits the privileges of its roles, and transitively those
+// of their own roles.
+type RoleMemberships map[string][]string
+
+// CheckPrivilege returns true if 'user' has 'privilege' on this descriptor,
+// either directly or inherited through the roles it is a member of.
+func (p *PrivilegeDescriptor) CheckPrivilege(user string, memberships RoleMemberships,
+	priv privilege.Kind) bool {
+	// Walk the roles breadth-first. Visited users and roles are skipped, which
+	// guards against cycles in the memberships.
+	visited := map[string]struct{}{user: {}}
+	queue := []string{user}
+	for len(queue) > 0 {
+		grantee := queue[0]
+		queue = queue[1:]
+		if userPriv, ok := p.findUser(grantee); ok {
+			// ALL is always good.
+			if isPrivilegeSet(userPriv.Privileges, privilege.ALL) ||
+				isPrivilegeSet(userPriv.Privileges, priv) {
+				return true
+			}
+		}
+		for _, role := range memberships[grantee] {
+			if _, ok := visited[role]; !ok {
+				visited[role] = struct{}{}
+				queue = append(queue, role)
+			}
+		}
 	}
-	// ALL is always good.
-	if isPrivilegeSet(userPriv.Privileges, privilege.ALL) {
-		return true
+	return false
+}
+
+// InheritedPrivilegeString describes the privileges a user or role inherits
+// from a role.
+type InheritedPrivilegeString struct {
+	User       string
+	Privileges string
+	// Via is the chain of roles the privileges are inherited through. It
+	// starts with a role User is a direct member of and ends with the role
+	// holding the privileges.
+	Via []string
+}
+
+// ShowInherited returns the privileges on this descriptor inherited through
+// the role memberships, sorted by user. Each role holding privileges is
+// listed once per member, through the shortest chain of roles.
+func (p *PrivilegeDescriptor) ShowInherited(memberships RoleMemberships) []InheritedPrivilegeString {
+	members := make([]string, 0, len(memberships))
+	for member := range memberships {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+
+	ret := []InheritedPrivilegeString{}
+	for _, member := range members {
+		visited := map[string]struct{}{member: {}}
+		queue := [][]string{nil}
+		for len(queue) > 0 {
+			via := queue[0]
+			queue = queue[1:]
+			grantee := member
+			if len(via) > 0 {
+				grantee = via[len(via)-1]
+				if userPriv, ok := p.findUser(grantee); ok {
+					ret = append(ret, InheritedPrivilegeString{
+						User:       member,
+						Privileges: privilege.ListFromBitField(userPriv.Privileges).SortedString(),
+						Via:        via,
+					})
+				}
+			}
+			roles := append([]string(nil), memberships[grantee]...)
+			sort.Strings(roles)
+			for _, role := range roles {
+				if _, ok := visited[role]; !ok {
+					visited[role] = struct{}{}
+					queue = append(queue, append(append([]string(nil), via...), role))
+				}
+			}
+		}
 	}
-	return isPrivilegeSet(userPriv.Privileges, priv)
+	return ret
 }
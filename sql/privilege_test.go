@@ -18,6 +18,7 @@
 package sql_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/keys"
@@ -92,6 +93,58 @@ func TestPrivilege(t *testing.T) {
 	}
 }
 
+// TestPrivilegeRoles exercises the privileges inherited through roles.
+func TestPrivilegeRoles(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	descriptor := sql.NewDefaultPrivilegeDescriptor()
+	descriptor.Grant("readers", privilege.List{privilege.SELECT})
+	descriptor.Grant("writers", privilege.List{privilege.INSERT, privilege.UPDATE})
+
+	// "foo" is a writer, and writers are readers. "loop1" and "loop2" are
+	// members of each other.
+	memberships := sql.RoleMemberships{
+		"foo":     {"writers"},
+		"writers": {"readers"},
+		"loop1":   {"loop2"},
+		"loop2":   {"loop1"},
+	}
+
+	testCases := []struct {
+		user     string
+		priv     privilege.Kind
+		expected bool
+	}{
+		{"readers", privilege.SELECT, true},
+		{"readers", privilege.INSERT, false},
+		{"writers", privilege.SELECT, true},
+		{"writers", privilege.INSERT, true},
+		{"foo", privilege.SELECT, true},
+		{"foo", privilege.UPDATE, true},
+		{"foo", privilege.DELETE, false},
+		{"bar", privilege.SELECT, false},
+		{"loop1", privilege.SELECT, false},
+		{security.RootUser, privilege.DELETE, true},
+	}
+	for i, tc := range testCases {
+		if ok := descriptor.CheckPrivilege(tc.user, memberships, tc.priv); ok != tc.expected {
+			t.Errorf("%d: expected %s privilege of %s to be %t, got %t",
+				i, tc.priv, tc.user, tc.expected, ok)
+		}
+	}
+	if descriptor.CheckPrivilege("foo", nil, privilege.SELECT) {
+		t.Error("expected foo not to have SELECT privilege without its roles")
+	}
+
+	expected := []sql.InheritedPrivilegeString{
+		{User: "foo", Privileges: "INSERT,UPDATE", Via: []string{"writers"}},
+		{User: "foo", Privileges: "SELECT", Via: []string{"writers", "readers"}},
+		{User: "writers", Privileges: "SELECT", Via: []string{"readers"}},
+	}
+	if show := descriptor.ShowInherited(memberships); !reflect.DeepEqual(show, expected) {
+		t.Errorf("expected inherited privileges %+v, got %+v", expected, show)
+	}
+}
+
 // TestPrivilegeValidate exercises validation for non-system descriptors.
 func TestPrivilegeValidate(t *testing.T) {
 	defer leaktest.AfterTest(t)
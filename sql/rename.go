@@ -281,13 +281,25 @@ func (p *planner) RenameColumn(n *parser.RenameColumn) (planNode, error) {
 		return nil, fmt.Errorf("column name %q already exists", newColName)
 	}
 
-	for _, idx := range tableDesc.Indexes {
+	for j := range tableDesc.Indexes {
+		idx := &tableDesc.Indexes[j]
+		if err := renameIndexExprColumn(tableDesc, idx, column.ID, newColName); err != nil {
+			return nil, err
+		}
 		for i, id := range idx.ColumnIDs {
 			if id == column.ID {
 				idx.ColumnNames[i] = newColName
 			}
 		}
 	}
+	for j := range tableDesc.Families {
+		family := &tableDesc.Families[j]
+		for i, id := range family.ColumnIDs {
+			if id == column.ID {
+				family.ColumnNames[i] = newColName
+			}
+		}
+	}
 	column.Name = newColName
 
 	descKey := MakeDescMetadataKey(tableDesc.GetID())
@@ -0,0 +1,233 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// getRoleMemberships reads the role memberships from the system.role_members
+// table. The table is read directly, without checking the privileges of the
+// user, as the memberships are needed to check those privileges. The
+// memberships are read once per statement.
+func (p *planner) getRoleMemberships() (RoleMemberships, error) {
+	if p.roleMemberships != nil {
+		return p.roleMemberships, nil
+	}
+	prefix := roachpb.Key(MakeIndexKeyPrefix(RoleMembersTable.ID, RoleMembersTable.PrimaryIndex.ID))
+	kvs, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// All the columns of system.role_members are part of the primary key, so
+	// each row is a single key.
+	memberships := RoleMemberships{}
+	valTypes := []parser.Datum{parser.DummyString, parser.DummyString}
+	vals := make([]parser.Datum, len(valTypes))
+	for _, kv := range kvs {
+		if _, _, err := decodeIndexKey(&RoleMembersTable, RoleMembersTable.PrimaryIndex,
+			valTypes, vals, kv.Key); err != nil {
+			return nil, err
+		}
+		role, member := string(vals[0].(parser.DString)), string(vals[1].(parser.DString))
+		memberships[member] = append(memberships[member], role)
+	}
+	p.roleMemberships = memberships
+	return memberships, nil
+}
+
+// execStmts parses and executes statements internally, with the privileges
+// of the user.
+func (p *planner) execStmts(sql string) error {
+	stmts, err := parser.ParseTraditional(sql)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		if _, err := p.makePlan(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryRows parses and runs a query internally, with the privileges of the
+// root user, and returns its rows. The accesses of the query are not
+// audited.
+func (p *planner) queryRows(sql string) ([]parser.DTuple, error) {
+	stmts, err := parser.ParseTraditional(sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, util.Errorf("expected a single query, found %d statements", len(stmts))
+	}
+	user, accesses := p.user, p.auditAccesses
+	p.user = security.RootUser
+	defer func() {
+		p.user, p.auditAccesses = user, accesses
+	}()
+	plan, err := p.makePlan(stmts[0])
+	if err != nil {
+		return nil, err
+	}
+	var rows []parser.DTuple
+	for plan.Next() {
+		rows = append(rows, append(parser.DTuple(nil), plan.Values()...))
+	}
+	return rows, plan.Err()
+}
+
+// CreateRole creates a role.
+// Privileges: INSERT on system.users.
+//   Notes: postgres requires the CREATEROLE attribute.
+func (p *planner) CreateRole(n *parser.CreateRole) (planNode, error) {
+	name := string(n.Name)
+	if name == security.RootUser || name == security.NodeUser {
+		return nil, fmt.Errorf("role name %s is reserved", name)
+	}
+	exists, _, err := p.lookupUser(name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("user or role %s already exists", name)
+	}
+	if err := p.execStmts(fmt.Sprintf(`INSERT INTO system.users (username, isRole) VALUES (%s, true)`,
+		parser.DString(name))); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// DropRole drops roles, along with their memberships. A role holding
+// privileges on a database or table cannot be dropped.
+// Privileges: DELETE on system.users and system.role_members.
+//   Notes: postgres requires the CREATEROLE attribute.
+func (p *planner) DropRole(n *parser.DropRole) (planNode, error) {
+	for _, name := range n.Names {
+		_, isRole, err := p.lookupUser(name)
+		if err != nil {
+			return nil, err
+		}
+		if !isRole {
+			return nil, fmt.Errorf("role %s does not exist", name)
+		}
+		if err := p.checkNoPrivileges(name); err != nil {
+			return nil, err
+		}
+		if err := p.execStmts(fmt.Sprintf(`
+DELETE FROM system.users WHERE username = %[1]s;
+DELETE FROM system.role_members WHERE role = %[1]s OR member = %[1]s;
+`, parser.DString(name))); err != nil {
+			return nil, err
+		}
+	}
+	p.roleMemberships = nil
+	return &valuesNode{}, nil
+}
+
+// GrantRole adds users or roles as members of roles.
+// Privileges: INSERT on system.role_members.
+//   Notes: postgres requires the CREATEROLE attribute or the admin option.
+func (p *planner) GrantRole(n *parser.GrantRole) (planNode, error) {
+	for _, role := range n.Roles {
+		_, isRole, err := p.lookupUser(role)
+		if err != nil {
+			return nil, err
+		}
+		if !isRole {
+			return nil, fmt.Errorf("role %s does not exist", role)
+		}
+	}
+
+	memberships, err := p.getRoleMemberships()
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range n.Roles {
+		for _, member := range n.Members {
+			if isDirectRoleMember(memberships, member, role) {
+				continue
+			}
+			// Making member a member of role creates a cycle if role already
+			// is a member of member.
+			if member == role || isRoleMember(memberships, role, member) {
+				return nil, fmt.Errorf("making %s a member of %s would create a cycle", member, role)
+			}
+			if err := p.execStmts(fmt.Sprintf(`INSERT INTO system.role_members VALUES (%s, %s)`,
+				parser.DString(role), parser.DString(member))); err != nil {
+				return nil, err
+			}
+			// The memberships read by the statement are kept up to date.
+			memberships[member] = append(memberships[member], role)
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// RevokeRole removes users or roles from the members of roles.
+// Privileges: DELETE on system.role_members.
+//   Notes: postgres requires the CREATEROLE attribute or the admin option.
+func (p *planner) RevokeRole(n *parser.RevokeRole) (planNode, error) {
+	for _, role := range n.Roles {
+		for _, member := range n.Members {
+			if err := p.execStmts(fmt.Sprintf(`DELETE FROM system.role_members WHERE role = %s AND member = %s`,
+				parser.DString(role), parser.DString(member))); err != nil {
+				return nil, err
+			}
+		}
+	}
+	p.roleMemberships = nil
+	return &valuesNode{}, nil
+}
+
+// isDirectRoleMember returns true if 'member' is a direct member of 'role'.
+func isDirectRoleMember(memberships RoleMemberships, member, role string) bool {
+	for _, r := range memberships[member] {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// isRoleMember returns true if 'member' is a member of 'role', directly or
+// through other roles.
+func isRoleMember(memberships RoleMemberships, member, role string) bool {
+	visited := map[string]struct{}{member: {}}
+	queue := []string{member}
+	for len(queue) > 0 {
+		grantee := queue[0]
+		queue = queue[1:]
+		for _, r := range memberships[grantee] {
+			if r == role {
+				return true
+			}
+			if _, ok := visited[r]; !ok {
+				visited[r] = struct{}{}
+				queue = append(queue, r)
+			}
+		}
+	}
+	return false
+}
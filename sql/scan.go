@@ -58,7 +58,7 @@ func (q *qvalue) String() string {
 }
 
 type qvalMap map[ColumnID]*qvalue
-type colKindMap map[ColumnID]ColumnType_Kind
+type colTypeMap map[ColumnID]ColumnType
 
 type span struct {
 	start roachpb.Key
@@ -150,22 +150,32 @@ type scanNode struct {
 	ordering         []int
 	exactPrefix      int
 	err              error
-	indexKey         []byte            // the index key of the current row
-	kvs              []client.KeyValue // the raw key/value pairs
-	kvIndex          int               // current index into the key/value pairs
-	rowIndex         int               // the index of the current row
-	colID            ColumnID          // column ID of the current key
-	valTypes         []parser.Datum    // the index key value types for the current row
-	vals             []parser.Datum    // the index key values for the current row
-	implicitValTypes []parser.Datum    // the implicit value types for unique indexes
-	implicitVals     []parser.Datum    // the implicit values for unique indexes
-	qvals            qvalMap           // the values in the current row
-	colKind          colKindMap        // map of column kinds for decoding column values
-	row              parser.DTuple     // the rendered row
-	filter           parser.Expr       // filtering expression for rows
-	render           []parser.Expr     // rendering expressions for rows
+	indexKey         []byte                    // the index key of the current row
+	kvs              []client.KeyValue         // the raw key/value pairs
+	kvIndex          int                       // current index into the key/value pairs
+	rowIndex         int                       // the index of the current row
+	family           *FamilyDescriptor         // column family of the current key
+	colID            ColumnID                  // default column ID of the current family
+	valTypes         []parser.Datum            // the index key value types for the current row
+	vals             []parser.Datum            // the index key values for the current row
+	implicitValTypes []parser.Datum            // the implicit value types for unique indexes
+	implicitVals     []parser.Datum            // the implicit values for unique indexes
+	qvals            qvalMap                   // the values in the current row
+	colType          colTypeMap                // map of column types for decoding column values
+	colValTypes      map[ColumnID]parser.Datum // map of column types for decoding packed values
+	families         map[FamilyID]*FamilyDescriptor
+	familyVals       map[ColumnID]parser.Datum // the values of the current packed family
+	explainVals      []parser.Datum            // the values of the current family for explainDebug
+	row              parser.DTuple             // the rendered row
+	filter           parser.Expr               // filtering expression for rows
+	render           []parser.Expr             // rendering expressions for rows
 	explain          explainMode
 	explainValue     parser.Datum
+	lock             parser.LockingStrength // row locking requested by a SELECT
+	lockValue        *roachpb.Value         // value of the current row's sentinel key
+	funcExpr         *parser.FuncExpr       // the table function in the FROM clause, if any
+	funcValues       []parser.Datum         // the values returned by the table function
+	funcIndex        int                    // current index into the table function values
 }
 
 func (n *scanNode) Columns() []string {
@@ -185,10 +195,28 @@ func (n *scanNode) Next() bool {
 		return false
 	}
 
+	// Check the statement deadline for every row, so that plans which buffer
+	// all of their input, such as sorts and aggregations, are cut off too.
+	if n.planner != nil {
+		if n.err = n.planner.checkDeadline(); n.err != nil {
+			return false
+		}
+	}
+
+	if n.funcExpr != nil {
+		return n.nextFuncRow()
+	}
+
 	if n.kvs == nil {
 		if !n.initScan() {
 			return false
 		}
+		// The scan itself may have run past the deadline.
+		if n.planner != nil {
+			if n.err = n.planner.checkDeadline(); n.err != nil {
+				return false
+			}
+		}
 	}
 
 	// All of the columns for a particular row will be grouped together. We loop
@@ -221,7 +249,9 @@ func (n *scanNode) ExplainPlan() (name, description string, children []planNode)
 	} else {
 		name = "scan"
 	}
-	if n.desc == nil {
+	if n.funcExpr != nil {
+		description = n.funcExpr.String()
+	} else if n.desc == nil {
 		description = "-"
 	} else {
 		description = fmt.Sprintf("%s@%s %s", n.desc.Name, n.index.Name,
@@ -237,6 +267,13 @@ func (n *scanNode) initFrom(p *planner, from parser.TableExprs) error {
 		return nil
 
 	case 1:
+		if ate, ok := from[0].(*parser.AliasedTableExpr); ok {
+			if fn, ok := ate.Expr.(*parser.FuncExpr); ok {
+				n.err = n.initFuncFrom(p, fn, ate.As)
+				return n.err
+			}
+		}
+
 		if n.desc, n.err = p.getAliasedTableDesc(from[0]); n.err != nil {
 			return n.err
 		}
@@ -295,6 +332,75 @@ func (n *scanNode) initFrom(p *planner, from parser.TableExprs) error {
 	}
 }
 
+// initFuncFrom initializes the scan of the rows returned by a table function.
+// The only table function is unnest, which returns a row for each of the
+// elements of an array. The rows have a single column named after the alias
+// of the function, or "unnest" when the function is not aliased.
+func (n *scanNode) initFuncFrom(p *planner, fn *parser.FuncExpr, as parser.Name) error {
+	if len(fn.Name.Indirect) > 0 || !strings.EqualFold(string(fn.Name.Base), "unnest") {
+		return fmt.Errorf("unsupported table function: %s", fn.Name)
+	}
+	if len(fn.Exprs) != 1 {
+		return fmt.Errorf("unnest takes a single argument: %s", fn)
+	}
+	expr, err := p.evalCtx.NormalizeAndTypeCheckExpr(fn.Exprs[0])
+	if err != nil {
+		return err
+	}
+	d, err := p.evalCtx.EvalExpr(expr)
+	if err != nil {
+		return err
+	}
+	var array *parser.DArray
+	switch t := d.(type) {
+	case *parser.DArray:
+		array = t
+	default:
+		if d != parser.DNull {
+			return fmt.Errorf("unnest argument must be an array: %s", d.Type())
+		}
+		array = parser.DummyArray
+	}
+
+	name := string(as)
+	if name == "" {
+		name = "unnest"
+	}
+	// The column of an array whose element type is unknown only holds NULLs.
+	col := ColumnDescriptor{Name: name, ID: 1, Nullable: true, Type: ColumnType{Kind: ColumnType_STRING}}
+	if array.ParamType != parser.DNull {
+		col.Type.Kind = datumColumnKind(array.ParamType)
+	}
+	n.desc = &TableDescriptor{Name: name, Alias: name, Columns: []ColumnDescriptor{col}}
+	n.visibleCols = n.desc.Columns
+	n.funcExpr = fn
+	n.funcValues = array.Elements
+	return nil
+}
+
+// nextFuncRow advances to the next row returned by the table function.
+func (n *scanNode) nextFuncRow() bool {
+	if n.explain == explainDebug {
+		n.err = fmt.Errorf("EXPLAIN (DEBUG) is not supported for table functions: %s", n.funcExpr)
+		return false
+	}
+	for n.funcIndex < len(n.funcValues) {
+		if qval, ok := n.qvals[n.visibleCols[0].ID]; ok {
+			qval.datum = n.funcValues[n.funcIndex]
+		}
+		n.funcIndex++
+		output := n.filterRow()
+		if n.err != nil {
+			return false
+		}
+		if output {
+			n.renderRow()
+			return n.err == nil
+		}
+	}
+	return false
+}
+
 // initScan initializes (and performs) the key-value scan.
 //
 // TODO(pmattis): The key-value scan currently reads all of the key-value
@@ -313,7 +419,7 @@ func (n *scanNode) initScan() bool {
 	if len(n.spans) == 0 {
 		// If no spans were specified retrieve all of the keys that start with our
 		// index key prefix.
-		start := roachpb.Key(MakeIndexKeyPrefix(n.desc.ID, n.index.ID))
+		start := makeIndexSpanPrefix(n.desc, n.index)
 		n.spans = append(n.spans, span{
 			start: start,
 			end:   start.PrefixEnd(),
@@ -359,10 +465,32 @@ func (n *scanNode) initScan() bool {
 			n.implicitVals = make([]parser.Datum, len(n.implicitValTypes))
 		}
 
-		// Prepare a map from column ID to column kind used for unmarshalling values.
-		n.colKind = make(colKindMap, len(n.desc.Columns))
+		// Prepare a map from column ID to column type used for unmarshalling values.
+		n.colType = make(colTypeMap, len(n.desc.Columns))
 		for _, col := range n.desc.Columns {
-			n.colKind[col.ID] = col.Type.Kind
+			n.colType[col.ID] = col.Type
+		}
+
+		if !n.isSecondaryIndex {
+			// Prepare the column families and the column types used for decoding
+			// their packed values.
+			colIDs := make([]ColumnID, len(n.desc.Columns))
+			for i, col := range n.desc.Columns {
+				colIDs[i] = col.ID
+			}
+			var valTypes []parser.Datum
+			if valTypes, n.err = makeKeyVals(n.desc, colIDs); n.err != nil {
+				return false
+			}
+			n.colValTypes = make(map[ColumnID]parser.Datum, len(colIDs))
+			for i, id := range colIDs {
+				n.colValTypes[id] = valTypes[i]
+			}
+			n.families = make(map[FamilyID]*FamilyDescriptor, len(n.desc.Families))
+			for i := range n.desc.Families {
+				n.families[n.desc.Families[i].ID] = &n.desc.Families[i]
+			}
+			n.familyVals = make(map[ColumnID]parser.Datum)
 		}
 	}
 	return true
@@ -532,14 +660,19 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 		}
 	}
 
-	var remaining []byte
-	remaining, n.err = decodeIndexKey(n.desc, *n.index, n.valTypes, n.vals, kv.Key)
-	if n.err != nil {
+	remaining, ok, err := decodeIndexKey(n.desc, *n.index, n.valTypes, n.vals, kv.Key)
+	if err != nil {
+		n.err = err
 		return false
 	}
+	if !ok {
+		// The key belongs to a row interleaved with the index's entries.
+		return true
+	}
 
 	if n.indexKey == nil {
 		n.indexKey = []byte(kv.Key[:len(kv.Key)-len(remaining)])
+		n.lockValue = nil
 
 		// This is the first key for the row, initialize the column values that are
 		// part of the index key.
@@ -551,31 +684,49 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 	}
 
 	var value parser.Datum
+	n.family = nil
 	n.colID = 0
 
-	if !n.isSecondaryIndex && len(remaining) > 0 {
-		var v uint64
-		_, v, n.err = encoding.DecodeUvarint(remaining)
-		if n.err != nil {
-			return false
-		}
-		n.colID = ColumnID(v)
-		if qval, ok := n.qvals[n.colID]; ok && qval.datum == nil {
-			value, ok = n.unmarshalValue(kv)
-			if !ok {
+	if !n.isSecondaryIndex {
+		// The key of family 0 is the primary index key itself. The keys of the
+		// other families are followed by the family ID.
+		var familyID FamilyID
+		if len(remaining) == 0 {
+			n.lockValue = kv.Value
+		} else {
+			var v uint64
+			_, v, n.err = encoding.DecodeUvarint(remaining)
+			if n.err != nil {
 				return false
 			}
-			qval.datum = value
-			if log.V(2) {
-				log.Infof("Scan %s -> %v", prettyKey(kv.Key, 0), value)
-			}
-		} else {
-			// No need to unmarshal the column value. Either the column was part of
-			// the index key or it isn't needed by any of the render or filter
-			// expressions.
-			if log.V(2) {
-				log.Infof("Scan %s -> [%d] (skipped)", prettyKey(kv.Key, 0), n.colID)
+			familyID = FamilyID(v)
+		}
+		var ok bool
+		if n.family, ok = n.families[familyID]; !ok {
+			n.err = util.Errorf("family-id \"%d\" does not exist", familyID)
+			return false
+		}
+		if n.family.DefaultColumnID != 0 {
+			n.colID = n.family.DefaultColumnID
+			if qval, ok := n.qvals[n.colID]; ok && qval.datum == nil {
+				value, ok = n.unmarshalValue(kv)
+				if !ok {
+					return false
+				}
+				qval.datum = value
+				if log.V(2) {
+					log.Infof("Scan %s -> %v", prettyKey(kv.Key, 0), value)
+				}
+			} else {
+				// No need to unmarshal the column value. Either the column was part of
+				// the index key or it isn't needed by any of the render or filter
+				// expressions.
+				if log.V(2) {
+					log.Infof("Scan %s -> [%d] (skipped)", prettyKey(kv.Key, 0), n.colID)
+				}
 			}
+		} else if !n.decodeFamily(kv) {
+			return false
 		}
 	} else {
 		if n.implicitVals != nil {
@@ -615,6 +766,44 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 	return true
 }
 
+// decodeFamily decodes the packed value of the current column family,
+// initializing the values of the columns in the family which are needed by
+// the render or filter expressions. Returns false if an error occurred.
+func (n *scanNode) decodeFamily(kv client.KeyValue) bool {
+	for id := range n.familyVals {
+		delete(n.familyVals, id)
+	}
+	if n.err = decodeFamilyValue(n.colValTypes, n.familyVals, kv.ValueBytes()); n.err != nil {
+		return false
+	}
+	for id, val := range n.familyVals {
+		if qval, ok := n.qvals[id]; ok && qval.datum == nil {
+			qval.datum = val
+		}
+	}
+
+	if log.V(2) {
+		log.Infof("Scan %s -> %v", prettyKey(kv.Key, 0), n.familyVals)
+	}
+
+	if n.explain == explainDebug && len(n.familyVals) > 0 {
+		// Output the values of all of the columns stored by the family, including
+		// the NULL ones.
+		n.explainVals = n.explainVals[:0]
+		for _, id := range n.family.ColumnIDs {
+			if n.desc.PrimaryIndex.containsColumnID(id) {
+				continue
+			}
+			if val, ok := n.familyVals[id]; ok {
+				n.explainVals = append(n.explainVals, val)
+			} else {
+				n.explainVals = append(n.explainVals, parser.DNull)
+			}
+		}
+	}
+	return true
+}
+
 // maybeOutputRow checks to see if the current key belongs to a new row and if
 // it does it outputs the last row. The return value indicates whether a row
 // was output or an error occurred. In either case, iteration should terminate.
@@ -622,12 +811,16 @@ func (n *scanNode) maybeOutputRow() bool {
 	if n.indexKey != nil &&
 		(n.kvIndex == len(n.kvs) || !bytes.HasPrefix(n.kvs[n.kvIndex].Key, n.indexKey)) {
 		// The current key belongs to a new row. Output the current row.
+		rowKey := n.indexKey
 		n.indexKey = nil
 		output := n.filterRow()
 		if n.err != nil {
 			return true
 		}
 		if output {
+			if n.lock != parser.LockNone && !n.isSecondaryIndex && !n.lockRow(rowKey) {
+				return true
+			}
 			n.renderRow()
 			return true
 		} else if n.explainValue != nil {
@@ -641,6 +834,27 @@ func (n *scanNode) maybeOutputRow() bool {
 	return false
 }
 
+// lockRow locks the row with the given primary index key, which is about to
+// be output, by writing the current value of its sentinel key back. This
+// lays down an intent: concurrent writers block on it and push this
+// transaction instead of forcing it to restart at commit time. The KV layer
+// has no shared locks, so FOR SHARE takes the same exclusive intent as FOR
+// UPDATE. The rows of an index join are locked by its table scan, which
+// only outputs the rows passing the filter. The locks are collected in the
+// planner and written in one batch at the end of the statement. Returns
+// false and sets n.err if the row could not be locked.
+func (n *scanNode) lockRow(key []byte) bool {
+	if n.lockValue == nil {
+		n.err = util.Errorf("row %s has no sentinel key", prettyKey(key, 0))
+		return false
+	}
+	if n.planner.locks == nil {
+		n.planner.locks = &client.Batch{}
+	}
+	n.planner.locks.Put(key, *n.lockValue)
+	return true
+}
+
 // filterRow checks to see if the current row matches the filter (i.e. the
 // where-clause). May set n.err if an error occurs during expression
 // evaluation.
@@ -698,6 +912,8 @@ func (n *scanNode) explainDebug(endOfRow, outputRow bool) {
 	n.row[1] = parser.DString(n.prettyKey())
 	if n.implicitVals != nil {
 		n.row[2] = parser.DString(prettyKeyVals(n.implicitVals))
+	} else if n.explainVals != nil {
+		n.row[2] = parser.DString(prettyKeyVals(n.explainVals))
 	} else {
 		// This conversion to DString is odd. `n.explainValue` is already a
 		// `Datum`, but logic_test currently expects EXPLAIN DEBUG output
@@ -714,6 +930,7 @@ func (n *scanNode) explainDebug(endOfRow, outputRow bool) {
 		n.row[3] = parser.DNull
 	}
 	n.explainValue = nil
+	n.explainVals = nil
 }
 
 func (n *scanNode) prettyKey() string {
@@ -729,6 +946,8 @@ func (n *scanNode) prettyKey() string {
 			panic(err)
 		}
 		fmt.Fprintf(&buf, "/%s", col.Name)
+	} else if n.family != nil && n.family.ID != 0 {
+		fmt.Fprintf(&buf, "/%s", n.family.Name)
 	}
 	return buf.String()
 }
@@ -742,13 +961,13 @@ func prettyKeyVals(vals []parser.Datum) string {
 }
 
 func (n *scanNode) unmarshalValue(kv client.KeyValue) (parser.Datum, bool) {
-	kind, ok := n.colKind[n.colID]
+	typ, ok := n.colType[n.colID]
 	if !ok {
 		n.err = fmt.Errorf("column-id \"%d\" does not exist", n.colID)
 		return nil, false
 	}
 	var d parser.Datum
-	d, n.err = unmarshalColumnValue(kind, kv.Value)
+	d, n.err = unmarshalColumnValue(typ, kv.Value)
 	return d, n.err == nil
 }
 
@@ -765,24 +984,7 @@ func (n *scanNode) getQVal(col ColumnDescriptor) *qvalue {
 		//
 		// TODO(pmattis): Nullable columns can have NULL values. The type analysis
 		// needs to take that into consideration, but how to surface that info?
-		switch col.Type.Kind {
-		case ColumnType_INT:
-			qval.datum = parser.DummyInt
-		case ColumnType_BOOL:
-			qval.datum = parser.DummyBool
-		case ColumnType_FLOAT:
-			qval.datum = parser.DummyFloat
-		case ColumnType_STRING:
-			qval.datum = parser.DummyString
-		case ColumnType_BYTES:
-			qval.datum = parser.DummyBytes
-		case ColumnType_DATE:
-			qval.datum = parser.DummyDate
-		case ColumnType_TIMESTAMP:
-			qval.datum = parser.DummyTimestamp
-		case ColumnType_INTERVAL:
-			qval.datum = parser.DummyInterval
-		default:
+		if qval.datum = col.Type.datumType(); qval.datum == nil {
 			panic(fmt.Sprintf("unsupported column type: %s", col.Type.Kind))
 		}
 		n.qvals[col.ID] = qval
@@ -832,6 +1034,11 @@ func (v *qnameVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser
 				if !equalName(name, col.Name) {
 					continue
 				}
+				if len(qname.Indirect) == 2 {
+					// The column is subscripted, as in "a[1]". NormalizeColumnName
+					// verified that the second indirection is an array subscript.
+					return v, &parser.IndirectionExpr{Expr: v.getQVal(col), Indirection: qname.Indirect[1:]}
+				}
 				return v, v.getQVal(col)
 			}
 		}
@@ -869,6 +1076,12 @@ func (v *qnameVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser
 		if desc == nil {
 			break
 		}
+		if len(desc.PrimaryIndex.ColumnIDs) == 0 {
+			// Table functions have no primary key and their column may contain
+			// NULLs, so count a constant instead.
+			t.Exprs[0] = parser.DInt(1)
+			return v, expr
+		}
 		// Replace the function argument with a non-NULL column. We currently use
 		// the first column of the primary index since that column will be a
 		// candidate for index selection, but any non-NULL column would do for
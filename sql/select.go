@@ -24,6 +24,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -39,10 +40,15 @@ import (
 //   Notes: postgres requires SELECT. Also requires UPDATE on "FOR UPDATE".
 //          mysql requires SELECT.
 func (p *planner) Select(n *parser.Select) (planNode, error) {
-	scan := &scanNode{planner: p, txn: p.txn}
+	scan := &scanNode{planner: p, txn: p.txn, lock: n.Lock}
 	if err := scan.initFrom(p, n.From); err != nil {
 		return nil, err
 	}
+	if n.Lock != parser.LockNone && scan.desc != nil && scan.funcExpr == nil {
+		if err := p.checkPrivilege(scan.desc, privilege.UPDATE); err != nil {
+			return nil, err
+		}
+	}
 	if err := scan.initWhere(n.Where); err != nil {
 		return nil, err
 	}
@@ -53,6 +59,15 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if n.Lock != parser.LockNone {
+		// The locked rows must correspond to the rows returned.
+		if group != nil {
+			return nil, fmt.Errorf("%s is not allowed with aggregate functions", n.Lock)
+		}
+		if n.Distinct {
+			return nil, fmt.Errorf("%s is not allowed with DISTINCT clause", n.Lock)
+		}
+	}
 	if group != nil && n.OrderBy != nil {
 		// TODO(pmattis): orderBy currently uses deep knowledge of the
 		// scanNode. Need to lift that out or make orderBy compatible with
@@ -92,8 +107,21 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 // "best" index from that set. The cost model based on keys per row, key size
 // and number of index elements used.
 func (p *planner) selectIndex(s *scanNode, ordering []int) (planNode, error) {
-	if s.desc == nil || (s.filter == nil && ordering == nil) {
-		// No table or no where-clause and no ordering.
+	if s.desc != nil && s.isSecondaryIndex && s.index.Predicate != "" {
+		// An explicitly requested partial index can only be used if it contains
+		// all of the rows the query can return.
+		ok, err := p.impliesIndexPredicate(s, s.index)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("index %q is a partial index that does not contain all the rows needed to execute this query",
+				s.index.Name)
+		}
+	}
+
+	if s.desc == nil || s.funcExpr != nil || (s.filter == nil && ordering == nil) {
+		// No table, a table function or no where-clause and no ordering.
 		s.initOrdering(0)
 		return s, nil
 	}
@@ -112,9 +140,19 @@ func (p *planner) selectIndex(s *scanNode, ordering []int) (planNode, error) {
 			index: &s.desc.PrimaryIndex,
 		})
 		for i := range s.desc.Indexes {
+			index := &s.desc.Indexes[i]
+			if index.Predicate != "" {
+				ok, err := p.impliesIndexPredicate(s, index)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
 			candidates = append(candidates, &indexInfo{
 				desc:  s.desc,
-				index: &s.desc.Indexes[i],
+				index: index,
 			})
 		}
 	}
@@ -160,7 +198,16 @@ func (p *planner) selectIndex(s *scanNode, ordering []int) (planNode, error) {
 		// to scan. An examples of this is "a IN (1, 2, 3)".
 
 		for _, c := range candidates {
-			c.analyzeRanges(exprs)
+			if len(c.index.ColumnExprs) == 0 {
+				c.analyzeRanges(exprs)
+				continue
+			}
+			// Analyze the filter in terms of the values of the index expressions.
+			filter, err := p.replaceIndexExprs(s, c.index)
+			if err != nil {
+				return nil, err
+			}
+			c.analyzeRanges(analyzeExpr(filter))
 		}
 	}
 
@@ -184,7 +231,7 @@ func (p *planner) selectIndex(s *scanNode, ordering []int) (planNode, error) {
 	c := candidates[0]
 	s.index = c.index
 	s.isSecondaryIndex = (c.index != &s.desc.PrimaryIndex)
-	s.spans = makeSpans(c.constraints, c.desc.ID, c.index.ID)
+	s.spans = makeSpans(c.constraints, c.desc, c.index)
 	if len(s.spans) == 0 {
 		// There are no spans to scan.
 		s.desc = nil
@@ -199,13 +246,107 @@ func (p *planner) selectIndex(s *scanNode, ordering []int) (planNode, error) {
 		}
 	}
 
-	if c.covering {
+	// The rows of a locking scan are locked through their sentinel keys in
+	// the primary index, so a covering secondary index still needs an index
+	// join.
+	if c.covering && (s.lock == parser.LockNone || !s.isSecondaryIndex) {
 		s.initOrdering(c.exactPrefix)
 		return s, nil
 	}
 	return makeIndexJoin(s, c.exactPrefix)
 }
 
+// resolveIndexExpr parses and resolves expr, the expression of an index
+// element or the predicate of a partial index, against the columns of the
+// scan. The expression is normalized so that it can be compared with the
+// filter of the scan. Unlike the filter, the expression does not add to the
+// columns retrieved by the scan.
+func (p *planner) resolveIndexExpr(s *scanNode, expr string) (parser.Expr, error) {
+	parsed, err := parser.ParseExpr(expr, parser.Traditional)
+	if err != nil {
+		return nil, err
+	}
+	exprScan := &scanNode{planner: p, desc: s.desc, visibleCols: s.visibleCols}
+	resolved, err := exprScan.resolveQNames(parsed)
+	if err != nil {
+		return nil, err
+	}
+	return p.evalCtx.NormalizeAndTypeCheckExpr(resolved)
+}
+
+// impliesIndexPredicate returns true if the filter of the scan implies the
+// predicate of the partial index, in which case the index contains all of the
+// rows the scan can return.
+func (p *planner) impliesIndexPredicate(s *scanNode, index *IndexDescriptor) (bool, error) {
+	if s.filter == nil {
+		return false, nil
+	}
+	pred, err := p.resolveIndexExpr(s, index.Predicate)
+	if err != nil {
+		return false, err
+	}
+	conjuncts := splitAndExpr(s.filter, nil)
+	for _, e := range splitAndExpr(pred, nil) {
+		if !exprImplies(s.filter, conjuncts, e) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// replaceIndexExprs returns a copy of the filter of the scan in which the
+// occurrences of the expressions of the index are replaced by qvalues for the
+// index elements, which allows the filter to be analyzed for constraints on
+// the expression values. Expressions are matched by their string
+// representation.
+func (p *planner) replaceIndexExprs(s *scanNode, index *IndexDescriptor) (parser.Expr, error) {
+	repl := map[string]parser.Expr{}
+	for i, id := range index.ColumnIDs {
+		if !index.isExpr(i) {
+			continue
+		}
+		expr, err := p.resolveIndexExpr(s, index.ColumnExprs[i])
+		if err != nil {
+			return nil, err
+		}
+		typ, err := parser.TypeCheckExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		repl[expr.String()] = &qvalue{
+			col:   ColumnDescriptor{Name: index.ColumnNames[i], ID: id},
+			datum: typ,
+		}
+	}
+	return replaceExprs(s.filter, repl), nil
+}
+
+// replaceExprs returns a copy of expr in which the subexpressions whose string
+// representation is a key of repl are replaced. Only the boolean and
+// comparison expressions are copied, the original expression is not modified.
+func replaceExprs(expr parser.Expr, repl map[string]parser.Expr) parser.Expr {
+	if r, ok := repl[expr.String()]; ok {
+		return r
+	}
+	switch t := expr.(type) {
+	case *parser.AndExpr:
+		return &parser.AndExpr{Left: replaceExprs(t.Left, repl), Right: replaceExprs(t.Right, repl)}
+	case *parser.OrExpr:
+		return &parser.OrExpr{Left: replaceExprs(t.Left, repl), Right: replaceExprs(t.Right, repl)}
+	case *parser.NotExpr:
+		return &parser.NotExpr{Expr: replaceExprs(t.Expr, repl)}
+	case *parser.ParenExpr:
+		return &parser.ParenExpr{Expr: replaceExprs(t.Expr, repl)}
+	case *parser.ComparisonExpr:
+		return &parser.ComparisonExpr{
+			Operator: t.Operator,
+			Left:     replaceExprs(t.Left, repl),
+			Right:    replaceExprs(t.Right, repl),
+		}
+	}
+	return expr
+}
+
 type indexConstraint struct {
 	start *parser.ComparisonExpr
 	end   *parser.ComparisonExpr
@@ -257,8 +398,9 @@ func (v *indexInfo) init(s *scanNode) {
 
 	// The base cost is the number of keys per row.
 	if v.index == &v.desc.PrimaryIndex {
-		// The primary index contains 1 key per column plus the sentinel key per
-		// row.
+		// The primary index contains the values of all of the columns in addition
+		// to the row sentinel. The values are packed into column families, but
+		// the cost of decoding them still grows with the number of columns.
 		v.cost = float64(1 + len(v.desc.Columns) - len(v.desc.PrimaryIndex.ColumnIDs))
 	} else {
 		v.cost = 1
@@ -524,15 +666,32 @@ func (v indexInfoByCost) Swap(i, j int) {
 }
 
 // makeSpans constructs the spans for an index given a set of constraints.
-func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span {
-	prefix := roachpb.Key(MakeIndexKeyPrefix(tableID, indexID))
+// The spans of an interleaved index start with the prefix of the root
+// ancestor's index, and the interleaved key layout is followed by inserting
+// the separators of interleavedSeparators before the constrained columns.
+// Spans constrained only on columns shared with an ancestor also contain the
+// rows of the ancestors and of the other tables interleaved into them, which
+// the scan skips.
+func makeSpans(constraints indexConstraints, desc *TableDescriptor, index *IndexDescriptor) []span {
+	prefix := makeIndexSpanPrefix(desc, index)
 	spans := []span{{
 		start: append(roachpb.Key(nil), prefix...),
 		end:   append(roachpb.Key(nil), prefix...),
 	}}
 	var buf [100]byte
+	seps := interleavedSeparators(desc, index)
+	// col is the position in the index of the first column of the current
+	// constraint.
+	col := 0
 
 	for i, c := range constraints {
+		sep := seps[col]
+		if c.tupleMap != nil {
+			col += len(c.tupleMap)
+		} else {
+			col++
+		}
+
 		// Is this the last end constraint? We perform special processing on the
 		// last end constraint to account for the exclusive nature of the scan end
 		// key.
@@ -565,8 +724,10 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 
 				switch t := datum.(type) {
 				case parser.DTuple:
+					tupleCol := col - len(c.tupleMap)
 					start = buf[:0]
-					for _, i := range c.tupleMap {
+					for k, i := range c.tupleMap {
+						start = append(start, seps[tupleCol+k]...)
 						var err error
 						if start, err = encodeTableKey(start, t[i]); err != nil {
 							panic(err)
@@ -581,6 +742,7 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 							if i+1 == len(c.tupleMap) {
 								d = d.Next()
 							}
+							end = append(end, seps[tupleCol+i]...)
 							var err error
 							if end, err = encodeTableKey(end, d); err != nil {
 								panic(err)
@@ -590,14 +752,14 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 
 				default:
 					var err error
-					if start, err = encodeTableKey(buf[:0], datum); err != nil {
+					if start, err = encodeTableKey(append(buf[:0], sep...), datum); err != nil {
 						panic(err)
 					}
 
 					end = start
 					if lastEnd {
 						var err error
-						if end, err = encodeTableKey(nil, datum.Next()); err != nil {
+						if end, err = encodeTableKey(append([]byte(nil), sep...), datum.Next()); err != nil {
 							panic(err)
 						}
 					}
@@ -624,11 +786,11 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 				// A != or IS NOT NULL expression allows us to constrain the start of
 				// the range to not include NULL.
 				for i := range spans {
-					spans[i].start = encoding.EncodeNotNull(spans[i].start)
+					spans[i].start = encoding.EncodeNotNull(append(spans[i].start, sep...))
 				}
 			default:
 				if datum, ok := c.start.Right.(parser.Datum); ok {
-					key, err := encodeTableKey(buf[:0], datum)
+					key, err := encodeTableKey(append(buf[:0], sep...), datum)
 					if err != nil {
 						panic(err)
 					}
@@ -647,14 +809,14 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 				// An IS NULL expressions allows us to constrain the end of the range
 				// to stop at NULL.
 				for i := range spans {
-					spans[i].end = encoding.EncodeNotNull(spans[i].end)
+					spans[i].end = encoding.EncodeNotNull(append(spans[i].end, sep...))
 				}
 			default:
 				if datum, ok := c.end.Right.(parser.Datum); ok {
 					if lastEnd && c.end.Operator != parser.LT {
 						datum = datum.Next()
 					}
-					key, err := encodeTableKey(buf[:0], datum)
+					key, err := encodeTableKey(append(buf[:0], sep...), datum)
 					if err != nil {
 						panic(err)
 					}
@@ -668,7 +830,7 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 					// This is the first constraint for which we don't have a start
 					// constraint. Add a not-NULL endpoint.
 					for i := range spans {
-						spans[i].start = encoding.EncodeNotNull(spans[i].start)
+						spans[i].start = encoding.EncodeNotNull(append(spans[i].start, sep...))
 					}
 				}
 			}
@@ -0,0 +1,90 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestSelectForUpdateLocksReturnedRows verifies that SELECT ... FOR UPDATE
+// only locks the rows it returns, by writing their sentinel keys, and not the
+// other rows it scans.
+func TestSelectForUpdateLocksReturnedRows(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT, w INT, FAMILY (k, v), FAMILY (w));
+INSERT INTO t.kv VALUES (1, 2, 3), (3, 4, 5), (5, 6, 7);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	nameKey := sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := sql.TableDescriptor{}
+	if err := kvDB.GetProto(sql.MakeDescMetadataKey(sql.ID(gr.ValueInt())), &desc); err != nil {
+		t.Fatal(err)
+	}
+	tablePrefix := sql.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID)
+
+	var mu sync.Mutex
+	var puts []roachpb.Key
+	storage.TestingCommandFilter = func(args roachpb.Request, h roachpb.Header) error {
+		if put, ok := args.(*roachpb.PutRequest); ok && bytes.HasPrefix(put.Key, tablePrefix) {
+			mu.Lock()
+			puts = append(puts, put.Key)
+			mu.Unlock()
+		}
+		return checkEndTransactionTrigger(args, h)
+	}
+
+	// The filter on v is not a constraint of the primary index, so all of
+	// the rows are scanned, but only row 3 is returned and locked.
+	var k int
+	if err := sqlDB.QueryRow(`SELECT k FROM t.kv WHERE v = 4 FOR UPDATE`).Scan(&k); err != nil {
+		t.Fatal(err)
+	}
+	if k != 3 {
+		t.Fatalf("expected row 3, got %d", k)
+	}
+
+	expected := roachpb.Key(encoding.EncodeVarint(append([]byte(nil), tablePrefix...), 3))
+	mu.Lock()
+	defer mu.Unlock()
+	if len(puts) == 0 {
+		t.Fatal("expected the returned row to be locked")
+	}
+	for _, key := range puts {
+		if !key.Equal(expected) {
+			t.Errorf("expected only the sentinel key %s to be locked, found %s", expected, key)
+		}
+	}
+}
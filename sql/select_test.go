@@ -18,8 +18,10 @@
 package sql
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
@@ -232,13 +234,71 @@ func TestMakeSpans(t *testing.T) {
 	for _, d := range testData {
 		desc, index := makeTestIndex(t, d.columns)
 		constraints := makeConstraints(t, d.expr, desc, index)
-		spans := makeSpans(constraints, desc.ID, index.ID)
+		spans := makeSpans(constraints, desc, index)
 		if s := prettySpans(spans, 2); d.expected != s {
 			t.Errorf("%s: expected %s, but found %s", d.expr, d.expected, s)
 		}
 	}
 }
 
+// TestMakeSpansInterleaved verifies that the spans of an interleaved index
+// follow the interleaved key layout, so that a lookup only scans the rows
+// sharing the constrained columns instead of the whole interleave hierarchy.
+func TestMakeSpansInterleaved(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	child := []InterleaveDescriptor_Ancestor{
+		{TableID: 50, IndexID: 1, SharedPrefixLen: 1},
+	}
+	grandchild := []InterleaveDescriptor_Ancestor{
+		{TableID: 50, IndexID: 1, SharedPrefixLen: 1},
+		{TableID: 60, IndexID: 1, SharedPrefixLen: 1},
+	}
+	type row [2]int
+	testData := []struct {
+		ancestors []InterleaveDescriptor_Ancestor
+		expr      string
+		in, out   []row // Rows inside and outside of the spans
+	}{
+		{child, `a = 1`, []row{{1, 1}, {1, 9}}, []row{{0, 9}, {2, 1}}},
+		{child, `a = 1 AND b = 2`, []row{{1, 2}}, []row{{1, 1}, {1, 3}, {2, 2}}},
+		{child, `a = 1 AND b > 2`, []row{{1, 3}, {1, 9}}, []row{{1, 2}, {2, 3}}},
+		{child, `a = 1 AND b IN (2, 4)`, []row{{1, 2}, {1, 4}}, []row{{1, 3}, {2, 2}}},
+		{grandchild, `a = 1 AND b = 2`, []row{{1, 2}}, []row{{1, 1}, {1, 3}, {2, 2}}},
+	}
+	for _, d := range testData {
+		desc, index := makeTestIndex(t, []string{"a", "b"})
+		index.Interleave.Ancestors = d.ancestors
+		constraints := makeConstraints(t, d.expr, desc, index)
+		spans := makeSpans(constraints, desc, index)
+
+		colMap := map[ColumnID]int{index.ColumnIDs[0]: 0, index.ColumnIDs[1]: 1}
+		contains := func(r row) bool {
+			key, _, err := makeIndexKey(desc, index, colMap,
+				[]parser.Datum{parser.DInt(r[0]), parser.DInt(r[1])})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range spans {
+				if bytes.Compare(s.start, key) <= 0 && bytes.Compare(key, s.end) < 0 {
+					return true
+				}
+			}
+			return false
+		}
+		for _, r := range d.in {
+			if !contains(r) {
+				t.Errorf("%s: expected row %v in spans %s", d.expr, r, prettySpans(spans, 0))
+			}
+		}
+		for _, r := range d.out {
+			if contains(r) {
+				t.Errorf("%s: expected row %v outside of spans %s", d.expr, r, prettySpans(spans, 0))
+			}
+		}
+	}
+}
+
 func TestExactPrefix(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
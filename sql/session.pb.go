@@ -31,6 +31,19 @@ type Session struct {
 	//	*Session_Location
 	//	*Session_Offset
 	Timezone isSession_Timezone `protobuf_oneof:"timezone"`
+	// The isolation level used by transactions which do not specify one.
+	DefaultIsolation cockroach_roachpb1.IsolationType `protobuf:"varint,7,opt,name=default_isolation,enum=cockroach.roachpb.IsolationType" json:"default_isolation"`
+	// The name of the client application, reported by SHOW APPLICATION_NAME.
+	ApplicationName string `protobuf:"bytes,8,opt,name=application_name" json:"application_name"`
+	// The maximum duration of a single statement in nanoseconds. Zero
+	// disables the timeout.
+	StatementTimeout int64 `protobuf:"varint,9,opt,name=statement_timeout" json:"statement_timeout"`
+	// Databases which are consulted, in order, when resolving a table name
+	// which is not found in the session database.
+	SearchPath []string `protobuf:"bytes,10,rep,name=search_path" json:"search_path,omitempty"`
+	// The maximum number of rows returned by a single statement. Zero
+	// disables the limit.
+	ResultRowLimit int64 `protobuf:"varint,11,opt,name=result_row_limit" json:"result_row_limit"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -102,6 +115,41 @@ func (m *Session) GetOffset() int64 {
 	return 0
 }
 
+func (m *Session) GetDefaultIsolation() cockroach_roachpb1.IsolationType {
+	if m != nil {
+		return m.DefaultIsolation
+	}
+	return cockroach_roachpb1.SERIALIZABLE
+}
+
+func (m *Session) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *Session) GetStatementTimeout() int64 {
+	if m != nil {
+		return m.StatementTimeout
+	}
+	return 0
+}
+
+func (m *Session) GetSearchPath() []string {
+	if m != nil {
+		return m.SearchPath
+	}
+	return nil
+}
+
+func (m *Session) GetResultRowLimit() int64 {
+	if m != nil {
+		return m.ResultRowLimit
+	}
+	return 0
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Session) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), []interface{}) {
 	return _Session_OneofMarshaler, _Session_OneofUnmarshaler, []interface{}{
@@ -154,6 +202,13 @@ type Session_Transaction struct {
 	// Timestamp to be used by SQL in the above transaction. Note: this is not the
 	// transaction timestamp in roachpb.Transaction above.
 	Timestamp cockroach_sql_driver.Datum_Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp"`
+	// Savepoints established in the transaction, in the order they were
+	// created.
+	Savepoints []Session_Savepoint `protobuf:"bytes,3,rep,name=savepoints" json:"savepoints"`
+	// Indicates that a statement in the transaction failed and the
+	// transaction can only be rolled back, either entirely or to a
+	// savepoint.
+	Failed bool `protobuf:"varint,4,opt,name=failed" json:"failed"`
 }
 
 func (m *Session_Transaction) Reset()         { *m = Session_Transaction{} }
@@ -174,6 +229,45 @@ func (m *Session_Transaction) GetTimestamp() cockroach_sql_driver.Datum_Timestam
 	return cockroach_sql_driver.Datum_Timestamp{}
 }
 
+func (m *Session_Transaction) GetSavepoints() []Session_Savepoint {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
+func (m *Session_Transaction) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+type Session_Savepoint struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	// The transaction sequence number at the time the savepoint was
+	// created.
+	Sequence int32 `protobuf:"varint,2,opt,name=sequence" json:"sequence"`
+}
+
+func (m *Session_Savepoint) Reset()         { *m = Session_Savepoint{} }
+func (m *Session_Savepoint) String() string { return proto.CompactTextString(m) }
+func (*Session_Savepoint) ProtoMessage()    {}
+
+func (m *Session_Savepoint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Session_Savepoint) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func (m *Session) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -221,6 +315,34 @@ func (m *Session) MarshalTo(data []byte) (int, error) {
 		}
 		i += nn2
 	}
+	data[i] = 0x38
+	i++
+	i = encodeVarintSession(data, i, uint64(m.DefaultIsolation))
+	data[i] = 0x42
+	i++
+	i = encodeVarintSession(data, i, uint64(len(m.ApplicationName)))
+	i += copy(data[i:], m.ApplicationName)
+	data[i] = 0x48
+	i++
+	i = encodeVarintSession(data, i, uint64(m.StatementTimeout))
+	if len(m.SearchPath) > 0 {
+		for _, s := range m.SearchPath {
+			data[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	data[i] = 0x58
+	i++
+	i = encodeVarintSession(data, i, uint64(m.ResultRowLimit))
 	return i, nil
 }
 
@@ -270,6 +392,51 @@ func (m *Session_Transaction) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n4
+	if len(m.Savepoints) > 0 {
+		for _, msg := range m.Savepoints {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintSession(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x20
+	i++
+	if m.Failed {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *Session_Savepoint) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Session_Savepoint) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintSession(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintSession(data, i, uint64(m.Sequence))
 	return i, nil
 }
 
@@ -314,6 +481,17 @@ func (m *Session) Size() (n int) {
 	if m.Timezone != nil {
 		n += m.Timezone.Size()
 	}
+	n += 1 + sovSession(uint64(m.DefaultIsolation))
+	l = len(m.ApplicationName)
+	n += 1 + l + sovSession(uint64(l))
+	n += 1 + sovSession(uint64(m.StatementTimeout))
+	if len(m.SearchPath) > 0 {
+		for _, s := range m.SearchPath {
+			l = len(s)
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	n += 1 + sovSession(uint64(m.ResultRowLimit))
 	return n
 }
 
@@ -337,6 +515,22 @@ func (m *Session_Transaction) Size() (n int) {
 	n += 1 + l + sovSession(uint64(l))
 	l = m.Timestamp.Size()
 	n += 1 + l + sovSession(uint64(l))
+	if len(m.Savepoints) > 0 {
+		for _, e := range m.Savepoints {
+			l = e.Size()
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *Session_Savepoint) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovSession(uint64(l))
+	n += 1 + sovSession(uint64(m.Sequence))
 	return n
 }
 
@@ -532,6 +726,121 @@ func (m *Session) Unmarshal(data []byte) error {
 				}
 			}
 			m.Timezone = &Session_Offset{v}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultIsolation", wireType)
+			}
+			m.DefaultIsolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DefaultIsolation |= (cockroach_roachpb1.IsolationType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatementTimeout", wireType)
+			}
+			m.StatementTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.StatementTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SearchPath = append(m.SearchPath, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultRowLimit", wireType)
+			}
+			m.ResultRowLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ResultRowLimit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSession(data[iNdEx:])
@@ -642,6 +951,156 @@ func (m *Session_Transaction) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Savepoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Savepoints = append(m.Savepoints, Session_Savepoint{})
+			if err := m.Savepoints[len(m.Savepoints)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Session_Savepoint) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Session_Savepoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Session_Savepoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sequence |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSession(data[iNdEx:])
@@ -32,36 +32,20 @@ func (p *planner) Set(n *parser.Set) (planNode, error) {
 	// By using QualifiedName.String() here any variables that are keywords will
 	// be double quoted.
 	name := strings.ToUpper(n.Name.String())
-	switch name {
-	case `DATABASE`:
-		dbName, err := p.getStringVal(name, n.Values)
-		if err != nil {
-			return nil, err
-		}
-		if len(dbName) != 0 {
-			// Verify database descriptor exists.
-			if _, err := p.getDatabaseDesc(dbName); err != nil {
-				return nil, err
-			}
-		}
-		p.session.Database = dbName
-
-	case `SYNTAX`:
-		s, err := p.getStringVal(name, n.Values)
-		if err != nil {
+	v, ok := varGen[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown variable: %q", name)
+	}
+	if v.set == nil {
+		return nil, fmt.Errorf("variable %q cannot be changed", name)
+	}
+	// A nil Values indicates SET ... = DEFAULT or RESET.
+	if n.Values == nil {
+		if err := v.reset(p); err != nil {
 			return nil, err
 		}
-		switch normalizeName(string(s)) {
-		case normalizeName(parser.Modern.String()):
-			p.session.Syntax = int32(parser.Modern)
-		case normalizeName(parser.Traditional.String()):
-			p.session.Syntax = int32(parser.Traditional)
-		default:
-			return nil, fmt.Errorf("%s: \"%s\" is not in (%q, %q)", name, s, parser.Modern, parser.Traditional)
-		}
-
-	default:
-		return nil, fmt.Errorf("unknown variable: %q", name)
+	} else if err := v.set(p, name, n.Values); err != nil {
+		return nil, err
 	}
 	return &valuesNode{}, nil
 }
@@ -82,6 +66,22 @@ func (p *planner) getStringVal(name string, values parser.Exprs) (string, error)
 	return string(s), nil
 }
 
+func (p *planner) getIntVal(name string, values parser.Exprs) (int64, error) {
+	if len(values) != 1 {
+		return 0, fmt.Errorf("%s: requires a single integer value", name)
+	}
+	val, err := p.evalCtx.EvalExpr(values[0])
+	if err != nil {
+		return 0, err
+	}
+	i, ok := val.(parser.DInt)
+	if !ok {
+		return 0, fmt.Errorf("%s: requires a single integer value: %s is a %s",
+			name, values[0], val.Type())
+	}
+	return int64(i), nil
+}
+
 func (p *planner) SetTimeZone(n *parser.SetTimeZone) (planNode, error) {
 	d, err := p.evalCtx.EvalExpr(n.Value)
 	if err != nil {
@@ -28,29 +28,33 @@ import (
 	"github.com/cockroachdb/cockroach/util/encoding"
 )
 
-// Show a session-local variable name.
+// Show a session-local variable name. SHOW ALL returns every session
+// variable along with its value.
 func (p *planner) Show(n *parser.Show) (planNode, error) {
 	name := strings.ToUpper(n.Name)
 
-	v := &valuesNode{columns: []string{name}}
-
-	switch name {
-	case `DATABASE`:
-		v.rows = append(v.rows, []parser.Datum{parser.DString(p.session.Database)})
-	case `TIME ZONE`:
-		loc, err := p.evalCtx.GetLocation()
-		if err != nil {
-			return nil, err
+	if name == `ALL` {
+		v := &valuesNode{columns: []string{"Variable", "Value"}}
+		for _, vName := range varNames {
+			s, err := varGen[vName].get(p)
+			if err != nil {
+				return nil, err
+			}
+			v.rows = append(v.rows, []parser.Datum{parser.DString(vName), parser.DString(s)})
 		}
-		v.rows = append(v.rows, []parser.Datum{parser.DString(loc.String())})
-	case `SYNTAX`:
-		v.rows = append(v.rows, []parser.Datum{parser.DString(parser.Syntax(p.session.Syntax).String())})
-	case `TRANSACTION ISOLATION LEVEL`:
-		v.rows = append(v.rows, []parser.Datum{parser.DString(p.txn.Proto.Isolation.String())})
-	default:
-		return nil, fmt.Errorf("unknown variable: %q", name)
+		return v, nil
 	}
 
+	gen, ok := varGen[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown variable: %q", name)
+	}
+	s, err := gen.get(p)
+	if err != nil {
+		return nil, err
+	}
+	v := &valuesNode{columns: []string{name}}
+	v.rows = append(v.rows, []parser.Datum{parser.DString(s)})
 	return v, nil
 }
 
@@ -123,7 +127,7 @@ func (p *planner) ShowGrants(n *parser.ShowGrants) (planNode, error) {
 		objectType = "Table"
 	}
 
-	v := &valuesNode{columns: []string{objectType, "User", "Privileges"}}
+	v := &valuesNode{columns: []string{objectType, "User", "Privileges", "Via"}}
 	var wantedUsers map[string]struct{}
 	if len(n.Grantees) != 0 {
 		wantedUsers = make(map[string]struct{})
@@ -146,6 +150,26 @@ func (p *planner) ShowGrants(n *parser.ShowGrants) (planNode, error) {
 			parser.DString(descriptor.GetName()),
 			parser.DString(userPriv.User),
 			parser.DString(userPriv.Privileges),
+			parser.DNull,
+		})
+	}
+
+	// List the privileges inherited through roles after the direct ones.
+	memberships, err := p.getRoleMemberships()
+	if err != nil {
+		return nil, err
+	}
+	for _, inherited := range descriptor.GetPrivileges().ShowInherited(memberships) {
+		if wantedUsers != nil {
+			if _, ok := wantedUsers[inherited.User]; !ok {
+				continue
+			}
+		}
+		v.rows = append(v.rows, []parser.Datum{
+			parser.DString(descriptor.GetName()),
+			parser.DString(inherited.User),
+			parser.DString(inherited.Privileges),
+			parser.DString(strings.Join(inherited.Via, " -> ")),
 		})
 	}
 	return v, nil
@@ -20,13 +20,15 @@ package sql
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
 )
 
-// ID, ColumnID, and IndexID are all uint32, but are each given a
+// ID, ColumnID, IndexID and FamilyID are all uint32, but are each given a
 // type alias to prevent accidental use of one of the types where
 // another is expected.
 
@@ -39,9 +41,15 @@ type ColumnID uint32
 // IndexID is a custom type for IndexDescriptor IDs.
 type IndexID uint32
 
+// FamilyID is a custom type for FamilyDescriptor IDs.
+type FamilyID uint32
+
 const (
 	// PrimaryKeyIndexName is the name of the index for the primary key.
 	PrimaryKeyIndexName = "primary"
+	// PrimaryFamilyName is the name of the column family created for a table
+	// which does not specify any families.
+	PrimaryFamilyName = "primary"
 )
 
 var errMissingColumns = errors.New("table must contain at least 1 column")
@@ -61,7 +69,10 @@ func validateName(name, typ string) error {
 func (desc *IndexDescriptor) allocateName(tableDesc *TableDescriptor) {
 	segments := make([]string, 0, len(desc.ColumnIDs)+2)
 	segments = append(segments, tableDesc.Name)
-	for _, columnName := range desc.ColumnNames {
+	for i, columnName := range desc.ColumnNames {
+		if desc.isExpr(i) {
+			columnName = "expr"
+		}
 		segments = append(segments, columnName)
 	}
 	if desc.Unique {
@@ -84,6 +95,34 @@ func (desc *IndexDescriptor) allocateName(tableDesc *TableDescriptor) {
 	desc.Name = name
 }
 
+// allocateName sets desc.Name to a value that is not equalName to any of
+// tableDesc's families. The name is derived from the family ID and the names
+// of the family's columns.
+func (desc *FamilyDescriptor) allocateName(tableDesc *TableDescriptor) {
+	segments := make([]string, 0, len(desc.ColumnNames)+2)
+	segments = append(segments, "fam", strconv.Itoa(int(desc.ID)))
+	segments = append(segments, desc.ColumnNames...)
+
+	baseName := strings.Join(segments, "_")
+	name := baseName
+
+	exists := func(name string) bool {
+		_, err := tableDesc.FindFamilyByName(name)
+		return err == nil
+	}
+	for i := 1; exists(name); i++ {
+		name = fmt.Sprintf("%s%d", baseName, i)
+	}
+
+	desc.Name = name
+}
+
+// isExpr returns true if the i-th element of the index is an expression rather
+// than a column.
+func (desc *IndexDescriptor) isExpr(i int) bool {
+	return i < len(desc.ColumnExprs) && desc.ColumnExprs[i] != ""
+}
+
 // containsColumnID returns true if the index descriptor contains the specified
 // column ID either in its explicit column IDs or the implicit "extra" column
 // IDs.
@@ -101,6 +140,18 @@ func (desc *IndexDescriptor) containsColumnID(colID ColumnID) bool {
 	return false
 }
 
+// isInterleaved returns true if any index of the table is interleaved into
+// the index of another table or has indexes of other tables interleaved into
+// it.
+func (desc *TableDescriptor) isInterleaved() bool {
+	for _, index := range append([]IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...) {
+		if len(index.Interleave.Ancestors) > 0 || len(index.InterleavedBy) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // fullColumnIDs returns the index column IDs including any implicit column IDs
 // for non-unique indexes.
 func (desc *IndexDescriptor) fullColumnIDs() []ColumnID {
@@ -150,6 +201,8 @@ func (desc *TableDescriptor) AllocateIDs() error {
 		desc.Columns[i].ID = columnID
 	}
 
+	desc.allocateFamilyIDs(columnNames)
+
 	// Keep track of unnamed indexes.
 	anonymousIndexes := make([]*IndexDescriptor, 0, len(desc.Indexes))
 
@@ -181,7 +234,14 @@ func (desc *TableDescriptor) AllocateIDs() error {
 				index.ColumnIDs = append(index.ColumnIDs, 0)
 			}
 			if index.ColumnIDs[j] == 0 {
-				index.ColumnIDs[j] = columnNames[normalizeName(colName)]
+				if index.isExpr(j) {
+					// The value of an expression is encoded in place of a column
+					// value, so the expression gets an ID of its own.
+					index.ColumnIDs[j] = desc.NextColumnID
+					desc.NextColumnID++
+				} else {
+					index.ColumnIDs[j] = columnNames[normalizeName(colName)]
+				}
 			}
 		}
 		if index != &desc.PrimaryIndex {
@@ -224,11 +284,105 @@ func (desc *TableDescriptor) AllocateIDs() error {
 	return err
 }
 
+// allocateFamilyIDs populates the IDs of the column families and of their
+// columns. The columns which are not part of any family are added to the
+// first family, which is created if the table does not have any families.
+func (desc *TableDescriptor) allocateFamilyIDs(columnNames map[string]ColumnID) {
+	if len(desc.Families) == 0 {
+		desc.Families = []FamilyDescriptor{{Name: PrimaryFamilyName}}
+	}
+	if desc.NextFamilyID == 0 {
+		// The families of a new table are numbered in the order they were
+		// specified. The first family gets ID 0 and shares its key with the row
+		// sentinel.
+		for i := range desc.Families {
+			desc.Families[i].ID = FamilyID(i)
+		}
+		desc.NextFamilyID = FamilyID(len(desc.Families))
+	}
+
+	columnsInFamilies := map[ColumnID]struct{}{}
+	for i := range desc.Families {
+		family := &desc.Families[i]
+		for j, colName := range family.ColumnNames {
+			if len(family.ColumnIDs) <= j {
+				family.ColumnIDs = append(family.ColumnIDs, 0)
+			}
+			if family.ColumnIDs[j] == 0 {
+				family.ColumnIDs[j] = columnNames[normalizeName(colName)]
+			}
+			columnsInFamilies[family.ColumnIDs[j]] = struct{}{}
+		}
+	}
+	for _, col := range desc.Columns {
+		if _, ok := columnsInFamilies[col.ID]; !ok {
+			desc.Families[0].ColumnNames = append(desc.Families[0].ColumnNames, col.Name)
+			desc.Families[0].ColumnIDs = append(desc.Families[0].ColumnIDs, col.ID)
+		}
+	}
+
+	for i := range desc.Families {
+		if len(desc.Families[i].Name) == 0 {
+			desc.Families[i].allocateName(desc)
+		}
+	}
+}
+
+// allocateColumnIDFamilies stores every column that is not part of the primary
+// key in a column family of its own whose ID is the column ID and whose value
+// is the value of that single column. The key of such a family is the primary
+// key followed by the column ID, which is the layout used by the system tables
+// and by the tables created before column families were introduced. The
+// primary key columns are placed in family 0, whose key is the row sentinel.
+func (desc *TableDescriptor) allocateColumnIDFamilies() {
+	families := []FamilyDescriptor{{Name: PrimaryFamilyName}}
+	desc.Families = nil
+	for _, col := range desc.Columns {
+		if desc.PrimaryIndex.containsColumnID(col.ID) {
+			families[0].ColumnNames = append(families[0].ColumnNames, col.Name)
+			families[0].ColumnIDs = append(families[0].ColumnIDs, col.ID)
+			continue
+		}
+		family := FamilyDescriptor{
+			ID:              FamilyID(col.ID),
+			ColumnNames:     []string{col.Name},
+			ColumnIDs:       []ColumnID{col.ID},
+			DefaultColumnID: col.ID,
+		}
+		family.allocateName(desc)
+		families = append(families, family)
+		if desc.NextFamilyID <= family.ID {
+			desc.NextFamilyID = family.ID + 1
+		}
+	}
+	// The keys of dropped columns may still exist. Do not reuse their IDs for
+	// new families.
+	if desc.NextFamilyID < FamilyID(desc.NextColumnID) {
+		desc.NextFamilyID = FamilyID(desc.NextColumnID)
+	}
+	desc.Families = families
+}
+
+// maybeUpgradeFormat upgrades a table descriptor written before column
+// families were introduced, which does not have any families, to the
+// equivalent family layout (see allocateColumnIDFamilies). Returns true if
+// the descriptor was upgraded.
+func (desc *TableDescriptor) maybeUpgradeFormat() bool {
+	if len(desc.Families) > 0 {
+		return false
+	}
+	desc.allocateColumnIDFamilies()
+	return true
+}
+
 // Validate validates that the table descriptor is well formed. Checks include
-// validating the table, column and index names, verifying that column names
-// and index names are unique and verifying that column IDs and index IDs are
-// consistent.
+// validating the table, column, index and family names, verifying that column
+// names, index names and family names are unique, verifying that column IDs,
+// index IDs and family IDs are consistent and verifying that every column
+// belongs to exactly one family. A descriptor written before column families
+// were introduced is upgraded first (see maybeUpgradeFormat).
 func (desc *TableDescriptor) Validate() error {
+	desc.maybeUpgradeFormat()
 	if err := validateName(desc.Name, "table"); err != nil {
 		return err
 	}
@@ -314,7 +468,20 @@ func (desc *TableDescriptor) Validate() error {
 			return fmt.Errorf("index \"%s\" must contain at least 1 column", index.Name)
 		}
 
+		if len(index.ColumnExprs) > 0 && len(index.ColumnExprs) != len(index.ColumnNames) {
+			return fmt.Errorf("mismatched column expressions (%d) and names (%d)",
+				len(index.ColumnExprs), len(index.ColumnNames))
+		}
+
 		for i, name := range index.ColumnNames {
+			if index.isExpr(i) {
+				id := index.ColumnIDs[i]
+				if _, ok := columnIDs[id]; ok || id == 0 || id >= desc.NextColumnID {
+					return fmt.Errorf("index \"%s\" expression \"%s\" has invalid ID %d",
+						index.Name, index.ColumnExprs[i], id)
+				}
+				continue
+			}
 			colID, ok := columnNames[normalizeName(name)]
 			if !ok {
 				return fmt.Errorf("index \"%s\" contains unknown column \"%s\"", index.Name, name)
@@ -323,17 +490,105 @@ func (desc *TableDescriptor) Validate() error {
 				return fmt.Errorf("index \"%s\" column \"%s\" should have ID %d, but found ID %d",
 					index.Name, name, colID, index.ColumnIDs[i])
 			}
+			// Array and JSONB values are not encoded in a way that preserves their
+			// order. Indexing JSONB containment queries will need an inverted
+			// index rather than an ordered one.
+			if col, err := desc.FindColumnByID(colID); err == nil && !col.Type.indexable() {
+				return fmt.Errorf("index \"%s\" column \"%s\" is of type %s and thus is not indexable",
+					index.Name, name, col.Type.SQLString())
+			}
 		}
 	}
+
+	if err := desc.validateFamilies(columnNames); err != nil {
+		return err
+	}
+
 	// Validate the privilege descriptor.
 	return desc.Privileges.Validate(desc.GetID())
 }
 
+func (desc *TableDescriptor) validateFamilies(columnNames map[string]ColumnID) error {
+	if len(desc.Families) == 0 {
+		return fmt.Errorf("table must contain at least 1 column family")
+	}
+	if desc.Families[0].ID != 0 {
+		return fmt.Errorf("the first column family must have ID 0, not %d", desc.Families[0].ID)
+	}
+
+	familyNames := map[string]struct{}{}
+	familyIDs := map[FamilyID]string{}
+	columnFamilies := map[ColumnID]string{}
+	for _, family := range desc.Families {
+		if err := validateName(family.Name, "family"); err != nil {
+			return err
+		}
+
+		if _, ok := familyNames[normalizeName(family.Name)]; ok {
+			return fmt.Errorf("duplicate family name: \"%s\"", family.Name)
+		}
+		familyNames[normalizeName(family.Name)] = struct{}{}
+
+		if other, ok := familyIDs[family.ID]; ok {
+			return fmt.Errorf("family \"%s\" duplicate ID of family \"%s\": %d",
+				family.Name, other, family.ID)
+		}
+		familyIDs[family.ID] = family.Name
+
+		if family.ID >= desc.NextFamilyID {
+			return fmt.Errorf("family \"%s\" invalid family ID (%d) > next family ID (%d)",
+				family.Name, family.ID, desc.NextFamilyID)
+		}
+
+		if len(family.ColumnIDs) != len(family.ColumnNames) {
+			return fmt.Errorf("mismatched column IDs (%d) and names (%d)",
+				len(family.ColumnIDs), len(family.ColumnNames))
+		}
+
+		for i, name := range family.ColumnNames {
+			colID, ok := columnNames[normalizeName(name)]
+			if !ok {
+				return fmt.Errorf("family \"%s\" contains unknown column \"%s\"", family.Name, name)
+			}
+			if colID != family.ColumnIDs[i] {
+				return fmt.Errorf("family \"%s\" column \"%s\" should have ID %d, but found ID %d",
+					family.Name, name, colID, family.ColumnIDs[i])
+			}
+			if other, ok := columnFamilies[colID]; ok {
+				return fmt.Errorf("column \"%s\" is in both family \"%s\" and \"%s\"",
+					name, other, family.Name)
+			}
+			columnFamilies[colID] = family.Name
+		}
+
+		if family.DefaultColumnID != 0 {
+			for _, id := range family.ColumnIDs {
+				if id != family.DefaultColumnID && !desc.PrimaryIndex.containsColumnID(id) {
+					return fmt.Errorf("family \"%s\" with default column %d contains other column %d",
+						family.Name, family.DefaultColumnID, id)
+				}
+			}
+		}
+	}
+
+	for _, column := range desc.Columns {
+		if _, ok := columnFamilies[column.ID]; !ok {
+			return fmt.Errorf("column \"%s\" is not in any column family", column.Name)
+		}
+	}
+	return nil
+}
+
 // AddColumn adds a column to the table.
 func (desc *TableDescriptor) AddColumn(col ColumnDescriptor) {
 	desc.Columns = append(desc.Columns, col)
 }
 
+// AddFamily adds a column family to the table.
+func (desc *TableDescriptor) AddFamily(family FamilyDescriptor) {
+	desc.Families = append(desc.Families, family)
+}
+
 // AddIndex adds an index to the table.
 func (desc *TableDescriptor) AddIndex(idx IndexDescriptor, primary bool) error {
 	if primary {
@@ -373,6 +628,39 @@ func (desc *TableDescriptor) FindColumnByID(id ColumnID) (*ColumnDescriptor, err
 	return nil, util.Errorf("column-id \"%d\" does not exist", id)
 }
 
+// findIndexExprByID finds the expression of the index element with the
+// specified ID.
+func (desc *TableDescriptor) findIndexExprByID(id ColumnID) (string, bool) {
+	for _, index := range desc.Indexes {
+		for i, exprID := range index.ColumnIDs {
+			if exprID == id && index.isExpr(i) {
+				return index.ColumnExprs[i], true
+			}
+		}
+	}
+	return "", false
+}
+
+// FindFamilyByName finds the column family with specified name.
+func (desc *TableDescriptor) FindFamilyByName(name string) (*FamilyDescriptor, error) {
+	for i, f := range desc.Families {
+		if equalName(f.Name, name) {
+			return &desc.Families[i], nil
+		}
+	}
+	return nil, util.Errorf("family %q does not exist", name)
+}
+
+// FindFamilyByID finds the column family with specified ID.
+func (desc *TableDescriptor) FindFamilyByID(id FamilyID) (*FamilyDescriptor, error) {
+	for i, f := range desc.Families {
+		if f.ID == id {
+			return &desc.Families[i], nil
+		}
+	}
+	return nil, util.Errorf("family-id \"%d\" does not exist", id)
+}
+
 // FindIndexByName finds the index with specified name.
 func (desc *TableDescriptor) FindIndexByName(name string) (*IndexDescriptor, error) {
 	for i, idx := range desc.Indexes {
@@ -395,6 +683,15 @@ func (desc *TableDescriptor) FindIndexByID(id IndexID) (*IndexDescriptor, error)
 	return nil, util.Errorf("index-id \"%d\" does not exist", id)
 }
 
+// indexable returns true if values of the type can be used in index keys.
+func (c *ColumnType) indexable() bool {
+	switch c.Kind {
+	case ColumnType_ARRAY, ColumnType_JSONB:
+		return false
+	}
+	return true
+}
+
 // SQLString returns the SQL string corresponding to the type.
 func (c *ColumnType) SQLString() string {
 	switch c.Kind {
@@ -413,6 +710,14 @@ func (c *ColumnType) SQLString() string {
 			}
 			return fmt.Sprintf("%s(%d)", c.Kind.String(), c.Precision)
 		}
+	case ColumnType_ARRAY:
+		return fmt.Sprintf("%s[]", c.ArrayContents.String())
+	case ColumnType_COLLATEDSTRING:
+		locale := parser.Name(c.Locale).String()
+		if c.Width > 0 {
+			return fmt.Sprintf("%s(%d) COLLATE %s", ColumnType_STRING.String(), c.Width, locale)
+		}
+		return fmt.Sprintf("%s COLLATE %s", ColumnType_STRING.String(), locale)
 	}
 	return c.Kind.String()
 }
@@ -22,38 +22,59 @@ var _ = math.Inf
 type ColumnType_Kind int32
 
 const (
-	ColumnType_BOOL      ColumnType_Kind = 0
-	ColumnType_INT       ColumnType_Kind = 1
-	ColumnType_FLOAT     ColumnType_Kind = 2
-	ColumnType_DECIMAL   ColumnType_Kind = 3
-	ColumnType_DATE      ColumnType_Kind = 4
-	ColumnType_TIMESTAMP ColumnType_Kind = 5
-	ColumnType_INTERVAL  ColumnType_Kind = 6
-	ColumnType_STRING    ColumnType_Kind = 7
-	ColumnType_BYTES     ColumnType_Kind = 8
+	ColumnType_BOOL           ColumnType_Kind = 0
+	ColumnType_INT            ColumnType_Kind = 1
+	ColumnType_FLOAT          ColumnType_Kind = 2
+	ColumnType_DECIMAL        ColumnType_Kind = 3
+	ColumnType_DATE           ColumnType_Kind = 4
+	ColumnType_TIMESTAMP      ColumnType_Kind = 5
+	ColumnType_INTERVAL       ColumnType_Kind = 6
+	ColumnType_STRING         ColumnType_Kind = 7
+	ColumnType_BYTES          ColumnType_Kind = 8
+	ColumnType_ARRAY          ColumnType_Kind = 9
+	ColumnType_JSONB          ColumnType_Kind = 10
+	ColumnType_TIMESTAMPTZ    ColumnType_Kind = 11
+	ColumnType_TIME           ColumnType_Kind = 12
+	ColumnType_COLLATEDSTRING ColumnType_Kind = 13
+	ColumnType_UUID           ColumnType_Kind = 14
+	ColumnType_INET           ColumnType_Kind = 15
 )
 
 var ColumnType_Kind_name = map[int32]string{
-	0: "BOOL",
-	1: "INT",
-	2: "FLOAT",
-	3: "DECIMAL",
-	4: "DATE",
-	5: "TIMESTAMP",
-	6: "INTERVAL",
-	7: "STRING",
-	8: "BYTES",
+	0:  "BOOL",
+	1:  "INT",
+	2:  "FLOAT",
+	3:  "DECIMAL",
+	4:  "DATE",
+	5:  "TIMESTAMP",
+	6:  "INTERVAL",
+	7:  "STRING",
+	8:  "BYTES",
+	9:  "ARRAY",
+	10: "JSONB",
+	11: "TIMESTAMPTZ",
+	12: "TIME",
+	13: "COLLATEDSTRING",
+	14: "UUID",
+	15: "INET",
 }
 var ColumnType_Kind_value = map[string]int32{
-	"BOOL":      0,
-	"INT":       1,
-	"FLOAT":     2,
-	"DECIMAL":   3,
-	"DATE":      4,
-	"TIMESTAMP": 5,
-	"INTERVAL":  6,
-	"STRING":    7,
-	"BYTES":     8,
+	"BOOL":           0,
+	"INT":            1,
+	"FLOAT":          2,
+	"DECIMAL":        3,
+	"DATE":           4,
+	"TIMESTAMP":      5,
+	"INTERVAL":       6,
+	"STRING":         7,
+	"BYTES":          8,
+	"ARRAY":          9,
+	"JSONB":          10,
+	"TIMESTAMPTZ":    11,
+	"TIME":           12,
+	"COLLATEDSTRING": 13,
+	"UUID":           14,
+	"INET":           15,
 }
 
 func (x ColumnType_Kind) Enum() *ColumnType_Kind {
@@ -73,12 +94,53 @@ func (x *ColumnType_Kind) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// AuditMode controls which statements touching the table are recorded in
+// the audit log.
+type TableDescriptor_AuditMode int32
+
+const (
+	// Statements are not audited.
+	TableDescriptor_DISABLED TableDescriptor_AuditMode = 0
+	// Statements reading or writing the table are audited.
+	TableDescriptor_READWRITE TableDescriptor_AuditMode = 1
+)
+
+var TableDescriptor_AuditMode_name = map[int32]string{
+	0: "DISABLED",
+	1: "READWRITE",
+}
+var TableDescriptor_AuditMode_value = map[string]int32{
+	"DISABLED":  0,
+	"READWRITE": 1,
+}
+
+func (x TableDescriptor_AuditMode) Enum() *TableDescriptor_AuditMode {
+	p := new(TableDescriptor_AuditMode)
+	*p = x
+	return p
+}
+func (x TableDescriptor_AuditMode) String() string {
+	return proto.EnumName(TableDescriptor_AuditMode_name, int32(x))
+}
+func (x *TableDescriptor_AuditMode) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TableDescriptor_AuditMode_value, data, "TableDescriptor_AuditMode")
+	if err != nil {
+		return err
+	}
+	*x = TableDescriptor_AuditMode(value)
+	return nil
+}
+
 type ColumnType struct {
 	Kind ColumnType_Kind `protobuf:"varint,1,opt,name=kind,enum=cockroach.sql.ColumnType_Kind" json:"kind"`
 	// BIT, INT, FLOAT, DECIMAL, CHAR and BINARY
 	Width int32 `protobuf:"varint,2,opt,name=width" json:"width"`
 	// FLOAT and DECIMAL.
 	Precision int32 `protobuf:"varint,3,opt,name=precision" json:"precision"`
+	// ARRAY. Only one-dimensional arrays of the scalar kinds are supported.
+	ArrayContents ColumnType_Kind `protobuf:"varint,4,opt,name=array_contents,enum=cockroach.sql.ColumnType_Kind" json:"array_contents"`
+	// COLLATEDSTRING. The locale of the collation, as a BCP 47 language tag.
+	Locale string `protobuf:"bytes,5,opt,name=locale" json:"locale"`
 }
 
 func (m *ColumnType) Reset()         { *m = ColumnType{} }
@@ -106,6 +168,20 @@ func (m *ColumnType) GetPrecision() int32 {
 	return 0
 }
 
+func (m *ColumnType) GetArrayContents() ColumnType_Kind {
+	if m != nil {
+		return m.ArrayContents
+	}
+	return ColumnType_BOOL
+}
+
+func (m *ColumnType) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
 type ColumnDescriptor struct {
 	Name     string     `protobuf:"bytes,1,opt,name=name" json:"name"`
 	ID       ColumnID   `protobuf:"varint,2,opt,name=id,casttype=ColumnID" json:"id"`
@@ -176,6 +252,22 @@ type IndexDescriptor struct {
 	// computed as PrimaryIndex.column_ids - column_ids. For the primary index
 	// the list will be empty.
 	ImplicitColumnIDs []ColumnID `protobuf:"varint,7,rep,name=implicit_column_ids,casttype=ColumnID" json:"implicit_column_ids,omitempty"`
+	// The ancestors of an interleaved index. The index's data is stored within
+	// the data of its immediate parent, which is the last ancestor.
+	Interleave InterleaveDescriptor `protobuf:"bytes,8,opt,name=interleave" json:"interleave"`
+	// The indexes of other tables which are interleaved into this index.
+	InterleavedBy []IndexReference `protobuf:"bytes,9,rep,name=interleaved_by" json:"interleaved_by"`
+	// The expressions, in SQL syntax, of the elements of an expression index.
+	// This list parallels the column_names list and is empty if the index only
+	// contains columns. The entries for plain columns are empty. The value of an
+	// expression is encoded in the index key in place of a column value. The
+	// column ID of an expression is allocated from the column IDs of the table
+	// but does not refer to a column.
+	ColumnExprs []string `protobuf:"bytes,10,rep,name=column_exprs" json:"column_exprs,omitempty"`
+	// The predicate, in SQL syntax, of a partial index. Only the rows which
+	// satisfy the predicate have an entry in the index. Empty if the index is
+	// not partial.
+	Predicate string `protobuf:"bytes,11,opt,name=predicate" json:"predicate"`
 }
 
 func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
@@ -231,6 +323,176 @@ func (m *IndexDescriptor) GetImplicitColumnIDs() []ColumnID {
 	return nil
 }
 
+func (m *IndexDescriptor) GetInterleave() InterleaveDescriptor {
+	if m != nil {
+		return m.Interleave
+	}
+	return InterleaveDescriptor{}
+}
+
+func (m *IndexDescriptor) GetInterleavedBy() []IndexReference {
+	if m != nil {
+		return m.InterleavedBy
+	}
+	return nil
+}
+
+func (m *IndexDescriptor) GetColumnExprs() []string {
+	if m != nil {
+		return m.ColumnExprs
+	}
+	return nil
+}
+
+func (m *IndexDescriptor) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+// An InterleaveDescriptor describes the interleaving of an index within the
+// data of the indexes of other tables. The key of an interleaved index entry
+// is formed by encoding, for each ancestor, the ancestor's table and index IDs
+// followed by the columns shared with that ancestor and an interleave
+// sentinel. The table and index IDs of the interleaved index and its
+// remaining columns follow.
+type InterleaveDescriptor struct {
+	// The ancestors, ordered from the root of the interleave hierarchy to the
+	// immediate parent. Empty if the index is not interleaved.
+	Ancestors []InterleaveDescriptor_Ancestor `protobuf:"bytes,1,rep,name=ancestors" json:"ancestors"`
+}
+
+func (m *InterleaveDescriptor) Reset()         { *m = InterleaveDescriptor{} }
+func (m *InterleaveDescriptor) String() string { return proto.CompactTextString(m) }
+func (*InterleaveDescriptor) ProtoMessage()    {}
+
+func (m *InterleaveDescriptor) GetAncestors() []InterleaveDescriptor_Ancestor {
+	if m != nil {
+		return m.Ancestors
+	}
+	return nil
+}
+
+type InterleaveDescriptor_Ancestor struct {
+	TableID ID      `protobuf:"varint,1,opt,name=table_id,casttype=ID" json:"table_id"`
+	IndexID IndexID `protobuf:"varint,2,opt,name=index_id,casttype=IndexID" json:"index_id"`
+	// The number of leading columns of the interleaved index which are
+	// encoded after this ancestor's IDs.
+	SharedPrefixLen uint32 `protobuf:"varint,3,opt,name=shared_prefix_len" json:"shared_prefix_len"`
+}
+
+func (m *InterleaveDescriptor_Ancestor) Reset()         { *m = InterleaveDescriptor_Ancestor{} }
+func (m *InterleaveDescriptor_Ancestor) String() string { return proto.CompactTextString(m) }
+func (*InterleaveDescriptor_Ancestor) ProtoMessage()    {}
+
+func (m *InterleaveDescriptor_Ancestor) GetTableID() ID {
+	if m != nil {
+		return m.TableID
+	}
+	return 0
+}
+
+func (m *InterleaveDescriptor_Ancestor) GetIndexID() IndexID {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *InterleaveDescriptor_Ancestor) GetSharedPrefixLen() uint32 {
+	if m != nil {
+		return m.SharedPrefixLen
+	}
+	return 0
+}
+
+// An IndexReference identifies an index of a table.
+type IndexReference struct {
+	TableID ID      `protobuf:"varint,1,opt,name=table_id,casttype=ID" json:"table_id"`
+	IndexID IndexID `protobuf:"varint,2,opt,name=index_id,casttype=IndexID" json:"index_id"`
+}
+
+func (m *IndexReference) Reset()         { *m = IndexReference{} }
+func (m *IndexReference) String() string { return proto.CompactTextString(m) }
+func (*IndexReference) ProtoMessage()    {}
+
+func (m *IndexReference) GetTableID() ID {
+	if m != nil {
+		return m.TableID
+	}
+	return 0
+}
+
+func (m *IndexReference) GetIndexID() IndexID {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+// A FamilyDescriptor describes a column family, a set of columns whose values
+// are stored together in a single key/value pair of each row. The key of the
+// pair is the primary index key followed by the family ID, except for family 0
+// whose key is the bare primary index key. The pair of family 0 doubles as the
+// row sentinel and is always written, even if all of its columns are NULL.
+// The pairs of other families are only written if at least one of their
+// columns is not NULL.
+type FamilyDescriptor struct {
+	Name string   `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ID   FamilyID `protobuf:"varint,2,opt,name=id,casttype=FamilyID" json:"id"`
+	// An ordered list of column names of the columns in the family. This list
+	// parallels the column_ids list.
+	ColumnNames []string `protobuf:"bytes,3,rep,name=column_names" json:"column_names,omitempty"`
+	// An ordered list of column ids of the columns in the family. This list
+	// parallels the column_names list. Columns which are part of the primary
+	// key are listed but not stored as their values are encoded in the key.
+	ColumnIDs []ColumnID `protobuf:"varint,4,rep,name=column_ids,casttype=ColumnID" json:"column_ids,omitempty"`
+	// If nonzero, the family stores only the column with this ID and the value
+	// is encoded like a single column instead of packing the column values
+	// together. Used by the system tables whose keys are constructed directly.
+	DefaultColumnID ColumnID `protobuf:"varint,5,opt,name=default_column_id,casttype=ColumnID" json:"default_column_id"`
+}
+
+func (m *FamilyDescriptor) Reset()         { *m = FamilyDescriptor{} }
+func (m *FamilyDescriptor) String() string { return proto.CompactTextString(m) }
+func (*FamilyDescriptor) ProtoMessage()    {}
+
+func (m *FamilyDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FamilyDescriptor) GetID() FamilyID {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *FamilyDescriptor) GetColumnNames() []string {
+	if m != nil {
+		return m.ColumnNames
+	}
+	return nil
+}
+
+func (m *FamilyDescriptor) GetColumnIDs() []ColumnID {
+	if m != nil {
+		return m.ColumnIDs
+	}
+	return nil
+}
+
+func (m *FamilyDescriptor) GetDefaultColumnID() ColumnID {
+	if m != nil {
+		return m.DefaultColumnID
+	}
+	return 0
+}
+
 // A TableDescriptor represents a table and is stored in a structured metadata
 // key. The TableDescriptor has a globally-unique ID, while its member
 // {Column,Index}Descriptors have locally-unique IDs.
@@ -251,6 +513,12 @@ type TableDescriptor struct {
 	// next_index_id is used to ensure that deleted index ids are not reused.
 	NextIndexID IndexID              `protobuf:"varint,9,opt,name=next_index_id,casttype=IndexID" json:"next_index_id"`
 	Privileges  *PrivilegeDescriptor `protobuf:"bytes,10,opt,name=privileges" json:"privileges,omitempty"`
+	// The column families of the table. Every column belongs to exactly one
+	// family.
+	Families []FamilyDescriptor `protobuf:"bytes,11,rep,name=families" json:"families"`
+	// next_family_id is used to ensure that deleted family ids are not reused.
+	NextFamilyID FamilyID                  `protobuf:"varint,12,opt,name=next_family_id,casttype=FamilyID" json:"next_family_id"`
+	AuditMode    TableDescriptor_AuditMode `protobuf:"varint,13,opt,name=audit_mode,enum=cockroach.sql.TableDescriptor_AuditMode" json:"audit_mode"`
 }
 
 func (m *TableDescriptor) Reset()         { *m = TableDescriptor{} }
@@ -327,6 +595,27 @@ func (m *TableDescriptor) GetPrivileges() *PrivilegeDescriptor {
 	return nil
 }
 
+func (m *TableDescriptor) GetFamilies() []FamilyDescriptor {
+	if m != nil {
+		return m.Families
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetNextFamilyID() FamilyID {
+	if m != nil {
+		return m.NextFamilyID
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetAuditMode() TableDescriptor_AuditMode {
+	if m != nil {
+		return m.AuditMode
+	}
+	return TableDescriptor_DISABLED
+}
+
 // DatabaseDescriptor represents a namespace (aka database) and is stored
 // in a structured metadata key. The DatabaseDescriptor has a globally-unique
 // ID shared with the TableDescriptor ID.
@@ -364,6 +653,7 @@ func (m *DatabaseDescriptor) GetPrivileges() *PrivilegeDescriptor {
 
 func init() {
 	proto.RegisterEnum("cockroach.sql.ColumnType_Kind", ColumnType_Kind_name, ColumnType_Kind_value)
+	proto.RegisterEnum("cockroach.sql.TableDescriptor_AuditMode", TableDescriptor_AuditMode_name, TableDescriptor_AuditMode_value)
 }
 func (m *ColumnType) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -389,6 +679,13 @@ func (m *ColumnType) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x18
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Precision))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ArrayContents))
+	data[i] = 0x2a
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Locale)))
+	i += copy(data[i:], m.Locale)
 	return i, nil
 }
 
@@ -513,10 +810,49 @@ func (m *IndexDescriptor) MarshalTo(data []byte) (int, error) {
 			i = encodeVarintStructured(data, i, uint64(num))
 		}
 	}
+	data[i] = 0x42
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Interleave.Size()))
+	n2, err := m.Interleave.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.InterleavedBy) > 0 {
+		for _, msg := range m.InterleavedBy {
+			data[i] = 0x4a
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ColumnExprs) > 0 {
+		for _, s := range m.ColumnExprs {
+			data[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	data[i] = 0x5a
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Predicate)))
+	i += copy(data[i:], m.Predicate)
 	return i, nil
 }
 
-func (m *TableDescriptor) Marshal() (data []byte, err error) {
+func (m *InterleaveDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -526,28 +862,14 @@ func (m *TableDescriptor) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
+func (m *InterleaveDescriptor) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
-	i += copy(data[i:], m.Name)
-	data[i] = 0x12
-	i++
-	i = encodeVarintStructured(data, i, uint64(len(m.Alias)))
-	i += copy(data[i:], m.Alias)
-	data[i] = 0x18
-	i++
-	i = encodeVarintStructured(data, i, uint64(m.ID))
-	data[i] = 0x20
-	i++
-	i = encodeVarintStructured(data, i, uint64(m.ParentID))
-	if len(m.Columns) > 0 {
-		for _, msg := range m.Columns {
-			data[i] = 0x2a
+	if len(m.Ancestors) > 0 {
+		for _, msg := range m.Ancestors {
+			data[i] = 0xa
 			i++
 			i = encodeVarintStructured(data, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(data[i:])
@@ -557,46 +879,61 @@ func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
-	data[i] = 0x30
+	return i, nil
+}
+
+func (m *InterleaveDescriptor_Ancestor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InterleaveDescriptor_Ancestor) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.NextColumnID))
-	data[i] = 0x3a
+	i = encodeVarintStructured(data, i, uint64(m.TableID))
+	data[i] = 0x10
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.PrimaryIndex.Size()))
-	n2, err := m.PrimaryIndex.MarshalTo(data[i:])
+	i = encodeVarintStructured(data, i, uint64(m.IndexID))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.SharedPrefixLen))
+	return i, nil
+}
+
+func (m *IndexReference) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
 	if err != nil {
-		return 0, err
-	}
-	i += n2
-	if len(m.Indexes) > 0 {
-		for _, msg := range m.Indexes {
-			data[i] = 0x42
-			i++
-			i = encodeVarintStructured(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		return nil, err
 	}
-	data[i] = 0x48
+	return data[:n], nil
+}
+
+func (m *IndexReference) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.NextIndexID))
-	if m.Privileges != nil {
-		data[i] = 0x52
-		i++
-		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
-		n3, err := m.Privileges.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
+	i = encodeVarintStructured(data, i, uint64(m.TableID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.IndexID))
 	return i, nil
 }
 
-func (m *DatabaseDescriptor) Marshal() (data []byte, err error) {
+func (m *FamilyDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -606,7 +943,7 @@ func (m *DatabaseDescriptor) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *DatabaseDescriptor) MarshalTo(data []byte) (int, error) {
+func (m *FamilyDescriptor) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -618,18 +955,166 @@ func (m *DatabaseDescriptor) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x10
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.ID))
-	if m.Privileges != nil {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
-		n4, err := m.Privileges.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	return i, nil
-}
+	if len(m.ColumnNames) > 0 {
+		for _, s := range m.ColumnNames {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.ColumnIDs) > 0 {
+		for _, num := range m.ColumnIDs {
+			data[i] = 0x20
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	data[i] = 0x28
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.DefaultColumnID))
+	return i, nil
+}
+
+func (m *TableDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Alias)))
+	i += copy(data[i:], m.Alias)
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ID))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ParentID))
+	if len(m.Columns) > 0 {
+		for _, msg := range m.Columns {
+			data[i] = 0x2a
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x30
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.NextColumnID))
+	data[i] = 0x3a
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.PrimaryIndex.Size()))
+	n3, err := m.PrimaryIndex.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if len(m.Indexes) > 0 {
+		for _, msg := range m.Indexes {
+			data[i] = 0x42
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x48
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.NextIndexID))
+	if m.Privileges != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
+		n4, err := m.Privileges.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Families) > 0 {
+		for _, msg := range m.Families {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x60
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.NextFamilyID))
+	data[i] = 0x68
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.AuditMode))
+	return i, nil
+}
+
+func (m *DatabaseDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DatabaseDescriptor) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ID))
+	if m.Privileges != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
+		n5, err := m.Privileges.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
 
 func encodeFixed64Structured(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
@@ -664,6 +1149,9 @@ func (m *ColumnType) Size() (n int) {
 	n += 1 + sovStructured(uint64(m.Kind))
 	n += 1 + sovStructured(uint64(m.Width))
 	n += 1 + sovStructured(uint64(m.Precision))
+	n += 1 + sovStructured(uint64(m.ArrayContents))
+	l = len(m.Locale)
+	n += 1 + l + sovStructured(uint64(l))
 	return n
 }
 
@@ -712,6 +1200,72 @@ func (m *IndexDescriptor) Size() (n int) {
 			n += 1 + sovStructured(uint64(e))
 		}
 	}
+	l = m.Interleave.Size()
+	n += 1 + l + sovStructured(uint64(l))
+	if len(m.InterleavedBy) > 0 {
+		for _, e := range m.InterleavedBy {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ColumnExprs) > 0 {
+		for _, s := range m.ColumnExprs {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	l = len(m.Predicate)
+	n += 1 + l + sovStructured(uint64(l))
+	return n
+}
+
+func (m *InterleaveDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Ancestors) > 0 {
+		for _, e := range m.Ancestors {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InterleaveDescriptor_Ancestor) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.TableID))
+	n += 1 + sovStructured(uint64(m.IndexID))
+	n += 1 + sovStructured(uint64(m.SharedPrefixLen))
+	return n
+}
+
+func (m *IndexReference) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.TableID))
+	n += 1 + sovStructured(uint64(m.IndexID))
+	return n
+}
+
+func (m *FamilyDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.ID))
+	if len(m.ColumnNames) > 0 {
+		for _, s := range m.ColumnNames {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ColumnIDs) > 0 {
+		for _, e := range m.ColumnIDs {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	n += 1 + sovStructured(uint64(m.DefaultColumnID))
 	return n
 }
 
@@ -744,6 +1298,14 @@ func (m *TableDescriptor) Size() (n int) {
 		l = m.Privileges.Size()
 		n += 1 + l + sovStructured(uint64(l))
 	}
+	if len(m.Families) > 0 {
+		for _, e := range m.Families {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	n += 1 + sovStructured(uint64(m.NextFamilyID))
+	n += 1 + sovStructured(uint64(m.AuditMode))
 	return n
 }
 
@@ -757,23 +1319,774 @@ func (m *DatabaseDescriptor) Size() (n int) {
 		l = m.Privileges.Size()
 		n += 1 + l + sovStructured(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovStructured(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozStructured(x uint64) (n int) {
+	return sovStructured(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ColumnType) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColumnType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColumnType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Kind |= (ColumnType_Kind(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			m.Width = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Width |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Precision |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayContents", wireType)
+			}
+			m.ArrayContents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ArrayContents |= (ColumnType_Kind(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locale = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColumnDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColumnDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColumnDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (ColumnID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Type.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nullable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nullable = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExpr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.DefaultExpr = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (IndexID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnNames = append(m.ColumnNames, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreColumnNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreColumnNames = append(m.StoreColumnNames, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIDs", wireType)
+			}
+			var v ColumnID
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ColumnID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnIDs = append(m.ColumnIDs, v)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitColumnIDs", wireType)
+			}
+			var v ColumnID
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ColumnID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImplicitColumnIDs = append(m.ImplicitColumnIDs, v)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interleave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Interleave.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterleavedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterleavedBy = append(m.InterleavedBy, IndexReference{})
+			if err := m.InterleavedBy[len(m.InterleavedBy)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnExprs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnExprs = append(m.ColumnExprs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *InterleaveDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterleaveDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterleaveDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ancestors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ancestors = append(m.Ancestors, InterleaveDescriptor_Ancestor{})
+			if err := m.Ancestors[len(m.Ancestors)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovStructured(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozStructured(x uint64) (n int) {
-	return sovStructured(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ColumnType) Unmarshal(data []byte) error {
+
+func (m *InterleaveDescriptor_Ancestor) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -796,17 +2109,17 @@ func (m *ColumnType) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ColumnType: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterleaveDescriptor_Ancestor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ColumnType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterleaveDescriptor_Ancestor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
 			}
-			m.Kind = 0
+			m.TableID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructured
@@ -816,16 +2129,16 @@ func (m *ColumnType) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Kind |= (ColumnType_Kind(b) & 0x7F) << shift
+				m.TableID |= (ID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
 			}
-			m.Width = 0
+			m.IndexID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructured
@@ -835,16 +2148,16 @@ func (m *ColumnType) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Width |= (int32(b) & 0x7F) << shift
+				m.IndexID |= (IndexID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedPrefixLen", wireType)
 			}
-			m.Precision = 0
+			m.SharedPrefixLen = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructured
@@ -854,7 +2167,7 @@ func (m *ColumnType) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Precision |= (int32(b) & 0x7F) << shift
+				m.SharedPrefixLen |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -880,7 +2193,8 @@ func (m *ColumnType) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ColumnDescriptor) Unmarshal(data []byte) error {
+
+func (m *IndexReference) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -903,65 +2217,17 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ColumnDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ColumnDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructured
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStructured
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructured
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.ID |= (ColumnID(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
 			}
-			var msglen int
+			m.TableID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructured
@@ -971,47 +2237,16 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.TableID |= (ID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStructured
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Type.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nullable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructured
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Nullable = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExpr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
 			}
-			var stringLen uint64
+			m.IndexID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructured
@@ -1021,22 +2256,11 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.IndexID |= (IndexID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStructured
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(data[iNdEx:postIndex])
-			m.DefaultExpr = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -1058,7 +2282,8 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *IndexDescriptor) Unmarshal(data []byte) error {
+
+func (m *FamilyDescriptor) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1081,10 +2306,10 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: FamilyDescriptor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FamilyDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1130,32 +2355,12 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ID |= (IndexID(b) & 0x7F) << shift
+				m.ID |= (FamilyID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructured
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Unique = bool(v != 0)
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ColumnNames", wireType)
 			}
@@ -1184,36 +2389,7 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 			}
 			m.ColumnNames = append(m.ColumnNames, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreColumnNames", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructured
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStructured
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StoreColumnNames = append(m.StoreColumnNames, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIDs", wireType)
 			}
@@ -1233,11 +2409,11 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 				}
 			}
 			m.ColumnIDs = append(m.ColumnIDs, v)
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitColumnIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultColumnID", wireType)
 			}
-			var v ColumnID
+			m.DefaultColumnID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructured
@@ -1247,12 +2423,11 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (ColumnID(b) & 0x7F) << shift
+				m.DefaultColumnID |= (ColumnID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ImplicitColumnIDs = append(m.ImplicitColumnIDs, v)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -1274,6 +2449,7 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+
 func (m *TableDescriptor) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -1562,6 +2738,75 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Families", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Families = append(m.Families, FamilyDescriptor{})
+			if err := m.Families[len(m.Families)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFamilyID", wireType)
+			}
+			m.NextFamilyID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextFamilyID |= (FamilyID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditMode", wireType)
+			}
+			m.AuditMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.AuditMode |= (TableDescriptor_AuditMode(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/gogo/protobuf/proto"
 )
 
 func TestAllocateIDs(t *testing.T) {
@@ -66,9 +67,14 @@ func TestAllocateIDs(t *testing.T) {
 			{ID: 3, Name: "e", ColumnIDs: []sql.ColumnID{2}, ColumnNames: []string{"b"},
 				ImplicitColumnIDs: []sql.ColumnID{1}},
 		},
+		Families: []sql.FamilyDescriptor{
+			{ID: 0, Name: "primary", ColumnIDs: []sql.ColumnID{1, 2, 3},
+				ColumnNames: []string{"a", "b", "c"}},
+		},
 		Privileges:   sql.NewDefaultPrivilegeDescriptor(),
 		NextColumnID: 4,
 		NextIndexID:  4,
+		NextFamilyID: 1,
 	}
 	if !reflect.DeepEqual(expected, desc) {
 		a, _ := json.MarshalIndent(expected, "", "  ")
@@ -86,6 +92,110 @@ func TestAllocateIDs(t *testing.T) {
 	}
 }
 
+func TestAllocateFamilyIDs(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := sql.TableDescriptor{
+		ID:       keys.MaxReservedDescID + 2,
+		ParentID: keys.MaxReservedDescID + 1,
+		Name:     "foo",
+		Columns: []sql.ColumnDescriptor{
+			{Name: "a"},
+			{Name: "b"},
+			{Name: "c"},
+			{Name: "d"},
+		},
+		PrimaryIndex: sql.IndexDescriptor{Name: "c", ColumnNames: []string{"a"}},
+		Families: []sql.FamilyDescriptor{
+			{Name: "f", ColumnNames: []string{"b"}},
+			{ColumnNames: []string{"c", "d"}},
+		},
+		Privileges: sql.NewDefaultPrivilegeDescriptor(),
+	}
+	if err := desc.AllocateIDs(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []sql.FamilyDescriptor{
+		{ID: 0, Name: "f", ColumnIDs: []sql.ColumnID{2, 1}, ColumnNames: []string{"b", "a"}},
+		{ID: 1, Name: "fam_1_c_d", ColumnIDs: []sql.ColumnID{3, 4}, ColumnNames: []string{"c", "d"}},
+	}
+	if !reflect.DeepEqual(expected, desc.Families) {
+		a, _ := json.MarshalIndent(expected, "", "  ")
+		b, _ := json.MarshalIndent(desc.Families, "", "  ")
+		t.Fatalf("expected %s, but found %s", a, b)
+	}
+	if desc.NextFamilyID != 2 {
+		t.Fatalf("expected next family ID 2, but found %d", desc.NextFamilyID)
+	}
+
+	// Columns added later go into the first family.
+	desc.AddColumn(sql.ColumnDescriptor{Name: "e"})
+	if err := desc.AllocateIDs(); err != nil {
+		t.Fatal(err)
+	}
+	expected[0].ColumnIDs = append(expected[0].ColumnIDs, 5)
+	expected[0].ColumnNames = append(expected[0].ColumnNames, "e")
+	if !reflect.DeepEqual(expected, desc.Families) {
+		a, _ := json.MarshalIndent(expected, "", "  ")
+		b, _ := json.MarshalIndent(desc.Families, "", "  ")
+		t.Fatalf("expected %s, but found %s", a, b)
+	}
+}
+
+// TestUpgradeFormatWithoutFamilies verifies that a table descriptor written
+// before column families were introduced validates and is given families
+// matching the keys of its existing rows.
+func TestUpgradeFormatWithoutFamilies(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	old := sql.TableDescriptor{
+		ID:       keys.MaxReservedDescID + 2,
+		ParentID: keys.MaxReservedDescID + 1,
+		Name:     "foo",
+		Columns: []sql.ColumnDescriptor{
+			{ID: 1, Name: "a"},
+			{ID: 2, Name: "b"},
+			{ID: 4, Name: "d"},
+		},
+		NextColumnID: 5,
+		PrimaryIndex: sql.IndexDescriptor{
+			ID: 1, Name: "primary", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"a"},
+			Unique: true,
+		},
+		NextIndexID: 2,
+		Privileges:  sql.NewDefaultPrivilegeDescriptor(),
+	}
+	buf, err := proto.Marshal(&old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var desc sql.TableDescriptor
+	if err := proto.Unmarshal(buf, &desc); err != nil {
+		t.Fatal(err)
+	}
+	if err := desc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []sql.FamilyDescriptor{
+		{ID: 0, Name: "primary", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"a"}},
+		{ID: 2, Name: "fam_2_b", ColumnIDs: []sql.ColumnID{2}, ColumnNames: []string{"b"},
+			DefaultColumnID: 2},
+		{ID: 4, Name: "fam_4_d", ColumnIDs: []sql.ColumnID{4}, ColumnNames: []string{"d"},
+			DefaultColumnID: 4},
+	}
+	if !reflect.DeepEqual(expected, desc.Families) {
+		a, _ := json.MarshalIndent(expected, "", "  ")
+		b, _ := json.MarshalIndent(desc.Families, "", "  ")
+		t.Fatalf("expected %s, but found %s", a, b)
+	}
+	// The ID of the dropped column 3 must not be reused by a new family.
+	if desc.NextFamilyID != 5 {
+		t.Fatalf("expected next family ID 5, but found %d", desc.NextFamilyID)
+	}
+}
+
 func TestValidateTableDesc(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
@@ -270,6 +380,56 @@ func TestValidateTableDesc(t *testing.T) {
 				NextColumnID: 2,
 				NextIndexID:  2,
 			}},
+		{`family "baz" contains unknown column "blah"`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "primary", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				Families: []sql.FamilyDescriptor{
+					{ID: 0, Name: "baz", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"blah"}},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+				NextFamilyID: 1,
+			}},
+		{`column "bar" is in both family "baz" and "qux"`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "primary", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				Families: []sql.FamilyDescriptor{
+					{ID: 0, Name: "baz", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+					{ID: 1, Name: "qux", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+				NextFamilyID: 2,
+			}},
+		{`column "blah" is not in any column family`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+					{ID: 2, Name: "blah"},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "primary", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				Families: []sql.FamilyDescriptor{
+					{ID: 0, Name: "baz", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				},
+				NextColumnID: 3,
+				NextIndexID:  2,
+				NextFamilyID: 1,
+			}},
 	}
 	for i, d := range testData {
 		if err := d.desc.Validate(); err == nil {
@@ -300,6 +460,15 @@ func TestColumnTypeSQLString(t *testing.T) {
 		{sql.ColumnType{Kind: sql.ColumnType_STRING}, "STRING"},
 		{sql.ColumnType{Kind: sql.ColumnType_STRING, Width: 10}, "STRING(10)"},
 		{sql.ColumnType{Kind: sql.ColumnType_BYTES}, "BYTES"},
+		{sql.ColumnType{Kind: sql.ColumnType_ARRAY, ArrayContents: sql.ColumnType_INT}, "INT[]"},
+		{sql.ColumnType{Kind: sql.ColumnType_ARRAY, ArrayContents: sql.ColumnType_STRING}, "STRING[]"},
+		{sql.ColumnType{Kind: sql.ColumnType_JSONB}, "JSONB"},
+		{sql.ColumnType{Kind: sql.ColumnType_TIMESTAMPTZ}, "TIMESTAMPTZ"},
+		{sql.ColumnType{Kind: sql.ColumnType_TIME}, "TIME"},
+		{sql.ColumnType{Kind: sql.ColumnType_COLLATEDSTRING, Locale: "de"}, "STRING COLLATE de"},
+		{sql.ColumnType{Kind: sql.ColumnType_COLLATEDSTRING, Width: 3, Locale: "de-DE"}, `STRING(3) COLLATE "de-DE"`},
+		{sql.ColumnType{Kind: sql.ColumnType_UUID}, "UUID"},
+		{sql.ColumnType{Kind: sql.ColumnType_INET}, "INET"},
 	}
 	for i, d := range testData {
 		sql := d.colType.SQLString()
@@ -45,7 +45,9 @@ CREATE TABLE system.descriptor (
 	usersTableSchema = `
 CREATE TABLE system.users (
   username       CHAR PRIMARY KEY,
-  hashedPassword BLOB
+  hashedPassword BLOB,
+  authMethod     CHAR,
+  isRole         BOOL
 );`
 
 	// Zone settings per DB/Table.
@@ -54,6 +56,14 @@ CREATE TABLE system.zones (
   id     INT PRIMARY KEY,
   config BLOB
 );`
+
+	// Membership of users and roles in roles.
+	roleMembersTableSchema = `
+CREATE TABLE system.role_members (
+  role   CHAR,
+  member CHAR,
+  PRIMARY KEY (role, member)
+);`
 )
 
 var (
@@ -78,16 +88,20 @@ var (
 	// ZonesTable is the descriptor for the zones table.
 	ZonesTable = createSystemTable(keys.ZonesTableID, zonesTableSchema)
 
+	// RoleMembersTable is the descriptor for the role members table.
+	RoleMembersTable = createSystemTable(keys.RoleMembersTableID, roleMembersTableSchema)
+
 	// SystemAllowedPrivileges describes the privileges allowed for each
 	// system object. No user may have more than those privileges, and
 	// the root user must have exactly those privileges.
 	// CREATE|DROP|ALL should always be denied.
 	SystemAllowedPrivileges = map[ID]privilege.List{
-		keys.SystemDatabaseID:  privilege.ReadData,
-		keys.NamespaceTableID:  privilege.ReadData,
-		keys.DescriptorTableID: privilege.ReadData,
-		keys.UsersTableID:      privilege.ReadWriteData,
-		keys.ZonesTableID:      privilege.ReadWriteData,
+		keys.SystemDatabaseID:   privilege.ReadData,
+		keys.NamespaceTableID:   privilege.ReadData,
+		keys.DescriptorTableID:  privilege.ReadData,
+		keys.UsersTableID:       privilege.ReadWriteData,
+		keys.ZonesTableID:       privilege.ReadWriteData,
+		keys.RoleMembersTableID: privilege.ReadWriteData,
 	}
 
 	// NumUsedSystemIDs is only used in tests that need to know the
@@ -117,6 +131,15 @@ func createSystemTable(id ID, cmd string) TableDescriptor {
 		log.Fatal(err)
 	}
 
+	// The keys of the system tables are constructed directly (see
+	// MakeNameMetadataKey, MakeDescMetadataKey and MakeZoneKey) and hold the
+	// value of a single column each.
+	desc.NextFamilyID = 0
+	desc.allocateColumnIDFamilies()
+	if err := desc.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
 	NumUsedSystemIDs++
 	return desc
 }
@@ -133,6 +156,7 @@ func GetInitialSystemValues() []roachpb.KeyValue {
 		{SystemDB.ID, &DescriptorTable},
 		{SystemDB.ID, &UsersTable},
 		{SystemDB.ID, &ZonesTable},
+		{SystemDB.ID, &RoleMembersTable},
 	}
 
 	// Initial kv pairs:
@@ -20,6 +20,7 @@ package sql
 import (
 	"bytes"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/cockroachdb/cockroach/keys"
@@ -83,6 +84,11 @@ func makeTableDesc(p *parser.CreateTable, parentID ID) (TableDescriptor, error)
 			if err := desc.AddIndex(idx, d.PrimaryKey); err != nil {
 				return desc, err
 			}
+		case *parser.FamilyTableDef:
+			desc.AddFamily(FamilyDescriptor{
+				Name:        string(d.Name),
+				ColumnNames: d.Columns,
+			})
 		default:
 			return desc, util.Errorf("unsupported table def: %T", def)
 		}
@@ -90,48 +96,164 @@ func makeTableDesc(p *parser.CreateTable, parentID ID) (TableDescriptor, error)
 	return desc, nil
 }
 
-func makeColumnDefDescs(d *parser.ColumnTableDef) (*ColumnDescriptor, *IndexDescriptor, error) {
-	col := &ColumnDescriptor{
-		Name:     string(d.Name),
-		Nullable: (d.Nullable != parser.NotNull),
-	}
-
-	var colDatumType parser.Datum
-	switch t := d.Type.(type) {
+// makeColumnType returns the column type corresponding to the type of a
+// column definition.
+func makeColumnType(typ parser.ColumnType) (ColumnType, error) {
+	var c ColumnType
+	switch t := typ.(type) {
 	case *parser.BoolType:
-		col.Type.Kind = ColumnType_BOOL
-		colDatumType = parser.DummyBool
+		c.Kind = ColumnType_BOOL
 	case *parser.IntType:
-		col.Type.Kind = ColumnType_INT
-		col.Type.Width = int32(t.N)
-		colDatumType = parser.DummyInt
+		c.Kind = ColumnType_INT
+		c.Width = int32(t.N)
 	case *parser.FloatType:
-		col.Type.Kind = ColumnType_FLOAT
-		col.Type.Precision = int32(t.Prec)
-		colDatumType = parser.DummyFloat
+		c.Kind = ColumnType_FLOAT
+		c.Precision = int32(t.Prec)
 	case *parser.DecimalType:
-		col.Type.Kind = ColumnType_DECIMAL
-		col.Type.Width = int32(t.Scale)
-		col.Type.Precision = int32(t.Prec)
+		c.Kind = ColumnType_DECIMAL
+		c.Width = int32(t.Scale)
+		c.Precision = int32(t.Prec)
 	case *parser.DateType:
-		col.Type.Kind = ColumnType_DATE
-		colDatumType = parser.DummyDate
+		c.Kind = ColumnType_DATE
 	case *parser.TimestampType:
-		col.Type.Kind = ColumnType_TIMESTAMP
-		colDatumType = parser.DummyTimestamp
+		c.Kind = ColumnType_TIMESTAMP
+	case *parser.TimestampTZType:
+		c.Kind = ColumnType_TIMESTAMPTZ
+	case *parser.TimeType:
+		c.Kind = ColumnType_TIME
 	case *parser.IntervalType:
-		col.Type.Kind = ColumnType_INTERVAL
-		colDatumType = parser.DummyInterval
+		c.Kind = ColumnType_INTERVAL
 	case *parser.StringType:
-		col.Type.Kind = ColumnType_STRING
-		col.Type.Width = int32(t.N)
-		colDatumType = parser.DummyString
+		c.Kind = ColumnType_STRING
+		c.Width = int32(t.N)
+	case *parser.CollatedStringType:
+		locale, err := parser.CanonicalLocale(t.Locale)
+		if err != nil {
+			return ColumnType{}, err
+		}
+		c.Kind = ColumnType_COLLATEDSTRING
+		c.Width = int32(t.N)
+		c.Locale = locale
 	case *parser.BytesType:
-		col.Type.Kind = ColumnType_BYTES
-		colDatumType = parser.DummyBytes
+		c.Kind = ColumnType_BYTES
+	case *parser.ArrayType:
+		contents, err := makeColumnType(t.ParamType)
+		if err != nil {
+			return ColumnType{}, err
+		}
+		// The width and precision of the elements are not enforced. Neither
+		// could the locale of collated strings be, as only the kind of the
+		// elements is stored.
+		switch contents.Kind {
+		case ColumnType_DECIMAL, ColumnType_ARRAY, ColumnType_JSONB, ColumnType_UUID,
+			ColumnType_INET, ColumnType_COLLATEDSTRING:
+			return ColumnType{}, fmt.Errorf("arrays of %s are not supported", t.ParamType)
+		}
+		c.Kind = ColumnType_ARRAY
+		c.ArrayContents = contents.Kind
+	case *parser.JSONType:
+		c.Kind = ColumnType_JSONB
+	case *parser.UUIDType:
+		c.Kind = ColumnType_UUID
+	case *parser.INetType:
+		c.Kind = ColumnType_INET
 	default:
-		return nil, nil, util.Errorf("unexpected type %T", t)
+		return ColumnType{}, util.Errorf("unexpected type %T", t)
+	}
+	return c, nil
+}
+
+// datumType returns a placeholder datum of the type of the values of the
+// column type, or nil if the column type is not supported.
+func (c ColumnType) datumType() parser.Datum {
+	switch c.Kind {
+	case ColumnType_BOOL:
+		return parser.DummyBool
+	case ColumnType_INT:
+		return parser.DummyInt
+	case ColumnType_FLOAT:
+		return parser.DummyFloat
+	case ColumnType_STRING:
+		return parser.DummyString
+	case ColumnType_BYTES:
+		return parser.DummyBytes
+	case ColumnType_DATE:
+		return parser.DummyDate
+	case ColumnType_TIMESTAMP:
+		return parser.DummyTimestamp
+	case ColumnType_TIMESTAMPTZ:
+		return parser.DummyTimestampTZ
+	case ColumnType_TIME:
+		return parser.DummyTime
+	case ColumnType_INTERVAL:
+		return parser.DummyInterval
+	case ColumnType_ARRAY:
+		paramType := ColumnType{Kind: c.ArrayContents}.datumType()
+		if paramType == nil {
+			return nil
+		}
+		return parser.DummyArrayOf(paramType)
+	case ColumnType_JSONB:
+		return parser.DummyJSON
+	case ColumnType_COLLATEDSTRING:
+		return parser.DummyCollatedString(c.Locale)
+	case ColumnType_UUID:
+		return parser.DummyUUID
+	case ColumnType_INET:
+		return parser.DummyInet
+	}
+	return nil
+}
+
+// datumColumnKind returns the kind of the columns holding values of the type
+// of d.
+func datumColumnKind(d parser.Datum) ColumnType_Kind {
+	switch d.(type) {
+	case parser.DBool:
+		return ColumnType_BOOL
+	case parser.DInt:
+		return ColumnType_INT
+	case parser.DFloat:
+		return ColumnType_FLOAT
+	case parser.DString:
+		return ColumnType_STRING
+	case parser.DBytes:
+		return ColumnType_BYTES
+	case parser.DDate:
+		return ColumnType_DATE
+	case parser.DTimestamp:
+		return ColumnType_TIMESTAMP
+	case parser.DTimestampTZ:
+		return ColumnType_TIMESTAMPTZ
+	case parser.DTime:
+		return ColumnType_TIME
+	case parser.DInterval:
+		return ColumnType_INTERVAL
+	case *parser.DArray:
+		return ColumnType_ARRAY
+	case *parser.DJSON:
+		return ColumnType_JSONB
+	case *parser.DCollatedString:
+		return ColumnType_COLLATEDSTRING
+	case parser.DUUID:
+		return ColumnType_UUID
+	case parser.DInet:
+		return ColumnType_INET
+	}
+	panic(fmt.Sprintf("unsupported datum type: %s", d.Type()))
+}
+
+func makeColumnDefDescs(d *parser.ColumnTableDef) (*ColumnDescriptor, *IndexDescriptor, error) {
+	col := &ColumnDescriptor{
+		Name:     string(d.Name),
+		Nullable: (d.Nullable != parser.NotNull),
+	}
+
+	var err error
+	if col.Type, err = makeColumnType(d.Type); err != nil {
+		return nil, nil, err
 	}
+	colDatumType := col.Type.datumType()
 
 	if d.DefaultExpr != nil {
 		// Verify the default expression type is compatible with the column type.
@@ -159,8 +281,35 @@ func makeColumnDefDescs(d *parser.ColumnTableDef) (*ColumnDescriptor, *IndexDesc
 	return col, idx, nil
 }
 
+// normalizeTableName normalizes qname using the session database. If the
+// session has a search path and qname is an unqualified table name, the table
+// is resolved against the session database and then against each database in
+// the search path, in order.
+func (p *planner) normalizeTableName(qname *parser.QualifiedName) error {
+	if qname != nil && len(qname.Indirect) == 0 && len(p.session.SearchPath) > 0 {
+		for _, dbName := range append([]string{p.session.Database}, p.session.SearchPath...) {
+			if dbName == "" {
+				continue
+			}
+			dbDesc, err := p.getDatabaseDesc(dbName)
+			if err != nil {
+				// Databases in the search path are not required to exist.
+				continue
+			}
+			gr, err := p.txn.Get(tableKey{dbDesc.ID, string(qname.Base)}.Key())
+			if err != nil {
+				return err
+			}
+			if gr.Exists() {
+				return qname.NormalizeTableName(dbName)
+			}
+		}
+	}
+	return qname.NormalizeTableName(p.session.Database)
+}
+
 func (p *planner) getTableDesc(qname *parser.QualifiedName) (*TableDescriptor, error) {
-	if err := qname.NormalizeTableName(p.session.Database); err != nil {
+	if err := p.normalizeTableName(qname); err != nil {
 		return nil, err
 	}
 	dbDesc, err := p.getDatabaseDesc(qname.Database())
@@ -175,6 +324,18 @@ func (p *planner) getTableDesc(qname *parser.QualifiedName) (*TableDescriptor, e
 	return &desc, nil
 }
 
+// getTableDescByID looks up the table descriptor with the given ID.
+func (p *planner) getTableDescByID(id ID) (*TableDescriptor, error) {
+	desc := &TableDescriptor{}
+	if err := p.txn.GetProto(MakeDescMetadataKey(id), desc); err != nil {
+		return nil, err
+	}
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
 func (p *planner) getTableNames(dbDesc *DatabaseDescriptor) (parser.QualifiedNames, error) {
 	prefix := MakeNameMetadataKey(dbDesc.ID, "")
 	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
@@ -228,6 +389,39 @@ func encodeIndexKey(columnIDs []ColumnID, colMap map[ColumnID]int,
 	return key, containsNull, nil
 }
 
+// makeIndexKey returns the key of the index entry for the row described by
+// colMap and values, which for a non-unique secondary index still lacks the
+// implicit columns. If the index is interleaved, the columns it shares with
+// each of its ancestors are encoded after the ancestor's table and index IDs.
+func makeIndexKey(desc *TableDescriptor, index *IndexDescriptor,
+	colMap map[ColumnID]int, values []parser.Datum) ([]byte, bool, error) {
+	if len(index.Interleave.Ancestors) == 0 {
+		return encodeIndexKey(index.ColumnIDs, colMap, values, MakeIndexKeyPrefix(desc.ID, index.ID))
+	}
+
+	var key []byte
+	var containsNull bool
+	key = append(key, keys.TableDataPrefix...)
+	columnIDs := index.ColumnIDs
+	for _, ancestor := range index.Interleave.Ancestors {
+		key = encoding.EncodeUvarint(key, uint64(ancestor.TableID))
+		key = encoding.EncodeUvarint(key, uint64(ancestor.IndexID))
+		length := int(ancestor.SharedPrefixLen)
+		var n bool
+		var err error
+		if key, n, err = encodeIndexKey(columnIDs[:length], colMap, values, key); err != nil {
+			return nil, containsNull, err
+		}
+		containsNull = containsNull || n
+		columnIDs = columnIDs[length:]
+		key = append(key, interleavedSentinel)
+	}
+	key = encoding.EncodeUvarint(key, uint64(desc.ID))
+	key = encoding.EncodeUvarint(key, uint64(index.ID))
+	key, n, err := encodeIndexKey(columnIDs, colMap, values, key)
+	return key, containsNull || n, err
+}
+
 func encodeTableKey(b []byte, val parser.Datum) ([]byte, error) {
 	if val == parser.DNull {
 		return encoding.EncodeNull(b), nil
@@ -251,37 +445,114 @@ func encodeTableKey(b []byte, val parser.Datum) ([]byte, error) {
 		return encoding.EncodeTime(b, t.Time), nil
 	case parser.DTimestamp:
 		return encoding.EncodeTime(b, t.Time), nil
+	case parser.DTimestampTZ:
+		return encoding.EncodeTime(b, t.Time), nil
+	case parser.DTime:
+		return encoding.EncodeVarint(b, int64(t.Duration)), nil
 	case parser.DInterval:
 		return encoding.EncodeVarint(b, int64(t.Duration)), nil
+	case *parser.DArray:
+		// The encoding of arrays does not preserve their order. Arrays are
+		// only encoded in column values and never in index keys.
+		v, err := encodeArray(nil, t)
+		if err != nil {
+			return nil, err
+		}
+		return encoding.EncodeBytes(b, v), nil
+	case *parser.DJSON:
+		// Like arrays, JSONB values are encoded as their canonical text, which
+		// does not preserve their order.
+		return encoding.EncodeString(b, t.JSONText()), nil
+	case *parser.DCollatedString:
+		// The collation key orders the values. It is followed by the contents,
+		// which cannot be recovered from the key and which order the values that
+		// collate equally.
+		b = encoding.EncodeBytes(b, t.Key)
+		return encoding.EncodeString(b, t.Contents), nil
+	case parser.DUUID:
+		return encoding.EncodeBytes(b, t[:]), nil
+	case parser.DInet:
+		return encoding.EncodeBytes(b, encodeInet(t)), nil
 	}
 	return nil, fmt.Errorf("unable to encode table key: %T", val)
 }
 
+// encodeInet returns the encoding of an INET value: the address family,
+// followed by the 4 or 16 bytes of the address and by the prefix length. The
+// encoding preserves the order of the values.
+func encodeInet(d parser.DInet) []byte {
+	b := []byte{d.Family}
+	b = append(b, d.IP()...)
+	return append(b, d.MaskLen)
+}
+
+// decodeInet decodes an INET value encoded by encodeInet.
+func decodeInet(b []byte) (parser.DInet, error) {
+	var d parser.DInet
+	switch {
+	case len(b) == 2+net.IPv4len && b[0] == 4:
+	case len(b) == 2+net.IPv6len && b[0] == 6:
+	default:
+		return parser.DInet{}, util.Errorf("invalid inet encoding: %x", b)
+	}
+	d.Family = b[0]
+	copy(d.Addr[:], b[1:len(b)-1])
+	d.MaskLen = b[len(b)-1]
+	return d, nil
+}
+
+// encodeArray appends the encoding of the elements of an array to b: the
+// number of elements followed by each element.
+func encodeArray(b []byte, array *parser.DArray) ([]byte, error) {
+	b = encoding.EncodeUvarint(b, uint64(len(array.Elements)))
+	for _, d := range array.Elements {
+		var err error
+		if b, err = encodeTableKey(b, d); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// decodeArray decodes an array encoded by encodeArray. The elements are
+// either NULL or of the type of paramType.
+func decodeArray(paramType parser.Datum, b []byte) (*parser.DArray, error) {
+	b, n, err := encoding.DecodeUvarint(b)
+	if err != nil {
+		return nil, err
+	}
+	elements := make([]parser.Datum, n)
+	for i := range elements {
+		if elements[i], b, err = decodeTableKey(paramType, b); err != nil {
+			return nil, err
+		}
+	}
+	if len(b) > 0 {
+		return nil, util.Errorf("%d trailing bytes in encoded array", len(b))
+	}
+	return &parser.DArray{ParamType: paramType, Elements: elements}, nil
+}
+
 func makeKeyVals(desc *TableDescriptor, columnIDs []ColumnID) ([]parser.Datum, error) {
 	vals := make([]parser.Datum, len(columnIDs))
 	for i, id := range columnIDs {
 		col, err := desc.FindColumnByID(id)
 		if err != nil {
-			return nil, err
+			// The ID might be that of an index expression.
+			expr, ok := desc.findIndexExprByID(id)
+			if !ok {
+				return nil, err
+			}
+			resolved, _, err := resolveIndexExpr(desc, expr)
+			if err != nil {
+				return nil, err
+			}
+			if vals[i], err = parser.TypeCheckExpr(resolved); err != nil {
+				return nil, err
+			}
+			continue
 		}
-		switch col.Type.Kind {
-		case ColumnType_BOOL:
-			vals[i] = parser.DummyBool
-		case ColumnType_INT:
-			vals[i] = parser.DummyInt
-		case ColumnType_FLOAT:
-			vals[i] = parser.DummyFloat
-		case ColumnType_STRING:
-			vals[i] = parser.DummyString
-		case ColumnType_BYTES:
-			vals[i] = parser.DummyBytes
-		case ColumnType_DATE:
-			vals[i] = parser.DummyDate
-		case ColumnType_TIMESTAMP:
-			vals[i] = parser.DummyTimestamp
-		case ColumnType_INTERVAL:
-			vals[i] = parser.DummyInterval
-		default:
+		if vals[i] = col.Type.datumType(); vals[i] == nil {
 			return nil, util.Errorf("TODO(pmattis): decoded index key: %s", col.Type.Kind)
 		}
 	}
@@ -314,18 +585,75 @@ func decodeIndexKeyPrefix(desc *TableDescriptor, key []byte) (IndexID, []byte, e
 // key. ValTypes is a slice returned from makeKeyVals. The remaining bytes in the
 // index key are returned which will either be an encoded column ID for the
 // primary key index, the primary key suffix for non-unique secondary indexes
-// or unique secondary indexes containing NULL or empty.
-func decodeIndexKey(desc *TableDescriptor, index IndexDescriptor, valTypes, vals []parser.Datum, key []byte) ([]byte, error) {
-	indexID, remaining, err := decodeIndexKeyPrefix(desc, key)
-	if err != nil {
-		return nil, err
+// or unique secondary indexes containing NULL or empty. The returned bool is
+// false if the key belongs to a row which is interleaved with the index's
+// entries (the row of an ancestor, of a sibling or of a descendant), in which
+// case the key should be skipped.
+func decodeIndexKey(desc *TableDescriptor, index IndexDescriptor, valTypes, vals []parser.Datum, key []byte) ([]byte, bool, error) {
+	if len(index.Interleave.Ancestors) == 0 {
+		indexID, remaining, err := decodeIndexKeyPrefix(desc, key)
+		if err != nil {
+			return nil, false, err
+		}
+		if indexID != index.ID {
+			return nil, false, util.Errorf("%s: unexpected index ID: %d != %d", desc.Name, index.ID, indexID)
+		}
+		if remaining, err = decodeKeyVals(valTypes, vals, remaining); err != nil {
+			return nil, false, err
+		}
+		if len(remaining) > 0 && remaining[0] == interleavedSentinel {
+			// A row interleaved into this index.
+			return nil, false, nil
+		}
+		return remaining, true, nil
 	}
 
-	if indexID != index.ID {
-		return nil, util.Errorf("%s: unexpected index ID: %d != %d", desc.Name, index.ID, indexID)
+	if !bytes.HasPrefix(key, keys.TableDataPrefix) {
+		return nil, false, util.Errorf("%s: invalid key prefix: %q", desc.Name, key)
+	}
+	key = bytes.TrimPrefix(key, keys.TableDataPrefix)
+	for _, ancestor := range index.Interleave.Ancestors {
+		var tableID, indexID uint64
+		var err error
+		if key, tableID, err = encoding.DecodeUvarint(key); err != nil {
+			return nil, false, err
+		}
+		if key, indexID, err = encoding.DecodeUvarint(key); err != nil {
+			return nil, false, err
+		}
+		if ID(tableID) != ancestor.TableID || IndexID(indexID) != ancestor.IndexID {
+			return nil, false, nil
+		}
+		length := int(ancestor.SharedPrefixLen)
+		if key, err = decodeKeyVals(valTypes[:length], vals[:length], key); err != nil {
+			return nil, false, err
+		}
+		valTypes, vals = valTypes[length:], vals[length:]
+		if len(key) == 0 || key[0] != interleavedSentinel {
+			// A row of the ancestor.
+			return nil, false, nil
+		}
+		key = key[1:]
 	}
 
-	return decodeKeyVals(valTypes, vals, remaining)
+	var tableID, indexID uint64
+	var err error
+	if key, tableID, err = encoding.DecodeUvarint(key); err != nil {
+		return nil, false, err
+	}
+	if key, indexID, err = encoding.DecodeUvarint(key); err != nil {
+		return nil, false, err
+	}
+	if ID(tableID) != desc.ID || IndexID(indexID) != index.ID {
+		return nil, false, nil
+	}
+	if key, err = decodeKeyVals(valTypes, vals, key); err != nil {
+		return nil, false, err
+	}
+	if len(key) > 0 && key[0] == interleavedSentinel {
+		return nil, false, nil
+	}
+	return key, true, nil
 }
 
 // decodeKeyVals decodes the values that are part of the key. ValTypes is a
@@ -373,9 +701,57 @@ func decodeTableKey(valType parser.Datum, key []byte) (parser.Datum, []byte, err
 	case parser.DTimestamp:
 		rkey, t, err := encoding.DecodeTime(key)
 		return parser.DTimestamp{Time: t}, rkey, err
+	case parser.DTimestampTZ:
+		rkey, t, err := encoding.DecodeTime(key)
+		return parser.DTimestampTZ{Time: t}, rkey, err
+	case parser.DTime:
+		rkey, d, err := encoding.DecodeVarint(key)
+		return parser.DTime{Duration: time.Duration(d)}, rkey, err
 	case parser.DInterval:
 		rkey, d, err := encoding.DecodeVarint(key)
 		return parser.DInterval{Duration: time.Duration(d)}, rkey, err
+	case *parser.DArray:
+		rkey, b, err := encoding.DecodeBytes(key, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		array, err := decodeArray(valType.(*parser.DArray).ParamType, b)
+		return array, rkey, err
+	case *parser.DJSON:
+		rkey, r, err := encoding.DecodeString(key, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		j, err := parser.ParseDJSON(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		return j, rkey, nil
+	case *parser.DCollatedString:
+		rkey, k, err := encoding.DecodeBytes(key, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		rkey, r, err := encoding.DecodeString(rkey, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		locale := valType.(*parser.DCollatedString).Locale
+		return &parser.DCollatedString{Contents: r, Locale: locale, Key: k}, rkey, nil
+	case parser.DUUID:
+		rkey, b, err := encoding.DecodeBytes(key, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		u, err := parser.MakeDUUID(b)
+		return u, rkey, err
+	case parser.DInet:
+		rkey, b, err := encoding.DecodeBytes(key, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		i, err := decodeInet(b)
+		return i, rkey, err
 	default:
 		return nil, nil, util.Errorf("TODO(pmattis): decoded index key: %s", valType.Type())
 	}
@@ -386,13 +762,35 @@ type indexEntry struct {
 	value []byte
 }
 
-func encodeSecondaryIndexes(tableID ID, indexes []IndexDescriptor,
+// encodeSecondaryIndexes returns the entries of indexes for the row described
+// by colMap and values. The expressions and predicates of the indexes are
+// evaluated using exprs, which is the result of makeIndexExprs for the
+// indexes. A row which does not satisfy the predicate of a partial index gets
+// an entry with a nil key for that index.
+func encodeSecondaryIndexes(desc *TableDescriptor, indexes []IndexDescriptor, exprs *indexExprs,
 	colMap map[ColumnID]int, values []parser.Datum) ([]indexEntry, error) {
+	if exprs != nil {
+		var err error
+		if colMap, values, err = exprs.addExprValues(colMap, values); err != nil {
+			return nil, err
+		}
+	}
+
 	var secondaryIndexEntries []indexEntry
-	for _, secondaryIndex := range indexes {
-		secondaryIndexKeyPrefix := MakeIndexKeyPrefix(tableID, secondaryIndex.ID)
-		secondaryIndexKey, containsNull, err := encodeIndexKey(
-			secondaryIndex.ColumnIDs, colMap, values, secondaryIndexKeyPrefix)
+	for i := range indexes {
+		secondaryIndex := &indexes[i]
+		if secondaryIndex.Predicate != "" {
+			ok, err := exprs.satisfiesPredicate(secondaryIndex.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				secondaryIndexEntries = append(secondaryIndexEntries, indexEntry{})
+				continue
+			}
+		}
+
+		secondaryIndexKey, containsNull, err := makeIndexKey(desc, secondaryIndex, colMap, values)
 		if err != nil {
 			return nil, err
 		}
@@ -424,6 +822,265 @@ func encodeSecondaryIndexes(tableID ID, indexes []IndexDescriptor,
 	return secondaryIndexEntries, nil
 }
 
+// resolveIndexExpr parses expr, the expression of an index element or the
+// predicate of a partial index, and resolves its column references against
+// the columns of desc. The referenced columns are returned in a qvalMap.
+func resolveIndexExpr(desc *TableDescriptor, expr string) (parser.Expr, qvalMap, error) {
+	parsed, err := parser.ParseExpr(expr, parser.Traditional)
+	if err != nil {
+		return nil, nil, err
+	}
+	s := &scanNode{desc: desc, visibleCols: desc.Columns}
+	resolved, err := s.resolveQNames(parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolved, s.qvals, nil
+}
+
+// checkIndexExpr verifies that expr, the expression of an index element or
+// the predicate of a partial index, can be evaluated for the rows of the table
+// described by desc.
+func checkIndexExpr(desc *TableDescriptor, expr string, isPredicate bool) error {
+	resolved, _, err := resolveIndexExpr(desc, expr)
+	if err != nil {
+		return err
+	}
+	if parser.ContainsImpureFuncs(resolved) {
+		return fmt.Errorf("impure functions are not allowed in index expressions: %s", expr)
+	}
+	typ, err := parser.TypeCheckExpr(resolved)
+	if err != nil {
+		return err
+	}
+	if isPredicate {
+		if typ != parser.DummyBool && typ != parser.DNull {
+			return fmt.Errorf("index predicate must be type %s, not type %s",
+				parser.DummyBool.Type(), typ.Type())
+		}
+		return nil
+	}
+	if _, err := encodeTableKey(nil, typ); err != nil {
+		return fmt.Errorf("index expression %s has unsupported type %s", expr, typ.Type())
+	}
+	// As for the columns of an index (see TableDescriptor.Validate), values
+	// which aren't encoded in a way that preserves their order can't be
+	// indexed.
+	if typ != parser.DNull {
+		if colTyp := (ColumnType{Kind: datumColumnKind(typ)}); !colTyp.indexable() {
+			return fmt.Errorf("index expression %s is of type %s and thus is not indexable",
+				expr, typ.Type())
+		}
+	}
+	return nil
+}
+
+// indexExprs holds the resolved expressions and predicates of the secondary
+// indexes of a table, which are evaluated to compute the index entries of the
+// rows being written.
+type indexExprs struct {
+	evalCtx    parser.EvalContext
+	qvals      qvalMap
+	exprs      map[ColumnID]parser.Expr
+	predicates map[IndexID]parser.Expr
+}
+
+// makeIndexExprs resolves the expressions and predicates of indexes. It
+// returns nil if none of the indexes is an expression or partial index.
+func (p *planner) makeIndexExprs(desc *TableDescriptor, indexes []IndexDescriptor) (*indexExprs, error) {
+	// The expressions are resolved using a single scanNode so that they share
+	// the qvalues of the columns they reference.
+	s := &scanNode{desc: desc, visibleCols: desc.Columns}
+	resolve := func(expr string) (parser.Expr, error) {
+		parsed, err := parser.ParseExpr(expr, parser.Traditional)
+		if err != nil {
+			return nil, err
+		}
+		return s.resolveQNames(parsed)
+	}
+
+	var exprs *indexExprs
+	for i := range indexes {
+		index := &indexes[i]
+		if len(index.ColumnExprs) == 0 && index.Predicate == "" {
+			continue
+		}
+		if exprs == nil {
+			exprs = &indexExprs{
+				evalCtx:    p.evalCtx,
+				exprs:      map[ColumnID]parser.Expr{},
+				predicates: map[IndexID]parser.Expr{},
+			}
+		}
+		for j, id := range index.ColumnIDs {
+			if !index.isExpr(j) {
+				continue
+			}
+			expr, err := resolve(index.ColumnExprs[j])
+			if err != nil {
+				return nil, err
+			}
+			exprs.exprs[id] = expr
+		}
+		if index.Predicate != "" {
+			pred, err := resolve(index.Predicate)
+			if err != nil {
+				return nil, err
+			}
+			exprs.predicates[index.ID] = pred
+		}
+	}
+	if exprs != nil {
+		exprs.qvals = s.qvals
+	}
+	return exprs, nil
+}
+
+// addExprValues binds the column references of the expressions to the row
+// described by colMap and values and returns a copy of the row extended with
+// the values of the index expressions, which are encoded like column values.
+func (e *indexExprs) addExprValues(colMap map[ColumnID]int,
+	values []parser.Datum) (map[ColumnID]int, []parser.Datum, error) {
+	for id, qval := range e.qvals {
+		if i, ok := colMap[id]; ok {
+			qval.datum = values[i]
+		} else {
+			qval.datum = parser.DNull
+		}
+	}
+	if len(e.exprs) == 0 {
+		return colMap, values, nil
+	}
+
+	exprColMap := make(map[ColumnID]int, len(colMap)+len(e.exprs))
+	for id, i := range colMap {
+		exprColMap[id] = i
+	}
+	exprValues := append([]parser.Datum(nil), values...)
+	for id, expr := range e.exprs {
+		d, err := e.evalCtx.EvalExpr(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		exprColMap[id] = len(exprValues)
+		exprValues = append(exprValues, d)
+	}
+	return exprColMap, exprValues, nil
+}
+
+// satisfiesPredicate returns true if the row last passed to addExprValues
+// satisfies the predicate of the partial index with the specified ID.
+func (e *indexExprs) satisfiesPredicate(id IndexID) (bool, error) {
+	d, err := e.evalCtx.EvalExpr(e.predicates[id])
+	if err != nil {
+		return false, err
+	}
+	return d == parser.DBool(true), nil
+}
+
+// renameIndexExprColumn rewrites the expressions and the predicate of index,
+// which belongs to desc, to refer to the column with the specified ID by
+// newName.
+func renameIndexExprColumn(desc *TableDescriptor, index *IndexDescriptor, colID ColumnID, newName string) error {
+	rename := func(expr string) (string, error) {
+		resolved, qvals, err := resolveIndexExpr(desc, expr)
+		if err != nil {
+			return "", err
+		}
+		for id, qval := range qvals {
+			name := qval.col.Name
+			if id == colID {
+				name = newName
+			}
+			qval.col.Name = parser.Name(name).String()
+		}
+		return resolved.String(), nil
+	}
+
+	for i := range index.ColumnIDs {
+		if !index.isExpr(i) {
+			continue
+		}
+		expr, err := rename(index.ColumnExprs[i])
+		if err != nil {
+			return err
+		}
+		index.ColumnExprs[i] = expr
+		index.ColumnNames[i] = expr
+	}
+	if index.Predicate != "" {
+		pred, err := rename(index.Predicate)
+		if err != nil {
+			return err
+		}
+		index.Predicate = pred
+	}
+	return nil
+}
+
+// encodeFamilyValue returns the value of the key/value pair holding the
+// columns of family in the row described by colMap and values. Columns which
+// are part of the primary key are not stored as their values are encoded in
+// the key. Unless the family has a default column, the values of the columns
+// are packed together, each prefixed by the ID of its column. NULL values are
+// omitted and the returned value is nil if all of the stored columns are NULL.
+func encodeFamilyValue(desc *TableDescriptor, family *FamilyDescriptor,
+	colMap map[ColumnID]int, values []parser.Datum) (interface{}, error) {
+	if family.DefaultColumnID != 0 {
+		i, ok := colMap[family.DefaultColumnID]
+		if !ok {
+			return nil, nil
+		}
+		col, err := desc.FindColumnByID(family.DefaultColumnID)
+		if err != nil {
+			return nil, err
+		}
+		return marshalColumnValue(*col, values[i])
+	}
+
+	var value []byte
+	for _, id := range family.ColumnIDs {
+		if desc.PrimaryIndex.containsColumnID(id) {
+			continue
+		}
+		i, ok := colMap[id]
+		if !ok || values[i] == parser.DNull {
+			continue
+		}
+		value = encoding.EncodeUvarint(value, uint64(id))
+		var err error
+		if value, err = encodeTableKey(value, values[i]); err != nil {
+			return nil, err
+		}
+	}
+	if value == nil {
+		return nil, nil
+	}
+	return value, nil
+}
+
+// decodeFamilyValue decodes the packed value of a column family written by
+// encodeFamilyValue. The types of the columns are looked up in valTypes and
+// the decoded values are stored in vals, keyed by column ID. Columns missing
+// from the value are NULL and are not stored in vals.
+func decodeFamilyValue(valTypes, vals map[ColumnID]parser.Datum, value []byte) error {
+	for len(value) > 0 {
+		var id uint64
+		var err error
+		if value, id, err = encoding.DecodeUvarint(value); err != nil {
+			return err
+		}
+		valType, ok := valTypes[ColumnID(id)]
+		if !ok {
+			return util.Errorf("column-id \"%d\" does not exist", id)
+		}
+		if vals[ColumnID(id)], value, err = decodeTableKey(valType, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // marshalColumnValue returns a Go primitive value equivalent of val, of the
 // type expected by col. If val's type is incompatible with col, or if
 // col's type is not yet implemented, an error is returned.
@@ -461,26 +1118,62 @@ func marshalColumnValue(col ColumnDescriptor, val parser.Datum) (interface{}, er
 		if v, ok := val.(parser.DTimestamp); ok {
 			return v.Time, nil
 		}
+	case ColumnType_TIMESTAMPTZ:
+		if v, ok := val.(parser.DTimestampTZ); ok {
+			return v.Time, nil
+		}
+	case ColumnType_TIME:
+		if v, ok := val.(parser.DTime); ok {
+			return v.Duration, nil
+		}
 	case ColumnType_INTERVAL:
 		if v, ok := val.(parser.DInterval); ok {
 			return v.Duration, nil
 		}
+	case ColumnType_ARRAY:
+		if v, ok := val.(*parser.DArray); ok {
+			paramType := ColumnType{Kind: col.Type.ArrayContents}.datumType()
+			if v.ParamType == parser.DNull || v.ParamType == paramType {
+				return encodeArray(nil, v)
+			}
+		}
+	case ColumnType_JSONB:
+		if v, ok := val.(*parser.DJSON); ok {
+			return v.JSONText(), nil
+		}
+	case ColumnType_COLLATEDSTRING:
+		if v, ok := val.(*parser.DCollatedString); ok && v.Locale == col.Type.Locale {
+			return v.Contents, nil
+		}
+	case ColumnType_UUID:
+		if v, ok := val.(parser.DUUID); ok {
+			return v[:], nil
+		}
+	case ColumnType_INET:
+		if v, ok := val.(parser.DInet); ok {
+			return encodeInet(v), nil
+		}
 	default:
 		return nil, util.Errorf("unsupported column type: %s", col.Type.Kind)
 	}
+	typ := col.Type.Kind.String()
+	if col.Type.Kind == ColumnType_ARRAY {
+		// Include the element type, e.g. INT[].
+		typ = col.Type.SQLString()
+	}
 	return nil, fmt.Errorf("value type %s doesn't match type %s of column %q",
-		val.Type(), col.Type.Kind, col.Name)
+		val.Type(), typ, col.Name)
 }
 
 // unmarshalColumnValue decodes the value from a key-value pair using the type
 // expected by the column. An error is returned if the value's type does not
 // match the column's type.
-func unmarshalColumnValue(kind ColumnType_Kind, value *roachpb.Value) (parser.Datum, error) {
+func unmarshalColumnValue(typ ColumnType, value *roachpb.Value) (parser.Datum, error) {
 	if value == nil {
 		return parser.DNull, nil
 	}
 
-	switch kind {
+	switch typ.Kind {
 	case ColumnType_BOOL:
 		v, err := value.GetInt()
 		if err != nil {
@@ -523,13 +1216,63 @@ func unmarshalColumnValue(kind ColumnType_Kind, value *roachpb.Value) (parser.Da
 			return nil, err
 		}
 		return parser.DTimestamp{Time: v}, nil
+	case ColumnType_TIMESTAMPTZ:
+		v, err := value.GetTime()
+		if err != nil {
+			return nil, err
+		}
+		return parser.DTimestampTZ{Time: v}, nil
+	case ColumnType_TIME:
+		v, err := value.GetInt()
+		if err != nil {
+			return nil, err
+		}
+		return parser.DTime{Duration: time.Duration(v)}, nil
 	case ColumnType_INTERVAL:
 		v, err := value.GetInt()
 		if err != nil {
 			return nil, err
 		}
 		return parser.DInterval{Duration: time.Duration(v)}, nil
+	case ColumnType_ARRAY:
+		v, err := value.GetBytesChecked()
+		if err != nil {
+			return nil, err
+		}
+		paramType := ColumnType{Kind: typ.ArrayContents}.datumType()
+		if paramType == nil {
+			return nil, util.Errorf("unsupported array element type: %s", typ.ArrayContents)
+		}
+		return decodeArray(paramType, v)
+	case ColumnType_JSONB:
+		v, err := value.GetBytesChecked()
+		if err != nil {
+			return nil, err
+		}
+		j, err := parser.ParseDJSON(string(v))
+		if err != nil {
+			return nil, err
+		}
+		return j, nil
+	case ColumnType_COLLATEDSTRING:
+		v, err := value.GetBytesChecked()
+		if err != nil {
+			return nil, err
+		}
+		return parser.NewDCollatedString(string(v), typ.Locale)
+	case ColumnType_UUID:
+		v, err := value.GetBytesChecked()
+		if err != nil {
+			return nil, err
+		}
+		return parser.MakeDUUID(v)
+	case ColumnType_INET:
+		v, err := value.GetBytesChecked()
+		if err != nil {
+			return nil, err
+		}
+		return decodeInet(v)
 	default:
-		return nil, util.Errorf("unsupported column type: %s", kind)
+		return nil, util.Errorf("unsupported column type: %s", typ.Kind)
 	}
 }
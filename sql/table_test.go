@@ -18,9 +18,11 @@
 package sql
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
@@ -68,6 +70,16 @@ func TestMakeTableDescColumns(t *testing.T) {
 			ColumnType{Kind: ColumnType_TIMESTAMP},
 			true,
 		},
+		{
+			"TIMESTAMP WITH TIME ZONE",
+			ColumnType{Kind: ColumnType_TIMESTAMPTZ},
+			true,
+		},
+		{
+			"TIME",
+			ColumnType{Kind: ColumnType_TIME},
+			true,
+		},
 		{
 			"INTERVAL",
 			ColumnType{Kind: ColumnType_INTERVAL},
@@ -88,6 +100,41 @@ func TestMakeTableDescColumns(t *testing.T) {
 			ColumnType{Kind: ColumnType_BYTES},
 			true,
 		},
+		{
+			"STRING COLLATE de_DE",
+			ColumnType{Kind: ColumnType_COLLATEDSTRING, Locale: "de-DE"},
+			true,
+		},
+		{
+			"CHAR(3) COLLATE sv",
+			ColumnType{Kind: ColumnType_COLLATEDSTRING, Width: 3, Locale: "sv"},
+			true,
+		},
+		{
+			"UUID",
+			ColumnType{Kind: ColumnType_UUID},
+			true,
+		},
+		{
+			"INET",
+			ColumnType{Kind: ColumnType_INET},
+			true,
+		},
+		{
+			"INT[]",
+			ColumnType{Kind: ColumnType_ARRAY, ArrayContents: ColumnType_INT},
+			true,
+		},
+		{
+			"TEXT ARRAY",
+			ColumnType{Kind: ColumnType_ARRAY, ArrayContents: ColumnType_STRING},
+			true,
+		},
+		{
+			"JSON",
+			ColumnType{Kind: ColumnType_JSONB},
+			true,
+		},
 		{
 			"INT NOT NULL",
 			ColumnType{Kind: ColumnType_INT},
@@ -230,3 +277,226 @@ func TestPrimaryKeyUnspecified(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestArrayColumnValue(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	col := ColumnDescriptor{
+		Name: "a",
+		Type: ColumnType{Kind: ColumnType_ARRAY, ArrayContents: ColumnType_STRING},
+	}
+	testData := []*parser.DArray{
+		{ParamType: parser.DummyString},
+		{ParamType: parser.DummyString, Elements: []parser.Datum{parser.DString("foo")}},
+		{ParamType: parser.DummyString, Elements: []parser.Datum{
+			parser.DString("foo"), parser.DNull, parser.DString(""),
+		}},
+	}
+	for i, d := range testData {
+		marshaled, err := marshalColumnValue(col, d)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		var value roachpb.Value
+		value.SetBytes(marshaled.([]byte))
+		unmarshaled, err := unmarshalColumnValue(col.Type, &value)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if d.Type() != unmarshaled.Type() || d.Compare(unmarshaled) != 0 {
+			t.Errorf("%d: expected %s, but got %s", i, d, unmarshaled)
+		}
+
+		// Arrays are also encoded in packed column families.
+		key, err := encodeTableKey(nil, d)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		decoded, rest, err := decodeTableKey(col.Type.datumType(), key)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if len(rest) != 0 || d.Type() != decoded.Type() || d.Compare(decoded) != 0 {
+			t.Errorf("%d: expected %s, but got %s (%d remaining bytes)", i, d, decoded, len(rest))
+		}
+	}
+
+	mismatched := &parser.DArray{ParamType: parser.DummyInt, Elements: []parser.Datum{parser.DInt(1)}}
+	if _, err := marshalColumnValue(col, mismatched); err == nil {
+		t.Fatalf("expected error marshaling %s", mismatched)
+	}
+}
+
+func TestJSONColumnValue(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	col := ColumnDescriptor{Name: "j", Type: ColumnType{Kind: ColumnType_JSONB}}
+	testData := []string{
+		`null`,
+		`"foo"`,
+		`[1, 2.5, true, {"a": []}]`,
+		`{"b": {"c": "\n"}, "a": 1}`,
+	}
+	for i, s := range testData {
+		d, err := parser.ParseDJSON(s)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		marshaled, err := marshalColumnValue(col, d)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		var value roachpb.Value
+		value.SetBytes([]byte(marshaled.(string)))
+		unmarshaled, err := unmarshalColumnValue(col.Type, &value)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if d.Compare(unmarshaled) != 0 {
+			t.Errorf("%d: expected %s, but got %s", i, d, unmarshaled)
+		}
+
+		key, err := encodeTableKey(nil, d)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		decoded, rest, err := decodeTableKey(col.Type.datumType(), key)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if len(rest) != 0 || d.Compare(decoded) != 0 {
+			t.Errorf("%d: expected %s, but got %s (%d remaining bytes)", i, d, decoded, len(rest))
+		}
+	}
+}
+
+func TestCollatedStringKeyOrder(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testData := []struct {
+		locale  string
+		ordered []string
+	}{
+		{"de", []string{"", "a", "A", "ä", "b", "z"}},
+		{"sv", []string{"a", "b", "z", "å", "ä", "ö"}},
+	}
+	for _, d := range testData {
+		col := ColumnDescriptor{Name: "s", Type: ColumnType{Kind: ColumnType_COLLATEDSTRING, Locale: d.locale}}
+		var prevKey []byte
+		for i, s := range d.ordered {
+			datum, err := parser.NewDCollatedString(s, d.locale)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.locale, i, err)
+			}
+			key, err := encodeTableKey(nil, datum)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.locale, i, err)
+			}
+			if prevKey != nil && bytes.Compare(prevKey, key) >= 0 {
+				t.Errorf("%s/%d: expected the key of %s to sort after the key of %s",
+					d.locale, i, s, d.ordered[i-1])
+			}
+			prevKey = key
+
+			decoded, rest, err := decodeTableKey(col.Type.datumType(), key)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.locale, i, err)
+			}
+			if len(rest) != 0 || datum.Compare(decoded) != 0 {
+				t.Errorf("%s/%d: expected %s, but got %s (%d remaining bytes)",
+					d.locale, i, datum, decoded, len(rest))
+			}
+
+			marshaled, err := marshalColumnValue(col, datum)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.locale, i, err)
+			}
+			var value roachpb.Value
+			value.SetBytes([]byte(marshaled.(string)))
+			unmarshaled, err := unmarshalColumnValue(col.Type, &value)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.locale, i, err)
+			}
+			if datum.Compare(unmarshaled) != 0 {
+				t.Errorf("%s/%d: expected %s, but got %s", d.locale, i, datum, unmarshaled)
+			}
+		}
+	}
+}
+
+func TestUUIDAndInetKeyOrder(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testData := []struct {
+		kind    ColumnType_Kind
+		ordered []string
+	}{
+		{ColumnType_UUID, []string{
+			"00000000-0000-0000-0000-000000000000",
+			"00000000-0000-0000-0000-0000000000ff",
+			"00000000-0000-0000-0000-000000000100",
+			"63616665-6630-3064-6465-616462656566",
+			"ffffffff-ffff-ffff-ffff-ffffffffffff",
+		}},
+		{ColumnType_INET, []string{
+			"0.0.0.0/0",
+			"10.0.0.0/8",
+			"10.0.0.0",
+			"10.0.0.1",
+			"192.168.0.1/16",
+			"255.255.255.255",
+			"::/0",
+			"::1",
+			"2001:db8::/32",
+		}},
+	}
+	for _, d := range testData {
+		col := ColumnDescriptor{Name: "c", Type: ColumnType{Kind: d.kind}}
+		var prevKey []byte
+		for i, s := range d.ordered {
+			var datum parser.Datum
+			var err error
+			switch d.kind {
+			case ColumnType_UUID:
+				datum, err = parser.ParseDUUID(s)
+			case ColumnType_INET:
+				datum, err = parser.ParseDInet(s)
+			}
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.kind, i, err)
+			}
+			key, err := encodeTableKey(nil, datum)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.kind, i, err)
+			}
+			if prevKey != nil && bytes.Compare(prevKey, key) >= 0 {
+				t.Errorf("%s/%d: expected the key of %s to sort after the key of %s",
+					d.kind, i, s, d.ordered[i-1])
+			}
+			prevKey = key
+
+			decoded, rest, err := decodeTableKey(col.Type.datumType(), key)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.kind, i, err)
+			}
+			if len(rest) != 0 || datum.Compare(decoded) != 0 {
+				t.Errorf("%s/%d: expected %s, but got %s (%d remaining bytes)",
+					d.kind, i, datum, decoded, len(rest))
+			}
+
+			marshaled, err := marshalColumnValue(col, datum)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.kind, i, err)
+			}
+			var value roachpb.Value
+			value.SetBytes(marshaled.([]byte))
+			unmarshaled, err := unmarshalColumnValue(col.Type, &value)
+			if err != nil {
+				t.Fatalf("%s/%d: %v", d.kind, i, err)
+			}
+			if datum.Compare(unmarshaled) != 0 {
+				t.Errorf("%s/%d: expected %s, but got %s", d.kind, i, datum, unmarshaled)
+			}
+		}
+	}
+}
@@ -42,6 +42,22 @@ func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 			return nil, err
 		}
 
+		if err := p.deleteTableData(&b, tableDesc); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.txn.Run(&b); err != nil {
+		return nil, err
+	}
+
+	return &valuesNode{}, nil
+}
+
+// deleteTableData adds the operations which delete all of the rows and index
+// entries of a table to b.
+func (p *planner) deleteTableData(b *client.Batch, tableDesc *TableDescriptor) error {
+	if !tableDesc.isInterleaved() {
 		tablePrefix := keys.MakeTablePrefix(uint32(tableDesc.ID))
 
 		// Delete rows and indexes starting with the table's prefix.
@@ -51,11 +67,54 @@ func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 			log.Infof("DelRange %s - %s", prettyKey(tableStartKey, 0), prettyKey(tableEndKey, 0))
 		}
 		b.DelRange(tableStartKey, tableEndKey)
+		return nil
 	}
 
-	if err := p.txn.Run(&b); err != nil {
-		return nil, err
+	indexes := append([]IndexDescriptor{tableDesc.PrimaryIndex}, tableDesc.Indexes...)
+	for i := range indexes {
+		if err := p.deleteIndexData(b, tableDesc, &indexes[i]); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return &valuesNode{}, nil
+// deleteIndexData adds the operations which delete all of the entries of an
+// index to b. The entries of an index which is interleaved, or which other
+// indexes are interleaved into, are not contiguous. They are found by
+// scanning the interleaved span and are deleted individually.
+func (p *planner) deleteIndexData(b *client.Batch, tableDesc *TableDescriptor, index *IndexDescriptor) error {
+	if len(index.Interleave.Ancestors) == 0 && len(index.InterleavedBy) == 0 {
+		indexStartKey := roachpb.Key(MakeIndexKeyPrefix(tableDesc.ID, index.ID))
+		indexEndKey := indexStartKey.PrefixEnd()
+		if log.V(2) {
+			log.Infof("DelRange %s - %s", prettyKey(indexStartKey, 0), prettyKey(indexEndKey, 0))
+		}
+		b.DelRange(indexStartKey, indexEndKey)
+		return nil
+	}
+
+	valTypes, err := makeKeyVals(tableDesc, index.fullColumnIDs())
+	if err != nil {
+		return err
+	}
+	vals := make([]parser.Datum, len(valTypes))
+	start := makeIndexSpanPrefix(tableDesc, index)
+	kvs, err := p.txn.Scan(start, start.PrefixEnd(), 0)
+	if err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		_, ok, err := decodeIndexKey(tableDesc, *index, valTypes, vals, kv.Key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if log.V(2) {
+				log.Infof("Del %s", prettyKey(kv.Key, 0))
+			}
+			b.Del(kv.Key)
+		}
+	}
+	return nil
 }
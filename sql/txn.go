@@ -18,6 +18,8 @@
 package sql
 
 import (
+	"fmt"
+
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
@@ -70,3 +72,51 @@ func (p *planner) setIsolationLevel(level parser.IsolationLevel) error {
 		return util.Errorf("unknown isolation level: %s", level)
 	}
 }
+
+// Savepoint establishes a savepoint in the current transaction.
+func (p *planner) Savepoint(n *parser.Savepoint) (planNode, error) {
+	p.savepoints = append(p.savepoints, Session_Savepoint{
+		Name:     string(n.Name),
+		Sequence: p.txn.Savepoint(),
+	})
+	return &valuesNode{}, nil
+}
+
+// ReleaseSavepoint destroys a savepoint and all savepoints established
+// after it. The writes performed since the savepoint are retained.
+func (p *planner) ReleaseSavepoint(n *parser.ReleaseSavepoint) (planNode, error) {
+	i, err := p.findSavepoint(n.Name)
+	if err != nil {
+		return nil, err
+	}
+	p.savepoints = p.savepoints[:i]
+	return &valuesNode{}, nil
+}
+
+// RollbackToSavepoint discards the writes performed since a savepoint was
+// established and destroys the savepoints established after it. The
+// savepoint itself remains and can be rolled back to again.
+func (p *planner) RollbackToSavepoint(n *parser.RollbackToSavepoint) (planNode, error) {
+	i, err := p.findSavepoint(n.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.txn.RollbackToSavepoint(p.savepoints[i].Sequence); err != nil {
+		return nil, err
+	}
+	p.savepoints = p.savepoints[:i+1]
+	p.txnFailed = false
+	return &valuesNode{}, nil
+}
+
+// findSavepoint returns the index of the most recently established savepoint
+// with the given name.
+func (p *planner) findSavepoint(name parser.Name) (int, error) {
+	normalizedName := normalizeName(string(name))
+	for i := len(p.savepoints) - 1; i >= 0; i-- {
+		if normalizeName(p.savepoints[i].Name) == normalizedName {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("savepoint %q does not exist", string(name))
+}
@@ -144,11 +144,16 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 	}
 
 	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
 
 	// Secondary indexes needing updating.
 	var indexes []IndexDescriptor
 	for _, index := range tableDesc.Indexes {
+		if len(index.ColumnExprs) > 0 || index.Predicate != "" {
+			// The expressions and the predicate of the index might refer to the
+			// updated columns.
+			indexes = append(indexes, index)
+			continue
+		}
 		for _, id := range index.ColumnIDs {
 			if _, ok := colIDSet[id]; ok {
 				indexes = append(indexes, index)
@@ -157,7 +162,23 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		}
 	}
 
-	marshalled := make([]interface{}, len(cols))
+	indexExprs, err := p.makeIndexExprs(tableDesc, indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Column families needing updating. Only the families containing an
+	// updated column are rewritten.
+	var families []*FamilyDescriptor
+	for i := range tableDesc.Families {
+		family := &tableDesc.Families[i]
+		for _, id := range family.ColumnIDs {
+			if _, ok := colIDSet[id]; ok {
+				families = append(families, family)
+				break
+			}
+		}
+	}
 
 	b := client.Batch{}
 	result := &valuesNode{}
@@ -165,14 +186,14 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		rowVals := rows.Values()
 		result.rows = append(result.rows, parser.DTuple(nil))
 
-		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
+		primaryIndexKey, _, err := makeIndexKey(
+			tableDesc, &primaryIndex, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
 		// Compute the current secondary index key:value pairs for this row.
 		secondaryIndexEntries, err := encodeSecondaryIndexes(
-			tableDesc.ID, indexes, colIDtoRowIndex, rowVals)
+			tableDesc, indexes, indexExprs, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
@@ -193,15 +214,14 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		// happen before index encoding because certain datum types (i.e. tuple)
 		// cannot be used as index values.
 		for i, val := range newVals {
-			var err error
-			if marshalled[i], err = marshalColumnValue(cols[i], val); err != nil {
+			if _, err := marshalColumnValue(cols[i], val); err != nil {
 				return nil, err
 			}
 		}
 
 		// Compute the new secondary index key:value pairs for this row.
 		newSecondaryIndexEntries, err := encodeSecondaryIndexes(
-			tableDesc.ID, indexes, colIDtoRowIndex, rowVals)
+			tableDesc, indexes, indexExprs, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
@@ -210,35 +230,43 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		for i, newSecondaryIndexEntry := range newSecondaryIndexEntries {
 			secondaryIndexEntry := secondaryIndexEntries[i]
 			if !bytes.Equal(newSecondaryIndexEntry.key, secondaryIndexEntry.key) {
-				if log.V(2) {
-					log.Infof("CPut %s -> %v", prettyKey(newSecondaryIndexEntry.key, 0),
-						newSecondaryIndexEntry.value)
+				// A nil key indicates that the row is not part of a partial index.
+				if newSecondaryIndexEntry.key != nil {
+					if log.V(2) {
+						log.Infof("CPut %s -> %v", prettyKey(newSecondaryIndexEntry.key, 0),
+							newSecondaryIndexEntry.value)
+					}
+					b.CPut(newSecondaryIndexEntry.key, newSecondaryIndexEntry.value, nil)
 				}
-				b.CPut(newSecondaryIndexEntry.key, newSecondaryIndexEntry.value, nil)
-				if log.V(2) {
-					log.Infof("Del %s", prettyKey(secondaryIndexEntry.key, 0))
+				if secondaryIndexEntry.key != nil {
+					if log.V(2) {
+						log.Infof("Del %s", prettyKey(secondaryIndexEntry.key, 0))
+					}
+					b.Del(secondaryIndexEntry.key)
 				}
-				b.Del(secondaryIndexEntry.key)
 			}
 		}
 
-		// Add the new values.
-		for i, val := range newVals {
-			col := cols[i]
+		// Rewrite the updated column families. The values of the columns of a
+		// family which are not being updated are part of the row as well.
+		for _, family := range families {
+			value, err := encodeFamilyValue(tableDesc, family, colIDtoRowIndex, rowVals)
+			if err != nil {
+				return nil, err
+			}
 
-			key := MakeColumnKey(col.ID, primaryIndexKey)
-			if marshalled[i] != nil {
-				// We only output non-NULL values. Non-existent column keys are
-				// considered NULL during scanning and the row sentinel ensures we know
-				// the row exists.
+			key := MakeFamilyKey(family.ID, primaryIndexKey)
+			if value != nil || family.ID == 0 {
+				// Family 0 is the row sentinel and is written even if all of its
+				// columns are NULL.
 				if log.V(2) {
-					log.Infof("Put %s -> %v", prettyKey(key, 0), val)
+					log.Infof("Put %s -> %v", prettyKey(key, 0), value)
 				}
 
-				b.Put(key, marshalled[i])
+				b.Put(key, value)
 			} else {
-				// The column might have already existed but is being set to NULL, so
-				// delete it.
+				// The family might have already existed but all of its columns are
+				// being set to NULL, so delete it.
 				if log.V(2) {
 					log.Infof("Del %s", prettyKey(key, 0))
 				}
@@ -0,0 +1,220 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// lookupUser returns whether 'name' is a user or role in the system.users
+// table and whether it is a role. The table is read without checking the
+// privileges of the user.
+func (p *planner) lookupUser(name string) (exists bool, isRole bool, err error) {
+	rows, err := p.queryRows(fmt.Sprintf(
+		`SELECT isRole FROM system.users WHERE username = %s`, parser.DString(name)))
+	if err != nil || len(rows) == 0 {
+		return false, false, err
+	}
+	return true, rows[0][0] == parser.DBool(true), nil
+}
+
+// checkUserExists returns an error unless 'name' is a user or role. The root
+// user always exists.
+func (p *planner) checkUserExists(name string) error {
+	if name == security.RootUser {
+		return nil
+	}
+	exists, _, err := p.lookupUser(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("user or role %s does not exist", name)
+	}
+	return nil
+}
+
+// checkNoPrivileges returns an error if the user or role holds privileges on
+// any database or table.
+func (p *planner) checkNoPrivileges(name string) error {
+	prefix := roachpb.Key(MakeIndexKeyPrefix(NamespaceTable.ID, NamespaceTable.PrimaryIndex.ID))
+	kvs, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return err
+	}
+
+	valTypes := []parser.Datum{parser.DummyInt, parser.DummyString}
+	vals := make([]parser.Datum, len(valTypes))
+	for _, kv := range kvs {
+		remaining, _, err := decodeIndexKey(&NamespaceTable, NamespaceTable.PrimaryIndex,
+			valTypes, vals, kv.Key)
+		if err != nil {
+			return err
+		}
+		if len(remaining) == 0 {
+			// The row sentinel.
+			continue
+		}
+
+		var desc descriptorProto
+		if ID(vals[0].(parser.DInt)) == keys.RootNamespaceID {
+			desc = &DatabaseDescriptor{}
+		} else {
+			desc = &TableDescriptor{}
+		}
+		if err := p.txn.GetProto(MakeDescMetadataKey(ID(kv.ValueInt())), desc); err != nil {
+			return err
+		}
+		if _, ok := desc.GetPrivileges().findUser(name); ok {
+			return fmt.Errorf("cannot drop %s: it has privileges on %s %s",
+				name, desc.TypeName(), desc.GetName())
+		}
+	}
+	return nil
+}
+
+// evalPassword evaluates the password of a CREATE or ALTER USER statement
+// and returns its hash.
+func (p *planner) evalPassword(expr parser.Expr) ([]byte, error) {
+	d, err := p.evalCtx.EvalExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	password, ok := d.(parser.DString)
+	if !ok {
+		return nil, fmt.Errorf("password must be a string, found %s", d.Type())
+	}
+	if len(password) == 0 {
+		return nil, fmt.Errorf("password cannot be empty")
+	}
+	return security.HashPassword(string(password))
+}
+
+// CreateUser creates a user. Users created with a password authenticate with
+// it, the others with a client certificate.
+// Privileges: INSERT on system.users.
+//   Notes: postgres requires the CREATEROLE attribute.
+func (p *planner) CreateUser(n *parser.CreateUser) (planNode, error) {
+	name := string(n.Name)
+	if name == security.RootUser || name == security.NodeUser {
+		return nil, fmt.Errorf("user name %s is reserved", name)
+	}
+	exists, _, err := p.lookupUser(name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("user or role %s already exists", name)
+	}
+
+	hashedPassword, authMethod := parser.Datum(parser.DNull), parser.Datum(parser.DNull)
+	if n.Password != nil {
+		hashed, err := p.evalPassword(n.Password)
+		if err != nil {
+			return nil, err
+		}
+		hashedPassword = parser.DBytes(hashed)
+		authMethod = parser.DString(security.PasswordAuthMethod)
+	}
+	if err := p.execStmts(fmt.Sprintf(`
+INSERT INTO system.users (username, hashedPassword, authMethod, isRole) VALUES (%s, %s, %s, false)`,
+		parser.DString(name), hashedPassword, authMethod)); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// AlterUser sets the password of a user, which then authenticates with it.
+// Privileges: UPDATE on system.users.
+//   Notes: postgres requires the CREATEROLE attribute, unless altering the
+//          user's own password.
+func (p *planner) AlterUser(n *parser.AlterUser) (planNode, error) {
+	name := string(n.Name)
+	exists, isRole, err := p.lookupUser(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists || isRole {
+		return nil, fmt.Errorf("user %s does not exist", name)
+	}
+	hashed, err := p.evalPassword(n.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.execStmts(fmt.Sprintf(`
+UPDATE system.users SET hashedPassword = %s, authMethod = %s WHERE username = %s`,
+		parser.DBytes(hashed), parser.DString(security.PasswordAuthMethod),
+		parser.DString(name))); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// DropUser drops users, along with their role memberships. A user holding
+// privileges on a database or table cannot be dropped.
+// Privileges: DELETE on system.users and system.role_members.
+//   Notes: postgres requires the CREATEROLE attribute.
+func (p *planner) DropUser(n *parser.DropUser) (planNode, error) {
+	for _, name := range n.Names {
+		exists, isRole, err := p.lookupUser(name)
+		if err != nil {
+			return nil, err
+		}
+		if !exists || isRole {
+			return nil, fmt.Errorf("user %s does not exist", name)
+		}
+		if err := p.checkNoPrivileges(name); err != nil {
+			return nil, err
+		}
+		if err := p.execStmts(fmt.Sprintf(`
+DELETE FROM system.users WHERE username = %[1]s;
+DELETE FROM system.role_members WHERE member = %[1]s;
+`, parser.DString(name))); err != nil {
+			return nil, err
+		}
+	}
+	p.roleMemberships = nil
+	return &valuesNode{}, nil
+}
+
+// ShowUsers returns all the users, excluding roles.
+// Privileges: SELECT on system.users.
+//   Notes: postgres does not have a SHOW USERS statement.
+//          mysql does not have a SHOW USERS statement.
+func (p *planner) ShowUsers(n *parser.ShowUsers) (planNode, error) {
+	stmts, err := parser.ParseTraditional(
+		`SELECT username FROM system.users WHERE isRole IS NOT TRUE`)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := p.makePlan(stmts[0])
+	if err != nil {
+		return nil, err
+	}
+	v := &valuesNode{columns: []string{"User"}}
+	for plan.Next() {
+		v.rows = append(v.rows, parser.DTuple{plan.Values()[0]})
+	}
+	if err := plan.Err(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// sessionVar provides the implementation of a session variable. Every
+// variable can be inspected with SHOW. Variables with a set function can
+// also be changed with SET and restored to their default with RESET (or
+// SET ... = DEFAULT).
+type sessionVar struct {
+	// get returns the current value of the variable.
+	get func(p *planner) (string, error)
+	// set validates values and stores them in the session. It is nil for
+	// read-only variables.
+	set func(p *planner, name string, values parser.Exprs) error
+	// reset restores the default value of the variable.
+	reset func(p *planner) error
+}
+
+// varGen holds the session variables, keyed by their upper-cased name.
+var varGen = map[string]sessionVar{
+	`DATABASE`: {
+		get: func(p *planner) (string, error) {
+			return p.session.Database, nil
+		},
+		set: func(p *planner, name string, values parser.Exprs) error {
+			dbName, err := p.getStringVal(name, values)
+			if err != nil {
+				return err
+			}
+			if len(dbName) != 0 {
+				// Verify database descriptor exists.
+				if _, err := p.getDatabaseDesc(dbName); err != nil {
+					return err
+				}
+			}
+			p.session.Database = dbName
+			return nil
+		},
+		reset: func(p *planner) error {
+			p.session.Database = ""
+			return nil
+		},
+	},
+
+	`SYNTAX`: {
+		get: func(p *planner) (string, error) {
+			return parser.Syntax(p.session.Syntax).String(), nil
+		},
+		set: func(p *planner, name string, values parser.Exprs) error {
+			s, err := p.getStringVal(name, values)
+			if err != nil {
+				return err
+			}
+			switch normalizeName(s) {
+			case normalizeName(parser.Modern.String()):
+				p.session.Syntax = int32(parser.Modern)
+			case normalizeName(parser.Traditional.String()):
+				p.session.Syntax = int32(parser.Traditional)
+			default:
+				return fmt.Errorf("%s: \"%s\" is not in (%q, %q)", name, s, parser.Modern, parser.Traditional)
+			}
+			return nil
+		},
+		reset: func(p *planner) error {
+			p.session.Syntax = int32(parser.Traditional)
+			return nil
+		},
+	},
+
+	// TIME ZONE is changed with the dedicated SET TIME ZONE statement.
+	`TIME ZONE`: {
+		get: func(p *planner) (string, error) {
+			loc, err := p.evalCtx.GetLocation()
+			if err != nil {
+				return "", err
+			}
+			return loc.String(), nil
+		},
+	},
+
+	// TRANSACTION ISOLATION LEVEL is changed with SET TRANSACTION.
+	`TRANSACTION ISOLATION LEVEL`: {
+		get: func(p *planner) (string, error) {
+			return p.txn.Proto.Isolation.String(), nil
+		},
+	},
+
+	`DEFAULT_TRANSACTION_ISOLATION`: {
+		get: func(p *planner) (string, error) {
+			return p.session.DefaultIsolation.String(), nil
+		},
+		set: func(p *planner, name string, values parser.Exprs) error {
+			s, err := p.getStringVal(name, values)
+			if err != nil {
+				return err
+			}
+			level, ok := roachpb.IsolationType_value[strings.ToUpper(s)]
+			if !ok {
+				return fmt.Errorf("%s: \"%s\" is not in (%q, %q)", name, s, roachpb.SERIALIZABLE, roachpb.SNAPSHOT)
+			}
+			p.session.DefaultIsolation = roachpb.IsolationType(level)
+			return nil
+		},
+		reset: func(p *planner) error {
+			p.session.DefaultIsolation = roachpb.SERIALIZABLE
+			return nil
+		},
+	},
+
+	`APPLICATION_NAME`: {
+		get: func(p *planner) (string, error) {
+			return p.session.ApplicationName, nil
+		},
+		set: func(p *planner, name string, values parser.Exprs) error {
+			s, err := p.getStringVal(name, values)
+			if err != nil {
+				return err
+			}
+			p.session.ApplicationName = s
+			return nil
+		},
+		reset: func(p *planner) error {
+			p.session.ApplicationName = ""
+			return nil
+		},
+	},
+
+	// STATEMENT_TIMEOUT accepts either an integer number of milliseconds (as
+	// postgres does) or a duration string such as '1m30s'.
+	`STATEMENT_TIMEOUT`: {
+		get: func(p *planner) (string, error) {
+			return time.Duration(p.session.StatementTimeout).String(), nil
+		},
+		set: func(p *planner, name string, values parser.Exprs) error {
+			if len(values) != 1 {
+				return fmt.Errorf("%s: requires a single value", name)
+			}
+			val, err := p.evalCtx.EvalExpr(values[0])
+			if err != nil {
+				return err
+			}
+			var timeout time.Duration
+			switch v := val.(type) {
+			case parser.DInt:
+				timeout = time.Duration(v) * time.Millisecond
+			case parser.DString:
+				if timeout, err = time.ParseDuration(string(v)); err != nil {
+					return fmt.Errorf("%s: %v", name, err)
+				}
+			case parser.DInterval:
+				timeout = v.Duration
+			default:
+				return fmt.Errorf("%s: requires an integer or string value: %s is a %s",
+					name, values[0], val.Type())
+			}
+			if timeout < 0 {
+				return fmt.Errorf("%s: cannot be negative", name)
+			}
+			p.session.StatementTimeout = int64(timeout)
+			return nil
+		},
+		reset: func(p *planner) error {
+			p.session.StatementTimeout = 0
+			return nil
+		},
+	},
+
+	`SEARCH_PATH`: {
+		get: func(p *planner) (string, error) {
+			return strings.Join(p.session.SearchPath, ", "), nil
+		},
+		set: func(p *planner, name string, values parser.Exprs) error {
+			var path []string
+			for _, value := range values {
+				s, err := p.getStringVal(name, parser.Exprs{value})
+				if err != nil {
+					return err
+				}
+				if len(s) == 0 {
+					return fmt.Errorf("%s: %s", name, errEmptyDatabaseName)
+				}
+				path = append(path, s)
+			}
+			p.session.SearchPath = path
+			return nil
+		},
+		reset: func(p *planner) error {
+			p.session.SearchPath = nil
+			return nil
+		},
+	},
+
+	`RESULT_ROW_LIMIT`: {
+		get: func(p *planner) (string, error) {
+			return strconv.FormatInt(p.session.ResultRowLimit, 10), nil
+		},
+		set: func(p *planner, name string, values parser.Exprs) error {
+			limit, err := p.getIntVal(name, values)
+			if err != nil {
+				return err
+			}
+			if limit < 0 {
+				return fmt.Errorf("%s: cannot be negative", name)
+			}
+			p.session.ResultRowLimit = limit
+			return nil
+		},
+		reset: func(p *planner) error {
+			p.session.ResultRowLimit = 0
+			return nil
+		},
+	},
+}
+
+// varNames holds the names of the session variables in sorted order, as
+// returned by SHOW ALL.
+var varNames = func() []string {
+	res := make([]string, 0, len(varGen))
+	for name := range varGen {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}()
@@ -50,11 +50,26 @@ const (
 	// probabilistic "jitter" to shouldRebalance() function: the store will not
 	// take every rebalancing opportunity available.
 	rebalanceShouldRebalanceChance = 0.05
+	// diversityEpsilon is the margin within which two locality diversity
+	// scores are considered equal.
+	diversityEpsilon = 1e-9
+	// loadRebalanceThreshold is the fraction above the mean load of the
+	// stores with matching attributes at which a store sheds replicas, and
+	// below which a store accepts them because of its load. The gap between
+	// the two keeps replicas from moving back and forth between stores of
+	// similar load.
+	loadRebalanceThreshold = 0.25
+	// minLoadQPS and minLoadWriteBytesPerSecond are the mean request rate
+	// and rate of bytes written below which the respective signal is
+	// ignored when rebalancing, as it is too weak to be meaningful.
+	minLoadQPS                 = 10
+	minLoadWriteBytesPerSecond = 64 << 10
 
 	// priorities for various repair operations.
-	removeDeadReplicaPriority  float64 = 10000
-	addMissingReplicaPriority  float64 = 1000
-	removeExtraReplicaPriority float64 = 100
+	removeDeadReplicaPriority   float64 = 10000
+	addMissingReplicaPriority   float64 = 1000
+	decommissionReplicaPriority float64 = 500
+	removeExtraReplicaPriority  float64 = 100
 )
 
 // AllocatorAction enumerates the various replication adjustments that may be
@@ -68,6 +83,7 @@ const (
 	AllocatorRemove
 	AllocatorAdd
 	AllocatorRemoveDead
+	AllocatorRemoveDecommissioning
 )
 
 // RebalancingOptions are configurable options which effect the way that the
@@ -100,6 +116,21 @@ type RebalancingOptions struct {
 // When choosing a rebalance target, a random store is selected from
 // amongst the set of stores with fraction of bytes within
 // rebalanceFromMean from the mean.
+//
+// Replicas are spread across failure domains according to the locality
+// of their nodes: targets are only chosen amongst the stores whose
+// localities are most diverse from those of the existing replicas, and
+// the replica removed from a range is chosen amongst those which
+// contribute least to its diversity.
+//
+// Stores also advertise their request rate and rate of bytes written. A
+// store whose load is sufficiently above the mean sheds replicas to stores
+// whose load is sufficiently below it, regardless of their usage; see
+// relativeLoad.
+//
+// Leader leases are moved between the replicas of a range according to
+// the zone's lease preferences and to the number of leases held by each
+// store; see TransferLeaseTarget.
 type Allocator struct {
 	storePool *StorePool
 	randGen   *rand.Rand
@@ -125,6 +156,28 @@ func getUsedNodes(existing []roachpb.ReplicaDescriptor) map[roachpb.NodeID]struc
 	return usedNodes
 }
 
+// getLocalities returns the localities of the nodes of the existing
+// replicas which are known to the store pool.
+func (a Allocator) getLocalities(existing []roachpb.ReplicaDescriptor) []roachpb.Locality {
+	var localities []roachpb.Locality
+	for _, replica := range existing {
+		if desc := a.storePool.getStoreDescriptor(replica.StoreID); desc != nil {
+			localities = append(localities, desc.Node.Locality)
+		}
+	}
+	return localities
+}
+
+// diversityScore returns the sum of the diversity scores of the given
+// locality with each of the others. See roachpb.Locality.DiversityScore.
+func diversityScore(locality roachpb.Locality, others []roachpb.Locality) float64 {
+	var score float64
+	for _, other := range others {
+		score += locality.DiversityScore(other)
+	}
+	return score
+}
+
 // ComputeAction determines the exact operation needed to repair the supplied
 // range, as governed by the supplied zone configuration. It returns the
 // required action that should be taken and a replica on which the action should
@@ -143,6 +196,15 @@ func (a *Allocator) ComputeAction(zone config.ZoneConfig, desc *roachpb.RangeDes
 	// TODO(mrtracy): Handle non-homogenous and mismatched attribute sets.
 	need := len(zone.ReplicaAttrs)
 	have := len(desc.Replicas)
+	if decommissioning := len(a.storePool.decommissioningReplicas(desc.Replicas)); decommissioning > 0 && have >= need {
+		// Replicas on decommissioning nodes are moved away: a replacement is
+		// added first unless the range has enough replicas without them, so
+		// that the range never becomes under-replicated.
+		if have-decommissioning < need {
+			return AllocatorAdd, decommissionReplicaPriority
+		}
+		return AllocatorRemoveDecommissioning, decommissionReplicaPriority
+	}
 	if have < need {
 		// Range is under-replicated, and should add an additional replica.
 		// Priority is adjusted by the difference between the current replica
@@ -166,24 +228,20 @@ func (a *Allocator) ComputeAction(zone config.ZoneConfig, desc *roachpb.RangeDes
 // out as targets. If relaxConstraints is true, then the required attributes
 // will be relaxed as necessary, from least specific to most specific, in order
 // to allocate a target. If needed, a filter function can be added that further
-// filter the results. The function will be passed the storeDesc and the used
-// and new counts. It returns a bool indicating inclusion or exclusion from the
-// set of stores being considered.
+// filter the results. The function will be passed the storeDesc and the list
+// of candidate stores with their stats. It returns a bool indicating inclusion
+// or exclusion from the set of stores being considered.
 func (a *Allocator) AllocateTarget(required roachpb.Attributes, existing []roachpb.ReplicaDescriptor, relaxConstraints bool,
-	filter func(storeDesc *roachpb.StoreDescriptor, count, used *stat) bool) (*roachpb.StoreDescriptor, error) {
+	filter func(storeDesc *roachpb.StoreDescriptor, sl *StoreList) bool) (*roachpb.StoreDescriptor, error) {
 	// Because more redundancy is better than less, if relaxConstraints, the
 	// matching here is lenient, and tries to find a target by relaxing an
 	// attribute constraint, from last attribute to first.
 	for attrs := append([]string(nil), required.Attrs...); ; attrs = attrs[:len(attrs)-1] {
-		stores, sl := a.selectRandom(3, roachpb.Attributes{Attrs: attrs}, existing)
+		stores, sl := a.selectRandom(3, roachpb.Attributes{Attrs: attrs}, existing, filter)
 
 		// Choose the store with the least fraction of bytes used.
 		var leastStore *roachpb.StoreDescriptor
 		for _, s := range stores {
-			// Filter store descriptor.
-			if filter != nil && !filter(s, &sl.count, &sl.used) {
-				continue
-			}
 			if leastStore == nil {
 				leastStore = s
 				continue
@@ -212,13 +270,18 @@ func (a *Allocator) AllocateTarget(required roachpb.Attributes, existing []roach
 
 // RemoveTarget returns a suitable replica to remove from the provided replica
 // set. It attempts to consider which of the provided replicas would be the best
-// candidate for removal.
+// candidate for removal. Only the replicas contributing least to the locality
+// diversity of the replica set are considered, and amongst those, replicas on
+// overloaded stores are removed first.
 //
-// TODO(mrtracy): removeTarget eventually needs to accept the attributes from
-// the zone config associated with the provided replicas. This will allow it to
-// make correct decisions in the case of ranges with heterogeneous replica
-// requirements (i.e. multiple data centers).
-func (a Allocator) RemoveTarget(existing []roachpb.ReplicaDescriptor) (roachpb.ReplicaDescriptor, error) {
+// The load of a store is measured relative to the stores matching the
+// required attributes, as in RebalanceTarget.
+//
+// TODO(mrtracy): removeTarget eventually needs to use the required attributes
+// to choose the replica to remove. This will allow it to make correct
+// decisions in the case of ranges with heterogeneous replica requirements
+// (i.e. multiple data centers).
+func (a Allocator) RemoveTarget(required roachpb.Attributes, existing []roachpb.ReplicaDescriptor) (roachpb.ReplicaDescriptor, error) {
 	if len(existing) == 0 {
 		return roachpb.ReplicaDescriptor{}, util.Errorf("must supply at least one replica to allocator.RemoveTarget()")
 	}
@@ -242,23 +305,62 @@ func (a Allocator) RemoveTarget(existing []roachpb.ReplicaDescriptor) (roachpb.R
 		usedStat.update(desc.Capacity.FractionUsed())
 	}
 
+	// Load is measured relative to all the stores matching the required
+	// attributes, as the replica set alone gives no indication of whether a
+	// store is overloaded.
+	sl := a.storePool.getStoreList(required, a.options.Deterministic)
+	loads := make([]float64, len(replStores))
+	for i, rs := range replStores {
+		if rs.store != nil {
+			loads[i] = relativeLoad(rs.store, sl)
+		}
+	}
+
+	// Determine how much each replica contributes to the diversity of the
+	// replica set, as the sum of its diversity scores with the others.
+	scores := make([]float64, len(replStores))
+	minScore := math.Inf(1)
+	for i, rs := range replStores {
+		if rs.store == nil {
+			continue
+		}
+		for j, other := range replStores {
+			if i != j && other.store != nil {
+				scores[i] += rs.store.Node.Locality.DiversityScore(other.store.Node.Locality)
+			}
+		}
+		minScore = math.Min(minScore, scores[i])
+	}
+
 	// Based on store statistics, determine which replica is the "worst" and
 	// thus should be removed.
 	var worst replStore
+	worstLoad := 0.0
+	first := true
 	for i, rs := range replStores {
-		if i == 0 {
-			worst = rs
+		if rs.store != nil && scores[i] > minScore+diversityEpsilon {
+			continue
+		}
+		if first {
+			worst, worstLoad = rs, loads[i]
+			first = false
 			continue
 		}
 
+		if loads[i] > 1+loadRebalanceThreshold || worstLoad > 1+loadRebalanceThreshold {
+			if loads[i] > worstLoad {
+				worst, worstLoad = rs, loads[i]
+			}
+			continue
+		}
 		if usedStat.mean < minFractionUsedThreshold {
 			if rs.store.Capacity.RangeCount > worst.store.Capacity.RangeCount {
-				worst = rs
+				worst, worstLoad = rs, loads[i]
 			}
 			continue
 		}
 		if rs.store.Capacity.FractionUsed() > worst.store.Capacity.FractionUsed() {
-			worst = rs
+			worst, worstLoad = rs, loads[i]
 		}
 	}
 	return worst.repl, nil
@@ -276,18 +378,28 @@ func (a Allocator) RemoveTarget(existing []roachpb.ReplicaDescriptor) (roachpb.R
 // doing their probabilistic best to rebalance. This helps prevent
 // a stampeding herd targeting an abnormally under-utilized store.
 func (a Allocator) RebalanceTarget(required roachpb.Attributes, existing []roachpb.ReplicaDescriptor) *roachpb.StoreDescriptor {
-	filter := func(s *roachpb.StoreDescriptor, count, used *stat) bool {
+	filter := func(s *roachpb.StoreDescriptor, sl *StoreList) bool {
+		// A store is eligible to be a rebalancing target if its load is
+		// sufficiently below the mean load for stores with matching
+		// attributes, as long as it isn't full. A store loaded above the
+		// mean never is, so that it isn't made an even worse hotspot.
+		load := relativeLoad(s, sl)
+		if load < 1-loadRebalanceThreshold {
+			return s.Capacity.FractionUsed() < maxFractionUsedThreshold
+		} else if load > 1 {
+			return false
+		}
 		// In clusters with very low disk usage, a store is eligible to be a
 		// rebalancing target if the number of ranges on that store is below
 		// average. This is primarily useful for distributing load evenly in a
 		// nascent deployment.
-		if used.mean < minFractionUsedThreshold {
-			return float64(s.Capacity.RangeCount) < count.mean
+		if sl.used.mean < minFractionUsedThreshold {
+			return float64(s.Capacity.RangeCount) < sl.count.mean
 		}
 		// A store is eligible to be a rebalancing target if its disk usage is
 		// sufficiently below the mean usage for stores with matching
 		// attributes.
-		maxFractionUsed := used.mean * (1 - rebalanceFromMean)
+		maxFractionUsed := sl.used.mean * (1 - rebalanceFromMean)
 		if maxFractionUsedThreshold < maxFractionUsed {
 			// In clusters with very high average usage, rebalancing is clamped
 			// at maxFractionUsedThreshold: even if a store's usage is below
@@ -320,6 +432,14 @@ func (a Allocator) ShouldRebalance(storeID roachpb.StoreID) bool {
 	if !a.options.Deterministic && a.randGen.Float32() > rebalanceShouldRebalanceChance {
 		return false
 	}
+	return a.shouldRebalance(storeID)
+}
+
+// shouldRebalance implements ShouldRebalance, without the random jitter.
+func (a Allocator) shouldRebalance(storeID roachpb.StoreID) bool {
+	if !a.options.AllowRebalance {
+		return false
+	}
 	if log.V(2) {
 		log.Infof("Attempting to rebalance from store %d", storeID)
 	}
@@ -335,6 +455,14 @@ func (a Allocator) ShouldRebalance(storeID roachpb.StoreID) bool {
 
 	sl := a.storePool.getStoreList(*storeDesc.CombinedAttrs(), a.options.Deterministic)
 
+	// A store is eligible for rebalancing if its load is sufficiently above
+	// the mean load for stores with matching attributes, whatever its usage.
+	if load := relativeLoad(storeDesc, sl); load > 1+loadRebalanceThreshold {
+		if log.V(2) {
+			log.Infof("Attempting to rebalance using load, relative load = %f", load)
+		}
+		return true
+	}
 	// In clusters with very low disk usage, a store is eligible for rebalancing
 	// if the number of ranges on the store is above average. This is primarily
 	// useful for distributing load in a nascent deployment.
@@ -359,20 +487,245 @@ func (a Allocator) ShouldRebalance(storeID roachpb.StoreID) bool {
 	return storeDesc.Capacity.FractionUsed() > minFractionUsed
 }
 
+// isOverloaded returns whether the load of the store is sufficiently above
+// the mean load for stores with matching attributes that it sheds replicas
+// and leases; see relativeLoad.
+func (a Allocator) isOverloaded(storeID roachpb.StoreID) bool {
+	if !a.options.AllowRebalance {
+		return false
+	}
+	storeDesc := a.storePool.getStoreDescriptor(storeID)
+	if storeDesc == nil {
+		return false
+	}
+	sl := a.storePool.getStoreList(*storeDesc.CombinedAttrs(), a.options.Deterministic)
+	return relativeLoad(storeDesc, sl) > 1+loadRebalanceThreshold
+}
+
+// loadShare returns the fractions of the request rate and of the rate of
+// bytes written of the store which are due to a replica with the given
+// rates. A rate of which the store has none counts as a zero fraction.
+func (a Allocator) loadShare(storeID roachpb.StoreID, qps, writeBytesPerSecond float64) (qpsShare, writeShare float64) {
+	storeDesc := a.storePool.getStoreDescriptor(storeID)
+	if storeDesc == nil {
+		return 0, 0
+	}
+	if storeDesc.Capacity.QueriesPerSecond > 0 {
+		qpsShare = math.Min(1, qps/storeDesc.Capacity.QueriesPerSecond)
+	}
+	if storeDesc.Capacity.WriteBytesPerSecond > 0 {
+		writeShare = math.Min(1, writeBytesPerSecond/storeDesc.Capacity.WriteBytesPerSecond)
+	}
+	return qpsShare, writeShare
+}
+
+// LoadTransferLeaseTarget returns the replica to which the leader lease
+// held by the replica on the overloaded leaseStoreID should be transferred
+// to shed the range's read load, or nil if there is none. Only the lease
+// holder serves reads, so moving the lease moves them without moving any
+// data. The target is the replica allowed to hold the lease by the zone's
+// lease preferences whose store is the least loaded, as long as its load
+// is sufficiently below the mean load for stores with the attributes of
+// leaseStoreID.
+func (a Allocator) LoadTransferLeaseTarget(zone config.ZoneConfig, existing []roachpb.ReplicaDescriptor,
+	leaseStoreID roachpb.StoreID) *roachpb.ReplicaDescriptor {
+	holder := a.storePool.getStoreDescriptor(leaseStoreID)
+	if holder == nil {
+		return nil
+	}
+	sl := a.storePool.getStoreList(*holder.CombinedAttrs(), a.options.Deterministic)
+	candidates, descs := a.leaseCandidates(zone, existing)
+
+	var target *roachpb.ReplicaDescriptor
+	minLoad := 1 - loadRebalanceThreshold
+	for i, desc := range descs {
+		if desc.StoreID == leaseStoreID {
+			continue
+		}
+		if load := relativeLoad(desc, sl); load < minLoad {
+			target, minLoad = &candidates[i], load
+		}
+	}
+	return target
+}
+
+// relativeLoad returns the load of the store relative to the mean load of
+// the stores in the list: the larger of the ratios of its request rate and
+// of its rate of bytes written to the respective means. Signals whose mean
+// is below minLoadQPS or minLoadWriteBytesPerSecond are ignored; if both
+// are, the load is considered average and 1 is returned.
+func relativeLoad(s *roachpb.StoreDescriptor, sl *StoreList) float64 {
+	load := -1.0
+	if sl.qps.mean >= minLoadQPS {
+		load = math.Max(load, s.Capacity.QueriesPerSecond/sl.qps.mean)
+	}
+	if sl.writeBytes.mean >= minLoadWriteBytesPerSecond {
+		load = math.Max(load, s.Capacity.WriteBytesPerSecond/sl.writeBytes.mean)
+	}
+	if load < 0 {
+		return 1
+	}
+	return load
+}
+
+// ShouldTransferLease returns whether the leader lease held by the replica
+// on leaseStoreID should be transferred to another of the existing
+// replicas. See TransferLeaseTarget.
+func (a Allocator) ShouldTransferLease(zone config.ZoneConfig, existing []roachpb.ReplicaDescriptor,
+	leaseStoreID roachpb.StoreID) bool {
+	target, rebalance := a.transferLeaseTarget(zone, existing, leaseStoreID)
+	if target == nil {
+		return false
+	}
+	// As for replicas, add some random jitter to lease rebalancing in
+	// production, so that the stores' lease counts, which are only
+	// periodically gossiped, don't all swing over at once.
+	if rebalance && !a.options.Deterministic && a.randGen.Float32() > rebalanceShouldRebalanceChance {
+		return false
+	}
+	return true
+}
+
+// TransferLeaseTarget returns the replica to which the leader lease held
+// by the replica on leaseStoreID should be transferred, or nil if the
+// lease should stay where it is.
+//
+// Leases are held by the replicas matching the first of the zone's lease
+// preferences which is matched by any live replica, or by any live replica
+// if no preference is matched. A lease held by another replica is moved
+// onto the matching replica whose store holds the fewest leases. When
+// rebalancing is allowed, a lease is also moved off a store holding more
+// leases than the mean of the matching replicas' stores, so as to spread
+// leases evenly.
+func (a Allocator) TransferLeaseTarget(zone config.ZoneConfig, existing []roachpb.ReplicaDescriptor,
+	leaseStoreID roachpb.StoreID) *roachpb.ReplicaDescriptor {
+	target, _ := a.transferLeaseTarget(zone, existing, leaseStoreID)
+	return target
+}
+
+// transferLeaseTarget implements TransferLeaseTarget, additionally
+// returning whether the transfer is only required to rebalance leases.
+func (a Allocator) transferLeaseTarget(zone config.ZoneConfig, existing []roachpb.ReplicaDescriptor,
+	leaseStoreID roachpb.StoreID) (*roachpb.ReplicaDescriptor, bool) {
+	if a.storePool.getStoreDescriptor(leaseStoreID) == nil {
+		// Without the lease holder's own descriptor, there's not enough
+		// information to make a decision.
+		return nil, false
+	}
+	candidates, descs := a.leaseCandidates(zone, existing)
+
+	var target, holder *roachpb.StoreDescriptor
+	var targetReplica *roachpb.ReplicaDescriptor
+	var total float64
+	for i, desc := range descs {
+		total += float64(desc.Capacity.LeaseCount)
+		if desc.StoreID == leaseStoreID {
+			holder = desc
+			continue
+		}
+		if target == nil || desc.Capacity.LeaseCount < target.Capacity.LeaseCount {
+			target, targetReplica = desc, &candidates[i]
+		}
+	}
+	if holder == nil {
+		// The lease holder doesn't match the zone's lease preferences.
+		return targetReplica, false
+	}
+	if target == nil || !a.options.AllowRebalance {
+		return nil, false
+	}
+	mean := total / float64(len(descs))
+	if float64(holder.Capacity.LeaseCount) <= math.Ceil(mean) {
+		return nil, false
+	}
+	if log.V(2) {
+		log.Infof("rebalancing lease from store %d (%d leases) to store %d (%d leases), mean = %f",
+			holder.StoreID, holder.Capacity.LeaseCount, target.StoreID, target.Capacity.LeaseCount, mean)
+	}
+	return targetReplica, true
+}
+
+// leaseCandidates returns the live replicas amongst existing which may hold
+// the leader lease according to the zone's lease preferences, along with
+// the descriptors of their stores.
+func (a Allocator) leaseCandidates(zone config.ZoneConfig, existing []roachpb.ReplicaDescriptor) (
+	[]roachpb.ReplicaDescriptor, []*roachpb.StoreDescriptor) {
+	dead := map[roachpb.StoreID]struct{}{}
+	for _, replica := range a.storePool.deadReplicas(existing) {
+		dead[replica.StoreID] = struct{}{}
+	}
+	var live []roachpb.ReplicaDescriptor
+	var liveDescs []*roachpb.StoreDescriptor
+	for _, replica := range existing {
+		if _, ok := dead[replica.StoreID]; ok {
+			continue
+		}
+		if desc := a.storePool.getStoreDescriptor(replica.StoreID); desc != nil {
+			live = append(live, replica)
+			liveDescs = append(liveDescs, desc)
+		}
+	}
+
+	for _, p := range zone.LeasePreferences {
+		var pref roachpb.Locality
+		if err := pref.Set(p); err != nil {
+			log.Warningf("ignoring invalid lease preference %q: %s", p, err)
+			continue
+		}
+		var candidates []roachpb.ReplicaDescriptor
+		var descs []*roachpb.StoreDescriptor
+		for i, desc := range liveDescs {
+			if desc.Node.Locality.Matches(pref) {
+				candidates = append(candidates, live[i])
+				descs = append(descs, desc)
+			}
+		}
+		if len(candidates) > 0 {
+			return candidates, descs
+		}
+	}
+	return live, liveDescs
+}
+
 // selectRandom chooses count random store descriptors which match the
 // required attributes and do not include any of the existing
-// replicas. If the supplied filter is nil, it is ignored. Returns the
-// list of matching descriptors, and the store list matching the
-// required attributes.
-func (a Allocator) selectRandom(count int, required roachpb.Attributes, existing []roachpb.ReplicaDescriptor) ([]*roachpb.StoreDescriptor, *StoreList) {
+// replicas. Stores rejected by the optional filter are excluded
+// before diversity is considered, so that only the remaining stores
+// whose localities are most diverse from those of the existing
+// replicas are chosen. Returns the list of matching descriptors, and
+// the store list matching the required attributes.
+func (a Allocator) selectRandom(count int, required roachpb.Attributes, existing []roachpb.ReplicaDescriptor,
+	filter func(storeDesc *roachpb.StoreDescriptor, sl *StoreList) bool) ([]*roachpb.StoreDescriptor, *StoreList) {
 	var descs []*roachpb.StoreDescriptor
 	sl := a.storePool.getStoreList(required, a.options.Deterministic)
 	used := getUsedNodes(existing)
 
+	// Score the available stores by locality diversity.
+	localities := a.getLocalities(existing)
+	candidates := make([]bool, len(sl.stores))
+	scores := make([]float64, len(sl.stores))
+	maxScore := 0.0
+	for i, s := range sl.stores {
+		// Skip used nodes.
+		if _, ok := used[s.Node.NodeID]; ok {
+			continue
+		}
+		// Filter store descriptor.
+		if filter != nil && !filter(s, sl) {
+			continue
+		}
+		candidates[i] = true
+		scores[i] = diversityScore(s.Node.Locality, localities)
+		maxScore = math.Max(maxScore, scores[i])
+	}
+
 	// Randomly permute available stores matching the required attributes.
 	for _, idx := range a.randGen.Perm(len(sl.stores)) {
-		// Skip used nodes.
-		if _, ok := used[sl.stores[idx].Node.NodeID]; ok {
+		if !candidates[idx] {
+			continue
+		}
+		// Skip stores which are less diverse than the best candidates.
+		if scores[idx] < maxScore-diversityEpsilon {
 			continue
 		}
 		// Add this store; exit loop if we've satisfied count.
@@ -22,6 +22,7 @@ package storage
 import (
 	"fmt"
 	"math/rand"
+	"reflect"
 	"sync"
 	"testing"
 
@@ -551,6 +552,156 @@ func TestAllocatorRebalanceByCount(t *testing.T) {
 	}
 }
 
+// TestAllocatorRebalanceByLoad verifies that stores loaded sufficiently
+// above the mean rebalance regardless of their usage, that rebalance targets
+// are chosen amongst stores loaded sufficiently below the mean, and that
+// stores loaded close to the mean do neither.
+func TestAllocatorRebalanceByLoad(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+
+	// Setup the stores with the same usage so that only load differs. Store 1
+	// is overloaded, stores 2 and 3 are close to the mean and store 4 is
+	// underloaded.
+	stores := []*roachpb.StoreDescriptor{
+		{
+			StoreID:  1,
+			Node:     roachpb.NodeDescriptor{NodeID: 1},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, QueriesPerSecond: 200},
+		},
+		{
+			StoreID:  2,
+			Node:     roachpb.NodeDescriptor{NodeID: 2},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, QueriesPerSecond: 100},
+		},
+		{
+			StoreID:  3,
+			Node:     roachpb.NodeDescriptor{NodeID: 3},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, QueriesPerSecond: 100},
+		},
+		{
+			StoreID:  4,
+			Node:     roachpb.NodeDescriptor{NodeID: 4},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, QueriesPerSecond: 20},
+		},
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	// Every rebalance target must be store 4 (or nil for case of missing the only option).
+	for i := 0; i < 10; i++ {
+		result := a.RebalanceTarget(roachpb.Attributes{}, []roachpb.ReplicaDescriptor{})
+		if result != nil && result.StoreID != 4 {
+			t.Errorf("expected store 4; got %d", result.StoreID)
+		}
+	}
+
+	// Verify ShouldRebalance results.
+	a.options.Deterministic = true
+	for i, store := range stores {
+		result := a.ShouldRebalance(store.StoreID)
+		if expResult := (i == 0); expResult != result {
+			t.Errorf("%d: expected rebalance %t; got %t", i, expResult, result)
+		}
+	}
+}
+
+// TestAllocatorShedLoad verifies that the lease of a range on an overloaded
+// store is moved to the replica on the least loaded store, and that a store
+// stops shedding load once the load moved off it brings it close to the
+// mean.
+func TestAllocatorShedLoad(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, storePool, a := createTestAllocator()
+	defer stopper.Stop()
+	a.options.Deterministic = true
+
+	stores := []*roachpb.StoreDescriptor{
+		{
+			StoreID:  1,
+			Node:     roachpb.NodeDescriptor{NodeID: 1},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, QueriesPerSecond: 200},
+		},
+		{
+			StoreID:  2,
+			Node:     roachpb.NodeDescriptor{NodeID: 2},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, QueriesPerSecond: 100},
+		},
+		{
+			StoreID:  3,
+			Node:     roachpb.NodeDescriptor{NodeID: 3},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, QueriesPerSecond: 40},
+		},
+		{
+			StoreID:  4,
+			Node:     roachpb.NodeDescriptor{NodeID: 4},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, QueriesPerSecond: 60},
+		},
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	replicas := []roachpb.ReplicaDescriptor{
+		{StoreID: 1, NodeID: 1, ReplicaID: 1},
+		{StoreID: 2, NodeID: 2, ReplicaID: 2},
+		{StoreID: 3, NodeID: 3, ReplicaID: 3},
+	}
+	if !a.isOverloaded(1) {
+		t.Fatal("expected store 1 to be overloaded")
+	}
+	if qpsShare, writeShare := a.loadShare(1, 50, 0); qpsShare != 0.25 || writeShare != 0 {
+		t.Errorf("expected load share (0.25, 0); got (%f, %f)", qpsShare, writeShare)
+	}
+	// Store 2 is loaded close to the mean, so only store 3 can take the lease.
+	if target := a.LoadTransferLeaseTarget(config.ZoneConfig{}, replicas, 1); target == nil || target.StoreID != 3 {
+		t.Errorf("expected lease transfer target on store 3; got %v", target)
+	}
+	if target := a.LoadTransferLeaseTarget(config.ZoneConfig{}, replicas[:2], 1); target != nil {
+		t.Errorf("expected no lease transfer target; got %v", target)
+	}
+
+	// Once the load of the ranges moved off store 1 is accounted for, it is
+	// close enough to the mean to stop shedding load.
+	storePool.updateLocalStoreLoad(1, -100, 0)
+	storePool.updateLocalStoreLoad(3, 100, 0)
+	if a.isOverloaded(1) {
+		t.Error("expected store 1 not to be overloaded")
+	}
+	if a.shouldRebalance(1) {
+		t.Error("expected store 1 not to rebalance")
+	}
+}
+
+// TestRelativeLoad verifies the computation of a store's load relative to
+// a list of stores.
+func TestRelativeLoad(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		qps, writeBytes         float64 // Load of the store
+		meanQPS, meanWriteBytes float64 // Mean load of the list
+		expected                float64
+	}{
+		// Both signals below their minimum.
+		{100, 1000, minLoadQPS / 2, minLoadWriteBytesPerSecond / 2, 1},
+		// Only one signal above its minimum.
+		{50, 1000, 100, minLoadWriteBytesPerSecond / 2, 0.5},
+		{50, 4 * minLoadWriteBytesPerSecond, 10 * minLoadQPS / 2, 2 * minLoadWriteBytesPerSecond, 2},
+		// The larger ratio wins.
+		{300, minLoadWriteBytesPerSecond, 100, 2 * minLoadWriteBytesPerSecond, 3},
+		{50, 3 * minLoadWriteBytesPerSecond, 100, 2 * minLoadWriteBytesPerSecond, 1.5},
+	}
+	for i, test := range testCases {
+		s := &roachpb.StoreDescriptor{
+			Capacity: roachpb.StoreCapacity{QueriesPerSecond: test.qps, WriteBytesPerSecond: test.writeBytes},
+		}
+		sl := &StoreList{}
+		sl.qps.mean = test.meanQPS
+		sl.writeBytes.mean = test.meanWriteBytes
+		if load := relativeLoad(s, sl); load != test.expected {
+			t.Errorf("%d: expected relative load %f; got %f", i, test.expected, load)
+		}
+	}
+}
+
 // TestAllocatorRemoveTarget verifies that the replica chosen by RemoveTarget is
 // the one with the lowest capacity.
 func TestAllocatorRemoveTarget(t *testing.T) {
@@ -608,7 +759,7 @@ func TestAllocatorRemoveTarget(t *testing.T) {
 	sg := gossiputil.NewStoreGossiper(g)
 	sg.GossipStores(stores, t)
 
-	targetRepl, err := a.RemoveTarget(replicas)
+	targetRepl, err := a.RemoveTarget(roachpb.Attributes{}, replicas)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -642,13 +793,219 @@ func TestAllocatorRemoveTarget(t *testing.T) {
 	}
 	sg.GossipStores(stores, t)
 
-	targetRepl, err = a.RemoveTarget(replicas)
+	targetRepl, err = a.RemoveTarget(roachpb.Attributes{}, replicas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, e := targetRepl, replicas[1]; a != e {
+		t.Fatalf("RemoveTarget did not select expected replica; expected %v, got %v", e, a)
+	}
+}
+
+// TestAllocatorRemoveTargetByLoad verifies that RemoveTarget prefers the
+// replica on an overloaded store to the one on the fullest store.
+func TestAllocatorRemoveTargetByLoad(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+
+	replicas := []roachpb.ReplicaDescriptor{
+		{StoreID: 1, NodeID: 1, ReplicaID: 1},
+		{StoreID: 2, NodeID: 2, ReplicaID: 2},
+		{StoreID: 3, NodeID: 3, ReplicaID: 3},
+	}
+
+	// Store 1 is the fullest, but store 2 is overloaded. Store 4 holds no
+	// replica but counts towards the mean load.
+	stores := []*roachpb.StoreDescriptor{
+		{
+			StoreID:  1,
+			Node:     roachpb.NodeDescriptor{NodeID: 1},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 50, RangeCount: 10, WriteBytesPerSecond: 1 << 20},
+		},
+		{
+			StoreID:  2,
+			Node:     roachpb.NodeDescriptor{NodeID: 2},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 90, RangeCount: 10, WriteBytesPerSecond: 3 << 20},
+		},
+		{
+			StoreID:  3,
+			Node:     roachpb.NodeDescriptor{NodeID: 3},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 80, RangeCount: 10, WriteBytesPerSecond: 1 << 20},
+		},
+		{
+			StoreID:  4,
+			Node:     roachpb.NodeDescriptor{NodeID: 4},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100, RangeCount: 5},
+		},
+	}
+	sg := gossiputil.NewStoreGossiper(g)
+	sg.GossipStores(stores, t)
+
+	targetRepl, err := a.RemoveTarget(roachpb.Attributes{}, replicas)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if a, e := targetRepl, replicas[1]; a != e {
 		t.Fatalf("RemoveTarget did not select expected replica; expected %v, got %v", e, a)
 	}
+
+	// With the load balanced, the fullest store is chosen again.
+	stores[1].Capacity.WriteBytesPerSecond = 1 << 20
+	stores[3].Capacity.WriteBytesPerSecond = 1 << 20
+	sg.GossipStores(stores, t)
+
+	targetRepl, err = a.RemoveTarget(roachpb.Attributes{}, replicas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, e := targetRepl, replicas[0]; a != e {
+		t.Fatalf("RemoveTarget did not select expected replica; expected %v, got %v", e, a)
+	}
+}
+
+// makeTestLocality returns a locality with the given zone and rack tiers.
+func makeTestLocality(zone, rack string) roachpb.Locality {
+	return roachpb.Locality{Tiers: []roachpb.Tier{
+		{Key: "zone", Value: zone},
+		{Key: "rack", Value: rack},
+	}}
+}
+
+// TestAllocatorLocalityDiversity verifies that allocation targets are
+// chosen to maximize the locality diversity of the replica set, and that
+// the replica chosen for removal is one which contributes least to it.
+func TestAllocatorLocalityDiversity(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+
+	localities := []roachpb.Locality{
+		makeTestLocality("a", "1"),
+		makeTestLocality("a", "1"),
+		makeTestLocality("a", "2"),
+		makeTestLocality("b", "1"),
+		makeTestLocality("b", "2"),
+		makeTestLocality("c", "1"),
+	}
+	var stores []*roachpb.StoreDescriptor
+	for i, locality := range localities {
+		id := i + 1
+		stores = append(stores, &roachpb.StoreDescriptor{
+			StoreID:  roachpb.StoreID(id),
+			Node:     roachpb.NodeDescriptor{NodeID: roachpb.NodeID(id), Locality: locality},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100 - int64(10*id)},
+		})
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	// With replicas in zones a and b, the only store in zone c is the most
+	// diverse target, even though it is the fullest.
+	existing := []roachpb.ReplicaDescriptor{
+		{NodeID: 1, StoreID: 1},
+		{NodeID: 4, StoreID: 4},
+	}
+	for i := 0; i < 10; i++ {
+		result, err := a.AllocateTarget(roachpb.Attributes{}, existing, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.StoreID != 6 {
+			t.Errorf("%d: expected store 6; got %d", i, result.StoreID)
+		}
+	}
+
+	// If the most diverse store is filtered out, the target is instead
+	// chosen by diversity among the remaining stores, here those in zones
+	// a and b on a different rack than the existing replicas.
+	filter := func(s *roachpb.StoreDescriptor, _ *StoreList) bool {
+		return s.StoreID != 6
+	}
+	for i := 0; i < 10; i++ {
+		result, err := a.AllocateTarget(roachpb.Attributes{}, existing, false, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.StoreID != 3 && result.StoreID != 5 {
+			t.Errorf("%d: expected store 3 or 5; got %d", i, result.StoreID)
+		}
+	}
+
+	// With two replicas on the same rack, one of them is removed rather
+	// than the fuller replica in zone c. Of the two, the fuller one is
+	// removed.
+	replicas := []roachpb.ReplicaDescriptor{
+		{NodeID: 1, StoreID: 1, ReplicaID: 1},
+		{NodeID: 2, StoreID: 2, ReplicaID: 2},
+		{NodeID: 6, StoreID: 6, ReplicaID: 3},
+	}
+	targetRepl, err := a.RemoveTarget(roachpb.Attributes{}, replicas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, e := targetRepl, replicas[1]; a != e {
+		t.Fatalf("RemoveTarget did not select expected replica; expected %v, got %v", e, a)
+	}
+}
+
+// TestAllocatorTransferLeaseTarget verifies that leader leases are moved
+// onto the replicas matching the zone's lease preferences, and off stores
+// holding more than their share of leases.
+func TestAllocatorTransferLeaseTarget(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, g, _, a := createTestAllocator()
+	defer stopper.Stop()
+
+	regions := []string{"us-east", "us-west", "us-east"}
+	leaseCounts := []int32{10, 0, 2}
+	var stores []*roachpb.StoreDescriptor
+	var existing []roachpb.ReplicaDescriptor
+	for i, region := range regions {
+		id := i + 1
+		var locality roachpb.Locality
+		if err := locality.Set("region=" + region); err != nil {
+			t.Fatal(err)
+		}
+		stores = append(stores, &roachpb.StoreDescriptor{
+			StoreID:  roachpb.StoreID(id),
+			Node:     roachpb.NodeDescriptor{NodeID: roachpb.NodeID(id), Locality: locality},
+			Capacity: roachpb.StoreCapacity{Capacity: 100, Available: 100, LeaseCount: leaseCounts[i]},
+		})
+		existing = append(existing, roachpb.ReplicaDescriptor{
+			NodeID:    roachpb.NodeID(id),
+			StoreID:   roachpb.StoreID(id),
+			ReplicaID: roachpb.ReplicaID(id),
+		})
+	}
+	gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+
+	testCases := []struct {
+		preferences []string
+		holder      roachpb.StoreID
+		expected    roachpb.StoreID // 0 if the lease should stay put
+	}{
+		// Without preferences, the lease moves off the store holding the
+		// most leases onto the store holding the fewest.
+		{nil, 1, 2},
+		{nil, 3, 0},
+		// With preferences, the lease stays amongst the matching replicas.
+		{[]string{"region=us-east"}, 1, 3},
+		{[]string{"region=us-east"}, 3, 0},
+		{[]string{"region=us-east"}, 2, 3},
+		// Preferences which no replica matches are skipped.
+		{[]string{"region=eu", "region=us-west"}, 1, 2},
+		{[]string{"region=eu", "region=us-west"}, 2, 0},
+	}
+	for i, test := range testCases {
+		zone := config.ZoneConfig{LeasePreferences: test.preferences}
+		var storeID roachpb.StoreID
+		if target := a.TransferLeaseTarget(zone, existing, test.holder); target != nil {
+			storeID = target.StoreID
+		}
+		if storeID != test.expected {
+			t.Errorf("%d: expected lease target %d; got %d", i, test.expected, storeID)
+		}
+	}
 }
 
 func TestAllocatorComputeAction(t *testing.T) {
@@ -1055,6 +1412,62 @@ func TestAllocatorComputeAction(t *testing.T) {
 	}
 }
 
+// TestAllocatorComputeActionDecommission verifies that replicas on the stores
+// of decommissioning nodes are replaced, and that such stores are not used
+// as allocation targets.
+func TestAllocatorComputeActionDecommission(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper, _, sp, a := createTestAllocator()
+	defer stopper.Stop()
+
+	// Set up five stores, the third of which is being decommissioned.
+	mockStorePool(sp, []roachpb.StoreID{1, 2, 3, 4, 5}, nil)
+	sp.mu.Lock()
+	sp.stores[3].desc.Node.Decommissioning = true
+	sp.mu.Unlock()
+
+	zone := config.ZoneConfig{
+		ReplicaAttrs: []roachpb.Attributes{{}, {}, {}},
+	}
+	makeDesc := func(storeIDs ...roachpb.StoreID) *roachpb.RangeDescriptor {
+		desc := &roachpb.RangeDescriptor{}
+		for _, storeID := range storeIDs {
+			desc.Replicas = append(desc.Replicas, roachpb.ReplicaDescriptor{
+				NodeID:    roachpb.NodeID(storeID),
+				StoreID:   storeID,
+				ReplicaID: roachpb.ReplicaID(storeID),
+			})
+		}
+		return desc
+	}
+
+	testCases := []struct {
+		desc           *roachpb.RangeDescriptor
+		expectedAction AllocatorAction
+	}{
+		// A replacement for the decommissioning replica is added first.
+		{makeDesc(1, 2, 3), AllocatorAdd},
+		// Then the decommissioning replica is removed.
+		{makeDesc(1, 2, 3, 4), AllocatorRemoveDecommissioning},
+		// Ranges without decommissioning replicas are unaffected.
+		{makeDesc(1, 2, 4), AllocatorNoop},
+	}
+	for i, tcase := range testCases {
+		if action, _ := a.ComputeAction(zone, tcase.desc); action != tcase.expectedAction {
+			t.Errorf("%d: expected action %d, got action %d", i, tcase.expectedAction, action)
+		}
+	}
+
+	sl := sp.getStoreList(roachpb.Attributes{}, true)
+	var storeIDs []roachpb.StoreID
+	for _, desc := range sl.stores {
+		storeIDs = append(storeIDs, desc.StoreID)
+	}
+	if exp := []roachpb.StoreID{1, 2, 4, 5}; !reflect.DeepEqual(storeIDs, exp) {
+		t.Errorf("expected stores %v as allocation candidates, got %v", exp, storeIDs)
+	}
+}
+
 type testStore struct {
 	roachpb.StoreDescriptor
 }
@@ -0,0 +1,94 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+const (
+	// consistencyQueueMaxSize is the max size of the consistency queue.
+	consistencyQueueMaxSize = 100
+	// consistencyCheckInterval is the target duration between consistency
+	// checks of each range.
+	consistencyCheckInterval = 24 * time.Hour
+)
+
+// consistencyQueue periodically has the leader of each range compare its
+// data with that of the range's other replicas. See Replica.CheckConsistency.
+type consistencyQueue struct {
+	countFn rangeCountFn
+	*baseQueue
+}
+
+// newConsistencyQueue returns a new instance of consistencyQueue.
+func newConsistencyQueue(gossip *gossip.Gossip, countFn rangeCountFn) *consistencyQueue {
+	cq := &consistencyQueue{countFn: countFn}
+	cq.baseQueue = newBaseQueue("consistency", cq, gossip, consistencyQueueMaxSize)
+	return cq
+}
+
+func (cq *consistencyQueue) needsLeaderLease() bool {
+	return true
+}
+
+func (cq *consistencyQueue) acceptsUnsplitRanges() bool {
+	return true
+}
+
+// shouldQueue determines whether a range should be queued for a
+// consistency check, and if so, at what priority. Returns true for shouldQ
+// in the event that it's been longer since the last check than the check
+// interval. The time of the last check is not persisted; a new replica
+// counts as just checked, so restarts postpone the next check.
+func (cq *consistencyQueue) shouldQueue(now roachpb.Timestamp, rng *Replica,
+	_ *config.SystemConfig) (shouldQ bool, priority float64) {
+
+	lastCheck := rng.getLastConsistencyCheck()
+	checkScore := float64(now.WallTime-lastCheck.WallTime) / float64(consistencyCheckInterval.Nanoseconds())
+	if checkScore > 1 {
+		priority = checkScore
+		shouldQ = true
+	}
+	return
+}
+
+// process runs a consistency check of the range. Inconsistencies are
+// reported by the replicas which find them rather than returned here.
+func (cq *consistencyQueue) process(now roachpb.Timestamp, rng *Replica,
+	_ *config.SystemConfig) error {
+
+	rng.setLastConsistencyCheck(now)
+	desc := rng.Desc()
+	args := roachpb.CheckConsistencyRequest{
+		Span: roachpb.Span{
+			Key: desc.StartKey.AsRawKey(),
+		},
+	}
+	_, err := rng.CheckConsistency(args, desc)
+	return err
+}
+
+// timer returns the duration of intervals between successive consistency
+// checks. The durations are sized so that the full complement of ranges
+// can be checked within consistencyCheckInterval.
+func (cq *consistencyQueue) timer() time.Duration {
+	return time.Duration(consistencyCheckInterval.Nanoseconds() / int64((cq.countFn() + 1)))
+}
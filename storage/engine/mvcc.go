@@ -555,6 +555,16 @@ func mvccGetInternal(engine Engine, key roachpb.Key, metaKey roachpb.EncodedKey,
 					txn.Epoch, meta.Txn.Epoch)
 			}
 			valueKey, err = getValue(engine, latestKey.Next(), MVCCEncodeKey(key.Next()), value)
+		} else if ownIntent && txn.IsRolledBack(meta.Txn.Sequence) {
+			// The write which laid down the intent was rolled back to a
+			// savepoint. Read the latest earlier write which wasn't rolled
+			// back or, if there is none, the value below the intent.
+			if i := latestIntentValue(meta, txn); i >= 0 {
+				*value = meta.IntentHistory[i].Value
+				valueKey = latestKey
+			} else {
+				valueKey, err = getValue(engine, latestKey.Next(), MVCCEncodeKey(key.Next()), value)
+			}
 		} else {
 			var ok bool
 			ok, _, _, err = engine.GetProto(latestKey, value)
@@ -751,6 +761,7 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 
 	var meta *MVCCMetadata
 	var origAgeSeconds int64
+	var intentHistory []SequencedValue
 	if ok {
 		// There is existing metadata for this key; ensure our write is permitted.
 		meta = &buf.meta
@@ -768,6 +779,15 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 					txn.Epoch, meta.Txn.Epoch, txn.ID)
 			}
 
+			// Within the same epoch, the replaced value is kept in the
+			// intent history in case this write is rolled back to a
+			// savepoint.
+			if txn.Epoch == meta.Txn.Epoch {
+				if intentHistory, err = mvccIntentHistory(engine, metaKey, meta, txn); err != nil {
+					return err
+				}
+			}
+
 			// We are replacing our own older write intent. If we are
 			// writing at the same timestamp we can simply overwrite it;
 			// otherwise we must explicitly delete the obsolete intent.
@@ -791,7 +811,7 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 			return nil
 		}
 	}
-	buf.newMeta = MVCCMetadata{Txn: txn, Timestamp: timestamp}
+	buf.newMeta = MVCCMetadata{Txn: txn, Timestamp: timestamp, IntentHistory: intentHistory}
 	newMeta := &buf.newMeta
 
 	// Make sure to zero the redundant timestamp (timestamp is encoded
@@ -822,6 +842,46 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key roachpb.Key, timestamp ro
 	return nil
 }
 
+// mvccIntentHistory returns the intent history to store with a write by txn
+// which replaces its own intent described by meta. The replaced value is
+// added to the history unless it was written by the same batch or has been
+// rolled back; values which have been rolled back are dropped from the
+// history.
+func mvccIntentHistory(engine Engine, metaKey roachpb.EncodedKey, meta *MVCCMetadata,
+	txn *roachpb.Transaction) ([]SequencedValue, error) {
+	var history []SequencedValue
+	for _, sv := range meta.IntentHistory {
+		if !txn.IsRolledBack(sv.Sequence) {
+			history = append(history, sv)
+		}
+	}
+	seq := meta.Txn.Sequence
+	if seq == txn.Sequence || txn.IsRolledBack(seq) {
+		return history, nil
+	}
+	var value MVCCValue
+	ok, _, _, err := engine.GetProto(mvccEncodeTimestamp(metaKey, meta.Timestamp), &value)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, util.Errorf("unable to find intent value for key %q", metaKey)
+	}
+	return append(history, SequencedValue{Sequence: seq, Value: value}), nil
+}
+
+// latestIntentValue returns the index of the latest value in the intent
+// history of meta which has not been rolled back by txn, or -1 if there is
+// none.
+func latestIntentValue(meta *MVCCMetadata, txn *roachpb.Transaction) int {
+	for i := len(meta.IntentHistory) - 1; i >= 0; i-- {
+		if !txn.IsRolledBack(meta.IntentHistory[i].Sequence) {
+			return i
+		}
+	}
+	return -1
+}
+
 // MVCCIncrement fetches the value for key, and assuming the value is
 // an "integer" type, increments it by inc and stores the new
 // value. The newly incremented value is returned.
@@ -1266,14 +1326,49 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key roachpb.Key, times
 	// timestamp-encoded key) if timestamp changed.
 	commit := txn.Status == roachpb.COMMITTED
 	pushed := txn.Status == roachpb.PENDING && meta.Txn.Timestamp.Less(txn.Timestamp)
+
+	// If the write which laid down the intent was rolled back to a
+	// savepoint, the latest earlier write which wasn't is restored and
+	// committed in its place. If there is no such write, the intent is
+	// removed as if the transaction had aborted.
+	if commit && meta.Txn.Epoch == txn.Epoch && txn.IsRolledBack(meta.Txn.Sequence) {
+		i := latestIntentValue(meta, txn)
+		if i < 0 {
+			commit = false
+		} else {
+			restoreTxn := *txn
+			restoreTxn.Status = roachpb.PENDING
+			restoreTxn.Sequence = meta.IntentHistory[i].Sequence
+			buf := putBufferPool.Get().(*putBuffer)
+			buf.value = meta.IntentHistory[i].Value
+			err := mvccPutInternal(engine, ms, key, meta.Timestamp, buf.value, &restoreTxn, buf)
+			putBufferPool.Put(buf)
+			if err != nil {
+				return err
+			}
+			// Reload the metadata written by the restoring put.
+			meta = &MVCCMetadata{}
+			if ok, origMetaKeySize, origMetaValSize, err = engine.GetProto(metaKey, meta); err != nil {
+				return err
+			} else if !ok {
+				return util.Errorf("unable to find restored intent for key %q", key)
+			}
+		}
+	}
+
 	if (commit || pushed) && meta.Txn.Epoch == txn.Epoch {
 		origTimestamp := meta.Timestamp
 		newMeta := *meta
 		newMeta.Timestamp = txn.Timestamp
 		if pushed { // keep intent if we're pushing timestamp
-			newMeta.Txn = txn
+			// The intent retains the sequence number of the write which
+			// laid it down.
+			pushedTxn := *txn
+			pushedTxn.Sequence = meta.Txn.Sequence
+			newMeta.Txn = &pushedTxn
 		} else {
 			newMeta.Txn = nil
+			newMeta.IntentHistory = nil
 		}
 		metaKeySize, metaValSize, err := PutProto(engine, metaKey, &newMeta)
 		if err != nil {
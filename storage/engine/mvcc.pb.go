@@ -57,6 +57,31 @@ func (m *MVCCValue) GetValue() *cockroach_roachpb1.Value {
 	return nil
 }
 
+// SequencedValue is a value written by a transaction at the given
+// sequence number.
+type SequencedValue struct {
+	Sequence int32     `protobuf:"varint,1,opt,name=sequence" json:"sequence"`
+	Value    MVCCValue `protobuf:"bytes,2,opt,name=value" json:"value"`
+}
+
+func (m *SequencedValue) Reset()         { *m = SequencedValue{} }
+func (m *SequencedValue) String() string { return proto.CompactTextString(m) }
+func (*SequencedValue) ProtoMessage()    {}
+
+func (m *SequencedValue) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SequencedValue) GetValue() MVCCValue {
+	if m != nil {
+		return m.Value
+	}
+	return MVCCValue{}
+}
+
 // MVCCMetadata holds MVCC metadata for a key. Used by storage/engine/mvcc.go.
 type MVCCMetadata struct {
 	Txn *cockroach_roachpb1.Transaction `protobuf:"bytes,1,opt,name=txn" json:"txn,omitempty"`
@@ -74,6 +99,11 @@ type MVCCMetadata struct {
 	// is only a single MVCC metadata row with value inlined, and with
 	// empty timestamp, key_bytes, and val_bytes.
 	Value *cockroach_roachpb1.Value `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
+	// The values previously written by the transaction holding the intent
+	// within the intent's epoch, ordered by sequence. They allow an earlier
+	// value to be restored when the writes which replaced it are rolled back
+	// to a savepoint.
+	IntentHistory []SequencedValue `protobuf:"bytes,7,rep,name=intent_history" json:"intent_history"`
 }
 
 func (m *MVCCMetadata) Reset()         { *m = MVCCMetadata{} }
@@ -122,6 +152,13 @@ func (m *MVCCMetadata) GetValue() *cockroach_roachpb1.Value {
 	return nil
 }
 
+func (m *MVCCMetadata) GetIntentHistory() []SequencedValue {
+	if m != nil {
+		return m.IntentHistory
+	}
+	return nil
+}
+
 // MVCCStats tracks byte and instance counts for:
 //  - Live key/values (i.e. what a scan at current time will reveal;
 //    note that this includes intent keys and values, but not keys and
@@ -279,6 +316,35 @@ func (m *MVCCValue) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SequencedValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SequencedValue) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintMvcc(data, i, uint64(m.Sequence))
+	data[i] = 0x12
+	i++
+	i = encodeVarintMvcc(data, i, uint64(m.Value.Size()))
+	n2, err := m.Value.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	return i, nil
+}
+
 func (m *MVCCMetadata) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -298,20 +364,20 @@ func (m *MVCCMetadata) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintMvcc(data, i, uint64(m.Txn.Size()))
-		n2, err := m.Txn.MarshalTo(data[i:])
+		n3, err := m.Txn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	data[i] = 0x12
 	i++
 	i = encodeVarintMvcc(data, i, uint64(m.Timestamp.Size()))
-	n3, err := m.Timestamp.MarshalTo(data[i:])
+	n4, err := m.Timestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	data[i] = 0x18
 	i++
 	if m.Deleted {
@@ -330,11 +396,23 @@ func (m *MVCCMetadata) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x32
 		i++
 		i = encodeVarintMvcc(data, i, uint64(m.Value.Size()))
-		n4, err := m.Value.MarshalTo(data[i:])
+		n5, err := m.Value.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
+	}
+	if len(m.IntentHistory) > 0 {
+		for _, msg := range m.IntentHistory {
+			data[i] = 0x3a
+			i++
+			i = encodeVarintMvcc(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -436,6 +514,15 @@ func (m *MVCCValue) Size() (n int) {
 	return n
 }
 
+func (m *SequencedValue) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovMvcc(uint64(m.Sequence))
+	l = m.Value.Size()
+	n += 1 + l + sovMvcc(uint64(l))
+	return n
+}
+
 func (m *MVCCMetadata) Size() (n int) {
 	var l int
 	_ = l
@@ -452,6 +539,12 @@ func (m *MVCCMetadata) Size() (n int) {
 		l = m.Value.Size()
 		n += 1 + l + sovMvcc(uint64(l))
 	}
+	if len(m.IntentHistory) > 0 {
+		for _, e := range m.IntentHistory {
+			l = e.Size()
+			n += 1 + l + sovMvcc(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -590,6 +683,106 @@ func (m *MVCCValue) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+
+func (m *SequencedValue) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMvcc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequencedValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequencedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMvcc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sequence |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMvcc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMvcc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMvcc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMvcc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MVCCMetadata) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -773,6 +966,37 @@ func (m *MVCCMetadata) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMvcc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMvcc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntentHistory = append(m.IntentHistory, SequencedValue{})
+			if err := m.IntentHistory[len(m.IntentHistory)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMvcc(data[iNdEx:])
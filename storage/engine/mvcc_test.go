@@ -1343,6 +1343,76 @@ func TestMVCCResolveTxn(t *testing.T) {
 	}
 }
 
+// TestMVCCSavepointRollback verifies that writes made at rolled back
+// sequence numbers are invisible to the transaction and are discarded on
+// commit, restoring the transaction's earlier writes.
+func TestMVCCSavepointRollback(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	engine := createTestEngine(stopper)
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Write value2, a deletion and value3 to testKey1 at sequences 1-3, and
+	// value4 to testKey2 at sequence 3.
+	ts := makeTS(0, 2)
+	txn := makeTxn(txn1, ts)
+	for i, v := range []*roachpb.Value{&value2, nil, &value3} {
+		txn.Sequence = int32(i + 1)
+		var err error
+		if v == nil {
+			err = MVCCDelete(engine, nil, testKey1, ts, txn)
+		} else {
+			err = MVCCPut(engine, nil, testKey1, ts, *v, txn)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := MVCCPut(engine, nil, testKey2, ts, value4, txn); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := func(key roachpb.Key, txn *roachpb.Transaction, expected *roachpb.Value) {
+		value, _, err := MVCCGet(engine, key, ts, true, txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected == nil {
+			if value != nil {
+				t.Fatalf("%s: expected no value; got %q", key, value.Bytes)
+			}
+		} else if value == nil || !bytes.Equal(expected.Bytes, value.Bytes) {
+			t.Fatalf("%s: expected value %q; got %+v", key, expected.Bytes, value)
+		}
+	}
+	expect(testKey1, txn, &value3)
+	expect(testKey2, txn, &value4)
+
+	// Rolling back sequence 3 reveals the deletion at sequence 2.
+	txn.RolledBack = []roachpb.SequenceRange{{Start: 3, End: 3}}
+	expect(testKey1, txn, nil)
+	expect(testKey2, txn, nil)
+
+	// Rolling back sequence 2 as well reveals value2.
+	txn.RolledBack = append(txn.RolledBack, roachpb.SequenceRange{Start: 2, End: 2})
+	expect(testKey1, txn, &value2)
+
+	// Committing restores value2 and removes the intent on testKey2.
+	commitTxn := makeTxn(txn, ts)
+	commitTxn.Status = roachpb.COMMITTED
+	for _, key := range []roachpb.Key{testKey1, testKey2} {
+		if err := MVCCResolveWriteIntent(engine, nil, key, ts, commitTxn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expect(testKey1, nil, &value2)
+	expect(testKey2, nil, nil)
+}
+
 // TestMVCCConditionalPutOldTimestamp tests a case where a conditional
 // put with an older timestamp happens after a put with a newer timestamp.
 //
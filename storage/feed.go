@@ -124,6 +124,14 @@ type EndScanRangesEvent struct {
 	StoreID roachpb.StoreID
 }
 
+// ConsistencyCheckFailedEvent occurs whenever a consistency check finds the
+// data of a replica on the store to differ from the data of its range's
+// leader.
+type ConsistencyCheckFailedEvent struct {
+	StoreID roachpb.StoreID
+	RangeID roachpb.RangeID
+}
+
 // StoreEventFeed is a helper structure which publishes store-specific events to
 // a util.Feed. The target feed may be shared by multiple StoreEventFeeds. If
 // the target feed is nil, event methods become no-ops.
@@ -206,6 +214,15 @@ func (sef StoreEventFeed) endScanRanges() {
 	sef.f.Publish(&EndScanRangesEvent{sef.id})
 }
 
+// consistencyCheckFailed publishes a ConsistencyCheckFailedEvent to this
+// feed.
+func (sef StoreEventFeed) consistencyCheckFailed(rangeID roachpb.RangeID) {
+	sef.f.Publish(&ConsistencyCheckFailedEvent{
+		StoreID: sef.id,
+		RangeID: rangeID,
+	})
+}
+
 // StoreEventListener is an interface that can be implemented by objects which
 // listen for events published by stores.
 type StoreEventListener interface {
@@ -219,6 +236,7 @@ type StoreEventListener interface {
 	OnEndScanRanges(event *EndScanRangesEvent)
 	OnStoreStatus(event *StoreStatusEvent)
 	OnReplicationStatus(event *ReplicationStatusEvent)
+	OnConsistencyCheckFailed(event *ConsistencyCheckFailedEvent)
 }
 
 // ProcessStoreEvent dispatches an event on the StoreEventListener.
@@ -244,6 +262,8 @@ func ProcessStoreEvent(l StoreEventListener, event interface{}) {
 		l.OnStoreStatus(specificEvent)
 	case *ReplicationStatusEvent:
 		l.OnReplicationStatus(specificEvent)
+	case *ConsistencyCheckFailedEvent:
+		l.OnConsistencyCheckFailed(specificEvent)
 	}
 }
 
@@ -292,6 +292,16 @@ func TestStoreEventFeed(t *testing.T) {
 				StoreID: roachpb.StoreID(1),
 			},
 		},
+		{
+			"ConsistencyCheckFailed",
+			func(feed StoreEventFeed) {
+				feed.consistencyCheckFailed(roachpb.RangeID(2))
+			},
+			&ConsistencyCheckFailedEvent{
+				StoreID: roachpb.StoreID(1),
+				RangeID: roachpb.RangeID(2),
+			},
+		},
 	}
 
 	// Compile expected events into a single slice.
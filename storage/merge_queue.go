@@ -0,0 +1,178 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// mergeQueueMaxSize is the max size of the merge queue.
+	mergeQueueMaxSize = 100
+	// mergeQueueTimerDuration is the duration between merges of queued ranges.
+	mergeQueueTimerDuration = 0 // zero duration to process merges greedily.
+	// mergeQueueMaxQPSFraction is the fraction of the zone's max request
+	// rate at or above which ranges are not merged.
+	mergeQueueMaxQPSFraction = 0.5
+)
+
+// mergeQueue manages a queue of ranges slated to be merged into their
+// right-hand neighbours because both ranges are smaller than the minimum
+// size for their zone. Such ranges are typically left behind by bulk
+// deletions.
+//
+// Ranges which see a significant share of their zone's max request rate
+// are not merged, since they are likely to have been split due to load.
+type mergeQueue struct {
+	*baseQueue
+}
+
+// newMergeQueue returns a new instance of mergeQueue.
+func newMergeQueue(gossip *gossip.Gossip) *mergeQueue {
+	mq := &mergeQueue{}
+	mq.baseQueue = newBaseQueue("merge", mq, gossip, mergeQueueMaxSize)
+	return mq
+}
+
+func (mq *mergeQueue) needsLeaderLease() bool {
+	return true
+}
+
+func (mq *mergeQueue) acceptsUnsplitRanges() bool {
+	return false
+}
+
+// shouldQueue determines whether a range should be queued for merging.
+// This is true if the range and its right-hand neighbour are both
+// smaller than the minimum size for their zone and can be merged (see
+// mergeNeighbor). Emptier ranges are merged first.
+func (mq *mergeQueue) shouldQueue(now roachpb.Timestamp, rng *Replica,
+	sysCfg *config.SystemConfig) (shouldQ bool, priority float64) {
+
+	desc := rng.Desc()
+	zone, err := sysCfg.GetZoneConfigForKey(desc.StartKey)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	size := rng.stats.GetSize()
+	if size >= zone.RangeMinBytes {
+		return
+	}
+	if _, err := mergeNeighbor(rng, zone, sysCfg); err != nil {
+		if log.V(2) {
+			log.Infof("not merging %s: %s", rng, err)
+		}
+		return
+	}
+	return true, 1 - float64(size)/float64(zone.RangeMinBytes)
+}
+
+// mergeNeighbor returns the right-hand neighbour of the range if the two
+// ranges can be merged: the neighbour must be smaller than the minimum size
+// for the zone, have the same zone config and replicas, neither range may
+// be busy, and the boundary between the ranges must not be a split
+// required by the zone configs.
+// Otherwise an error describing why the ranges cannot be merged is
+// returned.
+func mergeNeighbor(rng *Replica, zone *config.ZoneConfig,
+	sysCfg *config.SystemConfig) (*Replica, error) {
+
+	desc := rng.Desc()
+	if desc.EndKey.Equal(roachpb.RKeyMax) {
+		return nil, util.Errorf("cannot merge final range")
+	}
+	if sysCfg.NeedsSplit(desc.StartKey, desc.EndKey.Next()) {
+		return nil, util.Errorf("range end key %s is a split key", desc.EndKey)
+	}
+
+	right := rng.rm.LookupReplica(desc.EndKey, nil)
+	if right == nil {
+		return nil, util.Errorf("right-hand neighbour is not on this store")
+	}
+	rightDesc := right.Desc()
+	if sysCfg.NeedsSplit(rightDesc.StartKey, rightDesc.EndKey) {
+		return nil, util.Errorf("right-hand neighbour %s needs to be split", right)
+	}
+	rightZone, err := sysCfg.GetZoneConfigForKey(rightDesc.StartKey)
+	if err != nil {
+		return nil, err
+	}
+	if !reflect.DeepEqual(zone, rightZone) {
+		return nil, util.Errorf("right-hand neighbour %s has a different zone config", right)
+	}
+	if size := right.stats.GetSize(); size >= rightZone.RangeMinBytes {
+		return nil, util.Errorf("right-hand neighbour %s size=%d min=%d", right, size, rightZone.RangeMinBytes)
+	}
+	if zone.RangeMaxQPS > 0 {
+		now := rng.rm.Clock().PhysicalNow()
+		maxQPS := float64(zone.RangeMaxQPS) * mergeQueueMaxQPSFraction
+		if qps := rng.load.QPS(now); qps >= maxQPS {
+			return nil, util.Errorf("range %s is too busy to merge qps=%.2f", rng, qps)
+		}
+		if qps := right.load.QPS(now); qps >= maxQPS {
+			return nil, util.Errorf("right-hand neighbour %s is too busy to merge qps=%.2f", right, qps)
+		}
+	}
+	if !replicaSetsEqual(desc.Replicas, rightDesc.Replicas) {
+		return nil, util.Errorf("right-hand neighbour %s has different replicas", right)
+	}
+	return right, nil
+}
+
+// process synchronously invokes admin merge to merge the range with its
+// right-hand neighbour.
+func (mq *mergeQueue) process(now roachpb.Timestamp, rng *Replica,
+	sysCfg *config.SystemConfig) error {
+
+	desc := rng.Desc()
+	zone, err := sysCfg.GetZoneConfigForKey(desc.StartKey)
+	if err != nil {
+		return err
+	}
+	if size := rng.stats.GetSize(); size >= zone.RangeMinBytes {
+		// The range has grown since it was queued.
+		return nil
+	}
+	right, err := mergeNeighbor(rng, zone, sysCfg)
+	if err != nil {
+		if log.V(1) {
+			log.Infof("not merging %s: %s", rng, err)
+		}
+		return nil
+	}
+
+	log.Infof("merging %s into %s", right, rng)
+	if _, err := client.SendWrapped(rng, rng.context(), &roachpb.AdminMergeRequest{
+		Span: roachpb.Span{Key: desc.StartKey.AsRawKey()},
+	}); err != nil {
+		return util.Errorf("unable to merge %s into %s: %s", right, rng, err)
+	}
+	return nil
+}
+
+// timer returns interval between processing successive queued merges.
+func (mq *mergeQueue) timer() time.Duration {
+	return mergeQueueTimerDuration
+}
@@ -0,0 +1,149 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestMergeQueueShouldQueue verifies that shouldQueue only queues ranges
+// which, together with their right-hand neighbour, are smaller than the
+// minimum range size and can be merged.
+func TestMergeQueueShouldQueue(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Set zone configs.
+	zone := config.ZoneConfig{RangeMinBytes: 1 << 20, RangeMaxBytes: 64 << 20}
+	config.TestingSetZoneConfig(2000, &zone)
+	config.TestingSetZoneConfig(2001, &zone)
+	config.TestingSetZoneConfig(2002, &config.ZoneConfig{RangeMinBytes: 1 << 10, RangeMaxBytes: 64 << 20})
+
+	// Despite faking the zone configs, we still need to have a gossip entry.
+	if err := tc.gossip.AddInfoProto(gossip.KeySystemConfig, &config.SystemConfig{}, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	table2000 := keys.MakeTablePrefix(2000)
+	table2001 := keys.MakeTablePrefix(2001)
+	table2002 := keys.MakeTablePrefix(2002)
+	tableKey := func(prefix []byte, suffix string) roachpb.RKey {
+		return roachpb.RKey(append(append([]byte(nil), prefix...), suffix...))
+	}
+
+	// Shrink the test range to make room for a right-hand neighbour.
+	leftDesc := *tc.rng.Desc()
+	leftDesc.StartKey = tableKey(table2000, "a")
+	leftDesc.EndKey = tableKey(table2000, "m")
+	if err := tc.rng.setDesc(&leftDesc); err != nil {
+		t.Fatal(err)
+	}
+	rightDesc := leftDesc
+	rightDesc.RangeID = 2
+	rightDesc.StartKey = leftDesc.EndKey
+	rightDesc.EndKey = tableKey(table2000, "z")
+	right, err := NewReplica(&rightDesc, tc.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tc.store.AddReplicaTest(right); err != nil {
+		t.Fatal(err)
+	}
+
+	otherReplicas := createReplicaSets([]roachpb.StoreID{2})
+
+	testCases := []struct {
+		leftStart, leftEnd, rightEnd roachpb.RKey
+		leftBytes, rightBytes        int64
+		rightReplicas                []roachpb.ReplicaDescriptor
+		shouldQ                      bool
+		priority                     float64
+	}{
+		// Both ranges empty.
+		{tableKey(table2000, "a"), tableKey(table2000, "m"), tableKey(table2000, "z"), 0, 0, nil, true, 1},
+		// Left range half full.
+		{tableKey(table2000, "a"), tableKey(table2000, "m"), tableKey(table2000, "z"), 1 << 19, 0, nil, true, 0.5},
+		// Left range at min bytes.
+		{tableKey(table2000, "a"), tableKey(table2000, "m"), tableKey(table2000, "z"), 1 << 20, 0, nil, false, 0},
+		// Right range at min bytes.
+		{tableKey(table2000, "a"), tableKey(table2000, "m"), tableKey(table2000, "z"), 0, 1 << 20, nil, false, 0},
+		// Right range on other stores.
+		{tableKey(table2000, "a"), tableKey(table2000, "m"), tableKey(table2000, "z"), 0, 0, otherReplicas, false, 0},
+		// Right range needs to be split.
+		{tableKey(table2000, "a"), tableKey(table2000, "m"), tableKey(table2001, "z"), 0, 0, nil, false, 0},
+		// Boundary at a table prefix, despite equal zone configs.
+		{tableKey(table2000, "a"), table2001, tableKey(table2001, "z"), 0, 0, nil, false, 0},
+		// Boundary at a table prefix, with different zone configs.
+		{tableKey(table2001, "a"), table2002, tableKey(table2002, "z"), 0, 0, nil, false, 0},
+	}
+
+	mergeQ := newMergeQueue(tc.gossip)
+
+	cfg := tc.gossip.GetSystemConfig()
+	if cfg == nil {
+		t.Fatal("nil config")
+	}
+
+	for i, test := range testCases {
+		// Update the right-hand range first so that the ranges never overlap
+		// as the test cases only move forward in the key space.
+		rightCopy := *right.Desc()
+		rightCopy.StartKey = test.leftEnd
+		rightCopy.EndKey = test.rightEnd
+		rightCopy.Replicas = leftDesc.Replicas
+		if test.rightReplicas != nil {
+			rightCopy.Replicas = test.rightReplicas
+		}
+		if err := right.setDesc(&rightCopy); err != nil {
+			t.Fatal(err)
+		}
+		leftCopy := *tc.rng.Desc()
+		leftCopy.StartKey = test.leftStart
+		leftCopy.EndKey = test.leftEnd
+		if err := tc.rng.setDesc(&leftCopy); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := tc.rng.stats.SetMVCCStats(tc.rng.rm.Engine(), engine.MVCCStats{KeyBytes: test.leftBytes}); err != nil {
+			t.Fatal(err)
+		}
+		if err := right.stats.SetMVCCStats(right.rm.Engine(), engine.MVCCStats{KeyBytes: test.rightBytes}); err != nil {
+			t.Fatal(err)
+		}
+		shouldQ, priority := mergeQ.shouldQueue(roachpb.ZeroTimestamp, tc.rng, cfg)
+		if shouldQ != test.shouldQ {
+			t.Errorf("%d: should queue expected %t; got %t", i, test.shouldQ, shouldQ)
+		}
+		if math.Abs(priority-test.priority) > 0.00001 {
+			t.Errorf("%d: priority expected %f; got %f", i, test.priority, priority)
+		}
+	}
+}
+
+////
+// NOTE: tests which actually verify processing of the merge queue are
+// in client_merge_test.go, which is in a different test package in
+// order to allow for distributed transactions with a proper client.
@@ -0,0 +1,327 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// snapshotChunkSize is the approximate number of bytes of range data
+	// sent in each chunk of a raft snapshot.
+	snapshotChunkSize = 256 << 10
+	// incomingSnapshotTimeout is the time after which a staged snapshot
+	// which has neither received a new chunk nor been applied is discarded.
+	incomingSnapshotTimeout = time.Minute
+)
+
+// An outgoingSnapshot is a RocksDB snapshot pinned by Replica.Snapshot
+// from which the data of a raft snapshot is streamed to its recipients.
+// At most one snapshot is pinned per range; it is closed once it has been
+// replaced or released and no stream is reading from it.
+type outgoingSnapshot struct {
+	snap    engine.Engine
+	index   uint64
+	desc    roachpb.RangeDescriptor
+	streams int // Number of streams reading from snap
+}
+
+// An incomingSnapshot is a raft snapshot whose data is being received in
+// chunks. The chunks are written to a batch which is committed by
+// Replica.ApplySnapshot once raft accepts the snapshot. The keys written
+// are tracked so that the existing range data not overwritten by the
+// snapshot can be deleted when it is applied.
+type incomingSnapshot struct {
+	index, term uint64
+	nextSeq     uint64
+	batch       engine.Engine
+	keys        map[string]struct{} // Keys written to batch
+	lastChunk   time.Time
+	reserved    bool // Set once the MsgSnap message has been accepted
+}
+
+// clearRangeData writes the deletion of the range data in eng which is not
+// overwritten by the snapshot to the snapshot's batch. The deletions follow
+// the snapshot's data in the batch, so they must not include its keys.
+func (in *incomingSnapshot) clearRangeData(desc *roachpb.RangeDescriptor, eng engine.Engine) error {
+	iter := newRangeDataIterator(desc, eng)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if _, ok := in.keys[string(iter.Key())]; ok {
+			continue
+		}
+		if err := in.batch.Clear(iter.Key()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A snapshotLimiter limits the rate at which a store sends snapshot data.
+// Each chunk reserves the time needed to send it at the configured rate,
+// so that concurrent snapshots share the store's snapshot bandwidth.
+type snapshotLimiter struct {
+	sync.Mutex
+	bytesPerSecond int64
+	next           time.Time // Time at which the next chunk may be sent
+}
+
+// wait blocks until n bytes of snapshot data may be sent. Returns an
+// error if the stopper is stopped first.
+func (l *snapshotLimiter) wait(n int, stopper *stop.Stopper) error {
+	l.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(int64(n) * int64(time.Second) / l.bytesPerSecond))
+	l.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-stopper.ShouldStop():
+		return multiraft.ErrStopped
+	}
+}
+
+// pinSnapshot pins the RocksDB snapshot from which the data of the raft
+// snapshot of the range at the given applied index is to be streamed,
+// replacing any snapshot previously pinned for the range. The store takes
+// ownership of snap.
+func (s *Store) pinSnapshot(rangeID roachpb.RangeID, index uint64, snap engine.Engine,
+	desc roachpb.RangeDescriptor) {
+	s.snapMu.Lock()
+	defer s.snapMu.Unlock()
+	if s.outSnaps == nil {
+		// The store has stopped.
+		snap.Close()
+		return
+	}
+	if out, ok := s.outSnaps[rangeID]; ok {
+		if out.index == index {
+			// The pinned snapshot is at the same index; keep it.
+			snap.Close()
+			return
+		}
+		if out.streams == 0 {
+			out.snap.Close()
+		}
+	}
+	s.outSnaps[rangeID] = &outgoingSnapshot{snap: snap, index: index, desc: desc}
+}
+
+// unpinSnapshotLocked ends a stream from out, closing the RocksDB snapshot
+// if it is no longer pinned and no other stream is reading from it.
+// Requires that snapMu is held.
+func (s *Store) unpinSnapshotLocked(rangeID roachpb.RangeID, out *outgoingSnapshot) {
+	if out.streams > 0 {
+		return
+	}
+	if s.outSnaps != nil && s.outSnaps[rangeID] == out {
+		delete(s.outSnaps, rangeID)
+	}
+	out.snap.Close()
+}
+
+// StreamSnapshot implements the multiraft.SnapshotStreamer interface. It
+// reads the data of the range from the RocksDB snapshot pinned by
+// Replica.Snapshot and sends it in chunks of about snapshotChunkSize
+// bytes, each encoded as a RaftSnapshotData. The first chunk also carries
+// the range descriptor.
+func (s *Store) StreamSnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot,
+	send func(data []byte) error) error {
+	s.snapMu.Lock()
+	out, ok := s.outSnaps[groupID]
+	if !ok || out.index != snap.Metadata.Index {
+		s.snapMu.Unlock()
+		return util.Errorf("snapshot of range %d at index %d is no longer available",
+			groupID, snap.Metadata.Index)
+	}
+	out.streams++
+	s.snapMu.Unlock()
+
+	defer func() {
+		s.snapMu.Lock()
+		out.streams--
+		s.unpinSnapshotLocked(groupID, out)
+		s.snapMu.Unlock()
+	}()
+
+	chunk := roachpb.RaftSnapshotData{RangeDescriptor: out.desc}
+	size := 0
+	flush := func() error {
+		data, err := proto.Marshal(&chunk)
+		if err != nil {
+			return err
+		}
+		chunk = roachpb.RaftSnapshotData{}
+		size = 0
+		if err := s.snapLimiter.wait(len(data), s.stopper); err != nil {
+			return err
+		}
+		return send(data)
+	}
+
+	iter := newRangeDataIterator(&out.desc, out.snap)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		kv := &roachpb.RaftSnapshotData_KeyValue{Key: iter.Key(), Value: iter.Value()}
+		chunk.KV = append(chunk.KV, kv)
+		if size += len(kv.Key) + len(kv.Value); size >= snapshotChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// ReleaseSnapshot implements the multiraft.SnapshotStreamer interface.
+func (s *Store) ReleaseSnapshot(groupID roachpb.RangeID, snap raftpb.Snapshot) {
+	s.snapMu.Lock()
+	defer s.snapMu.Unlock()
+	if out, ok := s.outSnaps[groupID]; ok && out.index == snap.Metadata.Index {
+		s.unpinSnapshotLocked(groupID, out)
+	}
+}
+
+// SnapshotChunk implements the multiraft.SnapshotStreamer interface. The
+// first chunk of a snapshot starts a new batch, replacing any snapshot
+// previously staged for the range unless its MsgSnap message has been
+// accepted. Each chunk's data is then written to the batch. The existing
+// range data is only deleted by Replica.ApplySnapshot, so that keys written
+// while the snapshot is received are deleted too.
+func (s *Store) SnapshotChunk(req *multiraft.RaftSnapshotChunkRequest) error {
+	var chunk roachpb.RaftSnapshotData
+	if err := proto.Unmarshal(req.Data, &chunk); err != nil {
+		return err
+	}
+
+	s.snapMu.Lock()
+	defer s.snapMu.Unlock()
+	if s.inSnaps == nil {
+		return multiraft.ErrStopped
+	}
+	now := time.Now()
+	for rangeID, in := range s.inSnaps {
+		if now.Sub(in.lastChunk) > incomingSnapshotTimeout {
+			log.Warningf("discarding unapplied snapshot of range %d at index %d", rangeID, in.index)
+			in.batch.Close()
+			delete(s.inSnaps, rangeID)
+		}
+	}
+
+	in, ok := s.inSnaps[req.GroupID]
+	if req.Seq == 0 {
+		if ok {
+			if in.reserved {
+				return util.Errorf("snapshot of range %d at index %d is pending application",
+					req.GroupID, in.index)
+			}
+			in.batch.Close()
+		}
+		in = &incomingSnapshot{
+			index: req.Index,
+			term:  req.Term,
+			batch: s.engine.NewBatch(),
+			keys:  map[string]struct{}{},
+		}
+		s.inSnaps[req.GroupID] = in
+	} else if !ok || in.reserved || in.index != req.Index || in.term != req.Term || in.nextSeq != req.Seq {
+		return util.Errorf("unexpected chunk %d of snapshot of range %d at index %d",
+			req.Seq, req.GroupID, req.Index)
+	}
+
+	for _, kv := range chunk.KV {
+		if err := in.batch.Put(kv.Key, kv.Value); err != nil {
+			s.discardSnapshotLocked(req.GroupID)
+			return err
+		}
+		in.keys[string(kv.Key)] = struct{}{}
+	}
+	in.nextSeq++
+	in.lastChunk = now
+	return nil
+}
+
+// discardSnapshotLocked discards the snapshot staged for the range, if
+// any. Requires that snapMu is held.
+func (s *Store) discardSnapshotLocked(rangeID roachpb.RangeID) {
+	if in, ok := s.inSnaps[rangeID]; ok {
+		in.batch.Close()
+		delete(s.inSnaps, rangeID)
+	}
+}
+
+// ReserveSnapshot implements the multiraft.SnapshotStreamer interface.
+func (s *Store) ReserveSnapshot(rangeID roachpb.RangeID, index, term uint64) bool {
+	s.snapMu.Lock()
+	defer s.snapMu.Unlock()
+	in, ok := s.inSnaps[rangeID]
+	if !ok || in.index != index || in.term != term {
+		return false
+	}
+	in.reserved = true
+	in.lastChunk = time.Now()
+	return true
+}
+
+// takeIncomingSnapshot returns the staged snapshot of the range at the given
+// index and term, whose chunks have been written to its batch. The caller
+// takes ownership of the batch.
+func (s *Store) takeIncomingSnapshot(rangeID roachpb.RangeID, index, term uint64) (*incomingSnapshot, error) {
+	s.snapMu.Lock()
+	defer s.snapMu.Unlock()
+	in, ok := s.inSnaps[rangeID]
+	if !ok || in.index != index || in.term != term {
+		return nil, util.Errorf("data of snapshot of range %d at index %d has not been received",
+			rangeID, index)
+	}
+	delete(s.inSnaps, rangeID)
+	return in, nil
+}
+
+// releaseSnapshots closes all pinned and staged snapshots when the store
+// stops. Pinned snapshots which are still being streamed are closed when
+// their streams end.
+func (s *Store) releaseSnapshots() {
+	s.snapMu.Lock()
+	defer s.snapMu.Unlock()
+	outSnaps := s.outSnaps
+	s.outSnaps = nil
+	for rangeID, out := range outSnaps {
+		s.unpinSnapshotLocked(rangeID, out)
+	}
+	for _, in := range s.inSnaps {
+		in.batch.Close()
+	}
+	s.inSnaps = nil
+}
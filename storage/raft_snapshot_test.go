@@ -0,0 +1,154 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestSnapshotLimiter verifies that the limiter paces successive chunks
+// at the configured rate.
+func TestSnapshotLimiter(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	l := &snapshotLimiter{bytesPerSecond: 1000}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.wait(50, stopper); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The first chunk is sent immediately; the next two each wait 50ms.
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected chunks to be paced; took %s", elapsed)
+	}
+}
+
+// TestStoreStreamSnapshot verifies that the data of a snapshot streamed
+// from one replica is staged in chunks, and that it can be reserved and
+// taken by ApplySnapshot.
+func TestStoreStreamSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	rng := store.LookupReplica(roachpb.RKeyMin, nil)
+	if rng == nil {
+		t.Fatal("couldn't find first range")
+	}
+	snap, err := rng.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rangeID := rng.Desc().RangeID
+
+	var chunks [][]byte
+	if err := store.StreamSnapshot(rangeID, snap, func(data []byte) error {
+		chunks = append(chunks, data)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(chunks) == 0 {
+		t.Fatal("expected at least one chunk")
+	}
+
+	req := &multiraft.RaftSnapshotChunkRequest{
+		GroupID: rangeID,
+		Index:   snap.Metadata.Index,
+		Term:    snap.Metadata.Term,
+	}
+	for i, data := range chunks {
+		req.Seq = uint64(i)
+		req.Data = data
+		if err := store.SnapshotChunk(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A chunk out of sequence is rejected.
+	req.Seq = uint64(len(chunks) + 1)
+	if err := store.SnapshotChunk(req); err == nil {
+		t.Error("expected error for chunk out of sequence")
+	}
+
+	// Only the staged snapshot can be reserved, upon which it can no longer
+	// be replaced by another snapshot.
+	if store.ReserveSnapshot(rangeID, snap.Metadata.Index+1, snap.Metadata.Term) {
+		t.Error("expected reservation of unstaged snapshot to fail")
+	}
+	if !store.ReserveSnapshot(rangeID, snap.Metadata.Index, snap.Metadata.Term) {
+		t.Fatal("expected reservation of staged snapshot to succeed")
+	}
+	other := *req
+	other.Index++
+	other.Seq = 0
+	other.Data = chunks[0]
+	if err := store.SnapshotChunk(&other); err == nil {
+		t.Error("expected error replacing reserved snapshot")
+	}
+
+	// A key written after the snapshot was staged is deleted when it is
+	// applied, while the snapshot's own keys are kept.
+	newKey := roachpb.Key("new")
+	if err := engine.MVCCPut(store.Engine(), nil, newKey, store.Clock().Now(),
+		roachpb.Value{Bytes: []byte("value")}, nil); err != nil {
+		t.Fatal(err)
+	}
+	in, err := store.takeIncomingSnapshot(rangeID, snap.Metadata.Index, snap.Metadata.Term)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := in.clearRangeData(rng.Desc(), store.Engine()); err != nil {
+		t.Fatal(err)
+	}
+	if err := in.batch.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	in.batch.Close()
+	if v, _, err := engine.MVCCGet(store.Engine(), newKey, store.Clock().Now(), true, nil); err != nil {
+		t.Fatal(err)
+	} else if v != nil {
+		t.Errorf("expected key written after staging to be deleted; got %s", v)
+	}
+	if v, _, err := engine.MVCCGet(store.Engine(), keys.RangeDescriptorKey(roachpb.RKeyMin),
+		roachpb.MaxTimestamp, true, nil); err != nil {
+		t.Fatal(err)
+	} else if v == nil {
+		t.Error("expected range descriptor of snapshot to be kept")
+	}
+	if _, err := store.takeIncomingSnapshot(rangeID, snap.Metadata.Index, snap.Metadata.Term); err == nil {
+		t.Error("expected error taking snapshot twice")
+	}
+	if store.ReserveSnapshot(rangeID, snap.Metadata.Index, snap.Metadata.Term) {
+		t.Error("expected reservation of applied snapshot to fail")
+	}
+
+	// Once released, the snapshot can no longer be streamed.
+	store.ReleaseSnapshot(rangeID, snap)
+	if err := store.StreamSnapshot(rangeID, snap, func([]byte) error { return nil }); err == nil {
+		t.Error("expected error streaming released snapshot")
+	}
+}
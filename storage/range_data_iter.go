@@ -34,8 +34,8 @@ type keyRange struct {
 // The ranges keyRange slice specifies the key ranges which comprise
 // all of the range's data.
 //
-// A rangeDataIterator provides the same API as an Engine iterator
-// with the exception of the Seek() method.
+// A rangeDataIterator provides the same API as an Engine iterator,
+// though it can't iterate in reverse.
 type rangeDataIterator struct {
 	curIndex int
 	ranges   []keyRange
@@ -78,8 +78,21 @@ func (ri *rangeDataIterator) Close() {
 	ri.iter.Close()
 }
 
-// Seek seeks to the specified key.
+// Seek seeks to the specified key, or to the first key of the range's
+// data following it.
 func (ri *rangeDataIterator) Seek(key []byte) {
+	ri.curIndex = 0
+	for ri.curIndex < len(ri.ranges) && !roachpb.EncodedKey(key).Less(ri.ranges[ri.curIndex].end) {
+		ri.curIndex++
+	}
+	if ri.curIndex == len(ri.ranges) {
+		// Seek to end to make iterator invalid.
+		ri.iter.Seek(engine.MVCCKeyMax)
+		return
+	}
+	if start := ri.ranges[ri.curIndex].start; roachpb.EncodedKey(key).Less(start) {
+		key = start
+	}
 	ri.iter.Seek(key)
 	ri.advance()
 }
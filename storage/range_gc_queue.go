@@ -69,10 +69,14 @@ func (q *rangeGCQueue) acceptsUnsplitRanges() bool {
 // shouldQueue determines whether a range should be queued for GC, and
 // if so at what priority. Ranges which have been inactive for longer
 // than rangeGCQueueInactivityThreshold are considered for possible GC
-// at equal priority.
+// at equal priority. Quarantined replicas are always queued, at a higher
+// priority.
 func (q *rangeGCQueue) shouldQueue(now roachpb.Timestamp, rng *Replica,
 	_ *config.SystemConfig) (bool, float64) {
 
+	if rng.isCorrupted() {
+		return true, 1
+	}
 	if l := rng.getLease(); l.Expiration.Add(RangeGCQueueInactivityThreshold.Nanoseconds(), 0).Less(now) {
 		return true, 0
 	}
@@ -116,6 +120,13 @@ func (q *rangeGCQueue) process(now roachpb.Timestamp, rng *Replica, _ *config.Sy
 		}
 	}
 
+	if currentMember && rng.isCorrupted() {
+		// A quarantined replica asks to be removed from its range, which
+		// lets the range be up-replicated elsewhere. Its local data is
+		// cleaned up on a later pass, once it is no longer a member.
+		return rng.ChangeReplicas(roachpb.REMOVE_REPLICA, *rng.GetReplica(), &replyDesc)
+	}
+
 	if !currentMember {
 		// We are no longer a member of this range; clean up our local data.
 		if log.V(1) {
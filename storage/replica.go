@@ -165,9 +165,13 @@ type RangeManager interface {
 	MergeRange(subsumingRng *Replica, updatedEndKey roachpb.RKey, subsumedRangeID roachpb.RangeID) error
 	NewRangeDescriptor(start, end roachpb.RKey, replicas []roachpb.ReplicaDescriptor) (*roachpb.RangeDescriptor, error)
 	NewSnapshot() engine.Engine
+	pinSnapshot(rangeID roachpb.RangeID, index uint64, snap engine.Engine, desc roachpb.RangeDescriptor)
+	takeIncomingSnapshot(rangeID roachpb.RangeID, index, term uint64) (*incomingSnapshot, error)
 	ProposeRaftCommand(cmdIDKey, roachpb.RaftCommand) <-chan error
 	RemoveReplica(rng *Replica) error
+	quarantineReplica(rng *Replica) error
 	Tracer() *tracer.Tracer
+	closedTimestampInterval() time.Duration
 	SplitRange(origRng, newRng *Replica) error
 	processRangeDescriptorUpdate(rng *Replica) error
 }
@@ -183,20 +187,31 @@ type Replica struct {
 	rm       RangeManager   // Makes some store methods available
 	stats    *rangeStats    // Range statistics
 	maxBytes int64          // Max bytes before split.
+	maxQPS   int64          // Max queries per second before split.
+	load     *replicaLoad   // Request rates and key samples for load-based splits
 	// Last index persisted to the raft log (not necessarily committed).
 	// Updated atomically.
 	lastIndex uint64
 	// Last index applied to the state machine. Updated atomically.
 	appliedIndex uint64
+	// Set to 1 once the replica has been quarantined because its data was
+	// found to be corrupted. Updated atomically.
+	corrupted    int32
 	systemDBHash []byte         // sha1 hash of the system config @ last gossip
 	lease        unsafe.Pointer // Information for leader lease, updated atomically
 	llMu         sync.Mutex     // Synchronizes readers' requests for leader lease
 	respCache    *ResponseCache // Provides idempotence for retries
 
+	checksumMu    sync.Mutex                  // Protects the following fields:
+	checksums     map[string]*replicaChecksum // Consistency checks in progress by checksum ID
+	inconsistency *Inconsistency              // Found by the last consistency check; nil if none
+	lastCheckedAt roachpb.Timestamp           // Time of the last consistency check started here
+
 	sync.RWMutex                 // Protects the following fields:
 	cmdQ         *CommandQueue   // Enforce at most one command is running per key(s)
 	tsCache      *TimestampCache // Most recent timestamps for keys / key ranges
 	pendingCmds  map[cmdIDKey]*pendingCmd
+	closed       closedTimestampState // Closed timestamp for follower reads
 
 	// pendingReplica houses a replica that is not yet in the range
 	// descriptor, since we must be able to look up a replica's
@@ -222,8 +237,11 @@ func NewReplica(desc *roachpb.RangeDescriptor, rm RangeManager) (*Replica, error
 		tsCache:     NewTimestampCache(rm.Clock()),
 		respCache:   NewResponseCache(desc.RangeID),
 		pendingCmds: map[cmdIDKey]*pendingCmd{},
+		checksums:   map[string]*replicaChecksum{},
 	}
+	r.lastCheckedAt = rm.Clock().Now()
 	r.pendingReplica.Cond = sync.NewCond(r)
+	r.load = newReplicaLoad(rm.Clock().PhysicalNow())
 	r.setDescWithoutProcessUpdate(desc)
 
 	lastIndex, err := r.loadLastIndex()
@@ -238,6 +256,14 @@ func NewReplica(desc *roachpb.RangeDescriptor, rm RangeManager) (*Replica, error
 	}
 	atomic.StoreUint64(&r.appliedIndex, appliedIndex)
 
+	corrupted, err := r.loadCorrupted()
+	if err != nil {
+		return nil, err
+	}
+	if corrupted {
+		atomic.StoreInt32(&r.corrupted, 1)
+	}
+
 	lease, err := loadLeaderLease(r.rm.Engine(), desc.RangeID)
 	if err != nil {
 		return nil, err
@@ -292,6 +318,17 @@ func (r *Replica) SetMaxBytes(maxBytes int64) {
 	atomic.StoreInt64(&r.maxBytes, maxBytes)
 }
 
+// GetMaxQPS atomically gets the range maximum request rate.
+func (r *Replica) GetMaxQPS() int64 {
+	return atomic.LoadInt64(&r.maxQPS)
+}
+
+// SetMaxQPS atomically sets the maximum request rate before a
+// load-based split. This value is cached by the range for efficiency.
+func (r *Replica) SetMaxQPS(maxQPS int64) {
+	atomic.StoreInt64(&r.maxQPS, maxQPS)
+}
+
 // IsFirstRange returns true if this is the first range.
 func (r *Replica) IsFirstRange() bool {
 	return bytes.Equal(r.Desc().StartKey, roachpb.RKeyMin)
@@ -367,6 +404,46 @@ func (r *Replica) requestLeaderLease(timestamp roachpb.Timestamp) error {
 	return (<-pendingCmd.done).Err
 }
 
+// transferLeaderLease hands the leader lease held by this replica over to
+// the given replica of the range. The new lease starts now and runs for
+// the default lease duration, after which the new holder extends it as
+// usual. A NotLeaderError is returned if this replica doesn't currently
+// hold the lease.
+func (r *Replica) transferLeaderLease(target roachpb.ReplicaDescriptor) error {
+	now := r.rm.Clock().Now()
+	if lease := r.getLease(); !lease.OwnedBy(r.rm.StoreID()) || !lease.Covers(now) {
+		return r.newNotLeaderError(lease, r.rm.StoreID())
+	}
+	desc := r.Desc()
+	if target.StoreID == r.rm.StoreID() {
+		return util.Errorf("%s: cannot transfer leader lease to itself", r)
+	}
+	if idx, _ := desc.FindReplica(target.StoreID); idx == -1 {
+		return util.Errorf("%s: cannot transfer leader lease to store %d, which has no replica of the range",
+			r, target.StoreID)
+	}
+	args := &roachpb.TransferLeaseRequest{
+		Span: roachpb.Span{
+			Key: desc.StartKey.AsRawKey(),
+		},
+		Lease: roachpb.Lease{
+			Start:      now,
+			Expiration: now.Add(int64(DefaultLeaderLeaseDuration), 0),
+			Replica:    target,
+		},
+	}
+	// The request goes through the regular write path, so it is only
+	// proposed while this replica holds the lease.
+	_, err := client.SendWrappedWith(r, r.context(), roachpb.Header{
+		Timestamp: now,
+		RangeID:   desc.RangeID,
+	}, args)
+	if err == nil {
+		log.Infof("%s: transferred leader lease to store %d", r, target.StoreID)
+	}
+	return err
+}
+
 // redirectOnOrAcquireLeaderLease checks whether this replica has the
 // leader lease at the specified timestamp. If it does, returns
 // success. If another replica currently holds the lease, redirects by
@@ -384,6 +461,10 @@ func (r *Replica) requestLeaderLease(timestamp roachpb.Timestamp) error {
 //  will not incur latency waiting for the command to complete.
 //  Reads, however, must wait.
 func (r *Replica) redirectOnOrAcquireLeaderLease(trace *tracer.Trace, timestamp roachpb.Timestamp) error {
+	if r.isCorrupted() {
+		return r.corruptionRedirect()
+	}
+
 	r.llMu.Lock()
 	defer r.llMu.Unlock()
 
@@ -545,6 +626,9 @@ func (r *Replica) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.B
 	if err := r.checkBatchRequest(ba); err != nil {
 		return nil, roachpb.NewError(err)
 	}
+	if r.isCorrupted() {
+		return nil, roachpb.NewError(r.corruptionRedirect())
+	}
 
 	// TODO(tschottdorf) Some (internal) requests go here directly, so they
 	// won't be traced.
@@ -576,9 +660,43 @@ func (r *Replica) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.B
 	if err != nil {
 		return nil, roachpb.NewError(err)
 	}
+	if !ba.IsAdmin() {
+		r.recordLoad(ba)
+	}
 	return br, nil
 }
 
+// recordLoad counts the requests of the batch towards the range's load.
+// At the end of every load window, the range is added to the split queue
+// if its request rate exceeds the max rate specified in the zone config.
+func (r *Replica) recordLoad(ba roachpb.BatchRequest) {
+	now := r.rm.Clock().PhysicalNow()
+	rolled := false
+	for _, union := range ba.Requests {
+		h := union.GetInner().Header()
+		var endKey roachpb.RKey
+		if h.EndKey != nil {
+			endKey = keys.Addr(h.EndKey)
+		}
+		if r.load.record(now, keys.Addr(h.Key), endKey) {
+			rolled = true
+		}
+	}
+	if ba.IsWrite() {
+		r.load.recordWrite(now, int64(ba.Size()))
+	}
+	if maxQPS := r.GetMaxQPS(); rolled && maxQPS > 0 && r.load.QPS(now) > float64(maxQPS) {
+		r.rm.splitQueue().MaybeAdd(r, r.rm.Clock().Now())
+	}
+}
+
+// loadRates returns the request rate and the rate of bytes written of the
+// replica's last complete load window.
+func (r *Replica) loadRates() (qps, writeBytesPerSecond float64) {
+	now := r.rm.Clock().PhysicalNow()
+	return r.load.QPS(now), r.load.WriteBytesPerSecond(now)
+}
+
 // TODO(tschottdorf): almost obsolete.
 func (r *Replica) checkCmdHeader(header *roachpb.Span) error {
 	if !r.ContainsKeyRange(header.Key, header.EndKey) {
@@ -700,6 +818,10 @@ func (r *Replica) addAdminCmd(ctx context.Context, ba roachpb.BatchRequest) (*ro
 		var reply roachpb.AdminMergeResponse
 		reply, err = r.AdminMerge(*tArgs, r.Desc())
 		resp = &reply
+	case *roachpb.CheckConsistencyRequest:
+		var reply roachpb.CheckConsistencyResponse
+		reply, err = r.CheckConsistency(*tArgs, r.Desc())
+		resp = &reply
 	default:
 		return nil, util.Errorf("unrecognized admin command: %T", args)
 	}
@@ -731,8 +853,10 @@ func (r *Replica) addReadOnlyCmd(ctx context.Context, ba *roachpb.BatchRequest)
 	}
 
 	// If there are command keys (there might not be if reads are
-	// inconsistent), the read requires the leader lease.
-	if len(cmdKeys) > 0 {
+	// inconsistent), the read requires the leader lease, unless it is
+	// below the closed timestamp and can be served as a follower read.
+	followerRead := len(cmdKeys) > 0 && r.canServeFollowerRead(ba)
+	if len(cmdKeys) > 0 && !followerRead {
 		if err := r.redirectOnOrAcquireLeaderLease(trace, header.Timestamp); err != nil {
 			r.endCmds(cmdKeys, ba, err)
 			return nil, err
@@ -741,6 +865,11 @@ func (r *Replica) addReadOnlyCmd(ctx context.Context, ba *roachpb.BatchRequest)
 
 	// Execute read-only batch command.
 	br, intents, err := r.executeBatch(r.rm.Engine(), nil, ba)
+	if _, ok := err.(*roachpb.WriteIntentError); ok && followerRead {
+		// Only the lease holder can push the intent's transaction, so
+		// send the client there.
+		err = r.newNotLeaderError(r.getLease(), r.rm.StoreID())
+	}
 
 	r.handleSkippedIntents(intents)
 
@@ -829,6 +958,10 @@ func (r *Replica) addWriteCmd(ctx context.Context, ba *roachpb.BatchRequest, wg
 			}
 		}
 	}
+	// Keep the closed timestamp below this write until it has been applied.
+	writeTS := ba.Timestamp
+	r.trackWriteLocked(writeTS)
+	defer r.untrackWrite(writeTS)
 
 	r.Unlock()
 
@@ -859,6 +992,11 @@ func (r *Replica) proposeRaftCommand(ctx context.Context, ba *roachpb.BatchReque
 		ctx:  ctx,
 		done: make(chan roachpb.ResponseWithError, 1),
 	}
+	if r.isCorrupted() {
+		errChan := make(chan error, 1)
+		errChan <- r.corruptionRedirect()
+		return errChan, pendingCmd
+	}
 	desc := r.Desc()
 	_, replica := desc.FindReplica(r.rm.StoreID())
 	if replica == nil {
@@ -875,6 +1013,13 @@ func (r *Replica) proposeRaftCommand(ctx context.Context, ba *roachpb.BatchReque
 	idKey := makeCmdIDKey(cmdID)
 	r.Lock()
 	r.pendingCmds[idKey] = pendingCmd
+	if r.rm.closedTimestampInterval() > 0 {
+		// Piggyback the closed timestamp on the command if we hold the lease.
+		now := r.rm.Clock().Now()
+		if lease := r.getLease(); lease.Covers(now) && lease.OwnedBy(r.rm.StoreID()) {
+			raftCmd.ClosedTimestamp = r.closeTimestampLocked(now)
+		}
+	}
 	r.Unlock()
 	errChan := r.rm.ProposeRaftCommand(idKey, raftCmd)
 
@@ -912,6 +1057,11 @@ func (r *Replica) processRaftCommand(idKey cmdIDKey, index uint64, raftCmd roach
 	br, err := r.applyRaftCommand(ctx, index, raftCmd.OriginReplica, &raftCmd.Cmd)
 	err = r.maybeSetCorrupt(err)
 	execDone()
+	if err == nil && !raftCmd.ClosedTimestamp.Equal(roachpb.ZeroTimestamp) {
+		r.Lock()
+		r.closed.applied.Forward(raftCmd.ClosedTimestamp)
+		r.Unlock()
+	}
 	if err != nil {
 		trace.Event(fmt.Sprintf("error: %T", err))
 	}
@@ -0,0 +1,137 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+)
+
+// ClosedTimestampTargetLag is how far the closed timestamp published by a
+// leader lease holder trails its clock. Reads at timestamps older than this
+// can usually be served by any replica of the range.
+const ClosedTimestampTargetLag = 3 * time.Second
+
+// closedTimestampState tracks the closed timestamp of a replica. The lease
+// holder closes timestamps by raising the low water mark of its timestamp
+// cache, so that no write can subsequently be evaluated at or below them,
+// and attaches the closed timestamp to the Raft commands it proposes. A
+// replica which has applied such a command has applied every write at or
+// below the closed timestamp and may serve consistent reads up to it.
+//
+// Note that this covers only MVCC values. Inline values, such as those
+// written by merges, are not versioned and may be stale on followers.
+type closedTimestampState struct {
+	// applied is the highest closed timestamp carried by a command this
+	// replica has applied.
+	applied roachpb.Timestamp
+	// inflight counts the writes evaluated on this replica (as lease
+	// holder) which have not yet been applied, by timestamp. The closed
+	// timestamp is kept below all of them.
+	inflight map[roachpb.Timestamp]int
+	// lastProposal is the wall time at which the closed timestamp was last
+	// attached to a proposed command.
+	lastProposal int64
+}
+
+// trackWriteLocked registers a write which is about to be proposed at the
+// given timestamp. The replica lock must be held.
+func (r *Replica) trackWriteLocked(ts roachpb.Timestamp) {
+	if r.closed.inflight == nil {
+		r.closed.inflight = map[roachpb.Timestamp]int{}
+	}
+	r.closed.inflight[ts]++
+}
+
+// untrackWrite unregisters a write previously registered through
+// trackWriteLocked, once it has been applied or has failed.
+func (r *Replica) untrackWrite(ts roachpb.Timestamp) {
+	r.Lock()
+	defer r.Unlock()
+	if r.closed.inflight[ts]--; r.closed.inflight[ts] <= 0 {
+		delete(r.closed.inflight, ts)
+	}
+}
+
+// closeTimestampLocked closes the timestamp lagging the given time by
+// ClosedTimestampTargetLag, or just below the oldest write still in flight
+// if that is older. It returns the closed timestamp, which is zero if no
+// timestamp could be closed. The replica lock must be held, and the replica
+// must hold the leader lease.
+func (r *Replica) closeTimestampLocked(now roachpb.Timestamp) roachpb.Timestamp {
+	closed := now.Add(-ClosedTimestampTargetLag.Nanoseconds(), 0)
+	for ts := range r.closed.inflight {
+		if !ts.Less(closed) {
+			continue
+		}
+		if ts.Equal(roachpb.ZeroTimestamp) {
+			return roachpb.ZeroTimestamp
+		}
+		closed = ts.Prev()
+	}
+	if closed.WallTime <= 0 {
+		return roachpb.ZeroTimestamp
+	}
+	r.tsCache.SetLowWater(closed)
+	r.closed.lastProposal = now.WallTime
+	return closed
+}
+
+// canServeFollowerRead returns whether the given read-only batch may be
+// served without holding the leader lease, which is the case for
+// non-transactional consistent reads at or below the closed timestamp this
+// replica has applied. A replica holding a lease covering the read never
+// serves it as a follower.
+func (r *Replica) canServeFollowerRead(ba *roachpb.BatchRequest) bool {
+	if r.rm.closedTimestampInterval() <= 0 || ba.Txn != nil ||
+		ba.ReadConsistency != roachpb.CONSISTENT {
+		return false
+	}
+	if lease := r.getLease(); lease.Covers(ba.Timestamp) && lease.OwnedBy(r.rm.StoreID()) {
+		return false
+	}
+	r.RLock()
+	defer r.RUnlock()
+	return !r.closed.applied.Less(ba.Timestamp)
+}
+
+// maybeProposeClosedTimestamp proposes an empty command carrying a fresh
+// closed timestamp if this replica holds the leader lease and no closed
+// timestamp has been attached to a command recently. This keeps the closed
+// timestamp advancing on ranges which receive no writes.
+func (r *Replica) maybeProposeClosedTimestamp() {
+	interval := r.rm.closedTimestampInterval()
+	if interval <= 0 || r.isCorrupted() {
+		return
+	}
+	now := r.rm.Clock().Now()
+	if lease := r.getLease(); !lease.Covers(now) || !lease.OwnedBy(r.rm.StoreID()) {
+		return
+	}
+	r.RLock()
+	lastProposal := r.closed.lastProposal
+	r.RUnlock()
+	if now.WallTime-lastProposal < interval.Nanoseconds() {
+		return
+	}
+	ba := roachpb.BatchRequest{}
+	ba.Timestamp = now
+	ba.Add(&roachpb.NoopRequest{})
+	// The command is fire-and-forget: the channels it returns are buffered
+	// and the closed timestamp takes effect when the command is applied.
+	r.proposeRaftCommand(r.context(), &ba)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/uuid"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -136,6 +137,22 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 		var resp roachpb.LeaderLeaseResponse
 		resp, err = r.LeaderLease(batch, ms, h, *tArgs)
 		reply = &resp
+	case *roachpb.TransferLeaseRequest:
+		var resp roachpb.TransferLeaseResponse
+		resp, err = r.TransferLease(batch, ms, h, *tArgs)
+		reply = &resp
+	case *roachpb.ComputeChecksumRequest:
+		var resp roachpb.ComputeChecksumResponse
+		resp, err = r.ComputeChecksum(batch, ms, h, *tArgs)
+		reply = &resp
+	case *roachpb.VerifyChecksumRequest:
+		var resp roachpb.VerifyChecksumResponse
+		resp, err = r.VerifyChecksum(batch, ms, h, *tArgs)
+		reply = &resp
+	case *roachpb.CollectChecksumRequest:
+		var resp roachpb.CollectChecksumResponse
+		resp, err = r.CollectChecksum(batch, h, *tArgs)
+		reply = &resp
 	default:
 		err = util.Errorf("unrecognized command %s", args.Method())
 	}
@@ -324,6 +341,10 @@ func (r *Replica) EndTransaction(batch engine.Engine, ms *engine.MVCCStats, h ro
 		if reply.Txn.Priority < h.Txn.Priority {
 			reply.Txn.Priority = h.Txn.Priority
 		}
+		// The requester's write sequence and rolled back sequence ranges are
+		// authoritative; they determine which intents survive resolution.
+		reply.Txn.Sequence = h.Txn.Sequence
+		reply.Txn.RolledBack = h.Txn.RolledBack
 	}
 
 	// Take max of requested timestamp and possibly "pushed" txn
@@ -1059,14 +1080,48 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 	}
 
 	args.Lease.Start = effectiveStart
+	return reply, r.applyNewLeaseLocked(batch, ms, args.Lease, prevLease)
+}
+
+// TransferLease hands the leader lease for this range over to the replica
+// named in the new lease. Unlike LeaderLease, the new lease may start
+// before the previous one expires: the command is only executed if it was
+// proposed by the holder of the previous lease (see
+// applyRaftCommandInBatch), which stops serving requests under its lease
+// once the transfer applies. Reads it may have served up to the
+// expiration of its lease are accounted for by the timestamp cache of the
+// new holder.
+func (r *Replica) TransferLease(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.TransferLeaseRequest) (roachpb.TransferLeaseResponse, error) {
+	var reply roachpb.TransferLeaseResponse
+
+	r.Lock()
+	defer r.Unlock()
+
+	prevLease := r.getLease()
+	rErr := &roachpb.LeaseRejectedError{
+		Existing:  *prevLease,
+		Requested: args.Lease,
+	}
+	if !args.Lease.Start.Less(args.Lease.Expiration) || args.Lease.Start.Less(prevLease.Start) {
+		return reply, rErr
+	}
+	if idx, _ := r.Desc().FindReplica(args.Lease.Replica.StoreID); idx == -1 {
+		return reply, rErr
+	}
+	return reply, r.applyNewLeaseLocked(batch, ms, args.Lease, prevLease)
+}
 
-	rangeID := desc.RangeID
+// applyNewLeaseLocked stores the given lease to disk and in memory, taking
+// over from prevLease. If this replica is the new holder of the lease, it
+// commences the duties of the range leader. The replica must be locked.
+func (r *Replica) applyNewLeaseLocked(batch engine.Engine, ms *engine.MVCCStats, lease roachpb.Lease, prevLease *roachpb.Lease) error {
+	rangeID := r.Desc().RangeID
 
 	// Store the lease to disk & in-memory.
-	if err := engine.MVCCPutProto(batch, ms, keys.RaftLeaderLeaseKey(rangeID), roachpb.ZeroTimestamp, nil, &args.Lease); err != nil {
-		return reply, err
+	if err := engine.MVCCPutProto(batch, ms, keys.RaftLeaderLeaseKey(rangeID), roachpb.ZeroTimestamp, nil, &lease); err != nil {
+		return err
 	}
-	atomic.StorePointer(&r.lease, unsafe.Pointer(&args.Lease))
+	atomic.StorePointer(&r.lease, unsafe.Pointer(&lease))
 
 	// If this replica is a new holder of the lease, update the
 	// low water mark in the timestamp cache. We add the maximum
@@ -1076,16 +1131,106 @@ func (r *Replica) LeaderLease(batch engine.Engine, ms *engine.MVCCStats, h roach
 	if r.getLease().Replica.StoreID == r.rm.StoreID() &&
 		prevLease.Replica.StoreID != r.getLease().Replica.StoreID {
 		r.tsCache.SetLowWater(prevLease.Expiration.Add(int64(r.rm.Clock().MaxOffset()), 0))
-		log.Infof("range %d: new leader lease %s", rangeID, args.Lease)
+		log.Infof("range %d: new leader lease %s", rangeID, lease)
 	}
 
 	// Gossip system config if this range includes the system span.
 	if r.ContainsKey(keys.SystemDBSpan.Key) {
 		r.maybeGossipSystemConfigLocked()
 	}
+	return nil
+}
+
+// CheckConsistency runs a consistency check on the range. It first applies
+// a ComputeChecksum command on the range, which makes every replica compute
+// a checksum of its data. It then applies a VerifyChecksum command carrying
+// the checksum computed by this replica, the leader, against which every
+// replica compares its own. The leader's snapshot of the range data, if
+// requested, is not part of any command: replicas whose checksums differ
+// collect it from the leader (see CollectChecksum).
+func (r *Replica) CheckConsistency(args roachpb.CheckConsistencyRequest, desc *roachpb.RangeDescriptor) (roachpb.CheckConsistencyResponse, error) {
+	var reply roachpb.CheckConsistencyResponse
+	key := desc.StartKey.AsRawKey()
+	id := uuid.NewUUID4()
+	computeArgs := &roachpb.ComputeChecksumRequest{
+		Span: roachpb.Span{
+			Key: key,
+		},
+		ChecksumID: id,
+		Snapshot:   args.WithDiff,
+	}
+	if _, err := client.SendWrappedWith(r, r.context(), roachpb.Header{
+		RangeID: desc.RangeID,
+	}, computeArgs); err != nil {
+		return reply, err
+	}
+
+	checksum, err := r.getChecksum(id)
+	if err != nil {
+		return reply, err
+	}
+	verifyArgs := &roachpb.VerifyChecksumRequest{
+		Span: roachpb.Span{
+			Key: key,
+		},
+		ChecksumID: id,
+		Checksum:   checksum,
+	}
+	if _, err := client.SendWrappedWith(r, r.context(), roachpb.Header{
+		RangeID: desc.RangeID,
+	}, verifyArgs); err != nil {
+		return reply, err
+	}
+	return reply, nil
+}
+
+// ComputeChecksum starts the computation of a checksum of the replica's
+// data, read from a snapshot taken at the applied index of the command, so
+// that all replicas checksum the same data. The checksum is computed
+// asynchronously so as not to hold up the application of later commands.
+func (r *Replica) ComputeChecksum(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.ComputeChecksumRequest) (roachpb.ComputeChecksumResponse, error) {
+	var reply roachpb.ComputeChecksumResponse
+	if len(args.ChecksumID) != uuid.UUIDSize {
+		return reply, util.Errorf("invalid checksum ID %x", args.ChecksumID)
+	}
+	r.startChecksum(uuid.UUID(args.ChecksumID), r.rm.NewSnapshot(), args.Snapshot)
+	return reply, nil
+}
+
+// VerifyChecksum compares the replica's checksum with the leader's. The
+// comparison takes place once the replica's checksum has been computed.
+func (r *Replica) VerifyChecksum(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.VerifyChecksumRequest) (roachpb.VerifyChecksumResponse, error) {
+	var reply roachpb.VerifyChecksumResponse
+	if len(args.ChecksumID) != uuid.UUIDSize {
+		return reply, util.Errorf("invalid checksum ID %x", args.ChecksumID)
+	}
+	r.setLeaderChecksum(args)
 	return reply, nil
 }
 
+// CollectChecksum is sent to the leader by a replica whose checksum differs
+// from the leader's. It returns a chunk of the leader's snapshot of the range
+// data, if the consistency check requested one, from which the replica
+// computes the keys which differ. The replica collects the remaining chunks
+// by resending the request with the returned resume key.
+func (r *Replica) CollectChecksum(batch engine.Engine, h roachpb.Header, args roachpb.CollectChecksumRequest) (roachpb.CollectChecksumResponse, error) {
+	var reply roachpb.CollectChecksumResponse
+	if len(args.ChecksumID) != uuid.UUIDSize {
+		return reply, util.Errorf("invalid checksum ID %x", args.ChecksumID)
+	}
+	id := uuid.UUID(args.ChecksumID)
+	if len(args.ResumeKey) == 0 {
+		log.Errorf("%s: a replica reported checksum %x for check %s", r, args.Checksum, id)
+	}
+	snapData, resumeKey, err := r.collectChecksum(id, args.ResumeKey)
+	if err != nil || snapData == nil {
+		return reply, err
+	}
+	reply.ResumeKey = resumeKey
+	reply.Snapshot, err = proto.Marshal(snapData)
+	return reply, err
+}
+
 // AdminSplit divides the range into into two ranges, using either
 // args.SplitKey (if provided) or an internally computed key that aims to
 // roughly equipartition the range by size. The split is done inside of
@@ -0,0 +1,631 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/uuid"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// checksumComputeTimeout is the time for which the range leader waits
+	// for its own checksum to be computed during a consistency check.
+	checksumComputeTimeout = time.Minute
+	// checksumGCAge is the age after which the state of a consistency check
+	// which hasn't completed on a replica is discarded.
+	checksumGCAge = time.Hour
+	// checksumRetention is the time for which the range leader retains the
+	// state of a consistency check it coordinated, including its snapshot of
+	// the range data if one was requested, so that replicas whose checksums
+	// differ can collect it.
+	checksumRetention = 5 * time.Minute
+	// maxInconsistencyDiff is the maximum number of lines of the diff
+	// recorded for an inconsistent replica; further lines are omitted.
+	maxInconsistencyDiff = 1000
+)
+
+// An Inconsistency describes a replica whose data was found by a
+// consistency check to differ from the data of its range's leader.
+type Inconsistency struct {
+	RangeID        roachpb.RangeID `json:"rangeID"`
+	StoreID        roachpb.StoreID `json:"storeID"`
+	ChecksumID     uuid.UUID       `json:"checksumID"`
+	Checksum       []byte          `json:"checksum"`
+	LeaderChecksum []byte          `json:"leaderChecksum"`
+	DetectedAt     time.Time       `json:"detectedAt"`
+	// Diff lists the key/value pairs which differ between the leader and
+	// the replica, if a diff was requested. See snapshotDiffer.
+	Diff []string `json:"diff,omitempty"`
+}
+
+// A replicaChecksum is the state of a consistency check on a replica. The
+// replica's checksum is computed asynchronously once ComputeChecksum is
+// applied, and compared with the leader's checksum once both it and
+// VerifyChecksum have been applied, in whichever order they complete.
+type replicaChecksum struct {
+	started     time.Time
+	computed    bool
+	notify      chan struct{}                  // Closed once computed is set
+	checksum    []byte                         // The replica's checksum; nil on error
+	desc        roachpb.RangeDescriptor        // The descriptor the checksum was computed for
+	snap        engine.Engine                  // The replica's data, if requested
+	verify      *roachpb.VerifyChecksumRequest // The leader's checksum, once applied
+	retained    bool                           // Set on the leader until collected
+	diffStarted bool                           // Set on the leader once a diff pass is started
+	collected   bool                           // Set on the leader once snap was collected
+}
+
+// release closes the snapshot of the range data retained by the check, if
+// any. Must be called with the replica's checksumMu held.
+func (c *replicaChecksum) release() {
+	if c.snap != nil {
+		c.snap.Close()
+		c.snap = nil
+	}
+}
+
+// unreplicatedKeyPrefixes returns the prefixes of the range-local keys of
+// the range which hold state that legitimately differs between replicas:
+// the raft log and its metadata, which each replica maintains on its own,
+// the last verification timestamp, which is written by each replica's
+// verify queue, and the corruption marker of a quarantined replica. These
+// keys are excluded from consistency checks.
+func unreplicatedKeyPrefixes(rangeID roachpb.RangeID) []roachpb.Key {
+	return []roachpb.Key{
+		keys.RaftLogPrefix(rangeID),
+		keys.RaftHardStateKey(rangeID),
+		keys.RaftLastIndexKey(rangeID),
+		keys.RaftTruncatedStateKey(rangeID),
+		keys.RangeLastVerificationTimestampKey(rangeID),
+		keys.RangeCorruptionKey(rangeID),
+	}
+}
+
+// computeChecksum returns a SHA-512 checksum of the replicated data of the
+// range read from snap.
+func computeChecksum(desc *roachpb.RangeDescriptor, snap engine.Engine) ([]byte, error) {
+	unreplicated := unreplicatedKeyPrefixes(desc.RangeID)
+	sha := sha512.New()
+	var lengths [8]byte
+
+	iter := newRangeDataIterator(desc, snap)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		if skip, err := isUnreplicated(key, unreplicated); err != nil {
+			return nil, err
+		} else if skip {
+			continue
+		}
+		// The lengths of the key and value are hashed so that the boundaries
+		// between them are part of the checksum.
+		binary.BigEndian.PutUint32(lengths[:4], uint32(len(key)))
+		binary.BigEndian.PutUint32(lengths[4:], uint32(len(value)))
+		sha.Write(lengths[:])
+		sha.Write(key)
+		sha.Write(value)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return sha.Sum(nil), nil
+}
+
+// snapshotChunk returns the replicated data of the range read from snap,
+// starting at the given encoded key, in a chunk of about snapshotChunkSize
+// bytes. The key from which to read the next chunk is returned as well, or
+// nil if the chunk holds the last of the range data.
+func snapshotChunk(desc *roachpb.RangeDescriptor, snap engine.Engine,
+	start roachpb.EncodedKey) (*roachpb.RaftSnapshotData, roachpb.EncodedKey, error) {
+	snapData := &roachpb.RaftSnapshotData{RangeDescriptor: *desc}
+	unreplicated := unreplicatedKeyPrefixes(desc.RangeID)
+	size := 0
+
+	iter := newRangeDataIterator(desc, snap)
+	defer iter.Close()
+	if len(start) > 0 {
+		iter.Seek(start)
+	}
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		if size >= snapshotChunkSize {
+			return snapData, key, nil
+		}
+		if skip, err := isUnreplicated(key, unreplicated); err != nil {
+			return nil, nil, err
+		} else if skip {
+			continue
+		}
+		snapData.KV = append(snapData.KV,
+			&roachpb.RaftSnapshotData_KeyValue{Key: key, Value: value})
+		size += len(key) + len(value)
+	}
+	return snapData, nil, iter.Error()
+}
+
+// isUnreplicated returns true if the decoded encoded key has one of the
+// given unreplicated key prefixes.
+func isUnreplicated(key roachpb.EncodedKey, prefixes []roachpb.Key) (bool, error) {
+	decoded, _, _, err := engine.MVCCDecodeKey(key)
+	if err != nil {
+		return false, err
+	}
+	return hasAnyPrefix(decoded, prefixes), nil
+}
+
+// hasAnyPrefix returns true if key has one of the given prefixes.
+func hasAnyPrefix(key roachpb.Key, prefixes []roachpb.Key) bool {
+	for _, prefix := range prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// A snapshotDiffer finds the key/value pairs which differ between the
+// leader's snapshot of the range data, received in chunks, and a replica's,
+// read from its iterator. The pairs are formatted one per line and prefixed
+// with "-" if the pair is found only on the leader or "+" if it is found only
+// on the replica. Both snapshots are sorted by key, as read from a
+// rangeDataIterator. At most maxInconsistencyDiff lines are recorded.
+type snapshotDiffer struct {
+	iter         *rangeDataIterator
+	unreplicated []roachpb.Key
+	diff         []string
+	omitted      int
+}
+
+func newSnapshotDiffer(desc *roachpb.RangeDescriptor, snap engine.Engine) *snapshotDiffer {
+	return &snapshotDiffer{
+		iter:         newRangeDataIterator(desc, snap),
+		unreplicated: unreplicatedKeyPrefixes(desc.RangeID),
+	}
+}
+
+// close closes the replica's iterator.
+func (d *snapshotDiffer) close() {
+	d.iter.Close()
+}
+
+// add records a differing key/value pair.
+func (d *snapshotDiffer) add(prefix string, key roachpb.EncodedKey, value []byte) {
+	if len(d.diff) >= maxInconsistencyDiff {
+		d.omitted++
+		return
+	}
+	decoded, ts, _, err := engine.MVCCDecodeKey(key)
+	if err != nil {
+		d.diff = append(d.diff, fmt.Sprintf("%s%q: %x", prefix, key, value))
+		return
+	}
+	d.diff = append(d.diff, fmt.Sprintf("%s%s %s: %x", prefix, decoded, ts, value))
+}
+
+// peek returns the replica's next replicated key/value pair, or false if
+// there are none left.
+func (d *snapshotDiffer) peek() (roachpb.EncodedKey, []byte, bool) {
+	for ; d.iter.Valid(); d.iter.Next() {
+		key := d.iter.Key()
+		if skip, err := isUnreplicated(key, d.unreplicated); err == nil && skip {
+			continue
+		}
+		return key, d.iter.Value(), true
+	}
+	return nil, nil, false
+}
+
+// compare compares the next chunk of the leader's snapshot with the
+// replica's key/value pairs preceding its end.
+func (d *snapshotDiffer) compare(leader []*roachpb.RaftSnapshotData_KeyValue) {
+	for _, l := range leader {
+		for {
+			key, value, ok := d.peek()
+			c := -1
+			if ok {
+				c = bytes.Compare(l.Key, key)
+			}
+			if c > 0 {
+				d.add("+", key, value)
+				d.iter.Next()
+				continue
+			}
+			if c < 0 {
+				d.add("-", l.Key, l.Value)
+			} else {
+				if !bytes.Equal(l.Value, value) {
+					d.add("-", l.Key, l.Value)
+					d.add("+", key, value)
+				}
+				d.iter.Next()
+			}
+			break
+		}
+	}
+}
+
+// finish records the replica's key/value pairs following the last of the
+// leader's and returns the diff.
+func (d *snapshotDiffer) finish() ([]string, error) {
+	for key, value, ok := d.peek(); ok; key, value, ok = d.peek() {
+		d.add("+", key, value)
+		d.iter.Next()
+	}
+	if err := d.iter.Error(); err != nil {
+		return nil, err
+	}
+	if d.omitted > 0 {
+		d.diff = append(d.diff, fmt.Sprintf("... %d more lines omitted", d.omitted))
+	}
+	return d.diff, nil
+}
+
+// startChecksum registers a consistency check with the given ID and
+// starts the computation of the replica's checksum over snap, which it
+// closes once done unless withSnapshot is set, in which case snap is kept
+// for the diff against the leader's data. Consistency checks which were started long ago but
+// haven't completed are discarded.
+func (r *Replica) startChecksum(id uuid.UUID, snap engine.Engine, withSnapshot bool) {
+	now := time.Now()
+	r.checksumMu.Lock()
+	for key, c := range r.checksums {
+		if now.Sub(c.started) > checksumGCAge {
+			c.release()
+			delete(r.checksums, key)
+		}
+	}
+	if _, ok := r.checksums[string(id)]; ok {
+		r.checksumMu.Unlock()
+		snap.Close()
+		return
+	}
+	c := &replicaChecksum{started: now, notify: make(chan struct{})}
+	r.checksums[string(id)] = c
+	r.checksumMu.Unlock()
+
+	desc := *r.Desc()
+	if !r.rm.Stopper().RunAsyncTask(func() {
+		checksum, err := computeChecksum(&desc, snap)
+		if err != nil {
+			log.Errorf("%s: failed to compute checksum %s: %s", r, id, err)
+		}
+		if !withSnapshot || checksum == nil {
+			snap.Close()
+			snap = nil
+		}
+		r.computeChecksumDone(id, desc, checksum, snap)
+	}) {
+		snap.Close()
+		r.computeChecksumDone(id, desc, nil, nil)
+	}
+}
+
+// computeChecksumDone records the replica's checksum and snapshot, if any,
+// for the consistency check with the given ID, verifying the checksum if
+// the leader's has already been applied.
+func (r *Replica) computeChecksumDone(id uuid.UUID, desc roachpb.RangeDescriptor,
+	checksum []byte, snap engine.Engine) {
+	r.checksumMu.Lock()
+	c, ok := r.checksums[string(id)]
+	if !ok {
+		r.checksumMu.Unlock()
+		if snap != nil {
+			snap.Close()
+		}
+		return
+	}
+	c.computed = true
+	c.checksum = checksum
+	c.desc = desc
+	c.snap = snap
+	close(c.notify)
+	verify := c.verify != nil
+	r.checksumMu.Unlock()
+
+	if verify {
+		r.verifyChecksum(id, c)
+	}
+}
+
+// getChecksum waits for the replica's checksum for the consistency check
+// with the given ID to be computed and returns it. The state of the check
+// is then retained for checksumRetention, so that the replicas whose
+// checksums differ can collect it (see collectChecksum), and is released
+// afterwards.
+func (r *Replica) getChecksum(id uuid.UUID) ([]byte, error) {
+	r.checksumMu.Lock()
+	c, ok := r.checksums[string(id)]
+	r.checksumMu.Unlock()
+	if !ok {
+		return nil, util.Errorf("no checksum %s found", id)
+	}
+	select {
+	case <-c.notify:
+	case <-time.After(checksumComputeTimeout):
+		return nil, util.Errorf("checksum %s was not computed in time", id)
+	case <-r.rm.Stopper().ShouldStop():
+		return nil, util.Errorf("store is stopping")
+	}
+	if c.checksum == nil {
+		return nil, util.Errorf("checksum %s could not be computed", id)
+	}
+
+	r.checksumMu.Lock()
+	c.retained = true
+	r.checksumMu.Unlock()
+	if !r.rm.Stopper().RunAsyncTask(func() {
+		select {
+		case <-time.After(checksumRetention):
+		case <-r.rm.Stopper().ShouldStop():
+		}
+		r.dropChecksum(id)
+	}) {
+		r.dropChecksum(id)
+	}
+	return c.checksum, nil
+}
+
+// dropChecksum discards the state of the consistency check with the given
+// ID, releasing its snapshot.
+func (r *Replica) dropChecksum(id uuid.UUID) {
+	r.checksumMu.Lock()
+	if c, ok := r.checksums[string(id)]; ok {
+		c.release()
+		delete(r.checksums, string(id))
+	}
+	r.checksumMu.Unlock()
+}
+
+// collectChecksum is called on the leader on behalf of a replica whose
+// checksum for the consistency check with the given ID differs from the
+// leader's. If the check requested snapshots, it returns the chunk of the
+// leader's snapshot of the range data starting at resumeKey, along with the
+// key at which the next chunk starts. The snapshot is released once its
+// last chunk was collected. Otherwise, the first call for the check starts
+// a second check which requests them, so that the replicas can report the
+// keys which differ.
+func (r *Replica) collectChecksum(id uuid.UUID,
+	resumeKey roachpb.EncodedKey) (*roachpb.RaftSnapshotData, roachpb.EncodedKey, error) {
+	r.checksumMu.Lock()
+	c, ok := r.checksums[string(id)]
+	if !ok || !c.retained {
+		r.checksumMu.Unlock()
+		return nil, nil, util.Errorf("no checksum %s retained", id)
+	}
+	if c.snap != nil {
+		defer r.checksumMu.Unlock()
+		snapData, resume, err := snapshotChunk(&c.desc, c.snap, resumeKey)
+		if err == nil && resume == nil {
+			c.release()
+			c.collected = true
+		}
+		return snapData, resume, err
+	}
+	if c.collected {
+		r.checksumMu.Unlock()
+		return nil, nil, util.Errorf("snapshot for checksum %s was already collected", id)
+	}
+	startDiff := !c.diffStarted
+	c.diffStarted = true
+	r.checksumMu.Unlock()
+
+	if startDiff {
+		key := r.Desc().StartKey.AsRawKey()
+		if !r.rm.Stopper().RunAsyncTask(func() {
+			if err := r.rm.DB().CheckConsistency(key, true); err != nil {
+				log.Errorf("%s: failed to check consistency with diff: %s", r, err)
+			}
+		}) {
+			log.Warningf("%s: not checking consistency with diff: store is stopping", r)
+		}
+	}
+	return nil, nil, nil
+}
+
+// setLeaderChecksum records the leader's checksum for the consistency
+// check with the given ID, verifying the replica's checksum against it if
+// it has already been computed.
+func (r *Replica) setLeaderChecksum(args roachpb.VerifyChecksumRequest) {
+	id := uuid.UUID(args.ChecksumID)
+	r.checksumMu.Lock()
+	c, ok := r.checksums[string(id)]
+	if !ok {
+		// The replica may have been added to the range, or its store
+		// restarted, since the checksum was computed.
+		r.checksumMu.Unlock()
+		if log.V(1) {
+			log.Infof("%s: no checksum %s to verify", r, id)
+		}
+		return
+	}
+	c.verify = &args
+	computed := c.computed
+	r.checksumMu.Unlock()
+
+	if computed {
+		r.verifyChecksum(id, c)
+	}
+}
+
+// verifyChecksum compares the replica's checksum with the leader's once
+// both are known. A mismatch is logged, reported to the event feed and
+// recorded as the replica's inconsistency. The replica then collects the
+// leader's snapshot of the range data in chunks over CollectChecksum
+// requests, and compares each with its own snapshot to find the keys which
+// differ. If the check did not request snapshots, the leader runs a second
+// check which does instead.
+func (r *Replica) verifyChecksum(id uuid.UUID, c *replicaChecksum) {
+	// The replica's snapshot is owned by the verification from here on,
+	// unless it is retained to be collected.
+	var snap engine.Engine
+	r.checksumMu.Lock()
+	if !c.retained {
+		delete(r.checksums, string(id))
+		snap, c.snap = c.snap, nil
+	}
+	r.checksumMu.Unlock()
+
+	if c.checksum == nil {
+		log.Warningf("%s: unable to verify checksum %s", r, id)
+		return
+	}
+	if bytes.Equal(c.checksum, c.verify.Checksum) {
+		if snap != nil {
+			snap.Close()
+		}
+		r.checksumMu.Lock()
+		r.inconsistency = nil
+		r.checksumMu.Unlock()
+		return
+	}
+
+	desc := r.Desc()
+	inc := &Inconsistency{
+		RangeID:        desc.RangeID,
+		StoreID:        r.rm.StoreID(),
+		ChecksumID:     id,
+		Checksum:       c.checksum,
+		LeaderChecksum: c.verify.Checksum,
+		DetectedAt:     time.Now(),
+	}
+	log.Errorf("%s: replica is inconsistent with the leader: checksum %x != leader's %x",
+		r, c.checksum, c.verify.Checksum)
+
+	if snap == nil {
+		r.rm.EventFeed().consistencyCheckFailed(desc.RangeID)
+	}
+	r.checksumMu.Lock()
+	r.inconsistency = inc
+	r.checksumMu.Unlock()
+
+	if !r.rm.Stopper().RunAsyncTask(func() {
+		var differ *snapshotDiffer
+		if snap != nil {
+			defer snap.Close()
+			differ = newSnapshotDiffer(&c.desc, snap)
+			defer differ.close()
+		}
+		args := &roachpb.CollectChecksumRequest{
+			Span: roachpb.Span{
+				Key: desc.StartKey.AsRawKey(),
+			},
+			ChecksumID: id,
+			Checksum:   c.checksum,
+		}
+		for {
+			resp, err := client.SendWrapped(r.rm.DB().GetSender(), r.context(), args)
+			if err != nil {
+				log.Errorf("%s: failed to collect leader's checksum %s: %s", r, id, err)
+				return
+			}
+			reply := resp.(*roachpb.CollectChecksumResponse)
+			if differ == nil || reply.Snapshot == nil {
+				return
+			}
+			var leaderSnap roachpb.RaftSnapshotData
+			if err := proto.Unmarshal(reply.Snapshot, &leaderSnap); err != nil {
+				log.Errorf("%s: failed to decode leader's snapshot: %s", r, err)
+				return
+			}
+			differ.compare(leaderSnap.KV)
+			if len(reply.ResumeKey) == 0 {
+				break
+			}
+			args.ResumeKey = reply.ResumeKey
+		}
+		diff, err := differ.finish()
+		if err != nil {
+			log.Errorf("%s: failed to read snapshot: %s", r, err)
+			return
+		}
+		var buf bytes.Buffer
+		for _, line := range diff {
+			fmt.Fprintf(&buf, "\n%s", line)
+		}
+		log.Errorf("%s: key/values which differ from the leader's (-leader +replica):%s", r, buf.String())
+		withDiff := *inc
+		withDiff.Diff = diff
+		r.checksumMu.Lock()
+		if r.inconsistency == inc {
+			r.inconsistency = &withDiff
+		}
+		r.checksumMu.Unlock()
+	}) {
+		if snap != nil {
+			snap.Close()
+		}
+		log.Warningf("%s: not collecting leader's checksum %s: store is stopping", r, id)
+	}
+}
+
+// getInconsistency returns the inconsistency found by the last consistency
+// check of the replica, or nil if the check passed.
+func (r *Replica) getInconsistency() *Inconsistency {
+	r.checksumMu.Lock()
+	defer r.checksumMu.Unlock()
+	return r.inconsistency
+}
+
+// getLastConsistencyCheck returns the time at which the last consistency
+// check of the range was started by this replica.
+func (r *Replica) getLastConsistencyCheck() roachpb.Timestamp {
+	r.checksumMu.Lock()
+	defer r.checksumMu.Unlock()
+	return r.lastCheckedAt
+}
+
+// setLastConsistencyCheck records the time at which a consistency check of
+// the range was started by this replica.
+func (r *Replica) setLastConsistencyCheck(ts roachpb.Timestamp) {
+	r.checksumMu.Lock()
+	r.lastCheckedAt = ts
+	r.checksumMu.Unlock()
+}
+
+// inconsistenciesByRangeID implements sort.Interface for []Inconsistency.
+type inconsistenciesByRangeID []Inconsistency
+
+func (s inconsistenciesByRangeID) Len() int           { return len(s) }
+func (s inconsistenciesByRangeID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s inconsistenciesByRangeID) Less(i, j int) bool { return s[i].RangeID < s[j].RangeID }
+
+// Inconsistencies returns the inconsistencies found by the last consistency
+// checks of the store's replicas, ordered by range ID.
+func (s *Store) Inconsistencies() []Inconsistency {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var incs []Inconsistency
+	for _, rng := range s.replicas {
+		if inc := rng.getInconsistency(); inc != nil {
+			incs = append(incs, *inc)
+		}
+	}
+	sort.Sort(inconsistenciesByRangeID(incs))
+	return incs
+}
@@ -0,0 +1,149 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// TestSnapshotDiff verifies that a snapshotDiffer reports the key/value
+// pairs missing from either snapshot, as well as those whose values differ,
+// whether the leader's snapshot is received in one chunk or several. The
+// replica's unreplicated keys are ignored.
+func TestSnapshotDiff(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	eng := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
+	desc := &roachpb.RangeDescriptor{
+		RangeID:  1,
+		StartKey: roachpb.RKey("a"),
+		EndKey:   roachpb.RKey("z"),
+	}
+
+	kv := func(key, value string) *roachpb.RaftSnapshotData_KeyValue {
+		return &roachpb.RaftSnapshotData_KeyValue{
+			Key:   engine.MVCCEncodeKey(roachpb.Key(key)),
+			Value: []byte(value),
+		}
+	}
+	leader := []*roachpb.RaftSnapshotData_KeyValue{kv("a", "1"), kv("b", "2"), kv("d", "4")}
+	replica := []*roachpb.RaftSnapshotData_KeyValue{kv("a", "1"), kv("b", "3"), kv("c", "3")}
+	for _, kv := range replica {
+		if err := eng.Put(kv.Key, kv.Value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := eng.Put(engine.MVCCEncodeKey(keys.RaftHardStateKey(desc.RangeID)), []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+
+	diff := func(chunks ...[]*roachpb.RaftSnapshotData_KeyValue) []string {
+		differ := newSnapshotDiffer(desc, eng)
+		defer differ.close()
+		for _, chunk := range chunks {
+			differ.compare(chunk)
+		}
+		diff, err := differ.finish()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return diff
+	}
+	if d := diff(replica); len(d) != 0 {
+		t.Errorf("expected no differences between identical snapshots; got %v", d)
+	}
+	expected := []string{
+		`-"b" 0.000000000,0: 32`,
+		`+"b" 0.000000000,0: 33`,
+		`+"c" 0.000000000,0: 33`,
+		`-"d" 0.000000000,0: 34`,
+	}
+	if d := diff(leader); !reflect.DeepEqual(d, expected) {
+		t.Errorf("expected diff %v; got %v", expected, d)
+	}
+	if d := diff(leader[:1], leader[1:2], leader[2:]); !reflect.DeepEqual(d, expected) {
+		t.Errorf("expected diff %v; got %v", expected, d)
+	}
+
+	// The diff is truncated after maxInconsistencyDiff lines, including
+	// those for the replica's own keys.
+	var many []*roachpb.RaftSnapshotData_KeyValue
+	for i := 0; i < maxInconsistencyDiff+10; i++ {
+		many = append(many, kv(fmt.Sprintf("e%05d", i), "5"))
+	}
+	d := diff(many)
+	if len(d) != maxInconsistencyDiff+1 {
+		t.Fatalf("expected %d lines; got %d", maxInconsistencyDiff+1, len(d))
+	}
+	if last, e := d[len(d)-1], "... 13 more lines omitted"; last != e {
+		t.Errorf("expected %q; got %q", e, last)
+	}
+}
+
+// TestComputeChecksumUnreplicated verifies that the keys which legitimately
+// differ between replicas do not change the checksum of the range data.
+func TestComputeChecksumUnreplicated(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	eng := engine.NewInMem(roachpb.Attributes{}, 1<<20, stopper)
+	desc := &roachpb.RangeDescriptor{
+		RangeID:  1,
+		StartKey: roachpb.RKey("a"),
+		EndKey:   roachpb.RKey("z"),
+	}
+	if err := eng.Put(engine.MVCCEncodeKey(roachpb.Key("b")), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	expected, err := computeChecksum(desc, eng)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []roachpb.Key{
+		keys.RaftHardStateKey(desc.RangeID),
+		keys.RangeLastVerificationTimestampKey(desc.RangeID),
+		keys.RangeCorruptionKey(desc.RangeID),
+	} {
+		if err := eng.Put(engine.MVCCEncodeKey(key), []byte("value")); err != nil {
+			t.Fatal(err)
+		}
+		if checksum, err := computeChecksum(desc, eng); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(checksum, expected) {
+			t.Errorf("%s: expected checksum %x; got %x", key, expected, checksum)
+		}
+	}
+
+	// Replicated range-local keys do change it.
+	if err := eng.Put(engine.MVCCEncodeKey(keys.RangeStatsKey(desc.RangeID)), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if checksum, err := computeChecksum(desc, eng); err != nil {
+		t.Fatal(err)
+	} else if reflect.DeepEqual(checksum, expected) {
+		t.Errorf("expected checksum to change")
+	}
+}
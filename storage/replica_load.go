@@ -0,0 +1,191 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+)
+
+const (
+	// loadWindow is the interval over which a replica's request rate is
+	// measured and its request keys are sampled.
+	loadWindow = 10 * time.Second
+	// loadSampleSize is the number of request keys sampled per window as
+	// candidate load-based split keys.
+	loadSampleSize = 20
+	// loadSplitMinRequests is the minimum number of requests which must have
+	// been counted against a sampled key for it to be chosen as a split key.
+	loadSplitMinRequests = 20
+)
+
+// A loadSample is a request key sampled as a candidate split key, along
+// with the number of subsequent requests which fell to its left, to its
+// right, or spanned it.
+type loadSample struct {
+	key                   roachpb.RKey
+	left, right, spanning int
+}
+
+// replicaLoad tracks the rate of requests served by a replica and samples
+// the keys they access in order to find a split key which balances the
+// load between the two halves of the range. It also tracks the rate of
+// bytes written by the requests. Requests are counted in windows of
+// loadWindow; the rates and split key reported are those of the last
+// complete window.
+type replicaLoad struct {
+	sync.Mutex
+	rand           *rand.Rand
+	windowStart    int64 // Start of the current window in nanoseconds
+	count          int   // Requests in the current window
+	writeBytes     int64 // Bytes written in the current window
+	samples        []loadSample
+	lastQPS        float64      // Request rate of the last window
+	lastWriteBytes float64      // Rate of bytes written in the last window
+	lastSplit      roachpb.RKey // Split key of the last window; nil if none
+}
+
+// newReplicaLoad returns a new replicaLoad with its first window starting
+// at nowNanos.
+func newReplicaLoad(nowNanos int64) *replicaLoad {
+	return &replicaLoad{
+		rand:        rand.New(rand.NewSource(nowNanos)),
+		windowStart: nowNanos,
+		samples:     make([]loadSample, 0, loadSampleSize),
+	}
+}
+
+// record counts a request to the span [key, endKey), or to key alone if
+// endKey is nil, at time nowNanos. It returns true if the request started
+// a new window, in which case QPS and splitKey reflect the window that
+// just ended.
+func (rl *replicaLoad) record(nowNanos int64, key, endKey roachpb.RKey) bool {
+	rl.Lock()
+	defer rl.Unlock()
+	rolled := rl.maybeRollLocked(nowNanos)
+	rl.count++
+
+	for i := range rl.samples {
+		s := &rl.samples[i]
+		if endKey == nil {
+			if key.Less(s.key) {
+				s.left++
+			} else {
+				s.right++
+			}
+		} else if !s.key.Less(endKey) {
+			s.left++
+		} else if !key.Less(s.key) {
+			s.right++
+		} else {
+			s.spanning++
+		}
+	}
+
+	// Reservoir sampling keeps each request key of the window in the sample
+	// with equal probability.
+	if len(rl.samples) < loadSampleSize {
+		rl.samples = append(rl.samples, loadSample{key: key})
+	} else if i := rl.rand.Intn(rl.count); i < loadSampleSize {
+		rl.samples[i] = loadSample{key: key}
+	}
+	return rolled
+}
+
+// recordWrite counts bytes written by a request at time nowNanos.
+func (rl *replicaLoad) recordWrite(nowNanos int64, bytes int64) {
+	rl.Lock()
+	defer rl.Unlock()
+	rl.maybeRollLocked(nowNanos)
+	rl.writeBytes += bytes
+}
+
+// QPS returns the request rate of the last complete window.
+func (rl *replicaLoad) QPS(nowNanos int64) float64 {
+	rl.Lock()
+	defer rl.Unlock()
+	rl.maybeRollLocked(nowNanos)
+	return rl.lastQPS
+}
+
+// WriteBytesPerSecond returns the rate of bytes written in the last
+// complete window.
+func (rl *replicaLoad) WriteBytesPerSecond(nowNanos int64) float64 {
+	rl.Lock()
+	defer rl.Unlock()
+	rl.maybeRollLocked(nowNanos)
+	return rl.lastWriteBytes
+}
+
+// splitKey returns the key which best balanced the requests of the last
+// complete window between the two halves of the range, or nil if no
+// sampled key did.
+func (rl *replicaLoad) splitKey(nowNanos int64) roachpb.RKey {
+	rl.Lock()
+	defer rl.Unlock()
+	rl.maybeRollLocked(nowNanos)
+	return rl.lastSplit
+}
+
+// maybeRollLocked ends the current window if it is at least loadWindow
+// old, computing its rates and split key. Returns true if the window was
+// rolled. Requires that the lock is held.
+func (rl *replicaLoad) maybeRollLocked(nowNanos int64) bool {
+	elapsed := time.Duration(nowNanos - rl.windowStart)
+	if elapsed < loadWindow {
+		return false
+	}
+	rl.lastQPS = float64(rl.count) / elapsed.Seconds()
+	rl.lastWriteBytes = float64(rl.writeBytes) / elapsed.Seconds()
+	rl.lastSplit = bestLoadSplitKey(rl.samples)
+	rl.windowStart = nowNanos
+	rl.count = 0
+	rl.writeBytes = 0
+	rl.samples = rl.samples[:0]
+	return true
+}
+
+// bestLoadSplitKey returns the key of the sample which divides requests
+// most evenly between its left and right, or nil if there is none. Keys
+// spanned by more requests than fall on either side, keys with no requests
+// on one side and keys at which ranges may not be split are skipped.
+func bestLoadSplitKey(samples []loadSample) roachpb.RKey {
+	var best roachpb.RKey
+	bestDiff := 0
+	for _, s := range samples {
+		if s.left == 0 || s.right == 0 || s.left+s.right+s.spanning < loadSplitMinRequests {
+			continue
+		}
+		if s.spanning > s.left || s.spanning > s.right {
+			continue
+		}
+		if !engine.IsValidSplitKey(s.key.AsRawKey()) {
+			continue
+		}
+		diff := s.left - s.right
+		if diff < 0 {
+			diff = -diff
+		}
+		if best == nil || diff < bestDiff {
+			best, bestDiff = s.key, diff
+		}
+	}
+	return best
+}
@@ -0,0 +1,129 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestReplicaLoadQPS verifies that the request rate is reported for the
+// last complete window.
+func TestReplicaLoadQPS(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rl := newReplicaLoad(0)
+	key := roachpb.RKey("a")
+
+	for i := 0; i < 100; i++ {
+		if rl.record(int64(i)*int64(time.Millisecond), key, nil) {
+			t.Fatalf("%d: unexpected end of window", i)
+		}
+	}
+	if qps := rl.QPS(int64(loadWindow) - 1); qps != 0 {
+		t.Errorf("expected no rate before the end of the first window; got %f", qps)
+	}
+	if !rl.record(int64(loadWindow), key, nil) {
+		t.Errorf("expected end of window")
+	}
+	if qps, expQPS := rl.QPS(int64(loadWindow)), 100/loadWindow.Seconds(); qps != expQPS {
+		t.Errorf("expected qps=%f; got %f", expQPS, qps)
+	}
+	// The rate of a window is computed over its full length, which may
+	// exceed loadWindow if the range was idle.
+	if qps, expQPS := rl.QPS(3*int64(loadWindow)), 1/(2*loadWindow).Seconds(); qps != expQPS {
+		t.Errorf("expected qps=%f; got %f", expQPS, qps)
+	}
+	if qps := rl.QPS(4 * int64(loadWindow)); qps != 0 {
+		t.Errorf("expected qps=0 after an idle window; got %f", qps)
+	}
+}
+
+// TestReplicaLoadWriteBytes verifies that the rate of bytes written is
+// reported for the last complete window.
+func TestReplicaLoadWriteBytes(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rl := newReplicaLoad(0)
+
+	for i := 0; i < 100; i++ {
+		rl.recordWrite(int64(i)*int64(time.Millisecond), 1000)
+	}
+	if rate := rl.WriteBytesPerSecond(int64(loadWindow) - 1); rate != 0 {
+		t.Errorf("expected no rate before the end of the first window; got %f", rate)
+	}
+	if rate, expRate := rl.WriteBytesPerSecond(int64(loadWindow)), 100000/loadWindow.Seconds(); rate != expRate {
+		t.Errorf("expected rate=%f; got %f", expRate, rate)
+	}
+	if rate := rl.WriteBytesPerSecond(2 * int64(loadWindow)); rate != 0 {
+		t.Errorf("expected rate=0 after an idle window; got %f", rate)
+	}
+}
+
+// TestReplicaLoadSplitKey verifies that the split key balances the
+// requests observed by the range.
+func TestReplicaLoadSplitKey(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	key := func(i int) roachpb.RKey {
+		return roachpb.RKey(fmt.Sprintf("k%03d", i))
+	}
+
+	testCases := []struct {
+		// keyFn returns the span of the i'th request.
+		keyFn  func(i int) (roachpb.RKey, roachpb.RKey)
+		expMin int
+		expMax int
+		expNil bool
+	}{
+		// Uniform point requests split near the middle.
+		{func(i int) (roachpb.RKey, roachpb.RKey) { return key(i % 100), nil }, 40, 60, false},
+		// Requests skewed to the lower keys split among them.
+		{func(i int) (roachpb.RKey, roachpb.RKey) {
+			if i%4 == 0 {
+				return key(50 + i%50), nil
+			}
+			return key(i % 20), nil
+		}, 5, 15, false},
+		// A single hot key can't be split.
+		{func(i int) (roachpb.RKey, roachpb.RKey) { return key(7), nil }, 0, 0, true},
+		// Scans of the whole key space can't be split.
+		{func(i int) (roachpb.RKey, roachpb.RKey) { return key(i % 100), key(100) }, 0, 0, true},
+	}
+
+	for i, test := range testCases {
+		rl := newReplicaLoad(0)
+		for j := 0; j < 1000; j++ {
+			k, endKey := test.keyFn(j)
+			rl.record(int64(j)*int64(time.Millisecond), k, endKey)
+		}
+		splitKey := rl.splitKey(int64(loadWindow))
+		if test.expNil {
+			if splitKey != nil {
+				t.Errorf("%d: expected no split key; got %s", i, splitKey)
+			}
+			continue
+		}
+		if splitKey == nil {
+			t.Errorf("%d: expected a split key", i)
+			continue
+		}
+		if splitKey.Less(key(test.expMin)) || key(test.expMax).Less(splitKey) {
+			t.Errorf("%d: expected split key in [%s, %s]; got %s", i, key(test.expMin), key(test.expMax), splitKey)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync/atomic"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// A replica whose on-disk data is found to be corrupted is quarantined
+// rather than bringing down its node. A quarantined replica:
+//
+// - is marked as corrupted in its range-local metadata, so that it
+//   remains quarantined across restarts;
+// - serves neither reads nor proposals, redirecting clients to the other
+//   replicas of its range instead;
+// - is withdrawn from raft, so that it neither votes nor acknowledges log
+//   entries (see multiraft.QuarantiningStorage).
+//
+// The range GC queue then removes the replica from its range, which lets
+// the range leader's replicate queue up-replicate the range elsewhere, and
+// finally destroys the replica's data.
+
+// isCorrupted returns whether the replica has been quarantined.
+func (r *Replica) isCorrupted() bool {
+	return atomic.LoadInt32(&r.corrupted) != 0
+}
+
+// loadCorrupted returns whether the replica was marked as corrupted in its
+// range-local metadata.
+func (r *Replica) loadCorrupted() (bool, error) {
+	var detectedAt roachpb.Timestamp
+	return engine.MVCCGetProto(r.rm.Engine(), keys.RangeCorruptionKey(r.Desc().RangeID),
+		roachpb.ZeroTimestamp, true, nil, &detectedAt)
+}
+
+// quarantine withdraws the replica from service because of the given
+// error, which indicates that its on-disk data is corrupted.
+func (r *Replica) quarantine(cause error) {
+	if !atomic.CompareAndSwapInt32(&r.corrupted, 0, 1) {
+		return
+	}
+	log.Errorc(r.context(), "quarantining replica; probable data corruption: %s", cause)
+
+	// The engine may well be unable to persist the marker; the replica is
+	// quarantined regardless until the node restarts.
+	now := r.rm.Clock().Now()
+	if err := engine.MVCCPutProto(r.rm.Engine(), nil, keys.RangeCorruptionKey(r.Desc().RangeID),
+		roachpb.ZeroTimestamp, nil, &now); err != nil {
+		log.Errorc(r.context(), "unable to mark replica as corrupted: %s", err)
+	}
+	if err := r.rm.quarantineReplica(r); err != nil {
+		log.Errorc(r.context(), "unable to withdraw replica from raft: %s", err)
+	}
+	if err := r.rm.rangeGCQueue().Add(r, 1.0); err != nil {
+		log.Errorc(r.context(), "unable to add replica to GC queue: %s", err)
+	}
+}
+
+// corruptionRedirect returns the error with which a quarantined replica
+// answers requests: a NotLeaderError naming the leader if another replica
+// is known to hold the leader lease, or any other replica otherwise.
+func (r *Replica) corruptionRedirect() error {
+	storeID := r.rm.StoreID()
+	lease := r.getLease()
+	if lease == nil || lease.Replica.ReplicaID == 0 || lease.OwnedBy(storeID) {
+		lease = nil
+		for _, rep := range r.Desc().Replicas {
+			if rep.StoreID != storeID {
+				lease = &roachpb.Lease{Replica: rep}
+				break
+			}
+		}
+	}
+	if lease == nil {
+		return util.Errorf("%s is quarantined and has no other replicas", r)
+	}
+	return r.newNotLeaderError(lease, storeID)
+}
@@ -247,12 +247,19 @@ func setLastIndex(eng engine.Engine, rangeID roachpb.RangeID, lastIndex uint64)
 		}, nil)
 }
 
-// Snapshot implements the raft.Storage interface.
+// Snapshot implements the raft.Storage interface. Only the range
+// descriptor is included in the returned snapshot. The range data is read
+// from a consistent RocksDB snapshot which is pinned until the data has
+// been streamed to the recipient (see Store.StreamSnapshot).
 func (r *Replica) Snapshot() (raftpb.Snapshot, error) {
-	// Copy all the data from a consistent RocksDB snapshot into a RaftSnapshotData.
 	snap := r.rm.NewSnapshot()
-	defer snap.Close()
-	var snapData roachpb.RaftSnapshotData
+	pinned := false
+	defer func() {
+		if !pinned {
+			snap.Close()
+		}
+	}()
+	snapData := roachpb.RaftSnapshotData{Chunked: true}
 
 	// Read the range metadata from the snapshot instead of the members
 	// of the Range struct because they might be changed concurrently.
@@ -278,13 +285,6 @@ func (r *Replica) Snapshot() (raftpb.Snapshot, error) {
 	// Store RangeDescriptor as metadata, it will be retrieved by ApplySnapshot()
 	snapData.RangeDescriptor = desc
 
-	// Iterate over all the data in the range, including local-only data like
-	// the response cache.
-	for iter := newRangeDataIterator(curDesc, snap); iter.Valid(); iter.Next() {
-		snapData.KV = append(snapData.KV,
-			&roachpb.RaftSnapshotData_KeyValue{Key: iter.Key(), Value: iter.Value()})
-	}
-
 	data, err := proto.Marshal(&snapData)
 	if err != nil {
 		return raftpb.Snapshot{}, err
@@ -301,6 +301,11 @@ func (r *Replica) Snapshot() (raftpb.Snapshot, error) {
 		return raftpb.Snapshot{}, util.Errorf("failed to fetch term of %d: %s", appliedIndex, err)
 	}
 
+	// All the data in the range, including local-only data like the
+	// response cache, is streamed from the pinned snapshot.
+	r.rm.pinSnapshot(desc.RangeID, appliedIndex, snap, desc)
+	pinned = true
+
 	return raftpb.Snapshot{
 		Data: data,
 		Metadata: raftpb.SnapshotMetadata{
@@ -379,6 +384,7 @@ func (r *Replica) updateRangeInfo() error {
 	}
 
 	r.SetMaxBytes(zone.RangeMaxBytes)
+	r.SetMaxQPS(zone.RangeMaxQPS)
 	return nil
 }
 
@@ -403,20 +409,48 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 	// Extract the updated range descriptor.
 	desc := snapData.RangeDescriptor
 
-	batch := r.rm.Engine().NewBatch()
-	defer batch.Close()
+	// The existing range data is deleted as of the state of the engine just
+	// before the batch is committed; the replica lock is held meanwhile so
+	// that no write to the range is missed.
+	r.Lock()
+	locked := true
+	defer func() {
+		if locked {
+			r.Unlock()
+		}
+	}()
 
-	// Delete everything in the range and recreate it from the snapshot.
-	for iter := newRangeDataIterator(&desc, r.rm.Engine()); iter.Valid(); iter.Next() {
-		if err := batch.Clear(iter.Key()); err != nil {
+	var batch engine.Engine
+	if snapData.Chunked {
+		// The range data has been received in chunks and written to a
+		// batch. Delete the rest of the existing range data.
+		in, err := r.rm.takeIncomingSnapshot(rangeID, snap.Metadata.Index, snap.Metadata.Term)
+		if err != nil {
 			return err
 		}
+		batch = in.batch
+		defer batch.Close()
+		if err := in.clearRangeData(&desc, r.rm.Engine()); err != nil {
+			return err
+		}
+	} else {
+		batch = r.rm.Engine().NewBatch()
+		defer batch.Close()
 	}
 
-	// Write the snapshot into the range.
-	for _, kv := range snapData.KV {
-		if err := batch.Put(kv.Key, kv.Value); err != nil {
-			return err
+	if !snapData.Chunked {
+		// Delete everything in the range and recreate it from the snapshot.
+		for iter := newRangeDataIterator(&desc, r.rm.Engine()); iter.Valid(); iter.Next() {
+			if err := batch.Clear(iter.Key()); err != nil {
+				return err
+			}
+		}
+
+		// Write the snapshot into the range.
+		for _, kv := range snapData.KV {
+			if err := batch.Put(kv.Key, kv.Value); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -459,7 +493,10 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 		return err
 	}
 
-	if err := batch.Commit(); err != nil {
+	err = batch.Commit()
+	r.Unlock()
+	locked = false
+	if err != nil {
 		return err
 	}
 
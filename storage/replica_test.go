@@ -144,8 +144,10 @@ func (tc *testContext) Start(t testing.TB) {
 		}
 		// Now that we have our actual store, monkey patch the sender used in ctx.DB.
 		sender.store = tc.store
-		// We created the store without a real KV client, so it can't perform splits.
+		// We created the store without a real KV client, so it can't perform
+		// splits or merges.
 		tc.store.splitQueue().SetDisabled(true)
+		tc.store.mergeQueue.SetDisabled(true)
 
 		if tc.rng == nil && tc.bootstrapMode == bootstrapRangeWithMetadata {
 			if err := tc.store.BootstrapRange(nil); err != nil {
@@ -368,6 +370,112 @@ func TestRangeReadConsistency(t *testing.T) {
 	}
 }
 
+// TestReplicaFollowerRead verifies that a replica which doesn't hold the
+// leader lease serves consistent reads at or below the closed timestamp it
+// has applied, and redirects more recent reads to the lease holder.
+func TestReplicaFollowerRead(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.store.ctx.ClosedTimestampInterval = time.Second
+
+	secondReplica := roachpb.ReplicaDescriptor{
+		NodeID:    2,
+		StoreID:   2,
+		ReplicaID: 2,
+	}
+	rngDesc := tc.rng.Desc()
+	rngDesc.Replicas = append(rngDesc.Replicas, secondReplica)
+	tc.rng.setDescWithoutProcessUpdate(rngDesc)
+
+	key := roachpb.Key("a")
+	tc.manualClock.Set(int64(10 * time.Second))
+	pArgs := putArgs(key, []byte("value"))
+	writeTS := tc.clock.Now()
+	if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+		Timestamp: writeTS,
+	}, &pArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	// A later write carries a closed timestamp above the first one.
+	tc.manualClock.Set(int64(10*time.Second + 2*ClosedTimestampTargetLag))
+	pArgs = putArgs(roachpb.Key("b"), []byte("value"))
+	if _, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+		Timestamp: tc.clock.Now(),
+	}, &pArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	// Hand the lease to the second replica.
+	start := tc.rng.getLease().Expiration.Add(1, 0)
+	tc.manualClock.Set(start.WallTime)
+	setLeaderLease(t, tc.rng, &roachpb.Lease{
+		Start:      start,
+		Expiration: start.Add(10, 0),
+		Replica:    secondReplica,
+	})
+
+	// A read below the closed timestamp is served locally.
+	gArgs := getArgs(key)
+	reply, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), roachpb.Header{
+		Timestamp: writeTS.Add(1, 0),
+	}, &gArgs)
+	if err != nil {
+		t.Fatalf("expected follower read to succeed: %s", err)
+	}
+	if v := reply.(*roachpb.GetResponse).Value; v == nil {
+		t.Errorf("expected follower read to return the written value")
+	}
+
+	// A transactional read, or one above the closed timestamp, is not.
+	txn := newTransaction("test", key, 1, roachpb.SERIALIZABLE, tc.clock)
+	txn.Timestamp = writeTS.Add(1, 0)
+	for i, h := range []roachpb.Header{
+		{Timestamp: tc.clock.Now()},
+		{Timestamp: txn.Timestamp, Txn: txn},
+	} {
+		_, err := client.SendWrappedWith(tc.Sender(), tc.rng.context(), h, &gArgs)
+		if _, ok := err.(*roachpb.NotLeaderError); !ok {
+			t.Errorf("%d: expected not leader error; got %v", i, err)
+		}
+	}
+}
+
+// TestReplicaCloseTimestamp verifies that the closed timestamp trails the
+// clock, stays below writes in flight and is enforced through the timestamp
+// cache.
+func TestReplicaCloseTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	now := roachpb.Timestamp{WallTime: int64(10 * time.Second)}
+	inflight := roachpb.Timestamp{WallTime: int64(5 * time.Second)}
+
+	tc.rng.Lock()
+	defer tc.rng.Unlock()
+	if closed := tc.rng.closeTimestampLocked(roachpb.Timestamp{WallTime: 1}); !closed.Equal(roachpb.ZeroTimestamp) {
+		t.Errorf("expected no closed timestamp this early; got %s", closed)
+	}
+	tc.rng.trackWriteLocked(inflight)
+	if closed, exp := tc.rng.closeTimestampLocked(now), inflight.Prev(); !closed.Equal(exp) {
+		t.Errorf("expected closed timestamp %s; got %s", exp, closed)
+	}
+	tc.rng.Unlock()
+	tc.rng.untrackWrite(inflight)
+	tc.rng.Lock()
+	exp := now.Add(-ClosedTimestampTargetLag.Nanoseconds(), 0)
+	if closed := tc.rng.closeTimestampLocked(now); !closed.Equal(exp) {
+		t.Errorf("expected closed timestamp %s; got %s", exp, closed)
+	}
+	if rTS, _ := tc.rng.tsCache.GetMax(roachpb.Key("a"), nil, nil); rTS.Less(exp) {
+		t.Errorf("expected timestamp cache low water of at least %s; got %s", exp, rTS)
+	}
+}
+
 // TestApplyCmdLeaseError verifies that when during application of a Raft
 // command the proposing node no longer holds the leader lease, an error is
 // returned. This prevents regression of #1483.
@@ -492,6 +600,56 @@ func TestRangeLeaderLease(t *testing.T) {
 	}
 }
 
+// TestRangeTransferLeaderLease verifies that the holder of the leader lease
+// can hand it over to another replica of the range, after which it
+// redirects requests to the new holder.
+func TestRangeTransferLeaderLease(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Modify range descriptor to include a second replica; leader lease can
+	// only be obtained by Replicas which are part of the range descriptor. This
+	// workaround is sufficient for the purpose of this test.
+	secondReplica := roachpb.ReplicaDescriptor{
+		NodeID:    2,
+		StoreID:   2,
+		ReplicaID: 2,
+	}
+	rngDesc := tc.rng.Desc()
+	rngDesc.Replicas = append(rngDesc.Replicas, secondReplica)
+	tc.rng.setDescWithoutProcessUpdate(rngDesc)
+
+	if err := tc.rng.redirectOnOrAcquireLeaderLease(nil, tc.clock.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := tc.rng.transferLeaderLease(rngDesc.Replicas[0]); err == nil {
+		t.Errorf("expected error transferring lease to the current holder")
+	}
+	if err := tc.rng.transferLeaderLease(roachpb.ReplicaDescriptor{NodeID: 3, StoreID: 3, ReplicaID: 3}); err == nil {
+		t.Errorf("expected error transferring lease to a store without a replica")
+	}
+	if err := tc.rng.transferLeaderLease(secondReplica); err != nil {
+		t.Fatal(err)
+	}
+	if held, expired := hasLease(tc.rng, tc.clock.Now()); held || expired {
+		t.Errorf("expected the second replica to hold the leader lease")
+	}
+	if lease := tc.rng.getLease(); lease.Replica != secondReplica {
+		t.Errorf("expected lease to be held by %+v; got %+v", secondReplica, lease.Replica)
+	}
+
+	err := tc.rng.redirectOnOrAcquireLeaderLease(nil, tc.clock.Now())
+	if lErr, ok := err.(*roachpb.NotLeaderError); !ok || lErr == nil {
+		t.Fatalf("wanted NotLeaderError, got %s", err)
+	}
+	err = tc.rng.transferLeaderLease(rngDesc.Replicas[0])
+	if lErr, ok := err.(*roachpb.NotLeaderError); !ok || lErr == nil {
+		t.Fatalf("wanted NotLeaderError transferring a lease not held, got %s", err)
+	}
+}
+
 func TestRangeNotLeaderError(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	tc := testContext{}
@@ -2622,6 +2780,119 @@ func TestReplicaCorruption(t *testing.T) {
 	}
 }
 
+// TestReplicaQuarantine verifies that a quarantined replica stops serving
+// commands, is withdrawn from raft and remains quarantined when reloaded.
+func TestReplicaQuarantine(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	args := putArgs(roachpb.Key("test"), []byte("value"))
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &args); err != nil {
+		t.Fatal(err)
+	}
+	tc.rng.quarantine(errors.New("boom"))
+
+	rangeID := tc.rng.Desc().RangeID
+	if !tc.store.Quarantined(rangeID) {
+		t.Errorf("expected range %d to be quarantined", rangeID)
+	}
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &args); !testutils.IsError(err, "quarantined") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := tc.store.multiraft.CreateGroup(rangeID); !testutils.IsError(err, "quarantined") {
+		t.Errorf("unexpected error creating raft group: %v", err)
+	}
+
+	// The marker persists, so a reloaded replica is still quarantined.
+	rng, err := NewReplica(tc.rng.Desc(), tc.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rng.isCorrupted() {
+		t.Errorf("expected reloaded replica to be quarantined")
+	}
+}
+
+// TestReplicaCollectChecksum verifies that the leader's snapshot of the range
+// data is not part of any raft command, but is retained by the leader once
+// its checksum is computed and returned by CollectChecksum.
+func TestReplicaCollectChecksum(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Write enough data for the snapshot to be collected in several chunks.
+	const count = 100
+	value := bytes.Repeat([]byte("v"), 2*snapshotChunkSize/count)
+	for i := 0; i < count; i++ {
+		args := putArgs(roachpb.Key(fmt.Sprintf("test%03d", i)), value)
+		if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &args); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	id := uuid.NewUUID4()
+	key := tc.rng.Desc().StartKey.AsRawKey()
+	collectArgs := &roachpb.CollectChecksumRequest{
+		Span:       roachpb.Span{Key: key},
+		ChecksumID: id,
+	}
+	// Nothing can be collected before the check was run.
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), collectArgs); !testutils.IsError(err, "no checksum") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	computeArgs := &roachpb.ComputeChecksumRequest{
+		Span:       roachpb.Span{Key: key},
+		ChecksumID: id,
+		Snapshot:   true,
+	}
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), computeArgs); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tc.rng.getChecksum(id); err != nil {
+		t.Fatal(err)
+	}
+
+	found, chunks := map[string]struct{}{}, 0
+	for {
+		resp, err := client.SendWrapped(tc.Sender(), tc.rng.context(), collectArgs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reply := resp.(*roachpb.CollectChecksumResponse)
+		var snapData roachpb.RaftSnapshotData
+		if err := proto.Unmarshal(reply.Snapshot, &snapData); err != nil {
+			t.Fatal(err)
+		}
+		chunks++
+		for _, kv := range snapData.KV {
+			if decoded, _, _, err := engine.MVCCDecodeKey(kv.Key); err == nil && bytes.HasPrefix(decoded, roachpb.Key("test")) {
+				found[string(decoded)] = struct{}{}
+			}
+		}
+		if len(reply.ResumeKey) == 0 {
+			break
+		}
+		collectArgs.ResumeKey = reply.ResumeKey
+	}
+	if len(found) != count {
+		t.Errorf("expected the leader's snapshot to contain %d keys; got %d", count, len(found))
+	}
+	if chunks < 2 {
+		t.Errorf("expected the leader's snapshot to be collected in several chunks; got %d", chunks)
+	}
+
+	// The snapshot is released once collected.
+	collectArgs.ResumeKey = nil
+	if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), collectArgs); !testutils.IsError(err, "already collected") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 // TestChangeReplicasDuplicateError tests that a replica change that would
 // use a NodeID twice in the replica configuration fails.
 func TestChangeReplicasDuplicateError(t *testing.T) {
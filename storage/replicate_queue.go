@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"math"
 	"time"
 
 	"github.com/cockroachdb/cockroach/config"
@@ -38,7 +39,8 @@ const (
 )
 
 // replicateQueue manages a queue of replicas which may need to add an
-// additional replica to their range.
+// additional replica to their range, or to hand their leader lease over to
+// another replica.
 type replicateQueue struct {
 	*baseQueue
 	allocator Allocator
@@ -87,9 +89,43 @@ func (rq replicateQueue) shouldQueue(now roachpb.Timestamp, repl *Replica,
 	if action != AllocatorNoop {
 		return true, priority
 	}
+	// See if the leader lease should be moved to another replica.
+	if lease := repl.getLease(); lease.OwnedBy(repl.rm.StoreID()) && lease.Covers(now) &&
+		rq.allocator.ShouldTransferLease(*zone, desc.Replicas, repl.rm.StoreID()) {
+		return true, 0
+	}
 	// See if there is a rebalancing opportunity present.
-	shouldRebalance := rq.allocator.ShouldRebalance(repl.rm.StoreID())
-	return shouldRebalance, 0
+	if !rq.allocator.ShouldRebalance(repl.rm.StoreID()) {
+		return false, 0
+	}
+	// An overloaded store only sheds the replicas which carry its load, the
+	// most loaded first.
+	if rq.allocator.isOverloaded(repl.rm.StoreID()) {
+		qpsShare, writeShare := rq.replicaLoadShare(repl)
+		share := math.Max(qpsShare, writeShare)
+		return share > 0, share
+	}
+	return true, 0
+}
+
+// replicaLoadShare returns the fractions of the request rate and of the rate
+// of bytes written of the replica's store which are due to the replica.
+func (rq replicateQueue) replicaLoadShare(repl *Replica) (qpsShare, writeShare float64) {
+	qps, writeBytes := repl.loadRates()
+	return rq.allocator.loadShare(repl.rm.StoreID(), qps, writeBytes)
+}
+
+// moveLoad accounts for the request rate and rate of bytes written moved
+// from one store to another by a replica or leader lease change in the
+// store pool's descriptors of the stores, until they next gossip their own.
+// A zero store ID stands for no store.
+func (rq replicateQueue) moveLoad(from, to roachpb.StoreID, qps, writeBytes float64) {
+	if from != 0 {
+		rq.allocator.storePool.updateLocalStoreLoad(from, -qps, -writeBytes)
+	}
+	if to != 0 {
+		rq.allocator.storePool.updateLocalStoreLoad(to, qps, writeBytes)
+	}
 }
 
 func (rq replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *config.SystemConfig) error {
@@ -100,6 +136,8 @@ func (rq replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *c
 		return err
 	}
 	action, _ := rq.allocator.ComputeAction(*zone, desc)
+	storeID := repl.rm.StoreID()
+	qps, writeBytes := repl.loadRates()
 
 	// Avoid taking action if the range has too many dead replicas to make
 	// quorum.
@@ -123,14 +161,34 @@ func (rq replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *c
 		if err = repl.ChangeReplicas(roachpb.ADD_REPLICA, newReplica, desc); err != nil {
 			return err
 		}
+		rq.moveLoad(0, newStore.StoreID, 0, writeBytes)
 	case AllocatorRemove:
-		removeReplica, err := rq.allocator.RemoveTarget(desc.Replicas)
+		removeReplica, err := rq.allocator.RemoveTarget(zone.ReplicaAttrs[0], desc.Replicas)
 		if err != nil {
 			return err
 		}
 		if err = repl.ChangeReplicas(roachpb.REMOVE_REPLICA, removeReplica, desc); err != nil {
 			return err
 		}
+		// Do not requeue if we removed ourselves. The reads served by this
+		// replica, the lease holder, move along with the lease.
+		if removeReplica.StoreID == storeID {
+			rq.moveLoad(storeID, 0, qps, writeBytes)
+			return nil
+		}
+		rq.moveLoad(removeReplica.StoreID, 0, 0, writeBytes)
+	case AllocatorRemoveDecommissioning:
+		decommissioningReplicas := rq.allocator.storePool.decommissioningReplicas(desc.Replicas)
+		if len(decommissioningReplicas) == 0 {
+			if log.V(1) {
+				log.Warningf("Range of replica %s was identified as having decommissioning replicas, but none were found.", repl)
+			}
+			break
+		}
+		removeReplica := decommissioningReplicas[0]
+		if err = repl.ChangeReplicas(roachpb.REMOVE_REPLICA, removeReplica, desc); err != nil {
+			return err
+		}
 		// Do not requeue if we removed ourselves.
 		if removeReplica.StoreID == repl.rm.StoreID() {
 			return nil
@@ -147,7 +205,33 @@ func (rq replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *c
 		}
 	case AllocatorNoop:
 		// The Noop case will result if this replica was queued in order to
-		// rebalance. Attempt to find a rebalancing target.
+		// move its leader lease or to rebalance. Attempt to find a lease
+		// transfer target first, then a rebalancing target.
+		if target := rq.allocator.TransferLeaseTarget(*zone, desc.Replicas, storeID); target != nil {
+			// Once the lease is transferred, any further changes are up to
+			// the new lease holder.
+			return rq.transferLeaderLease(repl, *target, qps)
+		}
+		if rq.allocator.isOverloaded(storeID) {
+			qpsShare, writeShare := rq.replicaLoadShare(repl)
+			if qpsShare == 0 && writeShare == 0 {
+				// Moving a replica which carries none of the load would not
+				// relieve the store.
+				return nil
+			}
+			// The load of a range which is mostly read is shed by moving its
+			// lease, if there is a replica on a store which can take it.
+			if qpsShare > writeShare {
+				if target := rq.allocator.LoadTransferLeaseTarget(*zone, desc.Replicas, storeID); target != nil {
+					return rq.transferLeaderLease(repl, *target, qps)
+				}
+			}
+		} else if !rq.allocator.shouldRebalance(storeID) {
+			// The store doesn't need rebalancing anymore, for instance because
+			// the replicas and leases moved off it since this replica was
+			// queued brought its projected load below the threshold.
+			return nil
+		}
 		rebalanceStore := rq.allocator.RebalanceTarget(zone.ReplicaAttrs[0], desc.Replicas)
 		if rebalanceStore == nil {
 			// No action was necessary and no rebalance target was found. Return
@@ -161,6 +245,7 @@ func (rq replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *c
 		if err = repl.ChangeReplicas(roachpb.ADD_REPLICA, rebalanceReplica, desc); err != nil {
 			return err
 		}
+		rq.moveLoad(0, rebalanceStore.StoreID, 0, writeBytes)
 	}
 
 	// Enqueue this replica again to see if there are more changes to be made.
@@ -168,6 +253,16 @@ func (rq replicateQueue) process(now roachpb.Timestamp, repl *Replica, sysCfg *c
 	return nil
 }
 
+// transferLeaderLease transfers the leader lease of the replica to the
+// target, moving the replica's read load along with it.
+func (rq replicateQueue) transferLeaderLease(repl *Replica, target roachpb.ReplicaDescriptor, qps float64) error {
+	if err := repl.transferLeaderLease(target); err != nil {
+		return err
+	}
+	rq.moveLoad(repl.rm.StoreID(), target.StoreID, qps, 0)
+	return nil
+}
+
 func (rq replicateQueue) timer() time.Duration {
 	return replicateQueueTimerDuration
 }
@@ -35,8 +35,8 @@ const (
 	splitQueueTimerDuration = 0 // zero duration to process splits greedily.
 )
 
-// splitQueue manages a queue of ranges slated to be split due to size
-// or along intersecting zone config boundaries.
+// splitQueue manages a queue of ranges slated to be split due to size,
+// due to load or along intersecting zone config boundaries.
 type splitQueue struct {
 	*baseQueue
 	db *client.DB
@@ -61,7 +61,8 @@ func (sq *splitQueue) acceptsUnsplitRanges() bool {
 
 // shouldQueue determines whether a range should be queued for
 // splitting. This is true if the range is intersected by a zone config
-// prefix or if the range's size in bytes exceeds the limit for the zone.
+// prefix or if the range's size in bytes or request rate exceeds the
+// limit for the zone.
 func (sq *splitQueue) shouldQueue(now roachpb.Timestamp, rng *Replica,
 	sysCfg *config.SystemConfig) (shouldQ bool, priority float64) {
 
@@ -84,6 +85,15 @@ func (sq *splitQueue) shouldQueue(now roachpb.Timestamp, rng *Replica,
 		priority += ratio
 		shouldQ = true
 	}
+
+	// Add priority based on the request rate of the range compared to the
+	// max rate for the zone it's in.
+	if zone.RangeMaxQPS > 0 {
+		if ratio := rng.load.QPS(now.WallTime) / float64(zone.RangeMaxQPS); ratio > 1 {
+			priority += ratio
+			shouldQ = true
+		}
+	}
 	return
 }
 
@@ -117,6 +127,28 @@ func (sq *splitQueue) process(now roachpb.Timestamp, rng *Replica,
 		}); err != nil {
 			return err
 		}
+		return nil
+	}
+
+	// Finally handle case of splitting due to load, at the key which best
+	// balances the requests observed by the range.
+	if zone.RangeMaxQPS > 0 {
+		if qps := rng.load.QPS(now.WallTime); qps > float64(zone.RangeMaxQPS) {
+			splitKey := rng.load.splitKey(now.WallTime)
+			if splitKey == nil || !desc.StartKey.Less(splitKey) || !splitKey.Less(desc.EndKey) {
+				if log.V(1) {
+					log.Infof("no load-based split key found for %s qps=%.2f", rng, qps)
+				}
+				return nil
+			}
+			log.Infof("splitting %s at key %s qps=%.2f max=%d", rng, splitKey, qps, zone.RangeMaxQPS)
+			if _, err = client.SendWrapped(rng, rng.context(), &roachpb.AdminSplitRequest{
+				Span:     roachpb.Span{Key: desc.StartKey.AsRawKey()},
+				SplitKey: splitKey.AsRawKey(),
+			}); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -55,6 +55,9 @@ const (
 	defaultRaftTickInterval         = 100 * time.Millisecond
 	defaultHeartbeatIntervalTicks   = 3
 	defaultRaftElectionTimeoutTicks = 15
+	// defaultSnapshotBytesPerSecond is the default rate at which a store
+	// sends the data of raft snapshots.
+	defaultSnapshotBytesPerSecond = 8 << 20
 	// ttlStoreGossip is time-to-live for store-related info.
 	ttlStoreGossip = 2 * time.Minute
 )
@@ -263,16 +266,18 @@ type Store struct {
 	Ident             roachpb.StoreIdent
 	ctx               StoreContext
 	db                *client.DB
-	engine            engine.Engine   // The underlying key-value store
-	_allocator        Allocator       // Makes allocation decisions
-	rangeIDAlloc      *idAllocator    // Range ID allocator
-	gcQueue           *gcQueue        // Garbage collection queue
-	_splitQueue       *splitQueue     // Range splitting queue
-	verifyQueue       *verifyQueue    // Checksum verification queue
-	replicateQueue    replicateQueue  // Replication queue
-	_rangeGCQueue     *rangeGCQueue   // Range GC queue
-	scanner           *replicaScanner // Range scanner
-	feed              StoreEventFeed  // Event Feed
+	engine            engine.Engine     // The underlying key-value store
+	_allocator        Allocator         // Makes allocation decisions
+	rangeIDAlloc      *idAllocator      // Range ID allocator
+	gcQueue           *gcQueue          // Garbage collection queue
+	_splitQueue       *splitQueue       // Range splitting queue
+	mergeQueue        *mergeQueue       // Range merging queue
+	verifyQueue       *verifyQueue      // Checksum verification queue
+	consistencyQueue  *consistencyQueue // Replica consistency check queue
+	replicateQueue    replicateQueue    // Replication queue
+	_rangeGCQueue     *rangeGCQueue     // Range GC queue
+	scanner           *replicaScanner   // Range scanner
+	feed              StoreEventFeed    // Event Feed
 	removeReplicaChan chan removeReplicaOp
 	proposeChan       chan proposeOp
 	multiraft         *multiraft.MultiRaft
@@ -280,7 +285,12 @@ type Store struct {
 	stopper           *stop.Stopper
 	startedAt         int64
 	nodeDesc          *roachpb.NodeDescriptor
-	initComplete      sync.WaitGroup               // Signaled by async init tasks
+	decommissioning   int32            // Set to 1 once the node is being decommissioned; updated atomically
+	initComplete      sync.WaitGroup   // Signaled by async init tasks
+	snapLimiter       *snapshotLimiter // Limits the rate of outgoing snapshot data
+	snapMu            sync.Mutex       // Protects outSnaps and inSnaps
+	outSnaps          map[roachpb.RangeID]*outgoingSnapshot
+	inSnaps           map[roachpb.RangeID]*incomingSnapshot
 	mu                sync.RWMutex                 // Protects variables below...
 	replicas          map[roachpb.RangeID]*Replica // Map of replicas by Range ID
 	replicasByKey     *btree.BTree                 // btree keyed by ranges end keys.
@@ -289,6 +299,7 @@ type Store struct {
 
 var _ client.Sender = &Store{}
 var _ multiraft.Storage = &Store{}
+var _ multiraft.SnapshotStreamer = &Store{}
 
 // A StoreContext encompasses the auxiliary objects and configuration
 // required to create a store.
@@ -318,6 +329,10 @@ type StoreContext struct {
 	// for local networks.
 	RaftElectionTimeoutTicks int
 
+	// SnapshotBytesPerSecond limits the rate at which the store sends the
+	// data of raft snapshots, summed over all snapshots being sent.
+	SnapshotBytesPerSecond int64
+
 	// ScanInterval is the default value for the scan interval
 	ScanInterval time.Duration
 
@@ -334,6 +349,11 @@ type StoreContext struct {
 	// replicas to other stores.
 	RebalancingOptions RebalancingOptions
 
+	// ClosedTimestampInterval is the interval at which leader lease holders
+	// publish closed timestamps below which followers may serve reads. If
+	// zero, timestamps are not closed and all reads go to the lease holder.
+	ClosedTimestampInterval time.Duration
+
 	// EventFeed is a feed to which this store will publish events.
 	EventFeed *util.Feed
 
@@ -365,6 +385,9 @@ func (sc *StoreContext) setDefaults() {
 	if sc.RaftElectionTimeoutTicks == 0 {
 		sc.RaftElectionTimeoutTicks = defaultRaftElectionTimeoutTicks
 	}
+	if sc.SnapshotBytesPerSecond == 0 {
+		sc.SnapshotBytesPerSecond = defaultSnapshotBytesPerSecond
+	}
 }
 
 // NewStore returns a new instance of a store.
@@ -387,16 +410,19 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *roachpb.NodeDescrip
 		nodeDesc:          nodeDesc,
 		removeReplicaChan: make(chan removeReplicaOp),
 		proposeChan:       make(chan proposeOp),
+		snapLimiter:       &snapshotLimiter{bytesPerSecond: ctx.SnapshotBytesPerSecond},
 	}
 
 	// Add range scanner and configure with queues.
 	s.scanner = newReplicaScanner(ctx.ScanInterval, ctx.ScanMaxIdleTime, newStoreRangeSet(s))
 	s.gcQueue = newGCQueue(s.ctx.Gossip)
 	s._splitQueue = newSplitQueue(s.db, s.ctx.Gossip)
+	s.mergeQueue = newMergeQueue(s.ctx.Gossip)
 	s.verifyQueue = newVerifyQueue(s.ctx.Gossip, s.ReplicaCount)
+	s.consistencyQueue = newConsistencyQueue(s.ctx.Gossip, s.ReplicaCount)
 	s.replicateQueue = makeReplicateQueue(s.ctx.Gossip, s.allocator(), s.ctx.Clock, s.ctx.RebalancingOptions)
 	s._rangeGCQueue = newRangeGCQueue(s.db, s.ctx.Gossip)
-	s.scanner.AddQueues(s.gcQueue, s._splitQueue, s.verifyQueue, s.replicateQueue, s._rangeGCQueue)
+	s.scanner.AddQueues(s.gcQueue, s._splitQueue, s.mergeQueue, s.verifyQueue, s.consistencyQueue, s.replicateQueue, s._rangeGCQueue)
 
 	return s
 }
@@ -535,10 +561,25 @@ func (s *Store) Start(stopper *stop.Stopper) error {
 
 	s.mu.Unlock()
 
+	// Snapshots pinned for sending and staged on receipt are released
+	// when the store stops, before the engine is closed.
+	s.snapMu.Lock()
+	s.outSnaps = map[roachpb.RangeID]*outgoingSnapshot{}
+	s.inSnaps = map[roachpb.RangeID]*incomingSnapshot{}
+	s.snapMu.Unlock()
+	s.stopper.RunWorker(func() {
+		<-s.stopper.ShouldStop()
+		s.releaseSnapshots()
+	})
+
 	// Start Raft processing goroutines.
 	s.multiraft.Start()
 	s.processRaft()
 
+	if s.ctx.ClosedTimestampInterval > 0 {
+		s.startClosedTimestamps()
+	}
+
 	// Gossip is only ever nil while bootstrapping a cluster and
 	// in unittests.
 	if s.ctx.Gossip != nil {
@@ -629,6 +670,32 @@ func (s *Store) startGossip() {
 	})
 }
 
+// startClosedTimestamps runs a goroutine which periodically lets the
+// replicas holding the leader lease publish a closed timestamp, so that
+// follower reads remain possible on ranges without writes.
+func (s *Store) startClosedTimestamps() {
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(s.ctx.ClosedTimestampInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				newStoreRangeSet(s).Visit(func(rng *Replica) bool {
+					select {
+					case <-s.stopper.ShouldStop():
+						return false
+					default:
+					}
+					rng.maybeProposeClosedTimestamp()
+					return true
+				})
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
 // maybeGossipFirstRange checks whether the store has a replia of the first
 // range and if so, reminds it to gossip the first range descriptor and
 // sentinel gossip.
@@ -661,10 +728,12 @@ func (s *Store) maybeGossipSystemConfig() error {
 func (s *Store) systemGossipUpdate(cfg *config.SystemConfig) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	// For every range, update its MaxBytes and check if it needs to be split.
+	// For every range, update its MaxBytes and MaxQPS and check if it
+	// needs to be split.
 	for _, rng := range s.replicas {
 		if zone, err := cfg.GetZoneConfigForKey(rng.Desc().StartKey); err == nil {
 			rng.SetMaxBytes(zone.RangeMaxBytes)
+			rng.SetMaxQPS(zone.RangeMaxQPS)
 		}
 		s.splitQueue().MaybeAdd(rng, s.ctx.Clock.Now())
 	}
@@ -896,6 +965,9 @@ func (s *Store) EventFeed() StoreEventFeed { return s.feed }
 // Tracer accessor.
 func (s *Store) Tracer() *tracer.Tracer { return s.ctx.Tracer }
 
+// closedTimestampInterval accessor.
+func (s *Store) closedTimestampInterval() time.Duration { return s.ctx.ClosedTimestampInterval }
+
 // NewRangeDescriptor creates a new descriptor based on start and end
 // keys and the supplied roachpb.Replicas slice. It allocates new
 // replica IDs to fill out the supplied replicas.
@@ -1048,7 +1120,10 @@ func (s *Store) addReplicaToRangeMap(rng *Replica) error {
 
 type removeReplicaOp struct {
 	rep *Replica
-	ch  chan<- error
+	// groupOnly is set when only the replica's raft group is to be removed;
+	// see quarantineReplica.
+	groupOnly bool
+	ch        chan<- error
 }
 
 // RemoveReplica removes the replica from the store's replica map and from
@@ -1056,10 +1131,24 @@ type removeReplicaOp struct {
 func (s *Store) RemoveReplica(rep *Replica) error {
 
 	ch := make(chan error)
-	s.removeReplicaChan <- removeReplicaOp{rep, ch}
+	s.removeReplicaChan <- removeReplicaOp{rep: rep, ch: ch}
 	return <-ch
 }
 
+// quarantineReplica withdraws a replica found to hold corrupted data from
+// raft by removing its raft group, which Quarantined then prevents from
+// being recreated. The replica itself remains in the store until it has
+// been removed from its range and is garbage collected.
+func (s *Store) quarantineReplica(rep *Replica) error {
+	ch := make(chan error)
+	select {
+	case s.removeReplicaChan <- removeReplicaOp{rep: rep, groupOnly: true, ch: ch}:
+		return <-ch
+	case <-s.stopper.ShouldStop():
+		return util.Errorf("store %s stopped", s)
+	}
+}
+
 // removeReplicaImpl runs on the processRaft goroutine.
 func (s *Store) removeReplicaImpl(rep *Replica) error {
 	rangeID := rep.Desc().RangeID
@@ -1138,15 +1227,47 @@ func (s *Store) Descriptor() (*roachpb.StoreDescriptor, error) {
 		return nil, err
 	}
 	capacity.RangeCount = int32(s.ReplicaCount())
+	capacity.LeaseCount = int32(s.LeaseCount())
+	capacity.QueriesPerSecond, capacity.WriteBytesPerSecond = s.Load()
 	// Initialize the store descriptor.
+	nodeDesc := *s.nodeDesc
+	nodeDesc.Decommissioning = nodeDesc.Decommissioning || s.IsDecommissioning()
 	return &roachpb.StoreDescriptor{
 		StoreID:  s.Ident.StoreID,
 		Attrs:    s.Attrs(),
-		Node:     *s.nodeDesc,
+		Node:     nodeDesc,
 		Capacity: capacity,
 	}, nil
 }
 
+// SetDecommissioning marks the store as belonging to a node which is being
+// decommissioned. This is advertised in the store descriptor gossiped from
+// then on, upon which allocators stop placing replicas on the store and
+// move its existing replicas to other stores.
+func (s *Store) SetDecommissioning() {
+	atomic.StoreInt32(&s.decommissioning, 1)
+}
+
+// IsDecommissioning returns whether the store's node is being
+// decommissioned.
+func (s *Store) IsDecommissioning() bool {
+	return atomic.LoadInt32(&s.decommissioning) == 1
+}
+
+// QueueDecommissioningReplicas adds all replicas of a decommissioning store
+// to the replicate queue, so that they are moved to other stores without
+// waiting for the replica scanner. It does nothing if the store's node isn't
+// being decommissioned.
+func (s *Store) QueueDecommissioningReplicas() {
+	if !s.IsDecommissioning() {
+		return
+	}
+	newStoreRangeSet(s).Visit(func(rng *Replica) bool {
+		s.replicateQueue.MaybeAdd(rng, s.ctx.Clock.Now())
+		return true
+	})
+}
+
 // ReplicaCount returns the number of replicas contained by this store.
 func (s *Store) ReplicaCount() int {
 	s.mu.RLock()
@@ -1154,6 +1275,34 @@ func (s *Store) ReplicaCount() int {
 	return len(s.replicas)
 }
 
+// LeaseCount returns the number of replicas contained by this store which
+// currently hold the leader lease for their range.
+func (s *Store) LeaseCount() int {
+	now := s.ctx.Clock.Now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var count int
+	for _, rng := range s.replicas {
+		if lease := rng.getLease(); lease.OwnedBy(s.Ident.StoreID) && lease.Covers(now) {
+			count++
+		}
+	}
+	return count
+}
+
+// Load returns the request rate and the rate of bytes written summed over
+// the replicas contained by this store.
+func (s *Store) Load() (qps, writeBytesPerSecond float64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, rng := range s.replicas {
+		rngQPS, rngWriteBytes := rng.loadRates()
+		qps += rngQPS
+		writeBytesPerSecond += rngWriteBytes
+	}
+	return qps, writeBytesPerSecond
+}
+
 // Send fetches a range based on the header's replica, assembles
 // method, args & reply into a Raft Cmd struct and executes the
 // command using the fetched range.
@@ -1522,7 +1671,11 @@ func (s *Store) processRaft() {
 				}
 
 			case op := <-s.removeReplicaChan:
-				op.ch <- s.removeReplicaImpl(op.rep)
+				if op.groupOnly {
+					op.ch <- s.multiraft.RemoveGroup(op.rep.Desc().RangeID)
+				} else {
+					op.ch <- s.removeReplicaImpl(op.rep)
+				}
 
 			case op := <-s.proposeChan:
 				op.ch <- s.proposeRaftCommandImpl(op.idKey, op.cmd)
@@ -1569,6 +1722,14 @@ func (s *Store) ReplicaDescriptor(groupID roachpb.RangeID, replicaID roachpb.Rep
 	return rep.ReplicaDescriptor(replicaID)
 }
 
+// Quarantined implements the multiraft.QuarantiningStorage interface.
+func (s *Store) Quarantined(groupID roachpb.RangeID) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.replicas[groupID]
+	return ok && r.isCorrupted()
+}
+
 // ReplicaIDForStore implements the multiraft.Storage interface.
 func (s *Store) ReplicaIDForStore(groupID roachpb.RangeID, storeID roachpb.StoreID) (roachpb.ReplicaID, error) {
 	r, err := s.GetReplica(groupID)
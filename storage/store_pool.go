@@ -19,6 +19,7 @@ package storage
 
 import (
 	"container/heap"
+	"math"
 	"sort"
 	"sync"
 	"time"
@@ -260,6 +261,23 @@ func (sp *StorePool) getStoreDescriptor(storeID roachpb.StoreID) *roachpb.StoreD
 	return &desc
 }
 
+// updateLocalStoreLoad adjusts the load of the given store's descriptor by
+// the request rate and rate of bytes written of a replica or leader lease
+// which was moved to or from it. The adjusted load stands until the store
+// next gossips its descriptor, so that the replicas moved in the meantime
+// are accounted for when deciding whether to move more of them.
+func (sp *StorePool) updateLocalStoreLoad(storeID roachpb.StoreID, qpsDelta, writeBytesDelta float64) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	detail, ok := sp.stores[storeID]
+	if !ok || !detail.gossiped {
+		return
+	}
+	capacity := &detail.desc.Capacity
+	capacity.QueriesPerSecond = math.Max(0, capacity.QueriesPerSecond+qpsDelta)
+	capacity.WriteBytesPerSecond = math.Max(0, capacity.WriteBytesPerSecond+writeBytesDelta)
+}
+
 // findDeadReplicas returns any replicas from the supplied slice that are
 // located on dead stores.
 func (sp *StorePool) deadReplicas(repls []roachpb.ReplicaDescriptor) []roachpb.ReplicaDescriptor {
@@ -272,6 +290,18 @@ func (sp *StorePool) deadReplicas(repls []roachpb.ReplicaDescriptor) []roachpb.R
 	return deadReplicas
 }
 
+// decommissioningReplicas returns any replicas from the supplied slice that
+// are located on live stores of decommissioning nodes.
+func (sp *StorePool) decommissioningReplicas(repls []roachpb.ReplicaDescriptor) []roachpb.ReplicaDescriptor {
+	var decommissioningReplicas []roachpb.ReplicaDescriptor
+	for _, repl := range repls {
+		if detail := sp.getStoreDetail(repl.StoreID); !detail.dead && detail.desc.Node.Decommissioning {
+			decommissioningReplicas = append(decommissioningReplicas, repl)
+		}
+	}
+	return decommissioningReplicas
+}
+
 // stat provides a running sample size and mean.
 type stat struct {
 	n, mean float64
@@ -284,11 +314,12 @@ func (s *stat) update(x float64) {
 	s.mean += (x - s.mean) / s.n
 }
 
-// StoreList holds a list of store descriptors and associated count and used
-// stats for those stores.
+// StoreList holds a list of store descriptors and associated count, used
+// and load stats for those stores.
 type StoreList struct {
-	stores      []*roachpb.StoreDescriptor
-	count, used stat
+	stores          []*roachpb.StoreDescriptor
+	count, used     stat
+	qps, writeBytes stat
 }
 
 // add includes the store descriptor to the list of stores and updates
@@ -297,10 +328,13 @@ func (sl *StoreList) add(s *roachpb.StoreDescriptor) {
 	sl.stores = append(sl.stores, s)
 	sl.count.update(float64(s.Capacity.RangeCount))
 	sl.used.update(s.Capacity.FractionUsed())
+	sl.qps.update(s.Capacity.QueriesPerSecond)
+	sl.writeBytes.update(s.Capacity.WriteBytesPerSecond)
 }
 
 // GetStoreList returns a storeList that contains all active stores that
-// contain the required attributes and their associated stats.
+// contain the required attributes and their associated stats. Stores of
+// decommissioning nodes are not included.
 // TODO(embark, spencer): consider using a reverse index map from
 // Attr->stores, for efficiency. Ensure that entries in this map still
 // have an opportunity to be garbage collected.
@@ -320,7 +354,8 @@ func (sp *StorePool) getStoreList(required roachpb.Attributes, deterministic boo
 	sl := new(StoreList)
 	for _, storeID := range storeIDs {
 		detail := sp.stores[roachpb.StoreID(storeID)]
-		if !detail.dead && required.IsSubset(*detail.desc.CombinedAttrs()) {
+		if !detail.dead && !detail.desc.Node.Decommissioning &&
+			required.IsSubset(*detail.desc.CombinedAttrs()) {
 			desc := detail.desc
 			sl.add(&desc)
 		}
@@ -449,6 +449,27 @@ func TestStoreSend(t *testing.T) {
 	}
 }
 
+// TestStoreDescriptorDecommissioning verifies that the store descriptor
+// advertises a decommissioning node once the store has been told about it.
+func TestStoreDescriptorDecommissioning(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	for _, decommissioning := range []bool{false, true} {
+		if decommissioning {
+			store.SetDecommissioning()
+		}
+		desc, err := store.Descriptor()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if desc.Node.Decommissioning != decommissioning {
+			t.Errorf("expected decommissioning=%t in store descriptor, got %t", decommissioning, desc.Node.Decommissioning)
+		}
+	}
+}
+
 func TestStoreExecuteNoop(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	store, _, stopper := createTestStore(t)
@@ -84,7 +84,8 @@ func (vq *verifyQueue) shouldQueue(now roachpb.Timestamp, rng *Replica,
 
 // process iterates through all keys and values in a range. The very
 // act of scanning keys verifies on-disk checksums, as each block
-// checksum is checked on load.
+// checksum is checked on load. A replica which fails verification is
+// quarantined until it can be replaced and then destroyed.
 func (vq *verifyQueue) process(now roachpb.Timestamp, rng *Replica,
 	_ *config.SystemConfig) error {
 
@@ -99,10 +100,8 @@ func (vq *verifyQueue) process(now roachpb.Timestamp, rng *Replica,
 	// An error during iteration is presumed to mean a checksum failure
 	// while iterating over the underlying key/value data.
 	if iter.Error() != nil {
-		// TODO(spencer): do something other than fatal error here. We
-		// want to quarantine this range, make it a non-participating raft
-		// follower until it can be replaced and then destroyed.
-		log.Fatalf("unhandled failure when scanning range %s; probable data corruption: %s", rng, iter.Error())
+		rng.quarantine(iter.Error())
+		return nil
 	}
 
 	// Store current timestamp as last verification for this range.
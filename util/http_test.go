@@ -78,7 +78,8 @@ var jsonConfig = []byte(`{
     }
   ],
   "range_min_bytes": 1048576,
-  "range_max_bytes": 67108864
+  "range_max_bytes": 67108864,
+  "range_max_qps": 0
 }`)
 
 var protobufConfig []byte
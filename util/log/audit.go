@@ -0,0 +1,97 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package log
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/caller"
+)
+
+// auditTag replaces the severity in the name of the audit log files.
+const auditTag = "AUDIT"
+
+// An AuditEvent records a statement touching an audited table.
+type AuditEvent struct {
+	User      string `json:"user"`
+	Statement string `json:"statement"`
+	Table     string `json:"table"`
+	// Access is "READ" or "WRITE".
+	Access       string `json:"access"`
+	RowsAffected int64  `json:"rows_affected"`
+	// Error is the error the statement failed with, empty on success.
+	Error string `json:"error,omitempty"`
+}
+
+func (e AuditEvent) String() string {
+	status := "OK"
+	if e.Error != "" {
+		status = "ERROR: " + e.Error
+	}
+	return fmt.Sprintf("user=%s table=%s access=%s rows=%d status=%q statement=%q",
+		e.User, e.Table, e.Access, e.RowsAffected, status, e.Statement)
+}
+
+// Audit writes an audit event to the audit log. The audit log is kept in
+// files of its own, separate from those of the severities, and is written
+// regardless of the severity and verbosity settings. The event is also
+// stored as JSON in the log entry.
+func Audit(event AuditEvent) {
+	file, line, _ := caller.Lookup(1)
+	entry := &LogEntry{}
+	setLogEntry(nil, "%s", []interface{}{event}, entry)
+	logging.outputAuditEntry(file, line, entry)
+}
+
+// outputAuditEntry writes a log entry to the audit log file, or to standard
+// error when logging to files is disabled.
+func (l *loggingT) outputAuditEntry(file string, line int, entry *LogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.Severity = int32(InfoLog)
+	entry.Time = time.Now().UnixNano()
+	entry.ThreadID = int32(pid) // TODO: should be TID
+	entry.File = file
+	entry.Line = int32(line)
+
+	if l.toStderr {
+		if _, err := os.Stderr.Write(l.processForStderr(entry)); err != nil {
+			panic(err)
+		}
+		return
+	}
+	if l.audit == nil {
+		sb := &syncBuffer{
+			logger: l,
+			tag:    auditTag,
+		}
+		if err := sb.rotateFile(time.Now()); err != nil {
+			// Make sure the event appears somewhere.
+			if _, err := os.Stderr.Write(l.processForStderr(entry)); err != nil {
+				panic(err)
+			}
+			l.exit(err)
+			return
+		}
+		l.audit = sb
+	}
+	if _, err := l.audit.Write(encodeLogEntry(entry)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// swapAudit sets the audit log writer and returns the old one.
+func (l *loggingT) swapAudit(writer flushSyncWriter) flushSyncWriter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	old := l.audit
+	l.audit = writer
+	return old
+}
+
+func TestAudit(t *testing.T) {
+	setFlags()
+	buf := new(flushBuffer)
+	defer logging.swapAudit(logging.swapAudit(buf))
+	defer logging.swap(logging.newBuffers())
+
+	event := AuditEvent{
+		User:         "foo",
+		Statement:    "SELECT * FROM db.t",
+		Table:        "db.t",
+		Access:       "READ",
+		RowsAffected: 3,
+	}
+	Audit(event)
+
+	var entry LogEntry
+	if err := NewEntryDecoder(bytes.NewReader(buf.Bytes())).Decode(&entry); err != nil {
+		t.Fatal(err)
+	}
+	if len(entry.Args) != 1 {
+		t.Fatalf("expected a single argument, got %+v", entry.Args)
+	}
+	expected := `user=foo table=db.t access=READ rows=3 status="OK" statement="SELECT * FROM db.t"`
+	if entry.Args[0].Str != expected {
+		t.Errorf("expected %q, got %q", expected, entry.Args[0].Str)
+	}
+	var decoded AuditEvent
+	if err := json.Unmarshal(entry.Args[0].Json, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, event) {
+		t.Errorf("expected %+v, got %+v", event, decoded)
+	}
+
+	// Audit events do not go to the severity logs.
+	if c := contents(InfoLog); c != "" {
+		t.Errorf("unexpected info log contents: %q", c)
+	}
+}
+
+func TestAuditFile(t *testing.T) {
+	setFlags()
+	defer logging.swapAudit(logging.swapAudit(nil))
+
+	Audit(AuditEvent{User: "foo", Table: "db.t", Access: "WRITE", Error: "boom"})
+	sb, ok := logging.audit.(*syncBuffer)
+	if !ok {
+		t.Fatal("audit log wasn't created")
+	}
+	if name := sb.file.Name(); !strings.Contains(name, ".log."+auditTag+".") {
+		t.Errorf("unexpected audit log file name %q", name)
+	}
+
+	// The audit log files are not listed with the other log files.
+	files, err := ListLogFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if strings.Contains(f.Name, auditTag) {
+			t.Errorf("unexpected audit log file %s", f.Name)
+		}
+	}
+}
@@ -641,6 +641,8 @@ type loggingT struct {
 	mu sync.Mutex
 	// file holds writer for each of the log types.
 	file [NumSeverity]flushSyncWriter
+	// audit holds the writer for the audit log.
+	audit flushSyncWriter
 	// pcs is used in V to avoid an allocation when computing the caller's PC.
 	pcs [1]uintptr
 	// vmap is a cache of the V Level for each V() call site, identified by PC.
@@ -919,7 +921,7 @@ type syncBuffer struct {
 	logger *loggingT
 	*bufio.Writer
 	file   *os.File
-	sev    Severity
+	tag    string // The severity name, or auditTag
 	nbytes uint64 // The number of bytes written to this file
 }
 
@@ -952,7 +954,7 @@ func (sb *syncBuffer) rotateFile(now time.Time) error {
 		}
 	}
 	var err error
-	sb.file, _, err = create(sb.sev, now)
+	sb.file, _, err = create(sb.tag, now)
 	sb.nbytes = 0
 	if err != nil {
 		return err
@@ -1006,6 +1008,15 @@ func (l *loggingT) removeFilesLocked() error {
 		}
 		l.file[s] = nil
 	}
+	if sb, ok := l.audit.(*syncBuffer); ok {
+		if err := sb.file.Close(); err != nil {
+			return err
+		}
+		if err := os.Remove(sb.file.Name()); err != nil {
+			return err
+		}
+	}
+	l.audit = nil
 	return nil
 }
 
@@ -1018,7 +1029,7 @@ func (l *loggingT) createFiles(sev Severity) error {
 	for s := sev; s >= InfoLog && l.file[s] == nil; s-- {
 		sb := &syncBuffer{
 			logger: l,
-			sev:    s,
+			tag:    s.Name(),
 		}
 		if err := sb.rotateFile(now); err != nil {
 			return err
@@ -1056,6 +1067,10 @@ func (l *loggingT) flushAll() {
 			_ = file.Sync()  // ignore error
 		}
 	}
+	if l.audit != nil {
+		_ = l.audit.Flush() // ignore error
+		_ = l.audit.Sync()  // ignore error
+	}
 }
 
 // CopyStandardLogTo arranges for messages written to the Go "log" package's
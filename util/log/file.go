@@ -93,6 +93,13 @@ func removePeriods(s string) string {
 // logName returns a new log file name containing the severity, with start time
 // t, and the name for the symlink for the severity.
 func logName(severity Severity, t time.Time) (name, link string) {
+	return logNameForTag(severity.Name(), t)
+}
+
+// logNameForTag returns a new log file name containing the tag, which is
+// either a severity name or auditTag, with start time t, and the name for the
+// symlink for the tag.
+func logNameForTag(tag string, t time.Time) (name, link string) {
 	// Replace the ':'s in the time format with '_'s to allow for log files in
 	// Windows.
 	tFormatted := strings.Replace(t.Format(time.RFC3339), ":", "_", -1)
@@ -101,10 +108,10 @@ func logName(severity Severity, t time.Time) (name, link string) {
 		removePeriods(program),
 		removePeriods(host),
 		removePeriods(userName),
-		severity.Name(),
+		tag,
 		tFormatted,
 		pid)
-	return name, removePeriods(program) + "." + severity.Name()
+	return name, removePeriods(program) + "." + tag
 }
 
 // A FileDetails holds all of the particulars that can be parsed by the name of
@@ -157,14 +164,14 @@ func parseLogFilename(filename string) (FileDetails, error) {
 var errDirectoryNotSet = errors.New("log: log directory not set")
 
 // create creates a new log file and returns the file and its filename, which
-// contains severity ("INFO", "FATAL", etc.) and t. If the file is created
+// contains tag ("INFO", "FATAL", "AUDIT", etc.) and t. If the file is created
 // successfully, create also attempts to update the symlink for that tag, ignoring
 // errors.
-func create(severity Severity, t time.Time) (f *os.File, filename string, err error) {
+func create(tag string, t time.Time) (f *os.File, filename string, err error) {
 	if len(*logDir) == 0 {
 		return nil, "", errDirectoryNotSet
 	}
-	name, link := logName(severity, t)
+	name, link := logNameForTag(tag, t)
 	var lastErr error
 	fname := filepath.Join(*logDir, name)
 
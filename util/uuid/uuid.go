@@ -21,8 +21,10 @@ package uuid
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"strings"
 )
 
 const (
@@ -66,3 +68,26 @@ func (u UUID) Short() string {
 	b := []byte(u)
 	return fmt.Sprintf("%08x", b[:4])
 }
+
+// FromString parses a UUID from its canonical text form, optionally without
+// hyphens, in upper case or enclosed in braces.
+func FromString(s string) (UUID, error) {
+	t := s
+	if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+		t = t[1 : len(t)-1]
+	}
+	if len(t) == 36 {
+		if t[8] != '-' || t[13] != '-' || t[18] != '-' || t[23] != '-' {
+			return nil, fmt.Errorf("invalid UUID: %q", s)
+		}
+		t = t[:8] + t[9:13] + t[14:18] + t[19:23] + t[24:]
+	}
+	if len(t) != 2*UUIDSize {
+		return nil, fmt.Errorf("invalid UUID: %q", s)
+	}
+	b, err := hex.DecodeString(t)
+	if err != nil {
+		return nil, fmt.Errorf("invalid UUID: %q", s)
+	}
+	return UUID(b), nil
+}
@@ -40,3 +40,31 @@ func TestUUIDString(t *testing.T) {
 		t.Errorf("expected txn %s; got %s", expStr, str)
 	}
 }
+
+func TestUUIDFromString(t *testing.T) {
+	expStr := "d7aa0f5e-e42d-46d8-bdf7-16e4f9be5ebe"
+	for _, s := range []string{
+		expStr,
+		"D7AA0F5E-E42D-46D8-BDF7-16E4F9BE5EBE",
+		"d7aa0f5ee42d46d8bdf716e4f9be5ebe",
+		"{d7aa0f5e-e42d-46d8-bdf7-16e4f9be5ebe}",
+	} {
+		u, err := uuid.FromString(s)
+		if err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+		if str := u.String(); str != expStr {
+			t.Errorf("%s: expected %s; got %s", s, expStr, str)
+		}
+	}
+	for _, s := range []string{
+		"",
+		"d7aa0f5e-e42d-46d8-bdf7-16e4f9be5eb",
+		"d7aa0f5e_e42d_46d8_bdf7_16e4f9be5ebe",
+		"x7aa0f5e-e42d-46d8-bdf7-16e4f9be5ebe",
+	} {
+		if _, err := uuid.FromString(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}